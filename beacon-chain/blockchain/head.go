@@ -117,20 +117,38 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
 
 	// A chain re-org occurred, so we fire an event notifying the rest of the services.
 	headSlot := s.HeadSlot()
-	if bytesutil.ToBytes32(newHeadBlock.Block.ParentRoot) != bytesutil.ToBytes32(r) {
-		log.WithFields(logrus.Fields{
-			"newSlot": fmt.Sprintf("%d", newHeadBlock.Block.Slot),
-			"oldSlot": fmt.Sprintf("%d", headSlot),
-		}).Debug("Chain reorg occurred")
-		s.stateNotifier.StateFeed().Send(&feed.Event{
-			Type: statefeed.Reorg,
-			Data: &statefeed.ReorgData{
-				NewSlot: newHeadBlock.Block.Slot,
-				OldSlot: headSlot,
-			},
-		})
-
-		reorgCount.Inc()
+	oldHeadRoot := bytesutil.ToBytes32(r)
+	if bytesutil.ToBytes32(newHeadBlock.Block.ParentRoot) != oldHeadRoot {
+		reorg := &statefeed.ReorgData{
+			NewSlot:     newHeadBlock.Block.Slot,
+			OldSlot:     headSlot,
+			NewHeadRoot: headRoot,
+			OldHeadRoot: oldHeadRoot,
+		}
+		ancestorRoot, ancestorSlot, err := s.commonAncestor(ctx, oldHeadRoot, headRoot)
+		if err != nil {
+			log.WithError(err).Error("Could not find common ancestor of reorged heads")
+		} else {
+			reorg.CommonAncestorRoot = ancestorRoot
+			reorg.CommonAncestorSlot = ancestorSlot
+			reorg.Depth = headSlot - ancestorSlot
+		}
+		// The new head may descend from the old head across skipped blocks, which is no reorg.
+		if err != nil || ancestorRoot != oldHeadRoot {
+			log.WithFields(logrus.Fields{
+				"newSlot": fmt.Sprintf("%d", newHeadBlock.Block.Slot),
+				"oldSlot": fmt.Sprintf("%d", headSlot),
+				"depth":   reorg.Depth,
+			}).Debug("Chain reorg occurred")
+			s.stateNotifier.StateFeed().Send(&feed.Event{
+				Type: statefeed.Reorg,
+				Data: reorg,
+			})
+			reorgCount.Inc()
+			if err == nil {
+				reorgDepth.Observe(float64(reorg.Depth))
+			}
+		}
 	}
 
 	// Cache the new head info.
@@ -141,9 +159,61 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
 		return errors.Wrap(err, "could not save head root in DB")
 	}
 
+	// Notify the rest of the services of the new head.
+	s.stateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.NewHead,
+		Data: &statefeed.NewHeadData{
+			Slot:            newHeadBlock.Block.Slot,
+			BlockRoot:       headRoot,
+			StateRoot:       bytesutil.ToBytes32(newHeadBlock.Block.StateRoot),
+			EpochTransition: helpers.SlotToEpoch(newHeadBlock.Block.Slot) > helpers.SlotToEpoch(headSlot),
+		},
+	})
+
 	return nil
 }
 
+// This returns the root and slot of the latest block which both given blocks descend from,
+// walking back the chain with the higher slot until the two chains meet.
+func (s *Service) commonAncestor(ctx context.Context, root1, root2 [32]byte) ([32]byte, uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.commonAncestor")
+	defer span.End()
+
+	b1, err := s.beaconDB.Block(ctx, root1)
+	if err != nil {
+		return [32]byte{}, 0, errors.Wrap(err, "could not get block")
+	}
+	b2, err := s.beaconDB.Block(ctx, root2)
+	if err != nil {
+		return [32]byte{}, 0, errors.Wrap(err, "could not get block")
+	}
+	for root1 != root2 {
+		if ctx.Err() != nil {
+			return [32]byte{}, 0, ctx.Err()
+		}
+		if b1 == nil || b1.Block == nil || b2 == nil || b2.Block == nil {
+			return [32]byte{}, 0, errors.New("nil block")
+		}
+		slot1, slot2 := b1.Block.Slot, b2.Block.Slot
+		if slot1 >= slot2 {
+			root1 = bytesutil.ToBytes32(b1.Block.ParentRoot)
+			if b1, err = s.beaconDB.Block(ctx, root1); err != nil {
+				return [32]byte{}, 0, errors.Wrap(err, "could not get parent block")
+			}
+		}
+		if slot2 >= slot1 {
+			root2 = bytesutil.ToBytes32(b2.Block.ParentRoot)
+			if b2, err = s.beaconDB.Block(ctx, root2); err != nil {
+				return [32]byte{}, 0, errors.Wrap(err, "could not get parent block")
+			}
+		}
+	}
+	if b1 == nil || b1.Block == nil {
+		return [32]byte{}, 0, errors.New("nil block")
+	}
+	return root1, b1.Block.Slot, nil
+}
+
 // This gets called to update canonical root mapping. It does not save head block
 // root in DB. With the inception of initial-sync-cache-state flag, it uses finalized
 // check point as anchors to resume sync therefore head is no longer needed to be saved on per slot basis.
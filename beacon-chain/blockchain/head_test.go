@@ -6,8 +6,12 @@ import (
 	"testing"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
@@ -58,6 +62,41 @@ func TestSaveHead_Different(t *testing.T) {
 	assert.DeepEqual(t, headState.CloneInnerState(), service.headState(ctx).CloneInnerState(), "Head did not change")
 }
 
+func TestSaveHead_SendsNewHeadEvent(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := setupBeaconChain(t, beaconDB)
+
+	oldRoot := [32]byte{'A'}
+	service.head = &head{slot: 0, root: oldRoot}
+
+	newHeadSignedBlock := testutil.NewBeaconBlock()
+	newHeadSignedBlock.Block.Slot = params.BeaconConfig().SlotsPerEpoch
+	newHeadSignedBlock.Block.ParentRoot = oldRoot[:]
+	newHeadSignedBlock.Block.StateRoot = bytesutil.PadTo([]byte{'S'}, 32)
+	require.NoError(t, service.beaconDB.SaveBlock(ctx, newHeadSignedBlock))
+	newRoot, err := newHeadSignedBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	headState := testutil.NewBeaconState()
+	require.NoError(t, headState.SetSlot(newHeadSignedBlock.Block.Slot))
+	require.NoError(t, service.beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Slot: newHeadSignedBlock.Block.Slot, Root: newRoot[:]}))
+	require.NoError(t, service.beaconDB.SaveState(ctx, headState, newRoot))
+
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := service.stateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	require.NoError(t, service.saveHead(ctx, newRoot))
+
+	event := <-stateChannel
+	require.Equal(t, feed.EventType(statefeed.NewHead), event.Type)
+	data, ok := event.Data.(*statefeed.NewHeadData)
+	require.Equal(t, true, ok)
+	assert.Equal(t, newHeadSignedBlock.Block.Slot, data.Slot)
+	assert.Equal(t, newRoot, data.BlockRoot)
+	assert.DeepEqual(t, newHeadSignedBlock.Block.StateRoot, data.StateRoot[:])
+	assert.Equal(t, true, data.EpochTransition)
+}
+
 func TestSaveHead_Different_Reorg(t *testing.T) {
 	ctx := context.Background()
 	hook := logTest.NewGlobal()
@@ -94,6 +133,163 @@ func TestSaveHead_Different_Reorg(t *testing.T) {
 	require.LogsContain(t, hook, "Chain reorg occurred")
 }
 
+func TestSaveHead_SendsChainReorgEvent(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := setupBeaconChain(t, beaconDB)
+
+	// The old chain is genesis <- 1 <- 2, the new chain is genesis <- 3.
+	genesis := testutil.NewBeaconBlock()
+	require.NoError(t, beaconDB.SaveBlock(ctx, genesis))
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	oldParent := testutil.NewBeaconBlock()
+	oldParent.Block.Slot = 1
+	oldParent.Block.ParentRoot = genesisRoot[:]
+	require.NoError(t, beaconDB.SaveBlock(ctx, oldParent))
+	oldParentRoot, err := oldParent.Block.HashTreeRoot()
+	require.NoError(t, err)
+	oldHead := testutil.NewBeaconBlock()
+	oldHead.Block.Slot = 2
+	oldHead.Block.ParentRoot = oldParentRoot[:]
+	require.NoError(t, beaconDB.SaveBlock(ctx, oldHead))
+	oldRoot, err := oldHead.Block.HashTreeRoot()
+	require.NoError(t, err)
+	service.head = &head{slot: oldHead.Block.Slot, root: oldRoot, block: oldHead, state: testutil.NewBeaconState()}
+
+	newHead := testutil.NewBeaconBlock()
+	newHead.Block.Slot = 3
+	newHead.Block.ParentRoot = genesisRoot[:]
+	require.NoError(t, beaconDB.SaveBlock(ctx, newHead))
+	newRoot, err := newHead.Block.HashTreeRoot()
+	require.NoError(t, err)
+	headState := testutil.NewBeaconState()
+	require.NoError(t, headState.SetSlot(newHead.Block.Slot))
+	require.NoError(t, beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Slot: newHead.Block.Slot, Root: newRoot[:]}))
+	require.NoError(t, beaconDB.SaveState(ctx, headState, newRoot))
+
+	stateChannel := make(chan *feed.Event, 3)
+	stateSub := service.stateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	require.NoError(t, service.saveHead(ctx, newRoot))
+
+	var data *statefeed.ReorgData
+	for i := 0; i < 3 && data == nil; i++ {
+		event := <-stateChannel
+		if event.Type == statefeed.Reorg {
+			var ok bool
+			data, ok = event.Data.(*statefeed.ReorgData)
+			require.Equal(t, true, ok)
+		}
+	}
+	require.NotNil(t, data, "No chain reorg event received")
+	assert.Equal(t, oldHead.Block.Slot, data.OldSlot)
+	assert.Equal(t, oldRoot, data.OldHeadRoot)
+	assert.Equal(t, newHead.Block.Slot, data.NewSlot)
+	assert.Equal(t, newRoot, data.NewHeadRoot)
+	assert.Equal(t, uint64(0), data.CommonAncestorSlot)
+	assert.Equal(t, genesisRoot, data.CommonAncestorRoot)
+	assert.Equal(t, uint64(2), data.Depth)
+}
+
+func TestSaveHead_DescendantAcrossSkippedBlock_NoReorg(t *testing.T) {
+	ctx := context.Background()
+	hook := logTest.NewGlobal()
+	beaconDB := testDB.SetupDB(t)
+	service := setupBeaconChain(t, beaconDB)
+
+	// The chain is genesis <- 1 <- 3, with the head moving from 1 to 3 at once.
+	genesis := testutil.NewBeaconBlock()
+	require.NoError(t, beaconDB.SaveBlock(ctx, genesis))
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	oldHead := testutil.NewBeaconBlock()
+	oldHead.Block.Slot = 1
+	oldHead.Block.ParentRoot = genesisRoot[:]
+	require.NoError(t, beaconDB.SaveBlock(ctx, oldHead))
+	oldRoot, err := oldHead.Block.HashTreeRoot()
+	require.NoError(t, err)
+	service.head = &head{slot: oldHead.Block.Slot, root: oldRoot, block: oldHead, state: testutil.NewBeaconState()}
+	skipped := testutil.NewBeaconBlock()
+	skipped.Block.Slot = 2
+	skipped.Block.ParentRoot = oldRoot[:]
+	require.NoError(t, beaconDB.SaveBlock(ctx, skipped))
+	skippedRoot, err := skipped.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	newHead := testutil.NewBeaconBlock()
+	newHead.Block.Slot = 3
+	newHead.Block.ParentRoot = skippedRoot[:]
+	require.NoError(t, beaconDB.SaveBlock(ctx, newHead))
+	newRoot, err := newHead.Block.HashTreeRoot()
+	require.NoError(t, err)
+	headState := testutil.NewBeaconState()
+	require.NoError(t, headState.SetSlot(newHead.Block.Slot))
+	require.NoError(t, beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Slot: newHead.Block.Slot, Root: newRoot[:]}))
+	require.NoError(t, beaconDB.SaveState(ctx, headState, newRoot))
+
+	stateChannel := make(chan *feed.Event, 3)
+	stateSub := service.stateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	require.NoError(t, service.saveHead(ctx, newRoot))
+
+	event := <-stateChannel
+	require.Equal(t, feed.EventType(statefeed.NewHead), event.Type, "Only a new head event is expected")
+	assert.Equal(t, newRoot, service.headRoot())
+	require.LogsDoNotContain(t, hook, "Chain reorg occurred")
+}
+
+func TestCommonAncestor(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := setupBeaconChain(t, beaconDB)
+
+	// Builds the tree:
+	// 0 <- 1 <- 2 <- 4
+	//        \
+	//          3 <- 5
+	roots := make(map[uint64][32]byte)
+	parents := []struct{ slot, parent uint64 }{{1, 0}, {2, 1}, {3, 1}, {4, 2}, {5, 3}}
+	genesis := testutil.NewBeaconBlock()
+	require.NoError(t, beaconDB.SaveBlock(ctx, genesis))
+	r, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	roots[0] = r
+	for _, p := range parents {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = p.slot
+		parentRoot := roots[p.parent]
+		b.Block.ParentRoot = parentRoot[:]
+		require.NoError(t, beaconDB.SaveBlock(ctx, b))
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		roots[p.slot] = r
+	}
+
+	tests := []struct {
+		name         string
+		a, b         uint64
+		ancestorSlot uint64
+	}{
+		{name: "same block", a: 4, b: 4, ancestorSlot: 4},
+		{name: "descendant", a: 1, b: 4, ancestorSlot: 1},
+		{name: "siblings", a: 2, b: 3, ancestorSlot: 1},
+		{name: "different lengths", a: 4, b: 3, ancestorSlot: 1},
+		{name: "same lengths", a: 5, b: 4, ancestorSlot: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, slot, err := service.commonAncestor(ctx, roots[tt.a], roots[tt.b])
+			require.NoError(t, err)
+			assert.Equal(t, tt.ancestorSlot, slot)
+			assert.Equal(t, roots[tt.ancestorSlot], root)
+		})
+	}
+
+	_, _, err = service.commonAncestor(ctx, roots[4], [32]byte{'a'})
+	assert.ErrorContains(t, "nil block", err)
+}
+
 func TestCacheJustifiedStateBalances_CanCache(t *testing.T) {
 	beaconDB := testDB.SetupDB(t)
 	service := setupBeaconChain(t, beaconDB)
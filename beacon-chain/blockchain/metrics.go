@@ -98,6 +98,13 @@ var (
 		Name: "beacon_reorg_total",
 		Help: "Count the number of times beacon chain has a reorg",
 	})
+	reorgDepth = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "beacon_reorg_depth_slots",
+			Help:    "The number of slots between the replaced head and the common ancestor of a reorg",
+			Buckets: []float64{1, 2, 3, 4, 6, 8, 16, 32, 64},
+		},
+	)
 	attestationInclusionDelay = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "attestation_inclusion_delay_slots",
@@ -285,6 +285,39 @@ func TestChainService_InitializeChainInfo(t *testing.T) {
 	assert.Equal(t, genesisRoot, c.genesisRoot, "Genesis block root incorrect")
 }
 
+func TestChainService_InitializeChainInfo_FromCheckpoint(t *testing.T) {
+	beaconDB := testDB.SetupDB(t)
+	ctx := context.Background()
+
+	// A node started from a checkpoint only knows the origin block and its state.
+	finalizedSlot := params.BeaconConfig().SlotsPerEpoch * 2
+	originBlock := testutil.NewBeaconBlock()
+	originBlock.Block.Slot = finalizedSlot
+	originBlock.Block.ParentRoot = bytesutil.PadTo([]byte{'p'}, 32)
+	originState := testutil.NewBeaconState()
+	require.NoError(t, originState.SetSlot(finalizedSlot))
+	originRoot, err := originBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, originBlock))
+	require.NoError(t, beaconDB.SaveState(ctx, originState, originRoot))
+	require.NoError(t, beaconDB.SaveOriginBlockRoot(ctx, originRoot))
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: originRoot[:]}))
+
+	c := &Service{beaconDB: beaconDB, stateGen: stategen.New(beaconDB)}
+	require.NoError(t, c.initializeChainInfo(ctx))
+	headBlk, err := c.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, originBlock, headBlk, "Head block incorrect")
+	assert.Equal(t, finalizedSlot, c.HeadSlot(), "Head slot incorrect")
+	assert.Equal(t, [32]byte{}, c.genesisRoot, "Genesis block root incorrect")
+}
+
+func TestChainService_InitializeChainInfo_NoGenesis(t *testing.T) {
+	beaconDB := testDB.SetupDB(t)
+	c := &Service{beaconDB: beaconDB, stateGen: stategen.New(beaconDB)}
+	assert.ErrorContains(t, "no genesis block in db", c.initializeChainInfo(context.Background()))
+}
+
 func TestChainService_InitializeChainInfo_SetHeadAtGenesis(t *testing.T) {
 	beaconDB := testDB.SetupDB(t)
 	ctx := context.Background()
@@ -1,6 +1,8 @@
 package cache
 
 import (
+	"bytes"
+	"sort"
 	"sync"
 	"time"
 
@@ -124,6 +126,38 @@ func (c *subnetIDs) GetAllSubnets() []uint64 {
 	return sliceutil.SetUint64(committees)
 }
 
+// PersistentSubnets are the persistent subnets of a validator, along with the expiration time
+// of the subscription.
+type PersistentSubnets struct {
+	PublicKey  []byte
+	Subnets    []uint64
+	Expiration time.Time
+}
+
+// GetAllPersistentSubnets retrieves the non-expired persistent subnets of all the validators
+// in the cache, ordered by public key.
+func (c *subnetIDs) GetAllPersistentSubnets() []*PersistentSubnets {
+	c.subnetsLock.RLock()
+	defer c.subnetsLock.RUnlock()
+
+	itemsMap := c.persistentSubnets.Items()
+	subnets := make([]*PersistentSubnets, 0, len(itemsMap))
+	for k, v := range itemsMap {
+		if v.Expired() {
+			continue
+		}
+		subnets = append(subnets, &PersistentSubnets{
+			PublicKey:  []byte(k),
+			Subnets:    v.Object.([]uint64),
+			Expiration: time.Unix(0, v.Expiration),
+		})
+	}
+	sort.Slice(subnets, func(i, j int) bool {
+		return bytes.Compare(subnets[i].PublicKey, subnets[j].PublicKey) < 0
+	})
+	return subnets
+}
+
 // AddPersistentCommittee adds the relevant committee for that particular validator along with its
 // expiration period.
 func (c *subnetIDs) AddPersistentCommittee(pubkey []byte, comIndex []uint64, duration time.Duration) {
@@ -2,6 +2,7 @@ package cache
 
 import (
 	"testing"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
@@ -62,3 +63,22 @@ func TestSubnetIDsCache_PersistentCommitteeRoundtrip(t *testing.T) {
 	coms := c.GetAllSubnets()
 	assert.Equal(t, 20, len(coms))
 }
+
+func TestSubnetIDsCache_GetAllPersistentSubnets(t *testing.T) {
+	c := newSubnetIDs()
+	assert.Equal(t, 0, len(c.GetAllPersistentSubnets()))
+
+	before := time.Now()
+	for i := 3; i > 0; i-- {
+		pubkey := [48]byte{byte(i)}
+		c.AddPersistentCommittee(pubkey[:], []uint64{uint64(i), uint64(i + 10)}, time.Duration(i)*time.Minute)
+	}
+	subnets := c.GetAllPersistentSubnets()
+	require.Equal(t, 3, len(subnets))
+	for i, s := range subnets {
+		pubkey := [48]byte{byte(i + 1)}
+		assert.DeepEqual(t, pubkey[:], s.PublicKey)
+		assert.DeepEqual(t, []uint64{uint64(i + 1), uint64(i + 11)}, s.Subnets)
+		assert.Equal(t, true, s.Expiration.After(before.Add(time.Duration(i+1)*time.Minute)))
+	}
+}
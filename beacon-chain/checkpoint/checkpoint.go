@@ -0,0 +1,148 @@
+// Package checkpoint allows a beacon node to start from a trusted finalized block and
+// state, rather than from genesis, by seeding its database with that checkpoint.
+package checkpoint
+
+import (
+	"context"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// ErrAlreadyInitialized is returned when attempting to initialize a database which
+// already holds a beacon chain.
+var ErrAlreadyInitialized = errors.New("database already contains a beacon chain")
+
+// Initialize seeds the database with a trusted finalized block and its post state. The block
+// becomes the origin, head, justified and finalized checkpoint of the node, from which state
+// generation, fork choice and sync resume as they would after a restart.
+func Initialize(ctx context.Context, beaconDB db.HeadAccessDatabase, blk *ethpb.SignedBeaconBlock, st *stateTrie.BeaconState) error {
+	initialized, err := IsInitialized(ctx, beaconDB)
+	if err != nil {
+		return err
+	}
+	if initialized {
+		return ErrAlreadyInitialized
+	}
+	if blk == nil || blk.Block == nil {
+		return errors.New("nil checkpoint block")
+	}
+	if st == nil {
+		return errors.New("nil checkpoint state")
+	}
+	if st.Slot() != blk.Block.Slot {
+		return errors.Errorf("checkpoint state slot %d does not match block slot %d", st.Slot(), blk.Block.Slot)
+	}
+	stateRoot, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not hash checkpoint state")
+	}
+	if stateRoot != bytesutil.ToBytes32(blk.Block.StateRoot) {
+		return errors.Errorf("checkpoint state root %#x does not match block state root %#x", stateRoot, blk.Block.StateRoot)
+	}
+	blockRoot, err := blk.Block.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not hash checkpoint block")
+	}
+
+	if err := beaconDB.SaveBlock(ctx, blk); err != nil {
+		return errors.Wrap(err, "could not save checkpoint block")
+	}
+	if err := beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Slot: st.Slot(), Root: blockRoot[:]}); err != nil {
+		return errors.Wrap(err, "could not save checkpoint state summary")
+	}
+	if err := beaconDB.SaveState(ctx, st, blockRoot); err != nil {
+		return errors.Wrap(err, "could not save checkpoint state")
+	}
+	if err := beaconDB.SaveOriginBlockRoot(ctx, blockRoot); err != nil {
+		return errors.Wrap(err, "could not save origin block root")
+	}
+	if err := beaconDB.SaveHeadBlockRoot(ctx, blockRoot); err != nil {
+		return errors.Wrap(err, "could not save head block root")
+	}
+	cp := &ethpb.Checkpoint{Epoch: checkpointEpoch(blk.Block.Slot), Root: blockRoot[:]}
+	if err := beaconDB.SaveJustifiedCheckpoint(ctx, cp); err != nil {
+		return errors.Wrap(err, "could not save justified checkpoint")
+	}
+	if err := beaconDB.SaveFinalizedCheckpoint(ctx, cp); err != nil {
+		return errors.Wrap(err, "could not save finalized checkpoint")
+	}
+
+	log.WithFields(logrus.Fields{
+		"slot":      blk.Block.Slot,
+		"epoch":     cp.Epoch,
+		"blockRoot": blockRoot,
+	}).Info("Initialized beacon chain from checkpoint")
+	return nil
+}
+
+// LoadFiles reads an SSZ encoded signed block and beacon state from the given paths.
+func LoadFiles(blockPath, statePath string) (*ethpb.SignedBeaconBlock, *stateTrie.BeaconState, error) {
+	enc, err := ioutil.ReadFile(blockPath)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not read checkpoint block")
+	}
+	blk := &ethpb.SignedBeaconBlock{}
+	if err := blk.UnmarshalSSZ(enc); err != nil {
+		return nil, nil, errors.Wrap(err, "could not unmarshal checkpoint block")
+	}
+	enc, err = ioutil.ReadFile(statePath)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not read checkpoint state")
+	}
+	st, err := unmarshalState(enc)
+	if err != nil {
+		return nil, nil, err
+	}
+	return blk, st, nil
+}
+
+// IsInitialized returns whether the database already belongs to a beacon chain, that is
+// whether it holds a genesis block, a checkpoint origin or a finalized checkpoint.
+func IsInitialized(ctx context.Context, beaconDB db.ReadOnlyDatabase) (bool, error) {
+	genesisBlock, err := beaconDB.GenesisBlock(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "could not get genesis block")
+	}
+	if genesisBlock != nil {
+		return true, nil
+	}
+	originRoot, err := beaconDB.OriginBlockRoot(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "could not get origin block root")
+	}
+	if originRoot != params.BeaconConfig().ZeroHash {
+		return true, nil
+	}
+	cp, err := beaconDB.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	return bytesutil.ToBytes32(cp.Root) != params.BeaconConfig().ZeroHash, nil
+}
+
+// The checkpoint epoch of a block is the first epoch starting at or after its slot,
+// as the block is the latest one at that epoch's start slot.
+func checkpointEpoch(slot uint64) uint64 {
+	epoch := helpers.SlotToEpoch(slot)
+	if !helpers.IsEpochStart(slot) {
+		epoch++
+	}
+	return epoch
+}
+
+func unmarshalState(enc []byte) (*stateTrie.BeaconState, error) {
+	st := &pb.BeaconState{}
+	if err := st.UnmarshalSSZ(enc); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal checkpoint state")
+	}
+	return stateTrie.InitializeFromProtoUnsafe(st)
+}
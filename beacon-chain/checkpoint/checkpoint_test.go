@@ -0,0 +1,123 @@
+package checkpoint
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func checkpointAt(t *testing.T, slot uint64) (*ethpb.SignedBeaconBlock, *stateTrie.BeaconState) {
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, st.SetSlot(slot))
+	stateRoot, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = slot
+	blk.Block.ParentRoot = bytesutil.PadTo([]byte{'p'}, 32)
+	blk.Block.StateRoot = stateRoot[:]
+	return blk, st
+}
+
+func TestInitialize(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	slot := params.BeaconConfig().SlotsPerEpoch*3 + 5
+	blk, st := checkpointAt(t, slot)
+	blockRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	initialized, err := IsInitialized(ctx, beaconDB)
+	require.NoError(t, err)
+	assert.Equal(t, false, initialized, "Empty database should not be initialized")
+	require.NoError(t, Initialize(ctx, beaconDB, blk, st))
+	initialized, err = IsInitialized(ctx, beaconDB)
+	require.NoError(t, err)
+	assert.Equal(t, true, initialized, "Database should be initialized from the checkpoint")
+
+	originRoot, err := beaconDB.OriginBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, blockRoot, originRoot)
+	head, err := beaconDB.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, blk, head)
+	finalized, err := beaconDB.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &ethpb.Checkpoint{Epoch: 4, Root: blockRoot[:]}, finalized)
+	justified, err := beaconDB.JustifiedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, finalized, justified)
+	assert.Equal(t, true, beaconDB.IsFinalizedBlock(ctx, blockRoot))
+
+	resumed, err := stategen.New(beaconDB).Resume(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, slot, resumed.Slot())
+
+	assert.ErrorContains(t, ErrAlreadyInitialized.Error(), Initialize(ctx, beaconDB, blk, st))
+}
+
+func TestInitialize_GenesisExists(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	genesis := testutil.NewBeaconBlock()
+	require.NoError(t, beaconDB.SaveBlock(ctx, genesis))
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+
+	blk, st := checkpointAt(t, params.BeaconConfig().SlotsPerEpoch)
+	assert.ErrorContains(t, ErrAlreadyInitialized.Error(), Initialize(ctx, beaconDB, blk, st))
+}
+
+func TestInitialize_InvalidCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+
+	blk, st := checkpointAt(t, params.BeaconConfig().SlotsPerEpoch)
+	blk.Block.Slot++
+	assert.ErrorContains(t, "does not match block slot", Initialize(ctx, beaconDB, blk, st))
+
+	blk, st = checkpointAt(t, params.BeaconConfig().SlotsPerEpoch)
+	blk.Block.StateRoot = bytesutil.PadTo([]byte{'s'}, 32)
+	assert.ErrorContains(t, "does not match block state root", Initialize(ctx, beaconDB, blk, st))
+
+	assert.ErrorContains(t, "nil checkpoint block", Initialize(ctx, beaconDB, nil, st))
+}
+
+func TestLoadFiles(t *testing.T) {
+	blk, st := checkpointAt(t, params.BeaconConfig().SlotsPerEpoch)
+	dir := t.TempDir()
+	blockPath := filepath.Join(dir, "block.ssz")
+	statePath := filepath.Join(dir, "state.ssz")
+	enc, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(blockPath, enc, 0600))
+	enc, err = st.CloneInnerState().MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(statePath, enc, 0600))
+
+	loadedBlock, loadedState, err := LoadFiles(blockPath, statePath)
+	require.NoError(t, err)
+	assert.DeepEqual(t, blk, loadedBlock)
+	assert.DeepEqual(t, st.CloneInnerState(), loadedState.CloneInnerState())
+
+	_, _, err = LoadFiles(filepath.Join(dir, "missing.ssz"), statePath)
+	assert.ErrorContains(t, "could not read checkpoint block", err)
+}
+
+func TestCheckpointEpoch(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	assert.Equal(t, uint64(0), checkpointEpoch(0))
+	assert.Equal(t, uint64(2), checkpointEpoch(2*slotsPerEpoch))
+	assert.Equal(t, uint64(3), checkpointEpoch(2*slotsPerEpoch+1))
+}
@@ -0,0 +1,7 @@
+package checkpoint
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "checkpoint")
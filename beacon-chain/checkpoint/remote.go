@@ -0,0 +1,50 @@
+package checkpoint
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+)
+
+// FetchFinalized retrieves the latest finalized block and its post state from a trusted
+// beacon node, which must serve the debug endpoints (--enable-debug-rpc-endpoints).
+func FetchFinalized(
+	ctx context.Context,
+	chainClient ethpb.BeaconChainClient,
+	debugClient pbrpc.DebugClient,
+) (*ethpb.SignedBeaconBlock, *stateTrie.BeaconState, error) {
+	head, err := chainClient.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get chain head")
+	}
+	root := head.FinalizedBlockRoot
+	log.WithField("epoch", head.FinalizedEpoch).Infof("Downloading finalized checkpoint block %#x", root)
+	blockResp, err := debugClient.GetBlock(ctx, &pbrpc.BlockRequest{BlockRoot: root})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get checkpoint block")
+	}
+	if len(blockResp.Encoded) == 0 {
+		return nil, nil, errors.Errorf("checkpoint block %#x not found", root)
+	}
+	blk := &ethpb.SignedBeaconBlock{}
+	if err := blk.UnmarshalSSZ(blockResp.Encoded); err != nil {
+		return nil, nil, errors.Wrap(err, "could not unmarshal checkpoint block")
+	}
+
+	log.Info("Downloading finalized checkpoint state, this may take a while")
+	stateResp, err := debugClient.GetBeaconState(ctx, &pbrpc.BeaconStateRequest{
+		QueryFilter: &pbrpc.BeaconStateRequest_BlockRoot{BlockRoot: root},
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get checkpoint state")
+	}
+	st, err := unmarshalState(stateResp.Encoded)
+	if err != nil {
+		return nil, nil, err
+	}
+	return blk, st, nil
+}
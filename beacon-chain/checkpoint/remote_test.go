@@ -0,0 +1,62 @@
+package checkpoint
+
+import (
+	"context"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+type chainClient struct {
+	ethpb.BeaconChainClient
+	head *ethpb.ChainHead
+}
+
+func (c *chainClient) GetChainHead(_ context.Context, _ *ptypes.Empty, _ ...grpc.CallOption) (*ethpb.ChainHead, error) {
+	return c.head, nil
+}
+
+type debugClient struct {
+	pbrpc.DebugClient
+	blocks map[[32]byte][]byte
+	states map[[32]byte][]byte
+}
+
+func (c *debugClient) GetBlock(_ context.Context, req *pbrpc.BlockRequest, _ ...grpc.CallOption) (*pbrpc.SSZResponse, error) {
+	return &pbrpc.SSZResponse{Encoded: c.blocks[bytesutil.ToBytes32(req.BlockRoot)]}, nil
+}
+
+func (c *debugClient) GetBeaconState(_ context.Context, req *pbrpc.BeaconStateRequest, _ ...grpc.CallOption) (*pbrpc.SSZResponse, error) {
+	return &pbrpc.SSZResponse{Encoded: c.states[bytesutil.ToBytes32(req.GetBlockRoot())]}, nil
+}
+
+func TestFetchFinalized(t *testing.T) {
+	blk, st := checkpointAt(t, params.BeaconConfig().SlotsPerEpoch)
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	encBlock, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	encState, err := st.CloneInnerState().MarshalSSZ()
+	require.NoError(t, err)
+
+	chain := &chainClient{head: &ethpb.ChainHead{FinalizedEpoch: 1, FinalizedBlockRoot: root[:]}}
+	debug := &debugClient{
+		blocks: map[[32]byte][]byte{root: encBlock},
+		states: map[[32]byte][]byte{root: encState},
+	}
+	fetchedBlock, fetchedState, err := FetchFinalized(context.Background(), chain, debug)
+	require.NoError(t, err)
+	assert.DeepEqual(t, blk, fetchedBlock)
+	assert.DeepEqual(t, st.CloneInnerState(), fetchedState.CloneInnerState())
+
+	chain.head.FinalizedBlockRoot = bytesutil.PadTo([]byte{'x'}, 32)
+	_, _, err = FetchFinalized(context.Background(), chain, debug)
+	assert.ErrorContains(t, "not found", err)
+}
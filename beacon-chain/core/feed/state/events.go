@@ -14,9 +14,13 @@ const (
 	Initialized
 	// Synced is sent when the beacon node has completed syncing and is ready to participate in the network.
 	Synced
-	// Reorg is an event sent when the new head state's slot after a block
-	// transition is lower than its previous head state slot value.
+	// Reorg is an event sent when the new head is not a descendant of the previous head,
+	// describing the two heads and the block they have in common.
 	Reorg
+	// NewHead is sent when the chain head has been updated.
+	NewHead
+	// FinalizedCheckpoint is sent when the finalized checkpoint has been updated.
+	FinalizedCheckpoint
 )
 
 // BlockProcessedData is the data sent with BlockProcessed events.
@@ -55,4 +59,37 @@ type ReorgData struct {
 	NewSlot uint64
 	// OldSlot is the slot of the head state before the reorg.
 	OldSlot uint64
+	// NewHeadRoot is the root of the head block after the reorg.
+	NewHeadRoot [32]byte
+	// OldHeadRoot is the root of the head block before the reorg.
+	OldHeadRoot [32]byte
+	// CommonAncestorSlot is the slot of the latest block shared by both chains.
+	CommonAncestorSlot uint64
+	// CommonAncestorRoot is the root of the latest block shared by both chains, zero if it
+	// could not be determined.
+	CommonAncestorRoot [32]byte
+	// Depth is the number of slots between the old head and the common ancestor.
+	Depth uint64
+}
+
+// NewHeadData is the data sent with NewHead events.
+type NewHeadData struct {
+	// Slot is the slot of the new head block.
+	Slot uint64
+	// BlockRoot of the new head block.
+	BlockRoot [32]byte
+	// StateRoot of the new head state.
+	StateRoot [32]byte
+	// EpochTransition is true if the new head is in a later epoch than the previous head.
+	EpochTransition bool
+}
+
+// FinalizedCheckpointData is the data sent with FinalizedCheckpoint events.
+type FinalizedCheckpointData struct {
+	// Epoch of the new finalized checkpoint.
+	Epoch uint64
+	// BlockRoot of the new finalized checkpoint.
+	BlockRoot [32]byte
+	// StateRoot of the finalized block, if the block is known.
+	StateRoot [32]byte
 }
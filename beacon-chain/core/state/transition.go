@@ -28,18 +28,26 @@ import (
 // processFunc is a function that processes a block with a given state. State is mutated.
 type processFunc func(context.Context, *stateTrie.BeaconState, *ethpb.SignedBeaconBlock) (*stateTrie.BeaconState, error)
 
+// processStep is a named step of the block processing pipeline. Steps processing block operations
+// also split a block body into single operation bodies, so the operations can be processed one by one.
+type processStep struct {
+	name       string
+	process    processFunc
+	operations func(*ethpb.BeaconBlockBody) []*ethpb.BeaconBlockBody
+}
+
 // This defines the processing block routine as outlined in eth2 spec:
 // https://github.com/ethereum/eth2.0-specs/blob/dev/specs/phase0/beacon-chain.md#block-processing
-var processingPipeline = []processFunc{
-	b.ProcessBlockHeader,
-	b.ProcessRandao,
-	b.ProcessEth1DataInBlock,
-	VerifyOperationLengths,
-	b.ProcessProposerSlashings,
-	b.ProcessAttesterSlashings,
-	b.ProcessAttestations,
-	b.ProcessDeposits,
-	b.ProcessVoluntaryExits,
+var processingPipeline = []*processStep{
+	{name: StepProcessBlockHeader, process: b.ProcessBlockHeader},
+	{name: StepProcessRandao, process: b.ProcessRandao},
+	{name: StepProcessEth1Data, process: b.ProcessEth1DataInBlock},
+	{name: StepVerifyOperationLengths, process: VerifyOperationLengths},
+	{name: StepProposerSlashing, process: b.ProcessProposerSlashings, operations: proposerSlashingBodies},
+	{name: StepAttesterSlashing, process: b.ProcessAttesterSlashings, operations: attesterSlashingBodies},
+	{name: StepAttestation, process: b.ProcessAttestations, operations: attestationBodies},
+	{name: StepDeposit, process: b.ProcessDeposits, operations: depositBodies},
+	{name: StepVoluntaryExit, process: b.ProcessVoluntaryExits, operations: voluntaryExitBodies},
 }
 
 // ExecuteStateTransition defines the procedure for a state transition function.
@@ -370,15 +378,50 @@ func ProcessBlock(
 	ctx context.Context,
 	state *stateTrie.BeaconState,
 	signed *ethpb.SignedBeaconBlock,
+) (*stateTrie.BeaconState, error) {
+	return ProcessBlockWithStepHook(ctx, state, signed, nil)
+}
+
+// ProcessBlockWithStepHook processes a block as ProcessBlock does, running every step of the
+// processing pipeline through the given hook. When a hook is set, block operations are processed
+// one at a time, each in its own step. A nil hook processes the block as ProcessBlock.
+func ProcessBlockWithStepHook(
+	ctx context.Context,
+	state *stateTrie.BeaconState,
+	signed *ethpb.SignedBeaconBlock,
+	hook StepHook,
 ) (*stateTrie.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.ChainService.state.ProcessBlock")
 	defer span.End()
 
 	var err error
 	for _, p := range processingPipeline {
-		state, err = p(ctx, state, signed)
-		if err != nil {
-			return nil, errors.Wrap(err, "Could not process block")
+		if hook == nil {
+			state, err = p.process(ctx, state, signed)
+			if err != nil {
+				return nil, errors.Wrap(err, "Could not process block")
+			}
+			continue
+		}
+		if p.operations == nil {
+			step := &TransitionStep{Name: p.name}
+			if err := hook(step, func() error {
+				state, err = p.process(ctx, state, signed)
+				return err
+			}); err != nil {
+				return nil, errors.Wrap(err, "Could not process block")
+			}
+			continue
+		}
+		for i, body := range p.operations(signed.Block.Body) {
+			step := &TransitionStep{Name: p.name, OperationIndex: uint64(i)}
+			opBlock := operationBlock(signed, body)
+			if err := hook(step, func() error {
+				state, err = p.process(ctx, state, opBlock)
+				return err
+			}); err != nil {
+				return nil, errors.Wrap(err, "Could not process block")
+			}
 		}
 	}
 
@@ -0,0 +1,188 @@
+package state
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"go.opencensus.io/trace"
+)
+
+// Names of the steps of a state transition, following the function names of the eth2 spec.
+const (
+	StepProcessSlots           = "process_slots"
+	StepProcessBlockHeader     = "process_block_header"
+	StepProcessRandao          = "process_randao"
+	StepProcessEth1Data        = "process_eth1_data"
+	StepVerifyOperationLengths = "verify_operation_lengths"
+	StepProposerSlashing       = "process_proposer_slashing"
+	StepAttesterSlashing       = "process_attester_slashing"
+	StepAttestation            = "process_attestation"
+	StepDeposit                = "process_deposit"
+	StepVoluntaryExit          = "process_voluntary_exit"
+	StepVerifyStateRoot        = "verify_state_root"
+)
+
+// TransitionStep is a step of a state transition along with the time it took.
+type TransitionStep struct {
+	Name string
+	// OperationIndex is the index in the block body of the operation processed by a
+	// process_proposer_slashing, process_attester_slashing, process_attestation,
+	// process_deposit or process_voluntary_exit step.
+	OperationIndex uint64
+	Duration       time.Duration
+}
+
+// IsOperation returns whether the step processes a single block operation.
+func (s *TransitionStep) IsOperation() bool {
+	switch s.Name {
+	case StepProposerSlashing, StepAttesterSlashing, StepAttestation, StepDeposit, StepVoluntaryExit:
+		return true
+	}
+	return false
+}
+
+// StepHook runs a step of a state transition. The hook must call run once and return its error,
+// which it may wrap, so that callers can observe every step of block processing.
+type StepHook func(step *TransitionStep, run func() error) error
+
+// TransitionStepError is the error of a failed step of a state transition.
+type TransitionStepError struct {
+	Step *TransitionStep
+	Err  error
+}
+
+// Error describes the failed step and its error.
+func (e *TransitionStepError) Error() string {
+	if e.Step.IsOperation() {
+		return fmt.Sprintf("%s failed for operation %d: %v", e.Step.Name, e.Step.OperationIndex, e.Err)
+	}
+	return fmt.Sprintf("%s failed: %v", e.Step.Name, e.Err)
+}
+
+// Unwrap returns the error of the failed step.
+func (e *TransitionStepError) Unwrap() error {
+	return e.Err
+}
+
+// ExecuteStateTransitionWithSteps performs the same state transition as ExecuteStateTransition,
+// one step at a time, timing every step. Block operations are processed one by one, so that a
+// failure names the operation at fault.
+//
+// The executed steps are returned in order, up to and including a failed step, in which case
+// the error is a *TransitionStepError. As with ExecuteStateTransition, the post-state is
+// returned along with the error when only the state root verification fails.
+func ExecuteStateTransitionWithSteps(
+	ctx context.Context,
+	state *stateTrie.BeaconState,
+	signed *ethpb.SignedBeaconBlock,
+) (*stateTrie.BeaconState, []*TransitionStep, error) {
+	if ctx.Err() != nil {
+		return nil, nil, ctx.Err()
+	}
+	if signed == nil || signed.Block == nil || signed.Block.Body == nil {
+		return nil, nil, errors.New("nil block")
+	}
+
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.ChainService.ExecuteStateTransitionWithSteps")
+	defer span.End()
+
+	steps := make([]*TransitionStep, 0)
+	hook := func(step *TransitionStep, run func() error) error {
+		start := time.Now()
+		err := run()
+		step.Duration = time.Since(start)
+		steps = append(steps, step)
+		if err != nil {
+			return &TransitionStepError{Step: step, Err: err}
+		}
+		return nil
+	}
+
+	if err := hook(&TransitionStep{Name: StepProcessSlots}, func() error {
+		var err error
+		state, err = ProcessSlots(ctx, state, signed.Block.Slot)
+		return err
+	}); err != nil {
+		return nil, steps, err
+	}
+	state, err := ProcessBlockWithStepHook(ctx, state, signed, hook)
+	if err != nil {
+		return nil, steps, err
+	}
+
+	postState := state
+	if err := hook(&TransitionStep{Name: StepVerifyStateRoot}, func() error {
+		postStateRoot, err := postState.HashTreeRoot(ctx)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(postStateRoot[:], signed.Block.StateRoot) {
+			return fmt.Errorf("validate state root failed, wanted: %#x, received: %#x",
+				postStateRoot[:], signed.Block.StateRoot)
+		}
+		return nil
+	}); err != nil {
+		return postState, steps, err
+	}
+	return postState, steps, nil
+}
+
+// operationBlock returns a copy of the block with the given body, so that the block operations
+// can be processed one at a time.
+func operationBlock(signed *ethpb.SignedBeaconBlock, body *ethpb.BeaconBlockBody) *ethpb.SignedBeaconBlock {
+	return &ethpb.SignedBeaconBlock{
+		Block: &ethpb.BeaconBlock{
+			Slot:          signed.Block.Slot,
+			ProposerIndex: signed.Block.ProposerIndex,
+			ParentRoot:    signed.Block.ParentRoot,
+			StateRoot:     signed.Block.StateRoot,
+			Body:          body,
+		},
+		Signature: signed.Signature,
+	}
+}
+
+func proposerSlashingBodies(body *ethpb.BeaconBlockBody) []*ethpb.BeaconBlockBody {
+	bodies := make([]*ethpb.BeaconBlockBody, len(body.ProposerSlashings))
+	for i, slashing := range body.ProposerSlashings {
+		bodies[i] = &ethpb.BeaconBlockBody{ProposerSlashings: []*ethpb.ProposerSlashing{slashing}}
+	}
+	return bodies
+}
+
+func attesterSlashingBodies(body *ethpb.BeaconBlockBody) []*ethpb.BeaconBlockBody {
+	bodies := make([]*ethpb.BeaconBlockBody, len(body.AttesterSlashings))
+	for i, slashing := range body.AttesterSlashings {
+		bodies[i] = &ethpb.BeaconBlockBody{AttesterSlashings: []*ethpb.AttesterSlashing{slashing}}
+	}
+	return bodies
+}
+
+func attestationBodies(body *ethpb.BeaconBlockBody) []*ethpb.BeaconBlockBody {
+	bodies := make([]*ethpb.BeaconBlockBody, len(body.Attestations))
+	for i, att := range body.Attestations {
+		bodies[i] = &ethpb.BeaconBlockBody{Attestations: []*ethpb.Attestation{att}}
+	}
+	return bodies
+}
+
+func depositBodies(body *ethpb.BeaconBlockBody) []*ethpb.BeaconBlockBody {
+	bodies := make([]*ethpb.BeaconBlockBody, len(body.Deposits))
+	for i, deposit := range body.Deposits {
+		bodies[i] = &ethpb.BeaconBlockBody{Deposits: []*ethpb.Deposit{deposit}}
+	}
+	return bodies
+}
+
+func voluntaryExitBodies(body *ethpb.BeaconBlockBody) []*ethpb.BeaconBlockBody {
+	bodies := make([]*ethpb.BeaconBlockBody, len(body.VoluntaryExits))
+	for i, exit := range body.VoluntaryExits {
+		bodies[i] = &ethpb.BeaconBlockBody{VoluntaryExits: []*ethpb.SignedVoluntaryExit{exit}}
+	}
+	return bodies
+}
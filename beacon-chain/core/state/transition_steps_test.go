@@ -0,0 +1,121 @@
+package state_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	beaconstate "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func signBlock(t *testing.T, beaconState *beaconstate.BeaconState, privKeys []bls.SecretKey, block *ethpb.SignedBeaconBlock) {
+	st := beaconState.Copy()
+	require.NoError(t, st.SetSlot(block.Block.Slot))
+	proposerIdx, err := helpers.BeaconProposerIndex(st)
+	require.NoError(t, err)
+	block.Signature, err = helpers.ComputeDomainAndSign(
+		beaconState,
+		helpers.CurrentEpoch(beaconState),
+		block.Block,
+		params.BeaconConfig().DomainBeaconProposer,
+		privKeys[proposerIdx],
+	)
+	require.NoError(t, err)
+}
+
+func stepNames(steps []*state.TransitionStep) []string {
+	names := make([]string, len(steps))
+	for i, step := range steps {
+		names[i] = step.Name
+	}
+	return names
+}
+
+func TestExecuteStateTransitionWithSteps_MatchesStateTransition(t *testing.T) {
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 100)
+	conf := testutil.DefaultBlockGenConfig()
+	conf.NumAttestations = 1
+	block, err := testutil.GenerateFullBlock(beaconState, privKeys, conf, 1)
+	require.NoError(t, err)
+
+	wanted, err := state.ExecuteStateTransition(context.Background(), beaconState.Copy(), block)
+	require.NoError(t, err)
+	wantedRoot, err := wanted.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+
+	postState, steps, err := state.ExecuteStateTransitionWithSteps(context.Background(), beaconState.Copy(), block)
+	require.NoError(t, err)
+	postRoot, err := postState.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, wantedRoot, postRoot)
+	assert.DeepEqual(t, []string{
+		state.StepProcessSlots,
+		state.StepProcessBlockHeader,
+		state.StepProcessRandao,
+		state.StepProcessEth1Data,
+		state.StepVerifyOperationLengths,
+		state.StepAttestation,
+		state.StepVerifyStateRoot,
+	}, stepNames(steps))
+	assert.Equal(t, true, steps[5].IsOperation())
+	assert.Equal(t, uint64(0), steps[5].OperationIndex)
+	assert.Equal(t, false, steps[0].IsOperation())
+}
+
+func TestExecuteStateTransitionWithSteps_NamesFailingOperation(t *testing.T) {
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 100)
+	conf := testutil.DefaultBlockGenConfig()
+	conf.NumAttestations = 1
+	block, err := testutil.GenerateFullBlock(beaconState, privKeys, conf, 1)
+	require.NoError(t, err)
+	priv, err := bls.RandKey()
+	require.NoError(t, err)
+	block.Block.Body.Attestations = append(block.Block.Body.Attestations, &ethpb.Attestation{
+		Data: &ethpb.AttestationData{
+			Target:          &ethpb.Checkpoint{Epoch: 0, Root: make([]byte, 32)},
+			Source:          &ethpb.Checkpoint{Epoch: 0, Root: make([]byte, 32)},
+			BeaconBlockRoot: make([]byte, 32),
+		},
+		AggregationBits: bitfield.NewBitlist(3),
+		Signature:       priv.Sign([]byte("foo")).Marshal(),
+	})
+	signBlock(t, beaconState, privKeys, block)
+
+	postState, steps, err := state.ExecuteStateTransitionWithSteps(context.Background(), beaconState, block)
+	require.ErrorContains(t, "process_attestation failed for operation 1", err)
+	assert.Equal(t, (*beaconstate.BeaconState)(nil), postState)
+	stepErr := &state.TransitionStepError{}
+	require.Equal(t, true, errors.As(err, &stepErr))
+	assert.Equal(t, state.StepAttestation, stepErr.Step.Name)
+	assert.Equal(t, uint64(1), stepErr.Step.OperationIndex)
+	assert.Equal(t, 7, len(steps))
+	assert.Equal(t, stepErr.Step, steps[6])
+}
+
+func TestExecuteStateTransitionWithSteps_WrongStateRoot(t *testing.T) {
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 100)
+	block, err := testutil.GenerateFullBlock(beaconState, privKeys, nil, 1)
+	require.NoError(t, err)
+	block.Block.StateRoot = bytes32('a')
+	signBlock(t, beaconState, privKeys, block)
+
+	postState, steps, err := state.ExecuteStateTransitionWithSteps(context.Background(), beaconState, block)
+	require.ErrorContains(t, "verify_state_root failed", err)
+	require.NotNil(t, postState)
+	assert.Equal(t, state.StepVerifyStateRoot, steps[len(steps)-1].Name)
+}
+
+func bytes32(b byte) []byte {
+	root := make([]byte, 32)
+	root[0] = b
+	return root
+}
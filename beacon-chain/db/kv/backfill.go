@@ -0,0 +1,87 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+var errMissingOriginBlockRoot = errors.New("no origin block root to backfill from")
+
+// BackfillBlockRoot returns the root of the lowest block saved while backfilling the chain
+// below the origin block of a node started from a checkpoint, or zero hashes if no block
+// has been backfilled yet.
+func (s *Store) BackfillBlockRoot(ctx context.Context) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BackfillBlockRoot")
+	defer span.End()
+	var root [32]byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		copy(root[:], tx.Bucket(blocksBucket).Get(backfillBlockRootKey))
+		return nil
+	})
+	return root, err
+}
+
+// SaveBackfillBlockRoot records the lowest backfilled block. The block must be an ancestor of the
+// previously lowest backfilled block, or of the origin block, and all blocks in between are
+// added to the finalized block roots index, as backfilled blocks are part of the finalized chain.
+func (s *Store) SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBackfillBlockRoot")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(blocksBucket)
+		child := bkt.Get(backfillBlockRootKey)
+		if child == nil {
+			child = bkt.Get(originBlockRootKey)
+		}
+		if child == nil {
+			return errMissingOriginBlockRoot
+		}
+
+		// Walk down the ancestry chain to the new lowest block, indexing every parent on the way.
+		idx := tx.Bucket(finalizedBlockRootsIndexBucket)
+		for !bytes.Equal(child, blockRoot[:]) {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			signedBlock, err := s.Block(ctx, bytesutil.ToBytes32(child))
+			if err != nil {
+				traceutil.AnnotateError(span, err)
+				return err
+			}
+			if signedBlock == nil || signedBlock.Block == nil {
+				return fmt.Errorf("missing block in database: block root=%#x", child)
+			}
+			parentRoot := signedBlock.Block.ParentRoot
+			parent, err := s.Block(ctx, bytesutil.ToBytes32(parentRoot))
+			if err != nil {
+				traceutil.AnnotateError(span, err)
+				return err
+			}
+			if parent == nil || parent.Block == nil {
+				return fmt.Errorf("missing block in database: block root=%#x", parentRoot)
+			}
+			enc, err := encode(ctx, &dbpb.FinalizedBlockRootContainer{
+				ParentRoot: parent.Block.ParentRoot,
+				ChildRoot:  child,
+			})
+			if err != nil {
+				traceutil.AnnotateError(span, err)
+				return err
+			}
+			if err := idx.Put(parentRoot, enc); err != nil {
+				traceutil.AnnotateError(span, err)
+				return err
+			}
+			child = parentRoot
+		}
+		return bkt.Put(backfillBlockRootKey, blockRoot[:])
+	})
+}
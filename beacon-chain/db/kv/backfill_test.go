@@ -0,0 +1,47 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_SaveBackfillBlockRoot(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	blks := makeBlocks(t, 0, 10, genesisBlockRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	root := func(i int) [32]byte {
+		r, err := blks[i].Block.HashTreeRoot()
+		require.NoError(t, err)
+		return r
+	}
+	require.ErrorContains(t, "no origin block root", db.SaveBackfillBlockRoot(ctx, root(5)))
+	require.NoError(t, db.SaveOriginBlockRoot(ctx, root(9)))
+
+	backfillRoot, err := db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{}, backfillRoot, "Expected no backfill block root")
+
+	require.NoError(t, db.SaveBackfillBlockRoot(ctx, root(5)))
+	backfillRoot, err = db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, root(5), backfillRoot)
+	for i := 5; i < 9; i++ {
+		assert.Equal(t, true, db.IsFinalizedBlock(ctx, root(i)), "Block at index %d was not considered finalized", i)
+	}
+	assert.Equal(t, false, db.IsFinalizedBlock(ctx, root(4)), "Block below the backfill root was considered finalized")
+
+	// Continue from the previous backfill root.
+	require.NoError(t, db.SaveBackfillBlockRoot(ctx, root(2)))
+	for i := 2; i < 5; i++ {
+		assert.Equal(t, true, db.IsFinalizedBlock(ctx, root(i)), "Block at index %d was not considered finalized", i)
+	}
+
+	// A block which is not an ancestor of the backfill root can not be reached.
+	require.ErrorContains(t, "missing block", db.SaveBackfillBlockRoot(ctx, bytesutil.ToBytes32([]byte{'x'})))
+}
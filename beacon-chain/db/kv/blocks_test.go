@@ -160,6 +160,20 @@ func TestStore_GenesisBlock(t *testing.T) {
 	assert.Equal(t, true, proto.Equal(genesisBlock, retrievedBlock), "Wanted: %v, received: %v", genesisBlock, retrievedBlock)
 }
 
+func TestStore_OriginBlockRoot(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	root, err := db.OriginBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{}, root, "Expected no origin block root")
+
+	want := bytesutil.ToBytes32([]byte{'o', 'r', 'i', 'g', 'i', 'n'})
+	require.NoError(t, db.SaveOriginBlockRoot(ctx, want))
+	root, err = db.OriginBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, want, root)
+}
+
 func TestStore_BlocksCRUD_NoCache(t *testing.T) {
 	db := setupDB(t)
 	ctx := context.Background()
@@ -63,6 +63,32 @@ func TestStore_IsFinalizedBlockGenesis(t *testing.T) {
 	assert.Equal(t, true, db.IsFinalizedBlock(ctx, root), "Finalized genesis block doesn't exist in db")
 }
 
+func TestStore_IsFinalizedBlock_FromOrigin(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	db := setupDB(t)
+	ctx := context.Background()
+
+	// The parent of the origin block is unknown to a node started from a checkpoint.
+	blks := makeBlocks(t, slotsPerEpoch, slotsPerEpoch*2, bytesutil.ToBytes32([]byte{'p'}))
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	originRoot, err := blks[0].Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveOriginBlockRoot(ctx, originRoot))
+	require.NoError(t, db.SaveState(ctx, testutil.NewBeaconState(), originRoot))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: originRoot[:]}))
+	assert.Equal(t, true, db.IsFinalizedBlock(ctx, originRoot), "Origin block was not considered finalized")
+
+	root, err := blks[slotsPerEpoch].Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, testutil.NewBeaconState(), root))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: root[:]}))
+	for i := uint64(0); i <= slotsPerEpoch; i++ {
+		root, err := blks[i].Block.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, true, db.IsFinalizedBlock(ctx, root), "Block at index %d was not considered finalized in the index", i)
+	}
+}
+
 // This test scenario is to test a specific edge case where the finalized block root is not part of
 // the finalized and canonical chain.
 //
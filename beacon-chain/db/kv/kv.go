@@ -46,6 +46,7 @@ type Store struct {
 	validatorIndexCache *ristretto.Cache
 	stateSummaryCache   *stateSummaryCache
 	ctx                 context.Context
+	readOnly            bool
 }
 
 // NewKVStore initializes a new boltDB key-value store at the directory
@@ -70,33 +71,11 @@ func NewKVStore(ctx context.Context, dirPath string) (*Store, error) {
 		return nil, err
 	}
 	boltDB.AllocSize = boltAllocSize
-	blockCache, err := ristretto.NewCache(&ristretto.Config{
-		NumCounters: 1000,           // number of keys to track frequency of (1000).
-		MaxCost:     BlockCacheSize, // maximum cost of cache (1000 Blocks).
-		BufferItems: 64,             // number of keys per Get buffer.
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	validatorCache, err := ristretto.NewCache(&ristretto.Config{
-		NumCounters: NumOfVotes,     // number of keys to track frequency of (1M).
-		MaxCost:     VotesCacheSize, // maximum cost of cache (8MB).
-		BufferItems: 64,             // number of keys per Get buffer.
-	})
+	kv, err := newStore(ctx, dirPath, boltDB)
 	if err != nil {
 		return nil, err
 	}
 
-	kv := &Store{
-		db:                  boltDB,
-		databasePath:        dirPath,
-		blockCache:          blockCache,
-		validatorIndexCache: validatorCache,
-		stateSummaryCache:   newStateSummaryCache(),
-		ctx:                 ctx,
-	}
-
 	if err := kv.db.Update(func(tx *bolt.Tx) error {
 		return createBuckets(
 			tx,
@@ -134,6 +113,58 @@ func NewKVStore(ctx context.Context, dirPath string) (*Store, error) {
 	return kv, err
 }
 
+// NewReadOnlyKVStore opens the existing boltDB key-value store at the directory path
+// specified in read-only mode, so the database of a beacon node can be inspected by
+// other tools without modifying it.
+func NewReadOnlyKVStore(ctx context.Context, dirPath string) (*Store, error) {
+	datafile := path.Join(dirPath, DatabaseFileName)
+	if !fileutil.FileExists(datafile) {
+		return nil, errors.Errorf("no beacon node database found at %s", datafile)
+	}
+	boltDB, err := bolt.Open(datafile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{Timeout: 1 * time.Second, ReadOnly: true})
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
+		}
+		return nil, err
+	}
+	kv, err := newStore(ctx, dirPath, boltDB)
+	if err != nil {
+		return nil, err
+	}
+	kv.readOnly = true
+	return kv, nil
+}
+
+func newStore(ctx context.Context, dirPath string, boltDB *bolt.DB) (*Store, error) {
+	blockCache, err := ristretto.NewCache(&ristretto.Config{
+		NumCounters: 1000,           // number of keys to track frequency of (1000).
+		MaxCost:     BlockCacheSize, // maximum cost of cache (1000 Blocks).
+		BufferItems: 64,             // number of keys per Get buffer.
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	validatorCache, err := ristretto.NewCache(&ristretto.Config{
+		NumCounters: NumOfVotes,     // number of keys to track frequency of (1M).
+		MaxCost:     VotesCacheSize, // maximum cost of cache (8MB).
+		BufferItems: 64,             // number of keys per Get buffer.
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{
+		db:                  boltDB,
+		databasePath:        dirPath,
+		blockCache:          blockCache,
+		validatorIndexCache: validatorCache,
+		stateSummaryCache:   newStateSummaryCache(),
+		ctx:                 ctx,
+	}, nil
+}
+
 // ClearDB removes the previously stored database in the data directory.
 func (s *Store) ClearDB() error {
 	if _, err := os.Stat(s.databasePath); os.IsNotExist(err) {
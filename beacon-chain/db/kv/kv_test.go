@@ -4,6 +4,8 @@ import (
 	"context"
 	"testing"
 
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
 
@@ -16,3 +18,28 @@ func setupDB(t testing.TB) *Store {
 	})
 	return db
 }
+
+func TestNewReadOnlyKVStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	_, err := NewReadOnlyKVStore(ctx, dir)
+	require.ErrorContains(t, "no beacon node database found", err)
+
+	db, err := NewKVStore(ctx, dir)
+	require.NoError(t, err)
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 5
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, blk))
+	require.NoError(t, db.Close())
+
+	readOnlyDB, err := NewReadOnlyKVStore(ctx, dir)
+	require.NoError(t, err)
+	received, err := readOnlyDB.Block(ctx, root)
+	require.NoError(t, err)
+	require.DeepEqual(t, blk, received)
+	require.NotNil(t, readOnlyDB.SaveBlock(ctx, testutil.NewBeaconBlock()), "Expected writes to a read-only database to fail")
+	require.NoError(t, readOnlyDB.SaveStateSummary(ctx, &pb.StateSummary{Slot: 5, Root: root[:]}))
+	require.NoError(t, readOnlyDB.Close())
+}
@@ -37,6 +37,8 @@ var (
 	// Specific item keys.
 	headBlockRootKey          = []byte("head-root")
 	genesisBlockRootKey       = []byte("genesis-root")
+	originBlockRootKey        = []byte("origin-root")
+	backfillBlockRootKey      = []byte("backfill-root")
 	depositContractAddressKey = []byte("deposit-contract")
 	justifiedCheckpointKey    = []byte("justified-checkpoint")
 	finalizedCheckpointKey    = []byte("finalized-checkpoint")
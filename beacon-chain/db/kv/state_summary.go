@@ -92,6 +92,11 @@ func (s *Store) stateSummaryBytes(ctx context.Context, blockRoot [32]byte) ([]by
 
 // This saves all cached state summary objects to DB, and clears up the cache.
 func (s *Store) saveCachedStateSummariesDB(ctx context.Context) error {
+	// Summaries cannot be persisted to a read-only database, they are recovered from blocks when needed.
+	if s.readOnly {
+		s.stateSummaryCache.clear()
+		return nil
+	}
 	summaries := s.stateSummaryCache.getAll()
 	encs := make([][]byte, len(summaries))
 	for i, s := range summaries {
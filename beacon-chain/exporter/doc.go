@@ -0,0 +1,43 @@
+/*
+Package exporter streams events of the beacon node to an external sink, such as Kafka,
+for data analysis.
+
+The exporter subscribes to the state, block and operation feeds of the beacon node and
+publishes one message per event to a topic of the event type, named by the topic prefix
+followed by the event type, e.g. "beacon_events_head" for the default prefix.
+
+Every message value is a JSON envelope:
+
+	{
+	  "version": 1,
+	  "type": "head",
+	  "timestamp": "2020-11-20T12:00:00.123456789Z",
+	  "data": {...}
+	}
+
+The version is incremented on breaking changes of the envelope or of an event payload, the
+timestamp is the time at which the beacon node exported the event and the data is the
+payload of the event type:
+
+	head                  a new chain head: slot, block, state and epoch_transition.
+	finalized_checkpoint  a new finalized checkpoint: epoch, block and state.
+	chain_reorg           a head which does not descend from the previous one: slot, depth,
+	                      old_head_block, new_head_block, common_ancestor_slot and
+	                      common_ancestor_block.
+	block                 a signed beacon block received over gossip or RPC.
+	attestation           an unaggregated attestation received over gossip or RPC.
+	aggregate_and_proof   an aggregate attestation and proof received over gossip.
+	voluntary_exit        a signed voluntary exit received over gossip or RPC.
+	proposer_slashing     a proposer slashing received over gossip or RPC.
+	attester_slashing     an attester slashing received over gossip or RPC.
+
+Payloads of the head, finalized_checkpoint and chain_reorg events encode integers as
+decimal strings and roots as 0x-prefixed hex strings. Payloads of the other events are the
+proto3 JSON mapping of the corresponding ethereumapis messages with their original field
+names, so that integers are decimal strings and byte fields are base64 encoded.
+
+Messages of the head, finalized_checkpoint and chain_reorg events are keyed by the root of
+the new head or finalized block and messages of block events by the root of the block,
+other messages are not keyed.
+*/
+package exporter
@@ -0,0 +1,221 @@
+package exporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+)
+
+// EnvelopeVersion is the version of the envelope and event payloads published by the exporter.
+const EnvelopeVersion = 1
+
+// Types of the exported events.
+const (
+	EventHead                = "head"
+	EventFinalizedCheckpoint = "finalized_checkpoint"
+	EventChainReorg          = "chain_reorg"
+	EventBlock               = "block"
+	EventAttestation         = "attestation"
+	EventAggregateAndProof   = "aggregate_and_proof"
+	EventVoluntaryExit       = "voluntary_exit"
+	EventProposerSlashing    = "proposer_slashing"
+	EventAttesterSlashing    = "attester_slashing"
+)
+
+var marshaler = &jsonpb.Marshaler{OrigName: true, EmitDefaults: true}
+
+// Envelope wraps the payload of every exported event.
+type Envelope struct {
+	Version   int             `json:"version"`
+	Type      string          `json:"type"`
+	Timestamp time.Time       `json:"timestamp"`
+	Data      json.RawMessage `json:"data"`
+}
+
+type headData struct {
+	Slot            string `json:"slot"`
+	Block           string `json:"block"`
+	State           string `json:"state"`
+	EpochTransition bool   `json:"epoch_transition"`
+}
+
+type finalizedCheckpointData struct {
+	Epoch string `json:"epoch"`
+	Block string `json:"block"`
+	State string `json:"state"`
+}
+
+type chainReorgData struct {
+	Slot                string `json:"slot"`
+	Depth               string `json:"depth"`
+	OldHeadBlock        string `json:"old_head_block"`
+	NewHeadBlock        string `json:"new_head_block"`
+	CommonAncestorSlot  string `json:"common_ancestor_slot"`
+	CommonAncestorBlock string `json:"common_ancestor_block"`
+}
+
+// event is a feed event ready to be wrapped in an envelope.
+type event struct {
+	eventType string
+	key       []byte
+	data      interface{}
+}
+
+// stateEvent returns the exported event of a state feed event, or nil for events which
+// are not exported.
+func stateEvent(ev *feed.Event) *event {
+	switch ev.Type {
+	case statefeed.NewHead:
+		data, ok := ev.Data.(*statefeed.NewHeadData)
+		if !ok {
+			return nil
+		}
+		return &event{
+			eventType: EventHead,
+			key:       rootKey(data.BlockRoot),
+			data: &headData{
+				Slot:            uintString(data.Slot),
+				Block:           hexString(data.BlockRoot[:]),
+				State:           hexString(data.StateRoot[:]),
+				EpochTransition: data.EpochTransition,
+			},
+		}
+	case statefeed.FinalizedCheckpoint:
+		data, ok := ev.Data.(*statefeed.FinalizedCheckpointData)
+		if !ok {
+			return nil
+		}
+		return &event{
+			eventType: EventFinalizedCheckpoint,
+			key:       rootKey(data.BlockRoot),
+			data: &finalizedCheckpointData{
+				Epoch: uintString(data.Epoch),
+				Block: hexString(data.BlockRoot[:]),
+				State: hexString(data.StateRoot[:]),
+			},
+		}
+	case statefeed.Reorg:
+		data, ok := ev.Data.(*statefeed.ReorgData)
+		if !ok {
+			return nil
+		}
+		return &event{
+			eventType: EventChainReorg,
+			key:       rootKey(data.NewHeadRoot),
+			data: &chainReorgData{
+				Slot:                uintString(data.NewSlot),
+				Depth:               uintString(data.Depth),
+				OldHeadBlock:        hexString(data.OldHeadRoot[:]),
+				NewHeadBlock:        hexString(data.NewHeadRoot[:]),
+				CommonAncestorSlot:  uintString(data.CommonAncestorSlot),
+				CommonAncestorBlock: hexString(data.CommonAncestorRoot[:]),
+			},
+		}
+	}
+	return nil
+}
+
+// blockEvent returns the exported event of a block feed event, or nil for events which
+// are not exported.
+func blockEvent(ev *feed.Event) *event {
+	if ev.Type != blockfeed.ReceivedBlock {
+		return nil
+	}
+	data, ok := ev.Data.(*blockfeed.ReceivedBlockData)
+	if !ok || data.SignedBlock == nil || data.SignedBlock.Block == nil {
+		return nil
+	}
+	root, err := data.SignedBlock.Block.HashTreeRoot()
+	if err != nil {
+		log.WithError(err).Debug("Could not compute root of exported block")
+		return nil
+	}
+	return &event{
+		eventType: EventBlock,
+		key:       rootKey(root),
+		data:      data.SignedBlock,
+	}
+}
+
+// operationEvent returns the exported event of an operation feed event, or nil for events
+// which are not exported.
+func operationEvent(ev *feed.Event) *event {
+	switch ev.Type {
+	case opfeed.UnaggregatedAttReceived:
+		data, ok := ev.Data.(*opfeed.UnAggregatedAttReceivedData)
+		if !ok || data.Attestation == nil {
+			return nil
+		}
+		return &event{eventType: EventAttestation, data: data.Attestation}
+	case opfeed.AggregatedAttReceived:
+		data, ok := ev.Data.(*opfeed.AggregatedAttReceivedData)
+		if !ok || data.Attestation == nil {
+			return nil
+		}
+		return &event{eventType: EventAggregateAndProof, data: data.Attestation}
+	case opfeed.ExitReceived:
+		data, ok := ev.Data.(*opfeed.ExitReceivedData)
+		if !ok || data.Exit == nil {
+			return nil
+		}
+		return &event{eventType: EventVoluntaryExit, data: data.Exit}
+	case opfeed.ProposerSlashingReceived:
+		data, ok := ev.Data.(*opfeed.ProposerSlashingReceivedData)
+		if !ok || data.ProposerSlashing == nil {
+			return nil
+		}
+		return &event{eventType: EventProposerSlashing, data: data.ProposerSlashing}
+	case opfeed.AttesterSlashingReceived:
+		data, ok := ev.Data.(*opfeed.AttesterSlashingReceivedData)
+		if !ok || data.AttesterSlashing == nil {
+			return nil
+		}
+		return &event{eventType: EventAttesterSlashing, data: data.AttesterSlashing}
+	}
+	return nil
+}
+
+// encode wraps the event payload in a JSON envelope.
+func (e *event) encode(timestamp time.Time) ([]byte, error) {
+	var data []byte
+	if msg, ok := e.data.(proto.Message); ok {
+		buf := bytes.NewBuffer(nil)
+		if err := marshaler.Marshal(buf, msg); err != nil {
+			return nil, errors.Wrapf(err, "could not marshal %s event", e.eventType)
+		}
+		data = buf.Bytes()
+	} else {
+		var err error
+		data, err = json.Marshal(e.data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not marshal %s event", e.eventType)
+		}
+	}
+	return json.Marshal(&Envelope{
+		Version:   EnvelopeVersion,
+		Type:      e.eventType,
+		Timestamp: timestamp.UTC(),
+		Data:      data,
+	})
+}
+
+func rootKey(root [32]byte) []byte {
+	return root[:]
+}
+
+func uintString(i uint64) string {
+	return fmt.Sprintf("%d", i)
+}
+
+func hexString(b []byte) string {
+	return fmt.Sprintf("%#x", b)
+}
@@ -0,0 +1,72 @@
+// Package kafka defines an exporter sink which produces the exported beacon chain
+// events to Kafka topics.
+package kafka
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/exporter"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/confluentinc/confluent-kafka-go.v1/kafka"
+	_ "gopkg.in/confluentinc/confluent-kafka-go.v1/kafka/librdkafka" // Required for c++ kafka library.
+)
+
+var _ exporter.Sink = (*Sink)(nil)
+var log = logrus.WithField("prefix", "exporter")
+
+// Time to wait for outstanding messages to be delivered when the sink is closed.
+const flushTimeoutMs = 5000
+
+// Sink produces messages to Kafka topics.
+type Sink struct {
+	p *kafka.Producer
+}
+
+// NewSink connects a producer to the given comma separated list of Kafka bootstrap servers.
+func NewSink(bootstrapServers string) (*Sink, error) {
+	p, err := kafka.NewProducer(&kafka.ConfigMap{"bootstrap.servers": bootstrapServers})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create kafka producer")
+	}
+	s := &Sink{p: p}
+	go s.logDeliveryFailures()
+	return s, nil
+}
+
+// Publish enqueues the message for delivery to its topic.
+func (s *Sink) Publish(_ context.Context, msg *exporter.Message) error {
+	topic := msg.Topic
+	return s.p.Produce(&kafka.Message{
+		TopicPartition: kafka.TopicPartition{
+			Topic:     &topic,
+			Partition: kafka.PartitionAny,
+		},
+		Key:   msg.Key,
+		Value: msg.Value,
+	}, nil)
+}
+
+// Close waits for outstanding messages to be delivered and closes the producer.
+func (s *Sink) Close() error {
+	if remaining := s.p.Flush(flushTimeoutMs); remaining > 0 {
+		log.WithField("messages", remaining).Warn("Closing kafka producer with undelivered messages")
+	}
+	s.p.Close()
+	return nil
+}
+
+// logDeliveryFailures reports messages which could not be delivered, until the producer is closed.
+func (s *Sink) logDeliveryFailures() {
+	for e := range s.p.Events() {
+		m, ok := e.(*kafka.Message)
+		if !ok || m.TopicPartition.Error == nil {
+			continue
+		}
+		topic := ""
+		if m.TopicPartition.Topic != nil {
+			topic = *m.TopicPartition.Topic
+		}
+		log.WithError(m.TopicPartition.Error).WithField("topic", topic).Error("Could not deliver message")
+	}
+}
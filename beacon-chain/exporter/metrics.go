@@ -0,0 +1,23 @@
+package exporter
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	exportedEventsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "exporter_events_total",
+			Help: "Count of events published to the exporter sink.",
+		},
+		[]string{"type"},
+	)
+	failedEventsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "exporter_failed_events_total",
+			Help: "Count of events which could not be published to the exporter sink.",
+		},
+		[]string{"type"},
+	)
+)
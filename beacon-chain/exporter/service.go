@@ -0,0 +1,138 @@
+package exporter
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+var _ shared.Service = (*Service)(nil)
+
+// DefaultTopicPrefix is the prefix of the topics published to, unless configured otherwise.
+const DefaultTopicPrefix = "beacon_events_"
+
+// Enough to absorb bursts of events without blocking the feed senders on a slow sink.
+const eventsChannelSize = 1000
+
+// Config to set up the exporter service.
+type Config struct {
+	StateNotifier     statefeed.Notifier
+	BlockNotifier     blockfeed.Notifier
+	OperationNotifier opfeed.Notifier
+	Sink              Sink
+	TopicPrefix       string
+}
+
+// Service publishes the events of the beacon node feeds to a sink.
+type Service struct {
+	ctx               context.Context
+	cancel            context.CancelFunc
+	stateNotifier     statefeed.Notifier
+	blockNotifier     blockfeed.Notifier
+	operationNotifier opfeed.Notifier
+	sink              Sink
+	topicPrefix       string
+	done              chan struct{}
+}
+
+// NewService initializes the exporter service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	topicPrefix := cfg.TopicPrefix
+	if topicPrefix == "" {
+		topicPrefix = DefaultTopicPrefix
+	}
+	return &Service{
+		ctx:               ctx,
+		cancel:            cancel,
+		stateNotifier:     cfg.StateNotifier,
+		blockNotifier:     cfg.BlockNotifier,
+		operationNotifier: cfg.OperationNotifier,
+		sink:              cfg.Sink,
+		topicPrefix:       topicPrefix,
+		done:              make(chan struct{}),
+	}
+}
+
+// Start the exporter service.
+func (s *Service) Start() {
+	stateChannel := make(chan *feed.Event, eventsChannelSize)
+	stateSub := s.stateNotifier.StateFeed().Subscribe(stateChannel)
+	blockChannel := make(chan *feed.Event, eventsChannelSize)
+	blockSub := s.blockNotifier.BlockFeed().Subscribe(blockChannel)
+	opChannel := make(chan *feed.Event, eventsChannelSize)
+	opSub := s.operationNotifier.OperationFeed().Subscribe(opChannel)
+	go func() {
+		defer close(s.done)
+		defer stateSub.Unsubscribe()
+		defer blockSub.Unsubscribe()
+		defer opSub.Unsubscribe()
+		for {
+			select {
+			case ev := <-stateChannel:
+				s.export(stateEvent(ev))
+			case ev := <-blockChannel:
+				s.export(blockEvent(ev))
+			case ev := <-opChannel:
+				s.export(operationEvent(ev))
+			case err := <-stateSub.Err():
+				log.WithError(err).Error("Could not subscribe to state events")
+				return
+			case err := <-blockSub.Err():
+				log.WithError(err).Error("Could not subscribe to block events")
+				return
+			case err := <-opSub.Err():
+				log.WithError(err).Error("Could not subscribe to operation events")
+				return
+			case <-s.ctx.Done():
+				return
+			}
+		}
+	}()
+	log.WithField("topicPrefix", s.topicPrefix).Info("Exporting beacon chain events")
+}
+
+// Stop the exporter service and close its sink.
+func (s *Service) Stop() error {
+	s.cancel()
+	<-s.done
+	return s.sink.Close()
+}
+
+// Status of the exporter service.
+func (s *Service) Status() error {
+	return nil
+}
+
+// Topic returns the topic to which events of the given type are published.
+func (s *Service) Topic(eventType string) string {
+	return s.topicPrefix + eventType
+}
+
+// export publishes an event to the sink, events which are not exported are nil.
+func (s *Service) export(ev *event) {
+	if ev == nil {
+		return
+	}
+	value, err := ev.encode(timeutils.Now())
+	if err != nil {
+		failedEventsCounter.WithLabelValues(ev.eventType).Inc()
+		log.WithError(err).Error("Could not encode event")
+		return
+	}
+	if err := s.sink.Publish(s.ctx, &Message{
+		Topic: s.Topic(ev.eventType),
+		Key:   ev.key,
+		Value: value,
+	}); err != nil {
+		failedEventsCounter.WithLabelValues(ev.eventType).Inc()
+		log.WithError(err).WithField("type", ev.eventType).Error("Could not publish event")
+		return
+	}
+	exportedEventsCounter.WithLabelValues(ev.eventType).Inc()
+}
@@ -0,0 +1,205 @@
+package exporter
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func setupService(t *testing.T, topicPrefix string) (*Service, *mock.ChainService, *MemorySink) {
+	chainService := &mock.ChainService{}
+	sink := NewMemorySink()
+	s := NewService(context.Background(), &Config{
+		StateNotifier:     chainService.StateNotifier(),
+		BlockNotifier:     chainService.BlockNotifier(),
+		OperationNotifier: chainService.OperationNotifier(),
+		Sink:              sink,
+		TopicPrefix:       topicPrefix,
+	})
+	s.Start()
+	return s, chainService, sink
+}
+
+// waitForMessages waits for the sink to receive the given number of messages and returns
+// them by topic.
+func waitForMessages(t *testing.T, sink *MemorySink, count int) map[string]*Message {
+	deadline := time.Now().Add(5 * time.Second)
+	for len(sink.Messages()) < count {
+		if time.Now().After(deadline) {
+			t.Fatalf("Received %d messages, wanted %d", len(sink.Messages()), count)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	byTopic := make(map[string]*Message)
+	for _, msg := range sink.Messages() {
+		byTopic[msg.Topic] = msg
+	}
+	return byTopic
+}
+
+func decodeEnvelope(t *testing.T, msg *Message, data interface{}) *Envelope {
+	env := &Envelope{}
+	require.NoError(t, json.Unmarshal(msg.Value, env))
+	require.NoError(t, json.Unmarshal(env.Data, data))
+	return env
+}
+
+func TestService_ExportsStateEvents(t *testing.T) {
+	s, chainService, sink := setupService(t, "")
+	stateFeed := chainService.StateNotifier().StateFeed()
+	stateFeed.Send(&feed.Event{
+		Type: statefeed.BlockProcessed,
+		Data: &statefeed.BlockProcessedData{Slot: 9},
+	})
+	stateFeed.Send(&feed.Event{
+		Type: statefeed.NewHead,
+		Data: &statefeed.NewHeadData{Slot: 10, BlockRoot: [32]byte{'a'}, StateRoot: [32]byte{'b'}, EpochTransition: true},
+	})
+	stateFeed.Send(&feed.Event{
+		Type: statefeed.FinalizedCheckpoint,
+		Data: &statefeed.FinalizedCheckpointData{Epoch: 2, BlockRoot: [32]byte{'c'}, StateRoot: [32]byte{'d'}},
+	})
+	stateFeed.Send(&feed.Event{
+		Type: statefeed.Reorg,
+		Data: &statefeed.ReorgData{
+			OldSlot:            11,
+			OldHeadRoot:        [32]byte{'e'},
+			NewSlot:            12,
+			NewHeadRoot:        [32]byte{'f'},
+			CommonAncestorSlot: 9,
+			CommonAncestorRoot: [32]byte{'g'},
+			Depth:              2,
+		},
+	})
+	messages := waitForMessages(t, sink, 3)
+	require.NoError(t, s.Stop())
+	assert.Equal(t, 3, len(sink.Messages()), "Block processed events should not be exported")
+
+	msg, ok := messages["beacon_events_head"]
+	require.Equal(t, true, ok, "No head event")
+	head := &headData{}
+	env := decodeEnvelope(t, msg, head)
+	assert.Equal(t, EnvelopeVersion, env.Version)
+	assert.Equal(t, EventHead, env.Type)
+	assert.Equal(t, false, env.Timestamp.IsZero())
+	assert.DeepEqual(t, &headData{
+		Slot:            "10",
+		Block:           hexString([]byte{'a', 31: 0}),
+		State:           hexString([]byte{'b', 31: 0}),
+		EpochTransition: true,
+	}, head)
+	assert.DeepEqual(t, []byte{'a', 31: 0}, msg.Key)
+
+	msg, ok = messages["beacon_events_finalized_checkpoint"]
+	require.Equal(t, true, ok, "No finalized checkpoint event")
+	finalized := &finalizedCheckpointData{}
+	decodeEnvelope(t, msg, finalized)
+	assert.Equal(t, "2", finalized.Epoch)
+	assert.Equal(t, hexString([]byte{'c', 31: 0}), finalized.Block)
+
+	msg, ok = messages["beacon_events_chain_reorg"]
+	require.Equal(t, true, ok, "No chain reorg event")
+	reorg := &chainReorgData{}
+	decodeEnvelope(t, msg, reorg)
+	assert.Equal(t, "12", reorg.Slot)
+	assert.Equal(t, "2", reorg.Depth)
+	assert.Equal(t, hexString([]byte{'e', 31: 0}), reorg.OldHeadBlock)
+	assert.Equal(t, "9", reorg.CommonAncestorSlot)
+	assert.Equal(t, true, sink.Closed())
+}
+
+func TestService_ExportsBlocksAndOperations(t *testing.T) {
+	s, chainService, sink := setupService(t, "analytics.")
+	defer func() {
+		require.NoError(t, s.Stop())
+	}()
+	block := testutil.NewBeaconBlock()
+	block.Block.Slot = 5
+	chainService.BlockNotifier().BlockFeed().Send(&feed.Event{
+		Type: blockfeed.ReceivedBlock,
+		Data: &blockfeed.ReceivedBlockData{SignedBlock: block},
+	})
+	att := &ethpb.Attestation{
+		AggregationBits: []byte{0b11},
+		Data: &ethpb.AttestationData{
+			Slot:   6,
+			Source: &ethpb.Checkpoint{},
+			Target: &ethpb.Checkpoint{},
+		},
+	}
+	opFeed := chainService.OperationNotifier().OperationFeed()
+	opFeed.Send(&feed.Event{
+		Type: opfeed.UnaggregatedAttReceived,
+		Data: &opfeed.UnAggregatedAttReceivedData{Attestation: att},
+	})
+	opFeed.Send(&feed.Event{
+		Type: opfeed.AggregatedAttReceived,
+		Data: &opfeed.AggregatedAttReceivedData{
+			Attestation: &ethpb.AggregateAttestationAndProof{AggregatorIndex: 3, Aggregate: att},
+		},
+	})
+	opFeed.Send(&feed.Event{
+		Type: opfeed.ExitReceived,
+		Data: &opfeed.ExitReceivedData{
+			Exit: &ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{Epoch: 4, ValidatorIndex: 7}},
+		},
+	})
+	opFeed.Send(&feed.Event{
+		Type: opfeed.ProposerSlashingReceived,
+		Data: &opfeed.ProposerSlashingReceivedData{ProposerSlashing: &ethpb.ProposerSlashing{}},
+	})
+	opFeed.Send(&feed.Event{
+		Type: opfeed.AttesterSlashingReceived,
+		Data: &opfeed.AttesterSlashingReceivedData{AttesterSlashing: &ethpb.AttesterSlashing{}},
+	})
+	messages := waitForMessages(t, sink, 6)
+
+	msg, ok := messages["analytics.block"]
+	require.Equal(t, true, ok, "No block event")
+	root, err := block.Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, root[:], msg.Key)
+	blockData := make(map[string]interface{})
+	env := decodeEnvelope(t, msg, &blockData)
+	assert.Equal(t, EventBlock, env.Type)
+	blockFields, ok := blockData["block"].(map[string]interface{})
+	require.Equal(t, true, ok, "No block in block event")
+	assert.Equal(t, "5", blockFields["slot"])
+
+	msg, ok = messages["analytics.attestation"]
+	require.Equal(t, true, ok, "No attestation event")
+	assert.Equal(t, 0, len(msg.Key))
+	attData := make(map[string]interface{})
+	decodeEnvelope(t, msg, &attData)
+	assert.Equal(t, "Aw==", attData["aggregation_bits"])
+
+	msg, ok = messages["analytics.aggregate_and_proof"]
+	require.Equal(t, true, ok, "No aggregate event")
+	aggregateData := make(map[string]interface{})
+	decodeEnvelope(t, msg, &aggregateData)
+	assert.Equal(t, "3", aggregateData["aggregator_index"])
+
+	msg, ok = messages["analytics.voluntary_exit"]
+	require.Equal(t, true, ok, "No voluntary exit event")
+	exitData := make(map[string]interface{})
+	decodeEnvelope(t, msg, &exitData)
+	exitFields, ok := exitData["exit"].(map[string]interface{})
+	require.Equal(t, true, ok, "No exit in voluntary exit event")
+	assert.Equal(t, "7", exitFields["validator_index"])
+
+	_, ok = messages["analytics.proposer_slashing"]
+	assert.Equal(t, true, ok, "No proposer slashing event")
+	_, ok = messages["analytics.attester_slashing"]
+	assert.Equal(t, true, ok, "No attester slashing event")
+}
@@ -0,0 +1,67 @@
+package exporter
+
+import (
+	"context"
+	"sync"
+)
+
+// Message is a message published to a sink.
+type Message struct {
+	Topic string
+	Key   []byte
+	Value []byte
+}
+
+// Sink receives the messages published by the exporter. Publish is called for every
+// exported event in order and should not block on delivery.
+type Sink interface {
+	Publish(ctx context.Context, msg *Message) error
+	Close() error
+}
+
+// MemorySink is a sink keeping all published messages in memory, as an in-process stand-in
+// for an external sink.
+type MemorySink struct {
+	lock     sync.RWMutex
+	messages []*Message
+	closed   bool
+}
+
+// NewMemorySink initializes an empty in-memory sink.
+func NewMemorySink() *MemorySink {
+	return &MemorySink{
+		messages: make([]*Message, 0),
+	}
+}
+
+// Publish stores the message.
+func (s *MemorySink) Publish(_ context.Context, msg *Message) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.messages = append(s.messages, msg)
+	return nil
+}
+
+// Close marks the sink as closed.
+func (s *MemorySink) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.closed = true
+	return nil
+}
+
+// Messages returns the published messages in order of publication.
+func (s *MemorySink) Messages() []*Message {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	messages := make([]*Message, len(s.messages))
+	copy(messages, s.messages)
+	return messages
+}
+
+// Closed returns whether the sink has been closed.
+func (s *MemorySink) Closed() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.closed
+}
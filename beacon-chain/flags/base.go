@@ -111,6 +111,18 @@ var (
 		Name:  "disable-discv5",
 		Usage: "Does not run the discoveryV5 dht.",
 	}
+	// RPCTraceFile defines a flag to record the req/resp exchanges with peers to a file.
+	RPCTraceFile = &cli.StringFlag{
+		Name: "p2p-reqresp-trace-file",
+		Usage: "Records every request and response exchanged with peers over the req/resp protocols to the given file, " +
+			"which can be replayed against a local node with `pcli replay`.",
+	}
+	// RPCTraceMaxFileSize defines a flag to set the size at which the req/resp trace file is rotated.
+	RPCTraceMaxFileSize = &cli.Int64Flag{
+		Name:  "p2p-reqresp-trace-max-size",
+		Usage: "The size, in megabytes, at which the req/resp trace file is rotated.",
+		Value: 100,
+	}
 	// BlockBatchLimit specifies the requested block batch size.
 	BlockBatchLimit = &cli.IntFlag{
 		Name:  "block-batch-limit",
@@ -166,4 +178,22 @@ var (
 		Usage: "Sets the maximum number of headers that a deposit log query can fetch.",
 		Value: uint64(1000),
 	}
+	// CheckpointBlock defines a flag to set the path of an SSZ encoded finalized block to start the beacon chain from.
+	CheckpointBlock = &cli.StringFlag{
+		Name: "checkpoint-block",
+		Usage: "Path to an SSZ encoded signed beacon block of a trusted finalized checkpoint to start the beacon chain from " +
+			"instead of genesis. Requires --checkpoint-state and is ignored if the database already holds a beacon chain.",
+	}
+	// CheckpointState defines a flag to set the path of an SSZ encoded finalized state to start the beacon chain from.
+	CheckpointState = &cli.StringFlag{
+		Name:  "checkpoint-state",
+		Usage: "Path to an SSZ encoded beacon state, the post state of the block given by --checkpoint-block.",
+	}
+	// CheckpointSyncProvider defines a flag to set a trusted beacon node to download the finalized checkpoint from.
+	CheckpointSyncProvider = &cli.StringFlag{
+		Name: "checkpoint-sync-provider",
+		Usage: "gRPC endpoint of a trusted beacon node, running with --enable-debug-rpc-endpoints, to download the latest " +
+			"finalized block and state from and start the beacon chain at instead of genesis. " +
+			"Ignored if the database already holds a beacon chain.",
+	}
 )
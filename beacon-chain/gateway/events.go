@@ -0,0 +1,341 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/shared/event"
+)
+
+// EventsPath is the path under which chain events are served as Server-Sent Events.
+const EventsPath = "/eth/v1/events"
+
+// Event topics which may be requested from the events endpoint.
+const (
+	topicHead                = "head"
+	topicBlock               = "block"
+	topicAttestation         = "attestation"
+	topicVoluntaryExit       = "voluntary_exit"
+	topicFinalizedCheckpoint = "finalized_checkpoint"
+	topicChainReorg          = "chain_reorg"
+)
+
+var eventTopics = map[string]bool{
+	topicHead:                true,
+	topicBlock:               true,
+	topicAttestation:         true,
+	topicVoluntaryExit:       true,
+	topicFinalizedCheckpoint: true,
+	topicChainReorg:          true,
+}
+
+// Number of events queued for a client. A client falling further behind is disconnected, so
+// that slow clients never block the senders of the state and operation feeds.
+const eventsChannelSize = 100
+
+// sseEvent is an event queued to be written to a client.
+type sseEvent struct {
+	topic string
+	data  interface{}
+}
+
+// EventsServer returns a handler streaming the requested topics of beacon chain events
+// as Server-Sent Events, e.g. "/eth/v1/events?topics=head,block".
+func EventsServer(stateNotifier statefeed.Notifier, opNotifier opfeed.Notifier) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		topics, err := requestedTopics(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "Streaming is not supported", http.StatusInternalServerError)
+			return
+		}
+
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		stateChannel := make(chan *feed.Event, 1)
+		stateSub := stateNotifier.StateFeed().Subscribe(stateChannel)
+		opChannel := make(chan *feed.Event, 1)
+		opSub := opNotifier.OperationFeed().Subscribe(opChannel)
+		events := make(chan *sseEvent, eventsChannelSize)
+		go relayEvents(ctx, cancel, topics, stateChannel, stateSub, opChannel, opSub, events)
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		for {
+			select {
+			case e := <-events:
+				if err := writeEvent(w, e.topic, e.data); err != nil {
+					log.WithError(err).Debug("Could not write event")
+					return
+				}
+				flusher.Flush()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// relayEvents converts the feed events of the requested topics and queues them for the client,
+// without ever blocking on the client. The client is disconnected, by cancelling its context,
+// once its queue is full or once either feed subscription ends. The feed subscriptions are
+// ended on return, even if writing to the client is still blocked.
+func relayEvents(
+	ctx context.Context,
+	cancel context.CancelFunc,
+	topics map[string]bool,
+	stateChannel <-chan *feed.Event,
+	stateSub event.Subscription,
+	opChannel <-chan *feed.Event,
+	opSub event.Subscription,
+	events chan<- *sseEvent,
+) {
+	defer cancel()
+	defer stateSub.Unsubscribe()
+	defer opSub.Unsubscribe()
+	for {
+		var topic string
+		var data interface{}
+		select {
+		case e := <-stateChannel:
+			topic, data = stateEventData(e)
+		case e := <-opChannel:
+			topic, data = operationEventData(e)
+		case <-stateSub.Err():
+			return
+		case <-opSub.Err():
+			return
+		case <-ctx.Done():
+			return
+		}
+		if data == nil || !topics[topic] {
+			continue
+		}
+		select {
+		case events <- &sseEvent{topic: topic, data: data}:
+		default:
+			log.Debug("Events client is falling behind, disconnecting it")
+			return
+		}
+	}
+}
+
+// requestedTopics parses the topics query parameter, which may either be repeated
+// or hold a comma separated list of topics.
+func requestedTopics(r *http.Request) (map[string]bool, error) {
+	topics := make(map[string]bool)
+	for _, value := range r.URL.Query()["topics"] {
+		for _, topic := range strings.Split(value, ",") {
+			topic = strings.TrimSpace(topic)
+			if topic == "" {
+				continue
+			}
+			if !eventTopics[topic] {
+				return nil, fmt.Errorf("invalid topic %q", topic)
+			}
+			topics[topic] = true
+		}
+	}
+	if len(topics) == 0 {
+		return nil, fmt.Errorf("at least one topic is required")
+	}
+	return topics, nil
+}
+
+func writeEvent(w http.ResponseWriter, topic string, data interface{}) error {
+	enc, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", topic, enc)
+	return err
+}
+
+type headEvent struct {
+	Slot            string `json:"slot"`
+	Block           string `json:"block"`
+	State           string `json:"state"`
+	EpochTransition bool   `json:"epoch_transition"`
+}
+
+type blockEvent struct {
+	Slot  string `json:"slot"`
+	Block string `json:"block"`
+}
+
+type finalizedCheckpointEvent struct {
+	Block string `json:"block"`
+	State string `json:"state"`
+	Epoch string `json:"epoch"`
+}
+
+type chainReorgEvent struct {
+	Slot                string `json:"slot"`
+	Depth               string `json:"depth"`
+	OldHeadBlock        string `json:"old_head_block"`
+	NewHeadBlock        string `json:"new_head_block"`
+	CommonAncestorSlot  string `json:"common_ancestor_slot"`
+	CommonAncestorBlock string `json:"common_ancestor_block"`
+}
+
+type checkpointJSON struct {
+	Epoch string `json:"epoch"`
+	Root  string `json:"root"`
+}
+
+type attestationDataJSON struct {
+	Slot            string          `json:"slot"`
+	Index           string          `json:"index"`
+	BeaconBlockRoot string          `json:"beacon_block_root"`
+	Source          *checkpointJSON `json:"source"`
+	Target          *checkpointJSON `json:"target"`
+}
+
+type attestationEvent struct {
+	AggregationBits string               `json:"aggregation_bits"`
+	Data            *attestationDataJSON `json:"data"`
+	Signature       string               `json:"signature"`
+}
+
+type voluntaryExitJSON struct {
+	Epoch          string `json:"epoch"`
+	ValidatorIndex string `json:"validator_index"`
+}
+
+type voluntaryExitEvent struct {
+	Message   *voluntaryExitJSON `json:"message"`
+	Signature string             `json:"signature"`
+}
+
+// stateEventData returns the topic and API representation of a state feed event,
+// or a nil representation for events which are not exposed.
+func stateEventData(event *feed.Event) (string, interface{}) {
+	switch event.Type {
+	case statefeed.NewHead:
+		data, ok := event.Data.(*statefeed.NewHeadData)
+		if !ok {
+			return "", nil
+		}
+		return topicHead, &headEvent{
+			Slot:            uintString(data.Slot),
+			Block:           hexString(data.BlockRoot[:]),
+			State:           hexString(data.StateRoot[:]),
+			EpochTransition: data.EpochTransition,
+		}
+	case statefeed.BlockProcessed:
+		data, ok := event.Data.(*statefeed.BlockProcessedData)
+		if !ok {
+			return "", nil
+		}
+		return topicBlock, &blockEvent{
+			Slot:  uintString(data.Slot),
+			Block: hexString(data.BlockRoot[:]),
+		}
+	case statefeed.FinalizedCheckpoint:
+		data, ok := event.Data.(*statefeed.FinalizedCheckpointData)
+		if !ok {
+			return "", nil
+		}
+		return topicFinalizedCheckpoint, &finalizedCheckpointEvent{
+			Block: hexString(data.BlockRoot[:]),
+			State: hexString(data.StateRoot[:]),
+			Epoch: uintString(data.Epoch),
+		}
+	case statefeed.Reorg:
+		data, ok := event.Data.(*statefeed.ReorgData)
+		if !ok {
+			return "", nil
+		}
+		return topicChainReorg, &chainReorgEvent{
+			Slot:                uintString(data.NewSlot),
+			Depth:               uintString(data.Depth),
+			OldHeadBlock:        hexString(data.OldHeadRoot[:]),
+			NewHeadBlock:        hexString(data.NewHeadRoot[:]),
+			CommonAncestorSlot:  uintString(data.CommonAncestorSlot),
+			CommonAncestorBlock: hexString(data.CommonAncestorRoot[:]),
+		}
+	}
+	return "", nil
+}
+
+// operationEventData returns the topic and API representation of an operation feed event,
+// or a nil representation for events which are not exposed.
+func operationEventData(event *feed.Event) (string, interface{}) {
+	switch event.Type {
+	case opfeed.UnaggregatedAttReceived:
+		data, ok := event.Data.(*opfeed.UnAggregatedAttReceivedData)
+		if !ok || data.Attestation == nil {
+			return "", nil
+		}
+		return attestationEventData(data.Attestation)
+	case opfeed.AggregatedAttReceived:
+		data, ok := event.Data.(*opfeed.AggregatedAttReceivedData)
+		if !ok || data.Attestation == nil || data.Attestation.Aggregate == nil {
+			return "", nil
+		}
+		return attestationEventData(data.Attestation.Aggregate)
+	case opfeed.ExitReceived:
+		data, ok := event.Data.(*opfeed.ExitReceivedData)
+		if !ok || data.Exit == nil || data.Exit.Exit == nil {
+			return "", nil
+		}
+		return topicVoluntaryExit, &voluntaryExitEvent{
+			Message: &voluntaryExitJSON{
+				Epoch:          uintString(data.Exit.Exit.Epoch),
+				ValidatorIndex: uintString(data.Exit.Exit.ValidatorIndex),
+			},
+			Signature: hexString(data.Exit.Signature),
+		}
+	}
+	return "", nil
+}
+
+func attestationEventData(att *ethpb.Attestation) (string, interface{}) {
+	if att.Data == nil || att.Data.Source == nil || att.Data.Target == nil {
+		return "", nil
+	}
+	return topicAttestation, &attestationEvent{
+		AggregationBits: hexString(att.AggregationBits),
+		Data: &attestationDataJSON{
+			Slot:            uintString(att.Data.Slot),
+			Index:           uintString(att.Data.CommitteeIndex),
+			BeaconBlockRoot: hexString(att.Data.BeaconBlockRoot),
+			Source: &checkpointJSON{
+				Epoch: uintString(att.Data.Source.Epoch),
+				Root:  hexString(att.Data.Source.Root),
+			},
+			Target: &checkpointJSON{
+				Epoch: uintString(att.Data.Target.Epoch),
+				Root:  hexString(att.Data.Target.Root),
+			},
+		},
+		Signature: hexString(att.Signature),
+	}
+}
+
+func uintString(i uint64) string {
+	return fmt.Sprintf("%d", i)
+}
+
+func hexString(b []byte) string {
+	return fmt.Sprintf("%#x", b)
+}
@@ -0,0 +1,193 @@
+package gateway
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// sendWhenSubscribed sends the event once the events handler has subscribed to the feed.
+func sendWhenSubscribed(t *testing.T, f *event.Feed, e *feed.Event) {
+	for i := 0; f.Send(e) == 0; i++ {
+		require.Equal(t, true, i < 100, "Handler did not subscribe to feed")
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// readEvent reads the next event from the stream, returning its topic and data.
+func readEvent(t *testing.T, r *bufio.Reader) (string, string) {
+	var topic, data string
+	for {
+		line, err := r.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case strings.HasPrefix(line, "event: "):
+			topic = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			data = strings.TrimPrefix(line, "data: ")
+		case line == "" && topic != "":
+			return topic, data
+		}
+	}
+}
+
+func TestEventsServer_StreamsRequestedTopics(t *testing.T) {
+	stateNotifier := &mock.MockStateNotifier{}
+	opNotifier := &mock.MockOperationNotifier{}
+	srv := httptest.NewServer(EventsServer(stateNotifier, opNotifier))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + EventsPath + "?topics=head,voluntary_exit")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, resp.Body.Close())
+	}()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	reader := bufio.NewReader(resp.Body)
+
+	// Block events were not requested and are filtered out.
+	sendWhenSubscribed(t, stateNotifier.StateFeed(), &feed.Event{
+		Type: statefeed.BlockProcessed,
+		Data: &statefeed.BlockProcessedData{Slot: 4, BlockRoot: [32]byte{'b'}},
+	})
+	stateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.NewHead,
+		Data: &statefeed.NewHeadData{Slot: 5, BlockRoot: [32]byte{0x01}, StateRoot: [32]byte{0x02}},
+	})
+	topic, data := readEvent(t, reader)
+	assert.Equal(t, topicHead, topic)
+	assert.Equal(t, true, strings.Contains(data, `"slot":"5"`))
+	assert.Equal(t, true, strings.Contains(data, `"block":"0x01000000`))
+	assert.Equal(t, true, strings.Contains(data, `"epoch_transition":false`))
+
+	sendWhenSubscribed(t, opNotifier.OperationFeed(), &feed.Event{
+		Type: opfeed.ExitReceived,
+		Data: &opfeed.ExitReceivedData{
+			Exit: &ethpb.SignedVoluntaryExit{
+				Exit:      &ethpb.VoluntaryExit{Epoch: 3, ValidatorIndex: 7},
+				Signature: []byte{0xaa},
+			},
+		},
+	})
+	topic, data = readEvent(t, reader)
+	assert.Equal(t, topicVoluntaryExit, topic)
+	assert.Equal(t, `{"message":{"epoch":"3","validator_index":"7"},"signature":"0xaa"}`, data)
+}
+
+// blockingWriter is a streaming response writer whose writes block until it is released,
+// like the connection to a client which stopped reading.
+type blockingWriter struct {
+	header  http.Header
+	release chan struct{}
+}
+
+func (w *blockingWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *blockingWriter) Write(p []byte) (int, error) {
+	<-w.release
+	return len(p), nil
+}
+
+func (w *blockingWriter) WriteHeader(int) {}
+
+func (w *blockingWriter) Flush() {}
+
+func TestEventsServer_DisconnectsSlowClient(t *testing.T) {
+	stateNotifier := &mock.MockStateNotifier{}
+	handler := EventsServer(stateNotifier, &mock.MockOperationNotifier{})
+	w := &blockingWriter{header: make(http.Header), release: make(chan struct{})}
+	r := httptest.NewRequest(http.MethodGet, EventsPath+"?topics=head", nil)
+	done := make(chan struct{})
+	go func() {
+		handler(w, r)
+		close(done)
+	}()
+
+	event := &feed.Event{
+		Type: statefeed.NewHead,
+		Data: &statefeed.NewHeadData{Slot: 5},
+	}
+	sendWhenSubscribed(t, stateNotifier.StateFeed(), event)
+	// Sending never blocks on the client, which gets unsubscribed once it falls behind.
+	sent := make(chan struct{})
+	go func() {
+		for i := 0; i < 2*eventsChannelSize; i++ {
+			stateNotifier.StateFeed().Send(event)
+		}
+		close(sent)
+	}()
+	select {
+	case <-sent:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Sending events blocked on a slow client")
+	}
+	assert.Equal(t, 0, stateNotifier.StateFeed().Send(event), "Slow client is still subscribed")
+
+	// The client is disconnected once the pending write completes.
+	close(w.release)
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Slow client was not disconnected")
+	}
+}
+
+func TestEventsServer_InvalidTopics(t *testing.T) {
+	srv := httptest.NewServer(EventsServer(&mock.MockStateNotifier{}, &mock.MockOperationNotifier{}))
+	defer srv.Close()
+
+	for _, query := range []string{"", "?topics=", "?topics=head,foo"} {
+		resp, err := http.Get(srv.URL + EventsPath + query)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode, "Unexpected status for query %q", query)
+		require.NoError(t, resp.Body.Close())
+	}
+}
+
+func TestRequestedTopics(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, EventsPath+"?topics=head,block&topics=finalized_checkpoint", nil)
+	topics, err := requestedTopics(r)
+	require.NoError(t, err)
+	assert.DeepEqual(t, map[string]bool{topicHead: true, topicBlock: true, topicFinalizedCheckpoint: true}, topics)
+}
+
+func TestStateEventData_ChainReorg(t *testing.T) {
+	topic, data := stateEventData(&feed.Event{
+		Type: statefeed.Reorg,
+		Data: &statefeed.ReorgData{
+			OldSlot:            9,
+			OldHeadRoot:        [32]byte{'a'},
+			NewSlot:            10,
+			NewHeadRoot:        [32]byte{'b'},
+			CommonAncestorSlot: 7,
+			CommonAncestorRoot: [32]byte{'c'},
+			Depth:              2,
+		},
+	})
+	assert.Equal(t, topicChainReorg, topic)
+	enc, err := json.Marshal(data)
+	require.NoError(t, err)
+	want := `{"slot":"10","depth":"2",` +
+		`"old_head_block":"0x6100000000000000000000000000000000000000000000000000000000000000",` +
+		`"new_head_block":"0x6200000000000000000000000000000000000000000000000000000000000000",` +
+		`"common_ancestor_slot":"7",` +
+		`"common_ancestor_block":"0x6300000000000000000000000000000000000000000000000000000000000000"}`
+	assert.Equal(t, want, string(enc))
+}
@@ -46,6 +46,8 @@ var appFlags = []cli.Flag{
 	flags.HeadSync,
 	flags.DisableSync,
 	flags.DisableDiscv5,
+	flags.RPCTraceFile,
+	flags.RPCTraceMaxFileSize,
 	flags.BlockBatchLimit,
 	flags.BlockBatchLimitBurstFactor,
 	flags.InteropMockEth1DataVotesFlag,
@@ -60,6 +62,9 @@ var appFlags = []cli.Flag{
 	flags.NetworkID,
 	flags.WeakSubjectivityCheckpt,
 	flags.Eth1HeaderReqLimit,
+	flags.CheckpointBlock,
+	flags.CheckpointState,
+	flags.CheckpointSyncProvider,
 	cmd.EnableBackupWebhookFlag,
 	cmd.BackupWebhookOutputDir,
 	cmd.MinimalConfigFlag,
@@ -68,6 +73,7 @@ var appFlags = []cli.Flag{
 	cmd.BootstrapNode,
 	cmd.NoDiscovery,
 	cmd.StaticPeers,
+	cmd.TrustedPeers,
 	cmd.RelayNode,
 	cmd.P2PUDPPort,
 	cmd.P2PTCPPort,
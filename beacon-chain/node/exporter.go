@@ -0,0 +1,31 @@
+package node
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/exporter"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+)
+
+var errKafkaDisabled = errors.New("beacon node was built without kafka support")
+
+func (b *BeaconNode) registerExporterService() error {
+	bootstrapServers := featureconfig.Get().KafkaBootstrapServers
+	if bootstrapServers == "" {
+		return nil
+	}
+	sink, err := newKafkaSink(bootstrapServers)
+	if errors.Is(err, errKafkaDisabled) {
+		log.WithError(err).Warn("Not exporting beacon chain events, build with --config=kafka_enabled to enable")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	svc := exporter.NewService(b.ctx, &exporter.Config{
+		StateNotifier:     b,
+		BlockNotifier:     b,
+		OperationNotifier: b,
+		Sink:              sink,
+	})
+	return b.services.RegisterService(svc)
+}
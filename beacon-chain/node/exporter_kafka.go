@@ -0,0 +1,13 @@
+// +build kafka_enabled
+
+package node
+
+import (
+	"github.com/prysmaticlabs/prysm/beacon-chain/exporter"
+	"github.com/prysmaticlabs/prysm/beacon-chain/exporter/kafka"
+)
+
+// newKafkaSink connects an exporter sink to the given Kafka bootstrap servers.
+func newKafkaSink(bootstrapServers string) (exporter.Sink, error) {
+	return kafka.NewSink(bootstrapServers)
+}
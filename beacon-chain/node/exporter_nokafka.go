@@ -0,0 +1,12 @@
+// +build !kafka_enabled
+
+package node
+
+import (
+	"github.com/prysmaticlabs/prysm/beacon-chain/exporter"
+)
+
+// newKafkaSink is not supported without the kafka_enabled build tag.
+func newKafkaSink(_ string) (exporter.Sink, error) {
+	return nil, errKafkaDisabled
+}
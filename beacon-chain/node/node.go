@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"os/signal"
 	"path/filepath"
@@ -17,8 +18,10 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/checkpoint"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
@@ -32,9 +35,12 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	regularsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
 	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/backuputil"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
@@ -49,6 +55,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc"
 	"gopkg.in/yaml.v2"
 )
 
@@ -172,6 +179,10 @@ func NewBeaconNode(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.initializeFromCheckpoint(cliCtx); err != nil {
+		return nil, err
+	}
+
 	beacon.startStateGen()
 
 	if err := beacon.registerP2P(cliCtx); err != nil {
@@ -186,6 +197,10 @@ func NewBeaconNode(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.registerExporterService(); err != nil {
+		return nil, err
+	}
+
 	if err := beacon.registerInteropServices(); err != nil {
 		return nil, err
 	}
@@ -204,6 +219,10 @@ func NewBeaconNode(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.registerBackfillService(); err != nil {
+		return nil, err
+	}
+
 	if err := beacon.registerRPCService(); err != nil {
 		return nil, err
 	}
@@ -339,6 +358,58 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context) error {
 	return nil
 }
 
+// initializeFromCheckpoint seeds the database with a trusted finalized checkpoint, read from
+// files or downloaded from another beacon node, when the node is configured to do so.
+func (b *BeaconNode) initializeFromCheckpoint(cliCtx *cli.Context) error {
+	blockPath := cliCtx.String(flags.CheckpointBlock.Name)
+	statePath := cliCtx.String(flags.CheckpointState.Name)
+	provider := cliCtx.String(flags.CheckpointSyncProvider.Name)
+	if blockPath == "" && statePath == "" && provider == "" {
+		return nil
+	}
+	// The checkpoint is only needed to seed an empty database, so it is neither loaded nor
+	// downloaded on restarts.
+	initialized, err := checkpoint.IsInitialized(b.ctx, b.db)
+	if err != nil {
+		return errors.Wrap(err, "could not check whether the database is initialized")
+	}
+	if initialized {
+		log.Info("Database already contains a beacon chain, ignoring checkpoint")
+		return nil
+	}
+
+	var blk *ethpb.SignedBeaconBlock
+	var st *stateTrie.BeaconState
+	switch {
+	case blockPath != "" || statePath != "":
+		if blockPath == "" || statePath == "" {
+			return fmt.Errorf("both --%s and --%s are required", flags.CheckpointBlock.Name, flags.CheckpointState.Name)
+		}
+		blk, st, err = checkpoint.LoadFiles(blockPath, statePath)
+	default:
+		conn, dialErr := grpc.DialContext(
+			b.ctx,
+			provider,
+			grpc.WithInsecure(),
+			grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name))),
+		)
+		if dialErr != nil {
+			return errors.Wrapf(dialErr, "could not dial checkpoint sync provider %s", provider)
+		}
+		defer func() {
+			if err := conn.Close(); err != nil {
+				log.WithError(err).Error("Could not close connection to checkpoint sync provider")
+			}
+		}()
+		blk, st, err = checkpoint.FetchFinalized(b.ctx, ethpb.NewBeaconChainClient(conn), pbrpc.NewDebugClient(conn))
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not load checkpoint")
+	}
+
+	return checkpoint.Initialize(b.ctx, b.db, blk, st)
+}
+
 func (b *BeaconNode) startStateGen() {
 	b.stateGen = stategen.New(b.db)
 }
@@ -384,24 +455,27 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 	}
 
 	svc, err := p2p.NewService(b.ctx, &p2p.Config{
-		NoDiscovery:       cliCtx.Bool(cmd.NoDiscovery.Name),
-		StaticPeers:       sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
-		BootstrapNodeAddr: bootnodeAddrs,
-		RelayNodeAddr:     cliCtx.String(cmd.RelayNode.Name),
-		DataDir:           datadir,
-		LocalIP:           cliCtx.String(cmd.P2PIP.Name),
-		HostAddress:       cliCtx.String(cmd.P2PHost.Name),
-		HostDNS:           cliCtx.String(cmd.P2PHostDNS.Name),
-		PrivateKey:        cliCtx.String(cmd.P2PPrivKey.Name),
-		MetaDataDir:       cliCtx.String(cmd.P2PMetadata.Name),
-		TCPPort:           cliCtx.Uint(cmd.P2PTCPPort.Name),
-		UDPPort:           cliCtx.Uint(cmd.P2PUDPPort.Name),
-		MaxPeers:          cliCtx.Uint(cmd.P2PMaxPeers.Name),
-		AllowListCIDR:     cliCtx.String(cmd.P2PAllowList.Name),
-		DenyListCIDR:      sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
-		EnableUPnP:        cliCtx.Bool(cmd.EnableUPnPFlag.Name),
-		DisableDiscv5:     cliCtx.Bool(flags.DisableDiscv5.Name),
-		StateNotifier:     b,
+		NoDiscovery:         cliCtx.Bool(cmd.NoDiscovery.Name),
+		StaticPeers:         sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
+		TrustedPeers:        sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.TrustedPeers.Name)),
+		BootstrapNodeAddr:   bootnodeAddrs,
+		RelayNodeAddr:       cliCtx.String(cmd.RelayNode.Name),
+		DataDir:             datadir,
+		LocalIP:             cliCtx.String(cmd.P2PIP.Name),
+		HostAddress:         cliCtx.String(cmd.P2PHost.Name),
+		HostDNS:             cliCtx.String(cmd.P2PHostDNS.Name),
+		PrivateKey:          cliCtx.String(cmd.P2PPrivKey.Name),
+		MetaDataDir:         cliCtx.String(cmd.P2PMetadata.Name),
+		TCPPort:             cliCtx.Uint(cmd.P2PTCPPort.Name),
+		UDPPort:             cliCtx.Uint(cmd.P2PUDPPort.Name),
+		MaxPeers:            cliCtx.Uint(cmd.P2PMaxPeers.Name),
+		AllowListCIDR:       cliCtx.String(cmd.P2PAllowList.Name),
+		DenyListCIDR:        sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
+		EnableUPnP:          cliCtx.Bool(cmd.EnableUPnPFlag.Name),
+		DisableDiscv5:       cliCtx.Bool(flags.DisableDiscv5.Name),
+		RPCTraceFile:        cliCtx.String(flags.RPCTraceFile.Name),
+		RPCTraceMaxFileSize: cliCtx.Int64(flags.RPCTraceMaxFileSize.Name) * 1 << 20,
+		StateNotifier:       b,
 	})
 	if err != nil {
 		return err
@@ -569,6 +643,20 @@ func (b *BeaconNode) registerInitialSyncService() error {
 	return b.services.RegisterService(is)
 }
 
+func (b *BeaconNode) registerBackfillService() error {
+	var initSync *initialsync.Service
+	if err := b.services.FetchService(&initSync); err != nil {
+		return err
+	}
+
+	bs := backfill.NewService(b.ctx, &backfill.Config{
+		DB:          b.db,
+		P2P:         b.fetchP2P(),
+		InitialSync: initSync,
+	})
+	return b.services.RegisterService(bs)
+}
+
 func (b *BeaconNode) registerRPCService() error {
 	var chainService *blockchain.Service
 	if err := b.services.FetchService(&chainService); err != nil {
@@ -585,6 +673,16 @@ func (b *BeaconNode) registerRPCService() error {
 		return err
 	}
 
+	var regularSyncService *regularsync.Service
+	if err := b.services.FetchService(&regularSyncService); err != nil {
+		return err
+	}
+
+	var backfillService *backfill.Service
+	if err := b.services.FetchService(&backfillService); err != nil {
+		return err
+	}
+
 	genesisValidators := b.cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name)
 	genesisStatePath := b.cliCtx.String(flags.InteropGenesisStateFlag.Name)
 	var depositFetcher depositcache.DepositFetcher
@@ -622,6 +720,7 @@ func (b *BeaconNode) registerRPCService() error {
 		Broadcaster:             p2pService,
 		PeersFetcher:            p2pService,
 		PeerManager:             p2pService,
+		MetadataProvider:        p2pService,
 		ChainInfoFetcher:        chainService,
 		HeadFetcher:             chainService,
 		CanonicalFetcher:        chainService,
@@ -638,6 +737,8 @@ func (b *BeaconNode) registerRPCService() error {
 		ChainStartFetcher:       chainStartFetcher,
 		MockEth1Votes:           mockEth1DataVotes,
 		SyncService:             syncService,
+		AttestationValidator:    regularSyncService,
+		BackfillFetcher:         backfillService,
 		DepositFetcher:          depositFetcher,
 		PendingDepositFetcher:   b.depositCache,
 		BlockNotifier:           b,
@@ -697,12 +798,14 @@ func (b *BeaconNode) registerGRPCGateway() error {
 	gatewayAddress := fmt.Sprintf("%s:%d", gatewayHost, gatewayPort)
 	allowedOrigins := strings.Split(b.cliCtx.String(flags.GPRCGatewayCorsDomain.Name), ",")
 	enableDebugRPCEndpoints := b.cliCtx.Bool(flags.EnableDebugRPCEndpoints.Name)
+	mux := http.NewServeMux()
+	mux.HandleFunc(gateway.EventsPath, gateway.EventsServer(b, b))
 	return b.services.RegisterService(
 		gateway.New(
 			b.ctx,
 			selfAddress,
 			gatewayAddress,
-			nil, /*optional mux*/
+			mux,
 			allowedOrigins,
 			enableDebugRPCEndpoints,
 			b.cliCtx.Uint64(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
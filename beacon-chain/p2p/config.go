@@ -11,6 +11,7 @@ type Config struct {
 	EnableUPnP          bool
 	DisableDiscv5       bool
 	StaticPeers         []string
+	TrustedPeers        []string
 	BootstrapNodeAddr   []string
 	Discv5BootStrapAddr []string
 	RelayNodeAddr       string
@@ -25,5 +26,7 @@ type Config struct {
 	MaxPeers            uint
 	AllowListCIDR       string
 	DenyListCIDR        []string
+	RPCTraceFile        string
+	RPCTraceMaxFileSize int64
 	StateNotifier       statefeed.Notifier
 }
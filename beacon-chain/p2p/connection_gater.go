@@ -49,17 +49,21 @@ func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
 			"reason": "exceeded dial limit"}).Trace("Not accepting inbound dial from ip address")
 		return false
 	}
-	if s.isPeerAtLimit(true /* inbound */) {
-		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
-			"reason": "at peer limit"}).Trace("Not accepting inbound dial")
-		return false
-	}
 	return filterConnections(s.addrFilter, n.RemoteMultiaddr())
 }
 
 // InterceptSecured tests whether a given connection, now authenticated,
-// is allowed.
-func (s *Service) InterceptSecured(_ network.Direction, _ peer.ID, _ network.ConnMultiaddrs) (allow bool) {
+// is allowed. The peer limit is applied to inbound connections here, once
+// the remote peer is known, as trusted peers are exempt from it.
+func (s *Service) InterceptSecured(dir network.Direction, pid peer.ID, n network.ConnMultiaddrs) (allow bool) {
+	if dir != network.DirInbound {
+		return true
+	}
+	if !s.validateInbound(pid) {
+		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
+			"reason": "at peer limit"}).Trace("Not accepting inbound dial")
+		return false
+	}
 	return true
 }
 
@@ -78,7 +82,16 @@ func (s *Service) validateDial(addr multiaddr.Multiaddr) bool {
 		return false
 	}
 	s.ipLimiter.Add(ip.String(), 1)
-	return !s.peers.IsAboveInboundLimit()
+	return true
+}
+
+// validateInbound checks whether an inbound connection from the peer
+// is within our peer limits. Trusted peers are exempt from them.
+func (s *Service) validateInbound(pid peer.ID) bool {
+	if s.peers.IsTrusted(pid) {
+		return true
+	}
+	return !s.peers.IsAboveInboundLimit() && !s.isPeerAtLimit(true /* inbound */)
 }
 
 // configureFilter looks at the provided allow lists and
@@ -67,6 +67,57 @@ func TestPeer_AtMaxLimit(t *testing.T) {
 	require.NotNil(t, err, "Wanted connection to fail with max peer")
 }
 
+func TestPeer_AtMaxLimit_TrustedPeer(t *testing.T) {
+	// create host and remote peer
+	ipAddr, pkey := createAddrAndPrivKey(t)
+	ipAddr2, pkey2 := createAddrAndPrivKey(t)
+
+	listen, err := multiaddr.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d", ipAddr, 2000))
+	require.NoError(t, err, "Failed to p2p listen")
+	s := &Service{
+		ipLimiter: leakybucket.NewCollector(ipLimit, ipBurst, false),
+	}
+	s.peers = peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 0,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: 3,
+			},
+		},
+	})
+	s.cfg = &Config{MaxPeers: 0}
+	s.addrFilter, err = configureFilter(&Config{})
+	require.NoError(t, err)
+	h1, err := libp2p.New(context.Background(), []libp2p.Option{privKeyOption(pkey), libp2p.ListenAddrs(listen), libp2p.ConnectionGater(s)}...)
+	require.NoError(t, err)
+	s.host = h1
+	defer func() {
+		err := h1.Close()
+		require.NoError(t, err)
+	}()
+
+	for i := 0; i < highWatermarkBuffer; i++ {
+		addPeer(t, s.peers, peers.PeerConnected)
+	}
+
+	// create alternate host
+	listen, err = multiaddr.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d", ipAddr2, 3000))
+	require.NoError(t, err, "Failed to p2p listen")
+	h2, err := libp2p.New(context.Background(), []libp2p.Option{privKeyOption(pkey2), libp2p.ListenAddrs(listen)}...)
+	require.NoError(t, err)
+	defer func() {
+		err := h2.Close()
+		require.NoError(t, err)
+	}()
+	s.peers.AddTrusted(h2.ID(), nil)
+	multiAddress, err := multiaddr.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d/p2p/%s", ipAddr, 2000, h1.ID()))
+	require.NoError(t, err)
+	addrInfo, err := peer.AddrInfoFromP2pAddr(multiAddress)
+	require.NoError(t, err)
+	err = h2.Connect(context.Background(), *addrInfo)
+	require.NoError(t, err, "Wanted trusted peer to connect beyond max peers")
+}
+
 func TestService_InterceptBannedIP(t *testing.T) {
 	s := &Service{
 		ipLimiter: leakybucket.NewCollector(ipLimit, ipBurst, false),
@@ -102,18 +153,19 @@ func TestService_RejectInboundPeersBeyondLimit(t *testing.T) {
 			PeerLimit:    limit,
 			ScorerParams: &scorers.Config{},
 		}),
+		cfg: &Config{MaxPeers: uint(limit)},
 	}
-	var err error
-	s.addrFilter, err = configureFilter(&Config{})
-	require.NoError(t, err)
-	ip := "212.67.10.122"
-	multiAddress, err := multiaddr.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d", ip, 3000))
+	h, err := libp2p.New(context.Background(), libp2p.NoListenAddrs)
 	require.NoError(t, err)
+	s.host = h
+	defer func() {
+		require.NoError(t, h.Close())
+	}()
+	pid := addPeer(t, s.peers, peerdata.PeerConnectionState(ethpb.ConnectionState_DISCONNECTED))
+	trustedPid := addPeer(t, s.peers, peerdata.PeerConnectionState(ethpb.ConnectionState_DISCONNECTED))
+	s.peers.AddTrusted(trustedPid, nil)
 
-	valid := s.validateDial(multiAddress)
-	if !valid {
-		t.Errorf("Expected multiaddress with ip %s to be accepted as it is below the inbound limit", ip)
-	}
+	assert.Equal(t, true, s.validateInbound(pid), "Expected peer to be accepted as it is below the inbound limit")
 
 	inboundLimit := float64(limit) * peers.InboundRatio
 	// top off by 1 to trigger it above the limit.
@@ -122,10 +174,8 @@ func TestService_RejectInboundPeersBeyondLimit(t *testing.T) {
 	for i := 0; i < int(inboundLimit); i++ {
 		addPeer(t, s.peers, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
 	}
-	valid = s.validateDial(multiAddress)
-	if valid {
-		t.Errorf("Expected multiaddress with ip %s to be rejected as it exceeds the inbound limit", ip)
-	}
+	assert.Equal(t, false, s.validateInbound(pid), "Expected peer to be rejected as it exceeds the inbound limit")
+	assert.Equal(t, true, s.validateInbound(trustedPid), "Expected trusted peer to be accepted beyond the inbound limit")
 }
 
 func TestPeer_BelowMaxLimit(t *testing.T) {
@@ -1,6 +1,10 @@
 package p2p
 
 import (
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
@@ -25,6 +29,41 @@ var (
 		Name: "p2p_attestation_subnet_attempted_broadcasts",
 		Help: "The number of attestations that were attempted to be broadcast.",
 	})
+	gossipScore = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "p2p_gossip_score",
+		Help: "The minimum, average and maximum gossipsub score of all scored peers.",
+	},
+		[]string{"stat"})
+	gossipBehaviourPenalty = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "p2p_gossip_behaviour_penalty",
+		Help: "The minimum, average and maximum gossipsub behaviour penalty (P7) of all scored peers.",
+	},
+		[]string{"stat"})
+	gossipIPColocationFactor = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "p2p_gossip_ip_colocation_factor",
+		Help: "The minimum, average and maximum gossipsub IP colocation factor (P6) of all scored peers.",
+	},
+		[]string{"stat"})
+	gossipTopicTimeInMesh = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "p2p_gossip_topic_time_in_mesh_seconds",
+		Help: "The average time spent in the mesh of a topic by the peers grafted to it (P1).",
+	},
+		[]string{"topic"})
+	gossipTopicFirstMessageDeliveries = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "p2p_gossip_topic_first_message_deliveries",
+		Help: "The decayed number of messages first delivered by all peers on a topic (P2).",
+	},
+		[]string{"topic"})
+	gossipTopicMeshMessageDeliveries = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "p2p_gossip_topic_mesh_message_deliveries",
+		Help: "The decayed number of messages delivered by all peers in the mesh of a topic (P3).",
+	},
+		[]string{"topic"})
+	gossipTopicInvalidMessageDeliveries = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "p2p_gossip_topic_invalid_message_deliveries",
+		Help: "The decayed number of invalid messages delivered by all peers on a topic (P4).",
+	},
+		[]string{"topic"})
 )
 
 func (s *Service) updateMetrics() {
@@ -34,3 +73,75 @@ func (s *Service) updateMetrics() {
 	p2pPeerCount.WithLabelValues("Disconnecting").Set(float64(len(s.peers.Disconnecting())))
 	p2pPeerCount.WithLabelValues("Bad").Set(float64(len(s.peers.Bad())))
 }
+
+// resetGossipScoreMetrics removes the gossipsub score metrics of all peers, so that peers which are
+// no longer scored do not linger.
+func resetGossipScoreMetrics() {
+	gossipScore.Reset()
+	gossipBehaviourPenalty.Reset()
+	gossipIPColocationFactor.Reset()
+	gossipTopicTimeInMesh.Reset()
+	gossipTopicFirstMessageDeliveries.Reset()
+	gossipTopicMeshMessageDeliveries.Reset()
+	gossipTopicInvalidMessageDeliveries.Reset()
+}
+
+// updateGossipScoreMetrics exports the minimum, average and maximum of the gossipsub scores of all
+// peers, as labelling them by peer would not bound the number of series. The scores of single
+// peers are available through the ListPeers endpoint of the debug service.
+func updateGossipScoreMetrics(peerMap map[peer.ID]*pubsub.PeerScoreSnapshot) {
+	if len(peerMap) == 0 {
+		return
+	}
+	scores := make([]float64, 0, len(peerMap))
+	penalties := make([]float64, 0, len(peerMap))
+	colocationFactors := make([]float64, 0, len(peerMap))
+	for _, snapshot := range peerMap {
+		scores = append(scores, snapshot.Score)
+		penalties = append(penalties, snapshot.BehaviourPenalty)
+		colocationFactors = append(colocationFactors, snapshot.IPColocationFactor)
+	}
+	setAggregateGauges(gossipScore, scores)
+	setAggregateGauges(gossipBehaviourPenalty, penalties)
+	setAggregateGauges(gossipIPColocationFactor, colocationFactors)
+}
+
+// setAggregateGauges sets the min, avg and max gauges of the given vector to the minimum,
+// average and maximum of the given non-empty values.
+func setAggregateGauges(gauges *prometheus.GaugeVec, values []float64) {
+	min, max, sum := values[0], values[0], float64(0)
+	for _, v := range values {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+		sum += v
+	}
+	gauges.WithLabelValues("min").Set(min)
+	gauges.WithLabelValues("avg").Set(sum / float64(len(values)))
+	gauges.WithLabelValues("max").Set(max)
+}
+
+// updateGossipTopicMetrics exports the gossipsub score counters of each topic, summed over all
+// peers, as labelling them by peer as well would not bound the number of series. The time in
+// mesh is averaged over the peers which were grafted to the mesh of the topic.
+func updateGossipTopicMetrics(peerMap map[peer.ID]*pubsub.PeerScoreSnapshot) {
+	timeInMesh := make(map[string]time.Duration)
+	meshPeers := make(map[string]int)
+	for _, snapshot := range peerMap {
+		for topic, topicSnapshot := range snapshot.Topics {
+			if topicSnapshot.TimeInMesh > 0 {
+				timeInMesh[topic] += topicSnapshot.TimeInMesh
+				meshPeers[topic]++
+			}
+			gossipTopicFirstMessageDeliveries.WithLabelValues(topic).Add(topicSnapshot.FirstMessageDeliveries)
+			gossipTopicMeshMessageDeliveries.WithLabelValues(topic).Add(topicSnapshot.MeshMessageDeliveries)
+			gossipTopicInvalidMessageDeliveries.WithLabelValues(topic).Add(topicSnapshot.InvalidMessageDeliveries)
+		}
+	}
+	for topic, total := range timeInMesh {
+		gossipTopicTimeInMesh.WithLabelValues(topic).Set(total.Seconds() / float64(meshPeers[topic]))
+	}
+}
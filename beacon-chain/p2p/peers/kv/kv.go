@@ -0,0 +1,116 @@
+// Package kv defines a bolt-db, key-value store persisting the status of the peers known
+// by a beacon node across restarts.
+package kv
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"github.com/pkg/errors"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// DatabaseFileName is the name of the peers database.
+const DatabaseFileName = "peers.db"
+
+var peersBucket = []byte("peers")
+
+// Store persists peer records in a bolt database.
+type Store struct {
+	db           *bolt.DB
+	databasePath string
+}
+
+// NewStore initializes a new boltDB key-value store at the directory path specified.
+func NewStore(dirPath string) (*Store, error) {
+	hasDir, err := fileutil.HasDir(dirPath)
+	if err != nil {
+		return nil, err
+	}
+	if !hasDir {
+		if err := fileutil.MkdirAll(dirPath); err != nil {
+			return nil, err
+		}
+	}
+	datafile := path.Join(dirPath, DatabaseFileName)
+	boltDB, err := bolt.Open(datafile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{Timeout: 1 * time.Second})
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
+		}
+		return nil, err
+	}
+	if err := boltDB.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(peersBucket)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return &Store{db: boltDB, databasePath: dirPath}, nil
+}
+
+// Close closes the underlying boltdb database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// DatabasePath at which this database writes files.
+func (s *Store) DatabasePath() string {
+	return s.databasePath
+}
+
+// SavePeers replaces the persisted peer records with the given ones.
+func (s *Store) SavePeers(ctx context.Context, records []*dbpb.PeerRecord) error {
+	ctx, span := trace.StartSpan(ctx, "PeersDB.SavePeers")
+	defer span.End()
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket(peersBucket); err != nil {
+			return err
+		}
+		bkt, err := tx.CreateBucket(peersBucket)
+		if err != nil {
+			return err
+		}
+		for _, record := range records {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			enc, err := record.Marshal()
+			if err != nil {
+				return err
+			}
+			if err := bkt.Put(record.PeerId, enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Peers retrieves all the persisted peer records.
+func (s *Store) Peers(ctx context.Context) ([]*dbpb.PeerRecord, error) {
+	ctx, span := trace.StartSpan(ctx, "PeersDB.Peers")
+	defer span.End()
+
+	records := make([]*dbpb.PeerRecord, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(peersBucket).ForEach(func(_, enc []byte) error {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			record := &dbpb.PeerRecord{}
+			if err := record.Unmarshal(enc); err != nil {
+				return err
+			}
+			records = append(records, record)
+			return nil
+		})
+	})
+	return records, err
+}
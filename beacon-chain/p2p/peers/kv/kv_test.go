@@ -0,0 +1,44 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_SavePeers(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store, err := NewStore(dir)
+	require.NoError(t, err)
+
+	records, err := store.Peers(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(records))
+
+	require.NoError(t, store.SavePeers(ctx, []*dbpb.PeerRecord{
+		{PeerId: []byte("a"), BadResponses: 2},
+		{PeerId: []byte("b"), Trusted: true},
+	}))
+	require.NoError(t, store.SavePeers(ctx, []*dbpb.PeerRecord{
+		{PeerId: []byte("b"), Trusted: true},
+		{PeerId: []byte("c"), Banned: true},
+	}))
+	require.NoError(t, store.Close())
+
+	// Records are replaced on every save and survive a restart.
+	store, err = NewStore(dir)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, store.Close())
+	}()
+	records, err = store.Peers(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*dbpb.PeerRecord{
+		{PeerId: []byte("b"), Trusted: true},
+		{PeerId: []byte("c"), Banned: true},
+	}, records)
+}
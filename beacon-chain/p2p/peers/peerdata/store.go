@@ -47,15 +47,46 @@ type PeerData struct {
 	ConnState     PeerConnectionState
 	Enr           *enr.Record
 	NextValidTime time.Time
+	// Trusted peers are always kept connected, banned peers are never connected.
+	Trusted bool
+	Banned  bool
 	// Chain related data.
 	MetaData                  *pb.MetaData
 	ChainState                *pb.Status
 	ChainStateLastUpdated     time.Time
 	ChainStateValidationError error
+	// Gossipsub score, as last inspected.
+	GossipScore *GossipScore
 	// Scorers internal data.
 	BadResponses         int
 	ProcessedBlocks      uint64
 	BlockProviderUpdated time.Time
+	GossipMessages       map[string]*GossipMessageCounts
+}
+
+// GossipScore is the gossipsub score of a peer, broken down into its components.
+type GossipScore struct {
+	Score              float64
+	TopicScores        map[string]*TopicScore
+	AppSpecificScore   float64
+	IPColocationFactor float64
+	BehaviourPenalty   float64
+}
+
+// TopicScore holds the counters the gossipsub score of a peer is computed from for a single topic.
+type TopicScore struct {
+	TimeInMesh               time.Duration
+	FirstMessageDeliveries   float64
+	MeshMessageDeliveries    float64
+	InvalidMessageDeliveries float64
+}
+
+// GossipMessageCounts holds the (decayed) number of gossip messages received from a peer
+// on a single topic, by validation result.
+type GossipMessageCounts struct {
+	Accepted float64
+	Ignored  float64
+	Rejected float64
 }
 
 // NewStore creates new peer data store.
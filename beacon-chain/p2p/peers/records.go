@@ -0,0 +1,130 @@
+package peers
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+)
+
+// Records exports the status of the known peers, along with the data of the scorers, as
+// records to persist.
+func (p *Status) Records() ([]*dbpb.PeerRecord, error) {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	records := make([]*dbpb.PeerRecord, 0, len(p.store.Peers()))
+	for pid, peerData := range p.store.Peers() {
+		record := &dbpb.PeerRecord{
+			PeerId:          []byte(pid),
+			BadResponses:    uint64(peerData.BadResponses),
+			ProcessedBlocks: peerData.ProcessedBlocks,
+			Trusted:         peerData.Trusted,
+			Banned:          peerData.Banned,
+		}
+		if peerData.Address != nil {
+			record.Address = peerData.Address.Bytes()
+		}
+		// Unsigned records cannot be encoded, and are left out.
+		if peerData.Enr != nil && peerData.Enr.Signature() != nil {
+			enc, err := rlp.EncodeToBytes(peerData.Enr)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not encode enr of peer %s", pid)
+			}
+			record.Enr = enc
+		}
+		if !peerData.NextValidTime.IsZero() {
+			record.NextValidTime = peerData.NextValidTime.UnixNano()
+		}
+		if !peerData.BlockProviderUpdated.IsZero() {
+			record.BlockProviderUpdated = peerData.BlockProviderUpdated.UnixNano()
+		}
+		if len(peerData.GossipMessages) > 0 {
+			record.GossipMessages = make(map[string]*dbpb.GossipMessageCounts, len(peerData.GossipMessages))
+			for topic, counts := range peerData.GossipMessages {
+				record.GossipMessages[topic] = &dbpb.GossipMessageCounts{
+					Accepted: counts.Accepted,
+					Ignored:  counts.Ignored,
+					Rejected: counts.Rejected,
+				}
+			}
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// LoadRecords restores disconnected peers from persisted records. Peers which are already known
+// are left untouched. The number of restored peers is returned, along with an error if a record
+// could not be decoded, in which case the remaining records are still restored.
+func (p *Status) LoadRecords(records []*dbpb.PeerRecord) (int, error) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	restored := 0
+	var firstErr error
+	for _, record := range records {
+		pid, peerData, err := peerDataFromRecord(record)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		if _, ok := p.store.PeerData(pid); ok {
+			continue
+		}
+		p.store.SetPeerData(pid, peerData)
+		p.addIpToTracker(pid)
+		restored++
+	}
+	return restored, firstErr
+}
+
+func peerDataFromRecord(record *dbpb.PeerRecord) (peer.ID, *peerdata.PeerData, error) {
+	pid, err := peer.IDFromBytes(record.PeerId)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "could not decode peer id")
+	}
+	peerData := &peerdata.PeerData{
+		ConnState:       PeerDisconnected,
+		BadResponses:    int(record.BadResponses),
+		ProcessedBlocks: record.ProcessedBlocks,
+		Trusted:         record.Trusted,
+		Banned:          record.Banned,
+	}
+	if len(record.Address) > 0 {
+		peerData.Address, err = ma.NewMultiaddrBytes(record.Address)
+		if err != nil {
+			return "", nil, errors.Wrapf(err, "could not decode address of peer %s", pid)
+		}
+	}
+	if len(record.Enr) > 0 {
+		peerData.Enr = &enr.Record{}
+		if err := rlp.DecodeBytes(record.Enr, peerData.Enr); err != nil {
+			return "", nil, errors.Wrapf(err, "could not decode enr of peer %s", pid)
+		}
+	}
+	if record.NextValidTime != 0 {
+		peerData.NextValidTime = time.Unix(0, record.NextValidTime)
+	}
+	if record.BlockProviderUpdated != 0 {
+		peerData.BlockProviderUpdated = time.Unix(0, record.BlockProviderUpdated)
+	}
+	if len(record.GossipMessages) > 0 {
+		peerData.GossipMessages = make(map[string]*peerdata.GossipMessageCounts, len(record.GossipMessages))
+		for topic, counts := range record.GossipMessages {
+			peerData.GossipMessages[topic] = &peerdata.GossipMessageCounts{
+				Accepted: counts.Accepted,
+				Ignored:  counts.Ignored,
+				Rejected: counts.Rejected,
+			}
+		}
+	}
+	return pid, peerData, nil
+}
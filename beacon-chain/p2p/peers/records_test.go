@@ -0,0 +1,107 @@
+package peers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/libp2p/go-libp2p-core/network"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStatus_RecordsRoundTrip(t *testing.T) {
+	newStatus := func() *peers.Status {
+		return peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit: 30,
+			ScorerParams: &scorers.Config{
+				BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+					Threshold: 5,
+				},
+			},
+		})
+	}
+	p := newStatus()
+
+	address, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+	pid := createPeer(t, p, address, network.DirOutbound, peers.PeerConnected)
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	record := enode.NewV4(&key.PublicKey, []byte{213, 202, 254, 180}, 13000, 12000).Record()
+	p.Add(record, pid, address, network.DirOutbound)
+	p.Scorers().BadResponsesScorer().Increment(pid)
+	p.Scorers().BlockProviderScorer().IncrementProcessedBlocks(pid, 64)
+	topic := "/eth2/00000000/beacon_block/ssz_snappy"
+	p.Scorers().GossipScorer().IncrementAccepted(pid, topic)
+	p.Scorers().GossipScorer().IncrementRejected(pid, topic)
+	nextValidTime := time.Unix(0, time.Now().Add(time.Hour).UnixNano())
+	p.SetNextValidTime(pid, nextValidTime)
+	trusted := addPeer(t, p, peers.PeerConnected)
+	p.AddTrusted(trusted, nil)
+	banned := addPeer(t, p, peers.PeerDisconnected)
+	p.Ban(banned)
+
+	records, err := p.Records()
+	require.NoError(t, err)
+	require.Equal(t, 3, len(records))
+
+	restoredStatus := newStatus()
+	restored, err := restoredStatus.LoadRecords(records)
+	require.NoError(t, err)
+	assert.Equal(t, 3, restored)
+
+	state, err := restoredStatus.ConnectionState(pid)
+	require.NoError(t, err)
+	assert.Equal(t, peers.PeerDisconnected, state)
+	restoredAddress, err := restoredStatus.Address(pid)
+	require.NoError(t, err)
+	assert.Equal(t, address.String(), restoredAddress.String())
+	restoredRecord, err := restoredStatus.ENR(pid)
+	require.NoError(t, err)
+	assert.DeepEqual(t, enode.ValidSchemes.NodeAddr(record), enode.ValidSchemes.NodeAddr(restoredRecord))
+	assert.Equal(t, record.Seq(), restoredRecord.Seq())
+	badResponses, err := restoredStatus.Scorers().BadResponsesScorer().Count(pid)
+	require.NoError(t, err)
+	assert.Equal(t, 1, badResponses)
+	assert.Equal(t, uint64(64), restoredStatus.Scorers().BlockProviderScorer().ProcessedBlocks(pid))
+	counts, err := restoredStatus.Scorers().GossipScorer().MessageCounts(pid, topic)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &peerdata.GossipMessageCounts{Accepted: 1, Rejected: 1}, counts)
+	restoredNextValidTime, err := restoredStatus.NextValidTime(pid)
+	require.NoError(t, err)
+	assert.Equal(t, true, nextValidTime.Equal(restoredNextValidTime))
+	assert.Equal(t, true, restoredStatus.IsTrusted(trusted))
+	assert.Equal(t, true, restoredStatus.IsBanned(banned))
+
+	// Known peers are not overwritten.
+	restored, err = restoredStatus.LoadRecords(records)
+	require.NoError(t, err)
+	assert.Equal(t, 0, restored)
+}
+
+func TestStatus_LoadRecords_SkipsInvalidRecords(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	valid := addPeer(t, p, peers.PeerDisconnected)
+	records, err := p.Records()
+	require.NoError(t, err)
+
+	restoredStatus := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	restored, err := restoredStatus.LoadRecords(append([]*dbpb.PeerRecord{{PeerId: []byte("bad")}}, records...))
+	assert.ErrorContains(t, "could not decode peer id", err)
+	assert.Equal(t, 1, restored)
+	assert.DeepEqual(t, []string{valid.String()}, peerIDStrings(restoredStatus.All()))
+}
@@ -0,0 +1,220 @@
+package scorers
+
+import (
+	"math"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
+)
+
+var _ Scorer = (*GossipScorer)(nil)
+
+const (
+	// DefaultGossipRejectedThreshold defines how many rejected messages to tolerate before peer is deemed bad.
+	DefaultGossipRejectedThreshold = 16.0
+	// DefaultGossipAcceptedMessagesCap defines the number of accepted messages at which the reward of
+	// a peer is maxed out.
+	DefaultGossipAcceptedMessagesCap = 100.0
+	// DefaultGossipDecayInterval defines how often to decay previous statistics.
+	DefaultGossipDecayInterval = time.Minute
+	// DefaultGossipDecayFactor defines the factor message counts are multiplied by on every decay.
+	DefaultGossipDecayFactor = 0.9
+	// gossipDecayToZero defines the count below which a decayed count is reset to zero.
+	gossipDecayToZero = 0.01
+)
+
+// GossipScorer represents gossip validation results scoring service. Accepted messages are
+// rewarded, rejected messages are penalized. Ignored messages are tracked, but not scored, as
+// honest peers routinely forward messages which are ignored, e.g. duplicates.
+type GossipScorer struct {
+	config *GossipScorerConfig
+	store  *peerdata.Store
+}
+
+// GossipScorerConfig holds configuration parameters for gossip scoring service.
+type GossipScorerConfig struct {
+	// Threshold specifies number of rejected messages tolerated, before peer is banned.
+	Threshold float64
+	// AcceptedMessagesCap specifies the number of accepted messages at which the reward of a peer
+	// is maxed out.
+	AcceptedMessagesCap float64
+	// DecayInterval specifies how often message stats should be decayed.
+	DecayInterval time.Duration
+	// DecayFactor specifies the factor message stats are multiplied by on each decay step.
+	DecayFactor float64
+}
+
+// newGossipScorer creates new gossip scoring service.
+func newGossipScorer(store *peerdata.Store, config *GossipScorerConfig) *GossipScorer {
+	if config == nil {
+		config = &GossipScorerConfig{}
+	}
+	scorer := &GossipScorer{
+		config: config,
+		store:  store,
+	}
+	if scorer.config.Threshold == 0 {
+		scorer.config.Threshold = DefaultGossipRejectedThreshold
+	}
+	if scorer.config.AcceptedMessagesCap == 0 {
+		scorer.config.AcceptedMessagesCap = DefaultGossipAcceptedMessagesCap
+	}
+	if scorer.config.DecayInterval == 0 {
+		scorer.config.DecayInterval = DefaultGossipDecayInterval
+	}
+	if scorer.config.DecayFactor == 0 {
+		scorer.config.DecayFactor = DefaultGossipDecayFactor
+	}
+	return scorer
+}
+
+// Score returns the score of the gossip messages the peer delivered: a reward for accepted
+// messages, up to 1, minus a penalty for rejected messages.
+func (s *GossipScorer) Score(pid peer.ID) float64 {
+	s.store.RLock()
+	defer s.store.RUnlock()
+	return s.score(pid)
+}
+
+// score is a lock-free version of Score.
+func (s *GossipScorer) score(pid peer.ID) float64 {
+	if s.isBadPeer(pid) {
+		return BadPeerScore
+	}
+	peerData, ok := s.store.PeerData(pid)
+	if !ok {
+		return 0
+	}
+	accepted, _, rejected := totalGossipMessages(peerData)
+	score := math.Min(accepted/s.config.AcceptedMessagesCap, 1.0)
+	score -= rejected / s.config.Threshold
+	return math.Round(score*ScoreRoundingFactor) / ScoreRoundingFactor
+}
+
+// Params exposes scorer's parameters.
+func (s *GossipScorer) Params() *GossipScorerConfig {
+	return s.config
+}
+
+// MessageCounts returns a copy of the message counts of the given remote peer on a topic.
+func (s *GossipScorer) MessageCounts(pid peer.ID, topic string) (*peerdata.GossipMessageCounts, error) {
+	s.store.RLock()
+	defer s.store.RUnlock()
+
+	peerData, ok := s.store.PeerData(pid)
+	if !ok {
+		return nil, peerdata.ErrPeerUnknown
+	}
+	if counts, ok := peerData.GossipMessages[topic]; ok {
+		countsCopy := *counts
+		return &countsCopy, nil
+	}
+	return &peerdata.GossipMessageCounts{}, nil
+}
+
+// IncrementAccepted increments the number of messages received from the given remote peer on
+// a topic which passed validation.
+func (s *GossipScorer) IncrementAccepted(pid peer.ID, topic string) {
+	s.increment(pid, topic, func(counts *peerdata.GossipMessageCounts) {
+		counts.Accepted++
+	})
+}
+
+// IncrementIgnored increments the number of messages received from the given remote peer on
+// a topic which were ignored.
+func (s *GossipScorer) IncrementIgnored(pid peer.ID, topic string) {
+	s.increment(pid, topic, func(counts *peerdata.GossipMessageCounts) {
+		counts.Ignored++
+	})
+}
+
+// IncrementRejected increments the number of messages received from the given remote peer on
+// a topic which were rejected.
+func (s *GossipScorer) IncrementRejected(pid peer.ID, topic string) {
+	s.increment(pid, topic, func(counts *peerdata.GossipMessageCounts) {
+		counts.Rejected++
+	})
+}
+
+func (s *GossipScorer) increment(pid peer.ID, topic string, f func(counts *peerdata.GossipMessageCounts)) {
+	s.store.Lock()
+	defer s.store.Unlock()
+
+	peerData := s.store.PeerDataGetOrCreate(pid)
+	if peerData.GossipMessages == nil {
+		peerData.GossipMessages = make(map[string]*peerdata.GossipMessageCounts)
+	}
+	counts, ok := peerData.GossipMessages[topic]
+	if !ok {
+		counts = &peerdata.GossipMessageCounts{}
+		peerData.GossipMessages[topic] = counts
+	}
+	f(counts)
+}
+
+// IsBadPeer states if the peer is to be considered bad.
+// If the peer is unknown this will return `false`, which makes using this function easier than returning an error.
+func (s *GossipScorer) IsBadPeer(pid peer.ID) bool {
+	s.store.RLock()
+	defer s.store.RUnlock()
+	return s.isBadPeer(pid)
+}
+
+// isBadPeer is lock-free version of IsBadPeer.
+func (s *GossipScorer) isBadPeer(pid peer.ID) bool {
+	if peerData, ok := s.store.PeerData(pid); ok {
+		_, _, rejected := totalGossipMessages(peerData)
+		return rejected >= s.config.Threshold
+	}
+	return false
+}
+
+// BadPeers returns the peers that are considered bad.
+func (s *GossipScorer) BadPeers() []peer.ID {
+	s.store.RLock()
+	defer s.store.RUnlock()
+
+	badPeers := make([]peer.ID, 0)
+	for pid := range s.store.Peers() {
+		if s.isBadPeer(pid) {
+			badPeers = append(badPeers, pid)
+		}
+	}
+	return badPeers
+}
+
+// Decay reduces the message counts of all peers by the decay factor, so that the score of a
+// peer reflects its recent behaviour. Counts of topics which decayed to zero are dropped.
+func (s *GossipScorer) Decay() {
+	s.store.Lock()
+	defer s.store.Unlock()
+
+	decay := func(count float64) float64 {
+		count *= s.config.DecayFactor
+		if count < gossipDecayToZero {
+			return 0
+		}
+		return count
+	}
+	for _, peerData := range s.store.Peers() {
+		for topic, counts := range peerData.GossipMessages {
+			counts.Accepted = decay(counts.Accepted)
+			counts.Ignored = decay(counts.Ignored)
+			counts.Rejected = decay(counts.Rejected)
+			if counts.Accepted == 0 && counts.Ignored == 0 && counts.Rejected == 0 {
+				delete(peerData.GossipMessages, topic)
+			}
+		}
+	}
+}
+
+// totalGossipMessages sums the message counts of a peer over all topics.
+func totalGossipMessages(peerData *peerdata.PeerData) (accepted, ignored, rejected float64) {
+	for _, counts := range peerData.GossipMessages {
+		accepted += counts.Accepted
+		ignored += counts.Ignored
+		rejected += counts.Rejected
+	}
+	return accepted, ignored, rejected
+}
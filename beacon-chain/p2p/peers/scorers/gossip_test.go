@@ -0,0 +1,163 @@
+package scorers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+const gossipTopic = "/eth2/00000000/beacon_block/ssz_snappy"
+
+func TestScorers_Gossip_Score(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peerStatuses := peers.NewStatus(ctx, &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			GossipScorerConfig: &scorers.GossipScorerConfig{
+				Threshold:           4,
+				AcceptedMessagesCap: 10,
+			},
+		},
+	})
+	scorer := peerStatuses.Scorers().GossipScorer()
+
+	assert.Equal(t, 0.0, scorer.Score("peer1"), "Unexpected score for unregistered peer")
+	for i := 0; i < 5; i++ {
+		scorer.IncrementAccepted("peer1", gossipTopic)
+	}
+	assert.Equal(t, 0.5, scorer.Score("peer1"))
+	for i := 0; i < 10; i++ {
+		scorer.IncrementAccepted("peer1", gossipTopic)
+	}
+	assert.Equal(t, 1.0, scorer.Score("peer1"), "Accepted messages reward should be capped")
+	scorer.IncrementIgnored("peer1", gossipTopic)
+	assert.Equal(t, 1.0, scorer.Score("peer1"), "Ignored messages should not affect score")
+	scorer.IncrementRejected("peer1", gossipTopic)
+	assert.Equal(t, 0.75, scorer.Score("peer1"))
+	scorer.IncrementRejected("peer1", "another_topic")
+	assert.Equal(t, 0.5, scorer.Score("peer1"))
+	assert.Equal(t, false, scorer.IsBadPeer("peer1"))
+	scorer.IncrementRejected("peer1", gossipTopic)
+	scorer.IncrementRejected("peer1", gossipTopic)
+	assert.Equal(t, true, scorer.IsBadPeer("peer1"))
+	assert.Equal(t, scorers.BadPeerScore, scorer.Score("peer1"))
+}
+
+func TestScorers_Gossip_Params(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peerStatuses := peers.NewStatus(ctx, &peers.StatusConfig{
+		ScorerParams: &scorers.Config{},
+	})
+	params := peerStatuses.Scorers().GossipScorer().Params()
+	assert.Equal(t, scorers.DefaultGossipRejectedThreshold, params.Threshold)
+	assert.Equal(t, scorers.DefaultGossipAcceptedMessagesCap, params.AcceptedMessagesCap)
+	assert.Equal(t, scorers.DefaultGossipDecayInterval, params.DecayInterval)
+	assert.Equal(t, scorers.DefaultGossipDecayFactor, params.DecayFactor)
+}
+
+func TestScorers_Gossip_MessageCounts(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peerStatuses := peers.NewStatus(ctx, &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	scorer := peerStatuses.Scorers().GossipScorer()
+
+	pid := peer.ID("peer1")
+	_, err := scorer.MessageCounts(pid, gossipTopic)
+	assert.ErrorContains(t, peerdata.ErrPeerUnknown.Error(), err)
+
+	peerStatuses.Add(nil, pid, nil, network.DirUnknown)
+	counts, err := scorer.MessageCounts(pid, gossipTopic)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &peerdata.GossipMessageCounts{}, counts)
+
+	scorer.IncrementAccepted(pid, gossipTopic)
+	scorer.IncrementAccepted(pid, gossipTopic)
+	scorer.IncrementIgnored(pid, gossipTopic)
+	scorer.IncrementRejected(pid, "another_topic")
+	counts, err = scorer.MessageCounts(pid, gossipTopic)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &peerdata.GossipMessageCounts{Accepted: 2, Ignored: 1}, counts)
+	counts, err = scorer.MessageCounts(pid, "another_topic")
+	require.NoError(t, err)
+	assert.DeepEqual(t, &peerdata.GossipMessageCounts{Rejected: 1}, counts)
+
+	// Returned counts must be a copy.
+	counts.Rejected = 10
+	assert.Equal(t, false, scorer.IsBadPeer(pid))
+}
+
+func TestScorers_Gossip_Decay(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peerStatuses := peers.NewStatus(ctx, &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			GossipScorerConfig: &scorers.GossipScorerConfig{
+				Threshold:   2,
+				DecayFactor: 0.5,
+			},
+		},
+	})
+	scorer := peerStatuses.Scorers().GossipScorer()
+
+	pid := peer.ID("peer1")
+	scorer.IncrementAccepted(pid, gossipTopic)
+	scorer.IncrementRejected(pid, gossipTopic)
+	scorer.IncrementRejected(pid, gossipTopic)
+	assert.Equal(t, true, scorer.IsBadPeer(pid))
+
+	scorer.Decay()
+	assert.Equal(t, false, scorer.IsBadPeer(pid), "Peer should not be bad after decay")
+	counts, err := scorer.MessageCounts(pid, gossipTopic)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &peerdata.GossipMessageCounts{Accepted: 0.5, Rejected: 1}, counts)
+
+	// Counts decayed to (almost) zero are reset.
+	for i := 0; i < 10; i++ {
+		scorer.Decay()
+	}
+	counts, err = scorer.MessageCounts(pid, gossipTopic)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &peerdata.GossipMessageCounts{}, counts)
+	assert.Equal(t, 0.0, scorer.Score(pid))
+}
+
+func TestScorers_Gossip_BadPeers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peerStatuses := peers.NewStatus(ctx, &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			GossipScorerConfig: &scorers.GossipScorerConfig{
+				Threshold: 2,
+			},
+		},
+	})
+	scorer := peerStatuses.Scorers().GossipScorer()
+
+	for _, pid := range []peer.ID{"peer1", "peer2", "peer3"} {
+		peerStatuses.Add(nil, pid, nil, network.DirUnknown)
+	}
+	assert.Equal(t, 0, len(scorer.BadPeers()))
+	scorer.IncrementRejected("peer2", gossipTopic)
+	scorer.IncrementRejected("peer2", gossipTopic)
+	scorer.IncrementRejected("peer3", gossipTopic)
+	assert.DeepEqual(t, []peer.ID{"peer2"}, scorer.BadPeers())
+}
@@ -118,7 +118,7 @@ func TestScorers_Service_Score(t *testing.T) {
 			peerStatuses.Add(nil, pid, nil, network.DirUnknown)
 			// Not yet used peer gets boosted score.
 			startScore := s.BlockProviderScorer().MaxScore()
-			assert.Equal(t, startScore/float64(s.ActiveScorersCount()), s.Score(pid), "Unexpected score for not yet used peer")
+			assert.Equal(t, roundScore(startScore/float64(s.ActiveScorersCount())), s.Score(pid), "Unexpected score for not yet used peer")
 		}
 		return s, pids
 	}
@@ -156,8 +156,8 @@ func TestScorers_Service_Score(t *testing.T) {
 	t.Run("block providers score", func(t *testing.T) {
 		s, pids := setupScorer()
 		s1 := s.BlockProviderScorer()
-		startScore := s.BlockProviderScorer().MaxScore() / 2
-		batchWeight := s1.Params().ProcessedBatchWeight / 2
+		startScore := s.BlockProviderScorer().MaxScore() / float64(s.ActiveScorersCount())
+		batchWeight := s1.Params().ProcessedBatchWeight / float64(s.ActiveScorersCount())
 
 		// Partial batch.
 		s1.IncrementProcessedBlocks("peer1", batchSize/4)
@@ -303,3 +303,21 @@ func TestScorers_Service_BadPeers(t *testing.T) {
 	assert.Equal(t, true, peerStatuses.Scorers().IsBadPeer("peer3"))
 	assert.Equal(t, 2, len(peerStatuses.Scorers().BadPeers()))
 }
+
+func TestScorers_Service_IsBadPeer_Gossip(t *testing.T) {
+	peerStatuses := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			GossipScorerConfig: &scorers.GossipScorerConfig{
+				Threshold:     2,
+				DecayInterval: 50 * time.Second,
+			},
+		},
+	})
+
+	assert.Equal(t, false, peerStatuses.Scorers().IsBadPeer("peer1"))
+	peerStatuses.Scorers().GossipScorer().IncrementRejected("peer1", "topic")
+	peerStatuses.Scorers().GossipScorer().IncrementRejected("peer1", "topic")
+	assert.Equal(t, true, peerStatuses.Scorers().IsBadPeer("peer1"))
+	assert.DeepEqual(t, []peer.ID{"peer1"}, peerStatuses.Scorers().BadPeers())
+}
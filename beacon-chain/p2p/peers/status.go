@@ -17,9 +17,13 @@
 // - active if we are connecting or connected
 // - inactive if we are disconnecting or disconnected
 //
-// Peer information is persistent for the run of the service. This allows for collection of useful
-// long-term statistics such as number of bad responses obtained from the peer, giving the basis for
-// decisions to not talk to known-bad peers (by de-scoring them).
+// Peer information is persistent for the run of the service, and can be exported to records restored
+// on the next run. This allows for collection of useful long-term statistics such as number of bad
+// responses obtained from the peer, giving the basis for decisions to not talk to known-bad peers
+// (by de-scoring them).
+//
+// Peers can also be marked as trusted, in which case they are never considered bad, or banned, in
+// which case they are always considered bad.
 package peers
 
 import (
@@ -228,6 +232,38 @@ func (p *Status) Metadata(pid peer.ID) (*pb.MetaData, error) {
 	return nil, peerdata.ErrPeerUnknown
 }
 
+// SetGossipScore sets the gossipsub score of the given remote peer. Scores of unknown peers
+// are ignored.
+func (p *Status) SetGossipScore(pid peer.ID, score *peerdata.GossipScore) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	if peerData, ok := p.store.PeerData(pid); ok {
+		peerData.GossipScore = score
+	}
+}
+
+// GossipScore returns a copy of the last inspected gossipsub score of the given remote peer,
+// or nil if the peer has not been scored yet.
+func (p *Status) GossipScore(pid peer.ID) (*peerdata.GossipScore, error) {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	if peerData, ok := p.store.PeerData(pid); ok {
+		if peerData.GossipScore == nil {
+			return nil, nil
+		}
+		score := *peerData.GossipScore
+		score.TopicScores = make(map[string]*peerdata.TopicScore, len(peerData.GossipScore.TopicScores))
+		for topic, topicScore := range peerData.GossipScore.TopicScores {
+			topicScoreCopy := *topicScore
+			score.TopicScores[topic] = &topicScoreCopy
+		}
+		return &score, nil
+	}
+	return nil, peerdata.ErrPeerUnknown
+}
+
 // CommitteeIndices retrieves the committee subnets the peer is subscribed to.
 func (p *Status) CommitteeIndices(pid peer.ID) ([]uint64, error) {
 	p.store.RLock()
@@ -298,10 +334,14 @@ func (p *Status) ChainStateLastUpdated(pid peer.ID) (time.Time, error) {
 	return timeutils.Now(), peerdata.ErrPeerUnknown
 }
 
-// IsBad states if the peer is to be considered bad (by *any* of the registered scorers).
+// IsBad states if the peer is to be considered bad (by *any* of the registered scorers), or has
+// been banned. Trusted peers are never considered bad.
 // If the peer is unknown this will return `false`, which makes using this function easier than returning an error.
 func (p *Status) IsBad(pid peer.ID) bool {
-	return p.isfromBadIP(pid) || p.scorers.IsBadPeer(pid)
+	if p.IsTrusted(pid) {
+		return false
+	}
+	return p.IsBanned(pid) || p.isfromBadIP(pid) || p.scorers.IsBadPeer(pid)
 }
 
 // NextValidTime gets the earliest possible time it is to contact/dial
@@ -423,7 +463,13 @@ func (p *Status) Inactive() []peer.ID {
 
 // Bad returns the peers that are bad.
 func (p *Status) Bad() []peer.ID {
-	return p.scorers.BadResponsesScorer().BadPeers()
+	bad := p.scorers.BadResponsesScorer().BadPeers()
+	for _, pid := range p.Banned() {
+		if !p.scorers.BadResponsesScorer().IsBadPeer(pid) {
+			bad = append(bad, pid)
+		}
+	}
+	return bad
 }
 
 // All returns all the peers regardless of state.
@@ -447,30 +493,45 @@ func (p *Status) Prune() {
 		return
 	}
 
+	rejectedMessages := func(peerData *peerdata.PeerData) float64 {
+		rejected := 0.0
+		for _, counts := range peerData.GossipMessages {
+			rejected += counts.Rejected
+		}
+		return rejected
+	}
 	notBadPeer := func(peerData *peerdata.PeerData) bool {
-		return peerData.BadResponses < p.scorers.BadResponsesScorer().Params().Threshold
+		return peerData.BadResponses < p.scorers.BadResponsesScorer().Params().Threshold &&
+			rejectedMessages(peerData) < p.scorers.GossipScorer().Params().Threshold
 	}
 	type peerResp struct {
-		pid     peer.ID
-		badResp int
+		pid      peer.ID
+		badResp  int
+		rejected float64
 	}
 	peersToPrune := make([]*peerResp, 0)
-	// Select disconnected peers with a smaller bad response count.
+	// Select disconnected peers with a smaller bad response count. Trusted and
+	// banned peers are always kept.
 	for pid, peerData := range p.store.Peers() {
-		if peerData.ConnState == PeerDisconnected && notBadPeer(peerData) {
+		if peerData.ConnState == PeerDisconnected && notBadPeer(peerData) && !peerData.Trusted && !peerData.Banned {
 			peersToPrune = append(peersToPrune, &peerResp{
-				pid:     pid,
-				badResp: peerData.BadResponses,
+				pid:      pid,
+				badResp:  peerData.BadResponses,
+				rejected: rejectedMessages(peerData),
 			})
 		}
 	}
 
 	// Sort peers in ascending order, so the peers with the
-	// least amount of bad responses are pruned first. This
-	// is to protect the node from malicious/lousy peers so
-	// that their memory is still kept.
+	// least amount of bad responses and rejected gossip
+	// messages are pruned first. This is to protect the node
+	// from malicious/lousy peers so that their memory is
+	// still kept.
 	sort.Slice(peersToPrune, func(i, j int) bool {
-		return peersToPrune[i].badResp < peersToPrune[j].badResp
+		if peersToPrune[i].badResp != peersToPrune[j].badResp {
+			return peersToPrune[i].badResp < peersToPrune[j].badResp
+		}
+		return peersToPrune[i].rejected < peersToPrune[j].rejected
 	})
 
 	limitDiff := len(p.store.Peers()) - p.store.Config().MaxPeers
@@ -519,6 +519,48 @@ func TestPrune(t *testing.T) {
 	assert.ErrorContains(t, "peer unknown", err)
 }
 
+func TestPrune_GossipBadPeer(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			GossipScorerConfig: &scorers.GossipScorerConfig{
+				Threshold: 2,
+			},
+		},
+	})
+
+	for i := 0; i < p.MaxPeerLimit()+100; i++ {
+		if i%7 == 0 {
+			// Peer added as disconnected.
+			_ = addPeer(t, p, peers.PeerDisconnected)
+		}
+		// Peer added to peer handler.
+		_ = addPeer(t, p, peers.PeerConnected)
+	}
+
+	disPeers := p.Disconnected()
+	firstPID := disPeers[0]
+	secondPID := disPeers[1]
+
+	scorer := p.Scorers().GossipScorer()
+
+	// Make first peer a bad peer.
+	scorer.IncrementRejected(firstPID, "topic")
+	scorer.IncrementRejected(firstPID, "topic")
+	scorer.IncrementAccepted(secondPID, "topic")
+
+	p.Prune()
+
+	// Bad peer is expected to still be kept in handler.
+	counts, err := scorer.MessageCounts(firstPID, "topic")
+	require.NoError(t, err)
+	assert.Equal(t, 2.0, counts.Rejected)
+
+	// Other disconnected peer is pruned away.
+	_, err = scorer.MessageCounts(secondPID, "topic")
+	assert.ErrorContains(t, "peer unknown", err)
+}
+
 func TestPeerIPTracker(t *testing.T) {
 	maxBadResponses := 2
 	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
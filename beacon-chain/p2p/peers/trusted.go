@@ -0,0 +1,89 @@
+package peers
+
+import (
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+// AddTrusted marks the peer as trusted and records its address, lifting any ban on it.
+// Trusted peers bypass the peer limits and are reconnected to whenever they are disconnected.
+func (p *Status) AddTrusted(pid peer.ID, address ma.Multiaddr) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	peerData := p.store.PeerDataGetOrCreate(pid)
+	peerData.Trusted = true
+	peerData.Banned = false
+	if address != nil {
+		prevAddress := peerData.Address
+		peerData.Address = address
+		if !sameIP(prevAddress, address) {
+			p.addIpToTracker(pid)
+		}
+	}
+}
+
+// RemoveTrusted removes the trusted mark of the peer.
+func (p *Status) RemoveTrusted(pid peer.ID) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	if peerData, ok := p.store.PeerData(pid); ok {
+		peerData.Trusted = false
+	}
+}
+
+// IsTrusted returns whether the peer is trusted.
+func (p *Status) IsTrusted(pid peer.ID) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	peerData, ok := p.store.PeerData(pid)
+	return ok && peerData.Trusted
+}
+
+// Trusted returns the trusted peers.
+func (p *Status) Trusted() []peer.ID {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	peers := make([]peer.ID, 0)
+	for pid, peerData := range p.store.Peers() {
+		if peerData.Trusted {
+			peers = append(peers, pid)
+		}
+	}
+	return peers
+}
+
+// Ban bans the peer, removing its trusted mark. Banned peers are considered bad until they are
+// trusted again.
+func (p *Status) Ban(pid peer.ID) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	peerData := p.store.PeerDataGetOrCreate(pid)
+	peerData.Banned = true
+	peerData.Trusted = false
+}
+
+// IsBanned returns whether the peer is banned.
+func (p *Status) IsBanned(pid peer.ID) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	peerData, ok := p.store.PeerData(pid)
+	return ok && peerData.Banned
+}
+
+// Banned returns the banned peers.
+func (p *Status) Banned() []peer.ID {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	peers := make([]peer.ID, 0)
+	for pid, peerData := range p.store.Peers() {
+		if peerData.Banned {
+			peers = append(peers, pid)
+		}
+	}
+	return peers
+}
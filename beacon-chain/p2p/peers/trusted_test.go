@@ -0,0 +1,101 @@
+package peers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStatus_Trusted(t *testing.T) {
+	maxBadResponses := 2
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: maxBadResponses,
+			},
+		},
+	})
+	pid := addPeer(t, p, peers.PeerConnected)
+	for i := 0; i < maxBadResponses; i++ {
+		p.Scorers().BadResponsesScorer().Increment(pid)
+	}
+	require.Equal(t, true, p.IsBad(pid))
+
+	address, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+	p.AddTrusted(pid, address)
+	assert.Equal(t, true, p.IsTrusted(pid))
+	assert.Equal(t, false, p.IsBad(pid), "Trusted peer should never be bad")
+	assert.DeepEqual(t, []string{pid.String()}, peerIDStrings(p.Trusted()))
+
+	p.RemoveTrusted(pid)
+	assert.Equal(t, false, p.IsTrusted(pid))
+	assert.Equal(t, true, p.IsBad(pid))
+}
+
+func TestStatus_Ban(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: 2,
+			},
+		},
+	})
+	address, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+	pid := createPeer(t, p, address, network.DirOutbound, peers.PeerConnected)
+	p.AddTrusted(pid, nil)
+	assert.Equal(t, false, p.IsBad(pid))
+
+	p.Ban(pid)
+	assert.Equal(t, true, p.IsBanned(pid))
+	assert.Equal(t, false, p.IsTrusted(pid), "Ban should remove the trusted mark")
+	assert.Equal(t, true, p.IsBad(pid))
+	assert.DeepEqual(t, []string{pid.String()}, peerIDStrings(p.Bad()))
+
+	// Trusting a peer lifts its ban.
+	p.AddTrusted(pid, nil)
+	assert.Equal(t, false, p.IsBanned(pid))
+	assert.Equal(t, false, p.IsBad(pid))
+	assert.Equal(t, 0, len(p.Bad()))
+}
+
+func TestStatus_PruneKeepsTrustedAndBannedPeers(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 0,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: 2,
+			},
+		},
+	})
+	trusted := addPeer(t, p, peers.PeerDisconnected)
+	p.AddTrusted(trusted, nil)
+	banned := addPeer(t, p, peers.PeerDisconnected)
+	p.Ban(banned)
+	for i := 0; i < p.MaxPeerLimit(); i++ {
+		addPeer(t, p, peers.PeerDisconnected)
+	}
+
+	p.Prune()
+	assert.Equal(t, p.MaxPeerLimit(), len(p.All()))
+	assert.Equal(t, true, p.IsTrusted(trusted))
+	assert.Equal(t, true, p.IsBanned(banned))
+}
+
+func peerIDStrings(pids []peer.ID) []string {
+	strs := make([]string, len(pids))
+	for i, pid := range pids {
+		strs[i] = pid.String()
+	}
+	return strs
+}
@@ -9,6 +9,7 @@ import (
 	pubsub_pb "github.com/libp2p/go-libp2p-pubsub/pb"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -86,10 +87,30 @@ func (s *Service) SubscribeToTopic(topic string, opts ...pubsub.SubOpt) (*pubsub
 }
 
 // peerInspector will scrape all the relevant scoring data and add it to our
-// peer handler.
-// TODO(#6043): Add hooks to add in peer inspector to our global peer handler.
+// peer handler, and export it as metrics.
 func (s *Service) peerInspector(peerMap map[peer.ID]*pubsub.PeerScoreSnapshot) {
-	// no-op
+	resetGossipScoreMetrics()
+	for pid, snapshot := range peerMap {
+		topicScores := make(map[string]*peerdata.TopicScore, len(snapshot.Topics))
+		for topic, topicSnapshot := range snapshot.Topics {
+			topicScores[topic] = &peerdata.TopicScore{
+				TimeInMesh:               topicSnapshot.TimeInMesh,
+				FirstMessageDeliveries:   topicSnapshot.FirstMessageDeliveries,
+				MeshMessageDeliveries:    topicSnapshot.MeshMessageDeliveries,
+				InvalidMessageDeliveries: topicSnapshot.InvalidMessageDeliveries,
+			}
+		}
+		score := &peerdata.GossipScore{
+			Score:              snapshot.Score,
+			TopicScores:        topicScores,
+			AppSpecificScore:   snapshot.AppSpecificScore,
+			IPColocationFactor: snapshot.IPColocationFactor,
+			BehaviourPenalty:   snapshot.BehaviourPenalty,
+		}
+		s.peers.SetGossipScore(pid, score)
+	}
+	updateGossipScoreMetrics(peerMap)
+	updateGossipTopicMetrics(peerMap)
 }
 
 // Content addressable ID function.
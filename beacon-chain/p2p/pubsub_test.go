@@ -8,9 +8,16 @@ import (
 	"time"
 
 	"github.com/golang/snappy"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
 	testp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -21,6 +28,7 @@ import (
 func TestService_PublishToTopicConcurrentMapWrite(t *testing.T) {
 	s, err := NewService(context.Background(), &Config{
 		StateNotifier: &mock.MockStateNotifier{},
+		DataDir:       t.TempDir(),
 	})
 	require.NoError(t, err)
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
@@ -71,3 +79,66 @@ func TestMessageIDFunction_HashesCorrectly(t *testing.T) {
 	msgID = string(hashedData[:20])
 	assert.Equal(t, msgID, msgIDFunction(nMsg), "Got incorrect msg id")
 }
+
+func TestService_PeerInspector(t *testing.T) {
+	s := &Service{
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			ScorerParams: &scorers.Config{},
+		}),
+	}
+	knownPeer := peer.ID("known")
+	unknownPeer := peer.ID("unknown")
+	s.peers.Add(nil, knownPeer, nil, network.DirOutbound)
+
+	topic := "/eth2/00000000/beacon_block/ssz_snappy"
+	s.peerInspector(map[peer.ID]*pubsub.PeerScoreSnapshot{
+		knownPeer: {
+			Score: -10,
+			Topics: map[string]*pubsub.TopicScoreSnapshot{
+				topic: {
+					TimeInMesh:               2 * time.Second,
+					FirstMessageDeliveries:   3,
+					MeshMessageDeliveries:    4,
+					InvalidMessageDeliveries: 1,
+				},
+			},
+			IPColocationFactor: 1,
+			BehaviourPenalty:   2,
+		},
+		unknownPeer: {
+			Score: 5,
+			Topics: map[string]*pubsub.TopicScoreSnapshot{
+				topic: {FirstMessageDeliveries: 2},
+			},
+		},
+	})
+
+	score, err := s.peers.GossipScore(knownPeer)
+	require.NoError(t, err)
+	require.NotNil(t, score)
+	assert.Equal(t, float64(-10), score.Score)
+	assert.Equal(t, float64(1), score.IPColocationFactor)
+	assert.Equal(t, float64(2), score.BehaviourPenalty)
+	require.NotNil(t, score.TopicScores[topic])
+	assert.DeepEqual(t, &peerdata.TopicScore{
+		TimeInMesh:               2 * time.Second,
+		FirstMessageDeliveries:   3,
+		MeshMessageDeliveries:    4,
+		InvalidMessageDeliveries: 1,
+	}, score.TopicScores[topic])
+
+	// Scores of peers unknown to the peer handler are not tracked.
+	_, err = s.peers.GossipScore(unknownPeer)
+	assert.ErrorContains(t, "peer unknown", err)
+
+	// Peer scores are aggregated over all peers.
+	assert.Equal(t, float64(-10), testutil.ToFloat64(gossipScore.WithLabelValues("min")))
+	assert.Equal(t, float64(-2.5), testutil.ToFloat64(gossipScore.WithLabelValues("avg")))
+	assert.Equal(t, float64(5), testutil.ToFloat64(gossipScore.WithLabelValues("max")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(gossipBehaviourPenalty.WithLabelValues("avg")))
+
+	// Topic metrics are summed over all peers, and the time in mesh averaged over mesh peers.
+	assert.Equal(t, float64(2), testutil.ToFloat64(gossipTopicTimeInMesh.WithLabelValues(topic)))
+	assert.Equal(t, float64(5), testutil.ToFloat64(gossipTopicFirstMessageDeliveries.WithLabelValues(topic)))
+	assert.Equal(t, float64(1), testutil.ToFloat64(gossipTopicInvalidMessageDeliveries.WithLabelValues(topic)))
+}
@@ -0,0 +1,103 @@
+package p2p
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+// File in the data directory the random subnet assignments of the attached
+// validators are saved to.
+const randomSubnetsPath = "randomSubnets"
+
+// Period at which the random subnet assignments are saved to disk.
+var persistRandomSubnetsPeriod = time.Minute
+
+// Restores the random subnet assignments saved by the previous run, so that
+// the node keeps advertising the same subnets across restarts.
+func (s *Service) restoreRandomSubnets() error {
+	src, err := ioutil.ReadFile(path.Join(s.cfg.DataDir, randomSubnetsPath))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not read random subnets")
+	}
+	assignments := &dbpb.RandomSubnetAssignments{}
+	if err := assignments.Unmarshal(src); err != nil {
+		return errors.Wrap(err, "could not unmarshal random subnets")
+	}
+	restored := 0
+	now := timeutils.Now()
+	for _, assignment := range assignments.Assignments {
+		expiration := time.Unix(0, assignment.Expiration)
+		if !expiration.After(now) {
+			continue
+		}
+		_, ok, _ := cache.SubnetIDs.GetPersistentSubnets(assignment.PublicKey)
+		if ok {
+			continue
+		}
+		cache.SubnetIDs.AddPersistentCommittee(assignment.PublicKey, assignment.Subnets, expiration.Sub(now))
+		restored++
+	}
+	s.persistedRandomSubnets = src
+	log.WithField("validators", restored).Debug("Restored random subnet assignments from disk")
+	return nil
+}
+
+// Saves the random subnet assignments of the attached validators to disk, if
+// they changed since they were last saved.
+func (s *Service) persistRandomSubnets() {
+	if s.cfg == nil || s.cfg.DataDir == "" {
+		return
+	}
+	subnets := cache.SubnetIDs.GetAllPersistentSubnets()
+	assignments := &dbpb.RandomSubnetAssignments{
+		Assignments: make([]*dbpb.RandomSubnetAssignment, len(subnets)),
+	}
+	for i, subnet := range subnets {
+		assignments.Assignments[i] = &dbpb.RandomSubnetAssignment{
+			PublicKey:  subnet.PublicKey,
+			Subnets:    subnet.Subnets,
+			Expiration: subnet.Expiration.UnixNano(),
+		}
+	}
+	dst, err := assignments.Marshal()
+	if err != nil {
+		log.WithError(err).Error("Could not marshal random subnets")
+		return
+	}
+	if bytes.Equal(dst, s.persistedRandomSubnets) {
+		return
+	}
+	if err := fileutil.WriteFile(path.Join(s.cfg.DataDir, randomSubnetsPath), dst); err != nil {
+		log.WithError(err).Error("Could not save random subnets to disk")
+		return
+	}
+	s.persistedRandomSubnets = dst
+}
+
+// Saves the metadata of the node to disk, so that its sequence number keeps
+// increasing across restarts. Metadata provided by the user is left untouched.
+func (s *Service) persistMetadata() {
+	if s.cfg == nil || s.cfg.DataDir == "" || s.cfg.MetaDataDir != "" {
+		return
+	}
+	dst, err := s.metaData.Marshal()
+	if err != nil {
+		log.WithError(err).Error("Could not marshal metadata")
+		return
+	}
+	if err := fileutil.WriteFile(path.Join(s.cfg.DataDir, metaDataPath), dst); err != nil {
+		log.WithError(err).Error("Could not save metadata to disk")
+	}
+}
@@ -0,0 +1,81 @@
+package p2p
+
+import (
+	"io/ioutil"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_PersistRandomSubnets(t *testing.T) {
+	s := &Service{cfg: &Config{DataDir: t.TempDir()}}
+	pubkey := [48]byte{'p', 'e', 'r', 's', 'i', 's', 't'}
+	cache.SubnetIDs.AddPersistentCommittee(pubkey[:], []uint64{3, 7}, time.Hour)
+
+	s.persistRandomSubnets()
+	src, err := ioutil.ReadFile(path.Join(s.cfg.DataDir, randomSubnetsPath))
+	require.NoError(t, err)
+	assignments := &dbpb.RandomSubnetAssignments{}
+	require.NoError(t, assignments.Unmarshal(src))
+	var persisted *dbpb.RandomSubnetAssignment
+	for _, assignment := range assignments.Assignments {
+		if string(assignment.PublicKey) == string(pubkey[:]) {
+			persisted = assignment
+		}
+	}
+	require.NotNil(t, persisted)
+	assert.DeepEqual(t, []uint64{3, 7}, persisted.Subnets)
+	assert.Equal(t, true, time.Unix(0, persisted.Expiration).After(time.Now().Add(59*time.Minute)))
+}
+
+func TestService_RestoreRandomSubnets(t *testing.T) {
+	s := &Service{cfg: &Config{DataDir: t.TempDir()}}
+	// No assignments were saved yet.
+	require.NoError(t, s.restoreRandomSubnets())
+
+	activePubkey := [48]byte{'a', 'c', 't', 'i', 'v', 'e'}
+	expiredPubkey := [48]byte{'e', 'x', 'p', 'i', 'r', 'e', 'd'}
+	expiration := time.Now().Add(time.Hour)
+	assignments := &dbpb.RandomSubnetAssignments{
+		Assignments: []*dbpb.RandomSubnetAssignment{
+			{PublicKey: activePubkey[:], Subnets: []uint64{5}, Expiration: expiration.UnixNano()},
+			{PublicKey: expiredPubkey[:], Subnets: []uint64{6}, Expiration: time.Now().Add(-time.Minute).UnixNano()},
+		},
+	}
+	src, err := assignments.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, fileutil.WriteFile(path.Join(s.cfg.DataDir, randomSubnetsPath), src))
+
+	require.NoError(t, s.restoreRandomSubnets())
+	subnets, ok, exp := cache.SubnetIDs.GetPersistentSubnets(activePubkey[:])
+	require.Equal(t, true, ok)
+	assert.DeepEqual(t, []uint64{5}, subnets)
+	assert.Equal(t, true, exp.Sub(expiration) < time.Second && expiration.Sub(exp) < time.Second)
+	_, ok, _ = cache.SubnetIDs.GetPersistentSubnets(expiredPubkey[:])
+	assert.Equal(t, false, ok)
+}
+
+func TestService_PersistMetadata(t *testing.T) {
+	cfg := &Config{DataDir: t.TempDir()}
+	metaData, err := metaDataFromConfig(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), metaData.SeqNumber)
+
+	attnets := bitfield.NewBitvector64()
+	attnets.SetBitAt(4, true)
+	s := &Service{cfg: cfg, metaData: &pb.MetaData{SeqNumber: 5, Attnets: attnets}}
+	s.persistMetadata()
+
+	metaData, err = metaDataFromConfig(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), metaData.SeqNumber)
+	assert.DeepEqual(t, attnets, metaData.Attnets)
+}
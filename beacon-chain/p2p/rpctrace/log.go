@@ -0,0 +1,5 @@
+package rpctrace
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "rpctrace")
@@ -0,0 +1,69 @@
+package rpctrace
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+)
+
+// Reader reads the records of a trace file written by a Recorder.
+type Reader struct {
+	r *bufio.Reader
+}
+
+// NewReader returns a reader of the records in r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: bufio.NewReader(r)}
+}
+
+// Next returns the next record, or io.EOF if there are no more records.
+func (r *Reader) Next() (*dbpb.RPCTraceRecord, error) {
+	size, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, errors.Wrap(err, "could not read record size")
+	}
+	if size > maxRecordSize {
+		return nil, errors.Errorf("record size %d exceeds the maximum of %d", size, maxRecordSize)
+	}
+	buf := make([]byte, size)
+	if _, err := io.ReadFull(r.r, buf); err != nil {
+		return nil, errors.Wrap(err, "could not read record")
+	}
+	record := &dbpb.RPCTraceRecord{}
+	if err := record.Unmarshal(buf); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal record")
+	}
+	return record, nil
+}
+
+// ReadFile reads all the records of the given trace file.
+func ReadFile(path string) ([]*dbpb.RPCTraceRecord, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open trace file")
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Debug("Could not close trace file")
+		}
+	}()
+	records := make([]*dbpb.RPCTraceRecord, 0)
+	reader := NewReader(f)
+	for {
+		record, err := reader.Next()
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			return records, err
+		}
+		records = append(records, record)
+	}
+}
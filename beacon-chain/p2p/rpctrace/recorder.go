@@ -0,0 +1,146 @@
+// Package rpctrace records the request/response exchanges of the node over the req/resp
+// protocols to a rotating trace file, and reads them back, e.g. to replay a recorded session
+// against a local node.
+package rpctrace
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+const (
+	// DefaultMaxFileSize defines the size, in bytes, at which the trace file is rotated.
+	DefaultMaxFileSize = 100 * 1 << 20
+	// DefaultMaxBackups defines the number of rotated trace files to keep.
+	DefaultMaxBackups = 5
+	// maxPayloadSize is the maximum size of a traced request or response payload, larger
+	// payloads are truncated.
+	maxPayloadSize = 16 * 1 << 20
+	// maxRecordSize is the maximum size of an encoded record in the trace file.
+	maxRecordSize = 2*maxPayloadSize + 1<<10
+)
+
+// Config holds configuration parameters for the req/resp recorder.
+type Config struct {
+	// Path is the path of the trace file. Rotated files get a numeric suffix.
+	Path string
+	// MaxFileSize specifies the size, in bytes, at which the trace file is rotated.
+	MaxFileSize int64
+	// MaxBackups specifies the number of rotated trace files to keep.
+	MaxBackups int
+}
+
+// Recorder writes req/resp exchanges to a trace file, rotating it once it exceeds the
+// configured size. Records are written as uvarint length-prefixed protobuf messages.
+type Recorder struct {
+	config *Config
+	lock   sync.Mutex
+	file   *os.File
+	size   int64
+}
+
+// NewRecorder opens the trace file, appending to it if it already exists.
+func NewRecorder(config *Config) (*Recorder, error) {
+	if config == nil || config.Path == "" {
+		return nil, errors.New("no trace file provided")
+	}
+	if config.MaxFileSize == 0 {
+		config.MaxFileSize = DefaultMaxFileSize
+	}
+	if config.MaxBackups == 0 {
+		config.MaxBackups = DefaultMaxBackups
+	}
+	if err := os.MkdirAll(filepath.Dir(config.Path), params.BeaconIoConfig().ReadWriteExecutePermissions); err != nil {
+		return nil, errors.Wrap(err, "could not create trace directory")
+	}
+	r := &Recorder{config: config}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Record appends the given exchange to the trace file.
+func (r *Recorder) Record(record *dbpb.RPCTraceRecord) error {
+	enc, err := record.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "could not marshal record")
+	}
+	buf := make([]byte, binary.MaxVarintLen64, binary.MaxVarintLen64+len(enc))
+	buf = append(buf[:binary.PutUvarint(buf, uint64(len(enc)))], enc...)
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.file == nil {
+		return errors.New("recorder is closed")
+	}
+	if r.size > 0 && r.size+int64(len(buf)) > r.config.MaxFileSize {
+		if err := r.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := r.file.Write(buf)
+	r.size += int64(n)
+	if err != nil {
+		return errors.Wrap(err, "could not write record")
+	}
+	return nil
+}
+
+// Close closes the trace file. Records are dropped afterwards.
+func (r *Recorder) Close() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+// open opens the trace file for appending.
+func (r *Recorder) open() error {
+	f, err := os.OpenFile(r.config.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		return errors.Wrap(err, "could not open trace file")
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return errors.Wrap(err, "could not stat trace file")
+	}
+	r.file = f
+	r.size = info.Size()
+	return nil
+}
+
+// rotate shifts the trace file and its backups by one, dropping the oldest backup, and
+// opens a new trace file.
+func (r *Recorder) rotate() error {
+	if err := r.file.Close(); err != nil {
+		return errors.Wrap(err, "could not close trace file")
+	}
+	r.file = nil
+	for i := r.config.MaxBackups - 1; i > 0; i-- {
+		if err := os.Rename(BackupPath(r.config.Path, i), BackupPath(r.config.Path, i+1)); err != nil && !os.IsNotExist(err) {
+			return errors.Wrap(err, "could not rotate trace file")
+		}
+	}
+	if err := os.Rename(r.config.Path, BackupPath(r.config.Path, 1)); err != nil {
+		return errors.Wrap(err, "could not rotate trace file")
+	}
+	return r.open()
+}
+
+// BackupPath returns the path of the i-th most recent rotated trace file.
+func BackupPath(path string, i int) string {
+	return fmt.Sprintf("%s.%d", path, i)
+}
@@ -0,0 +1,99 @@
+package rpctrace_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/rpctrace"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func testRecord(i int) *dbpb.RPCTraceRecord {
+	return &dbpb.RPCTraceRecord{
+		Direction:    dbpb.RPCTraceRecord_OUTBOUND,
+		PeerId:       fmt.Sprintf("peer%d", i),
+		ProtocolId:   "/eth2/beacon_chain/req/ping/1/ssz_snappy",
+		StartTime:    int64(i),
+		Duration:     1000,
+		Request:      []byte{0x08, byte(i)},
+		Response:     []byte{0x00, 0x08, byte(i)},
+		ResponseCode: 0,
+	}
+}
+
+func TestRecorder_Record(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces", "reqresp.trace")
+	_, err := rpctrace.NewRecorder(&rpctrace.Config{})
+	assert.ErrorContains(t, "no trace file provided", err)
+
+	recorder, err := rpctrace.NewRecorder(&rpctrace.Config{Path: path})
+	require.NoError(t, err)
+	require.NoError(t, recorder.Record(testRecord(1)))
+	require.NoError(t, recorder.Record(testRecord(2)))
+	require.NoError(t, recorder.Close())
+	assert.ErrorContains(t, "recorder is closed", recorder.Record(testRecord(3)))
+
+	// Records are appended to an existing trace file.
+	recorder, err = rpctrace.NewRecorder(&rpctrace.Config{Path: path})
+	require.NoError(t, err)
+	require.NoError(t, recorder.Record(testRecord(3)))
+	require.NoError(t, recorder.Close())
+
+	records, err := rpctrace.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(records))
+	for i, record := range records {
+		assert.DeepEqual(t, testRecord(i+1), record)
+	}
+}
+
+func TestRecorder_Rotate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "reqresp.trace")
+	record, err := testRecord(1).Marshal()
+	require.NoError(t, err)
+	recorder, err := rpctrace.NewRecorder(&rpctrace.Config{
+		Path: path,
+		// Fit two records per file.
+		MaxFileSize: int64(2 * (len(record) + 1)),
+		MaxBackups:  2,
+	})
+	require.NoError(t, err)
+	for i := 0; i < 7; i++ {
+		require.NoError(t, recorder.Record(testRecord(i)))
+	}
+	require.NoError(t, recorder.Close())
+
+	// The oldest records were dropped along with the oldest backup.
+	assert.Equal(t, false, fileutil.FileExists(rpctrace.BackupPath(path, 3)))
+	for i, file := range []string{rpctrace.BackupPath(path, 2), rpctrace.BackupPath(path, 1), path} {
+		records, err := rpctrace.ReadFile(file)
+		require.NoError(t, err)
+		first := 2*i + 2
+		want := []*dbpb.RPCTraceRecord{testRecord(first), testRecord(first + 1)}
+		if first+1 == 7 {
+			want = want[:1]
+		}
+		assert.DeepEqual(t, want, records)
+	}
+}
+
+func TestReadFile_Truncated(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "reqresp.trace")
+	recorder, err := rpctrace.NewRecorder(&rpctrace.Config{Path: path})
+	require.NoError(t, err)
+	require.NoError(t, recorder.Record(testRecord(1)))
+	require.NoError(t, recorder.Record(testRecord(2)))
+	require.NoError(t, recorder.Close())
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(path, info.Size()-1))
+	records, err := rpctrace.ReadFile(path)
+	assert.ErrorContains(t, "could not read record", err)
+	assert.DeepEqual(t, []*dbpb.RPCTraceRecord{testRecord(1)}, records)
+}
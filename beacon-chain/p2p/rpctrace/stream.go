@@ -0,0 +1,117 @@
+package rpctrace
+
+import (
+	"io"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+// Stream is a req/resp stream, which traces the request and response payloads read from
+// and written to it. The exchange is recorded once the stream is closed or reset.
+type Stream struct {
+	network.Stream
+	recorder  *Recorder
+	direction dbpb.RPCTraceRecord_Direction
+	start     time.Time
+	lock      sync.Mutex
+	request   []byte
+	response  []byte
+	truncated bool
+	err       error
+	finished  bool
+}
+
+// TraceStream wraps the given stream, so that its exchange gets recorded. The direction is
+// that of the request: inbound streams are opened by the remote peer, outbound ones by the node.
+func (r *Recorder) TraceStream(stream network.Stream, direction dbpb.RPCTraceRecord_Direction) *Stream {
+	return &Stream{
+		Stream:    stream,
+		recorder:  r,
+		direction: direction,
+		start:     timeutils.Now(),
+	}
+}
+
+// Read reads from the underlying stream, tracing the data read.
+func (s *Stream) Read(p []byte) (int, error) {
+	n, err := s.Stream.Read(p)
+	s.trace(s.direction == dbpb.RPCTraceRecord_INBOUND, p[:n], err)
+	return n, err
+}
+
+// Write writes to the underlying stream, tracing the data written.
+func (s *Stream) Write(p []byte) (int, error) {
+	n, err := s.Stream.Write(p)
+	s.trace(s.direction == dbpb.RPCTraceRecord_OUTBOUND, p[:n], err)
+	return n, err
+}
+
+// Close closes the underlying stream and records the exchange.
+func (s *Stream) Close() error {
+	err := s.Stream.Close()
+	s.Finish()
+	return err
+}
+
+// Reset resets the underlying stream and records the exchange.
+func (s *Stream) Reset() error {
+	err := s.Stream.Reset()
+	s.Finish()
+	return err
+}
+
+// Finish records the exchange, if it has not been recorded yet. Any data read from or
+// written to the stream afterwards is not traced.
+func (s *Stream) Finish() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.finished {
+		return
+	}
+	s.finished = true
+
+	record := &dbpb.RPCTraceRecord{
+		Direction:  s.direction,
+		PeerId:     s.Conn().RemotePeer().String(),
+		ProtocolId: string(s.Protocol()),
+		StartTime:  s.start.UnixNano(),
+		Duration:   int64(timeutils.Since(s.start)),
+		Request:    s.request,
+		Response:   s.response,
+		Truncated:  s.truncated,
+	}
+	if len(s.response) > 0 {
+		record.ResponseCode = uint32(s.response[0])
+	}
+	if s.err != nil {
+		record.Error = s.err.Error()
+	}
+	if err := s.recorder.Record(record); err != nil {
+		log.WithError(err).Debug("Could not record req/resp exchange")
+	}
+}
+
+// trace appends the given data to the request or response payload.
+func (s *Stream) trace(request bool, p []byte, err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.finished {
+		return
+	}
+	payload := &s.response
+	if request {
+		payload = &s.request
+	}
+	if len(*payload)+len(p) > maxPayloadSize {
+		p = p[:maxPayloadSize-len(*payload)]
+		s.truncated = true
+	}
+	*payload = append(*payload, p...)
+	if err != nil && err != io.EOF && s.err == nil {
+		s.err = err
+	}
+}
@@ -0,0 +1,83 @@
+package rpctrace_test
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/rpctrace"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStream_Trace(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+
+	path := filepath.Join(t.TempDir(), "reqresp.trace")
+	recorder, err := rpctrace.NewRecorder(&rpctrace.Config{Path: path})
+	require.NoError(t, err)
+
+	topic := "/testing/1/ssz_snappy"
+	request := []byte{0x01, 0x02, 0x03}
+	response := []byte{0x00, 0x04, 0x05}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(protocol.ID(topic), func(stream network.Stream) {
+		defer wg.Done()
+		traced := recorder.TraceStream(stream, dbpb.RPCTraceRecord_INBOUND)
+		received, err := ioutil.ReadAll(traced)
+		require.NoError(t, err)
+		assert.DeepEqual(t, request, received)
+		_, err = traced.Write(response)
+		require.NoError(t, err)
+		require.NoError(t, traced.Close())
+		// Stream is only recorded once.
+		traced.Finish()
+	})
+
+	stream, err := p1.BHost.NewStream(context.Background(), p2.PeerID(), protocol.ID(topic))
+	require.NoError(t, err)
+	traced := recorder.TraceStream(stream, dbpb.RPCTraceRecord_OUTBOUND)
+	_, err = traced.Write(request)
+	require.NoError(t, err)
+	require.NoError(t, traced.CloseWrite())
+	received, err := ioutil.ReadAll(traced)
+	require.NoError(t, err)
+	assert.DeepEqual(t, response, received)
+	require.NoError(t, traced.Reset())
+	if testutil.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+	require.NoError(t, recorder.Close())
+
+	records, err := rpctrace.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(records))
+	for _, record := range records {
+		assert.Equal(t, topic, record.ProtocolId)
+		assert.DeepEqual(t, request, record.Request)
+		assert.DeepEqual(t, response, record.Response)
+		assert.Equal(t, uint32(0), record.ResponseCode)
+		assert.Equal(t, false, record.Truncated)
+		assert.Equal(t, "", record.Error)
+		assert.Equal(t, true, record.StartTime > 0)
+	}
+	inbound, outbound := records[0], records[1]
+	if inbound.Direction == dbpb.RPCTraceRecord_OUTBOUND {
+		inbound, outbound = outbound, inbound
+	}
+	assert.Equal(t, dbpb.RPCTraceRecord_INBOUND, inbound.Direction)
+	assert.Equal(t, p1.PeerID().String(), inbound.PeerId)
+	assert.Equal(t, dbpb.RPCTraceRecord_OUTBOUND, outbound.Direction)
+	assert.Equal(t, p2.PeerID().String(), outbound.PeerId)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/libp2p/go-libp2p-core/protocol"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"go.opencensus.io/trace"
 )
@@ -32,6 +33,9 @@ func (s *Service) Send(ctx context.Context, message interface{}, baseTopic strin
 		traceutil.AnnotateError(span, err)
 		return nil, err
 	}
+	if s.rpcRecorder != nil {
+		stream = s.rpcRecorder.TraceStream(stream, dbpb.RPCTraceRecord_OUTBOUND)
+	}
 	// do not encode anything if we are sending a metadata request
 	if baseTopic != RPCMetaDataTopic {
 		if _, err := s.Encoding().EncodeWithMaxLength(stream, message); err != nil {
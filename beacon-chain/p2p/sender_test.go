@@ -2,6 +2,7 @@ package p2p
 
 import (
 	"context"
+	"path"
 	"sync"
 	"testing"
 	"time"
@@ -10,7 +11,9 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/rpctrace"
 	testp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
@@ -60,3 +63,80 @@ func TestService_Send(t *testing.T) {
 		t.Errorf("Expected identical message to be received. got %v want %v", rcvd, msg)
 	}
 }
+
+func TestService_Send_Traced(t *testing.T) {
+	p1 := testp2p.NewTestP2P(t)
+	p2 := testp2p.NewTestP2P(t)
+	p1.Connect(p2)
+
+	dir := t.TempDir()
+	senderRecorder, err := rpctrace.NewRecorder(&rpctrace.Config{Path: path.Join(dir, "sender.trace")})
+	require.NoError(t, err)
+	receiverRecorder, err := rpctrace.NewRecorder(&rpctrace.Config{Path: path.Join(dir, "receiver.trace")})
+	require.NoError(t, err)
+	sender := &Service{
+		host:        p1.BHost,
+		cfg:         &Config{},
+		rpcRecorder: senderRecorder,
+	}
+	receiver := &Service{
+		host:        p2.BHost,
+		cfg:         &Config{},
+		rpcRecorder: receiverRecorder,
+	}
+
+	msg := &pb.Fork{
+		CurrentVersion:  []byte("fooo"),
+		PreviousVersion: []byte("barr"),
+		Epoch:           55,
+	}
+
+	// Register listener which will repeat the message back.
+	var wg sync.WaitGroup
+	wg.Add(1)
+	topic := "/testing/1"
+	RPCTopicMappings[topic] = new(pb.Fork)
+	defer func() {
+		delete(RPCTopicMappings, topic)
+	}()
+	receiver.SetStreamHandler(topic+"/ssz_snappy", func(stream network.Stream) {
+		defer wg.Done()
+		rcvd := &pb.Fork{}
+		require.NoError(t, receiver.Encoding().DecodeWithMaxLength(stream, rcvd))
+		_, err := stream.Write([]byte{0x00})
+		require.NoError(t, err)
+		_, err = receiver.Encoding().EncodeWithMaxLength(stream, rcvd)
+		require.NoError(t, err)
+	})
+
+	stream, err := sender.Send(context.Background(), msg, topic, p2.BHost.ID())
+	require.NoError(t, err)
+	testutil.WaitTimeout(&wg, 1*time.Second)
+	code := make([]byte, 1)
+	_, err = stream.Read(code)
+	require.NoError(t, err)
+	rcvd := &pb.Fork{}
+	require.NoError(t, sender.Encoding().DecodeWithMaxLength(stream, rcvd))
+	require.NoError(t, stream.Close())
+	require.NoError(t, senderRecorder.Close())
+	require.NoError(t, receiverRecorder.Close())
+
+	sent, err := rpctrace.ReadFile(path.Join(dir, "sender.trace"))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(sent))
+	received, err := rpctrace.ReadFile(path.Join(dir, "receiver.trace"))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(received))
+	assert.Equal(t, dbpb.RPCTraceRecord_OUTBOUND, sent[0].Direction)
+	assert.Equal(t, p2.BHost.ID().String(), sent[0].PeerId)
+	assert.Equal(t, dbpb.RPCTraceRecord_INBOUND, received[0].Direction)
+	assert.Equal(t, p1.BHost.ID().String(), received[0].PeerId)
+	for _, record := range []*dbpb.RPCTraceRecord{sent[0], received[0]} {
+		assert.Equal(t, topic+"/ssz_snappy", record.ProtocolId)
+		assert.Equal(t, uint32(0), record.ResponseCode)
+	}
+	assert.DeepEqual(t, sent[0].Request, received[0].Request)
+	assert.DeepEqual(t, sent[0].Response, received[0].Response)
+	// The response is the status code followed by the encoded request message.
+	assert.DeepEqual(t, append([]byte{0x00}, sent[0].Request...), sent[0].Response)
+}
@@ -25,7 +25,10 @@ import (
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/kv"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/rpctrace"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
@@ -54,30 +57,33 @@ var maxDialTimeout = params.BeaconNetworkConfig().RespTimeout
 
 // Service for managing peer to peer (p2p) networking.
 type Service struct {
-	started               bool
-	isPreGenesis          bool
-	currentForkDigest     [4]byte
-	pingMethod            func(ctx context.Context, id peer.ID) error
-	cancel                context.CancelFunc
-	cfg                   *Config
-	peers                 *peers.Status
-	addrFilter            *multiaddr.Filters
-	ipLimiter             *leakybucket.Collector
-	privKey               *ecdsa.PrivateKey
-	metaData              *pb.MetaData
-	pubsub                *pubsub.PubSub
-	joinedTopics          map[string]*pubsub.Topic
-	joinedTopicsLock      sync.Mutex
-	subnetsLock           map[uint64]*sync.RWMutex
-	subnetsLockLock       sync.Mutex // Lock access to subnetsLock
-	initializationLock    sync.Mutex
-	dv5Listener           Listener
-	startupErr            error
-	stateNotifier         statefeed.Notifier
-	ctx                   context.Context
-	host                  host.Host
-	genesisTime           time.Time
-	genesisValidatorsRoot []byte
+	started                bool
+	isPreGenesis           bool
+	currentForkDigest      [4]byte
+	pingMethod             func(ctx context.Context, id peer.ID) error
+	cancel                 context.CancelFunc
+	cfg                    *Config
+	peers                  *peers.Status
+	peersDB                *kv.Store
+	persistedRandomSubnets []byte
+	rpcRecorder            *rpctrace.Recorder
+	addrFilter             *multiaddr.Filters
+	ipLimiter              *leakybucket.Collector
+	privKey                *ecdsa.PrivateKey
+	metaData               *pb.MetaData
+	pubsub                 *pubsub.PubSub
+	joinedTopics           map[string]*pubsub.Topic
+	joinedTopicsLock       sync.Mutex
+	subnetsLock            map[uint64]*sync.RWMutex
+	subnetsLockLock        sync.Mutex // Lock access to subnetsLock
+	initializationLock     sync.Mutex
+	dv5Listener            Listener
+	startupErr             error
+	stateNotifier          statefeed.Notifier
+	ctx                    context.Context
+	host                   host.Host
+	genesisTime            time.Time
+	genesisValidatorsRoot  []byte
 }
 
 // NewService initializes a new p2p service compatible with shared.Service interface. No
@@ -165,6 +171,27 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 			},
 		},
 	})
+	if s.cfg.DataDir != "" {
+		if err := s.restorePeers(); err != nil {
+			log.WithError(err).Error("Failed to restore peers")
+			return nil, err
+		}
+		if err := s.restoreRandomSubnets(); err != nil {
+			log.WithError(err).Error("Failed to restore random subnets")
+			return nil, err
+		}
+	}
+	if s.cfg.RPCTraceFile != "" {
+		s.rpcRecorder, err = rpctrace.NewRecorder(&rpctrace.Config{
+			Path:        s.cfg.RPCTraceFile,
+			MaxFileSize: s.cfg.RPCTraceMaxFileSize,
+		})
+		if err != nil {
+			log.WithError(err).Error("Failed to create req/resp recorder")
+			return nil, err
+		}
+		log.WithField("path", s.cfg.RPCTraceFile).Info("Recording req/resp exchanges")
+	}
 
 	return s, nil
 }
@@ -219,12 +246,19 @@ func (s *Service) Start() {
 		}
 		s.connectWithAllPeers(addrs)
 	}
+	if len(s.cfg.TrustedPeers) > 0 {
+		s.trustConfiguredPeers()
+	}
+	s.connectToTrustedPeers()
 
 	// Periodic functions.
 	runutil.RunEvery(s.ctx, params.BeaconNetworkConfig().TtfbTimeout, func() {
 		ensurePeerConnections(s.ctx, s.host, peersToWatch...)
 	})
+	runutil.RunEvery(s.ctx, trustedPeersReconnectPeriod, s.connectToTrustedPeers)
 	runutil.RunEvery(s.ctx, 30*time.Minute, s.Peers().Prune)
+	runutil.RunEvery(s.ctx, persistPeersPeriod, s.persistPeers)
+	runutil.RunEvery(s.ctx, persistRandomSubnetsPeriod, s.persistRandomSubnets)
 	runutil.RunEvery(s.ctx, params.BeaconNetworkConfig().RespTimeout, s.updateMetrics)
 	runutil.RunEvery(s.ctx, refreshRate, func() {
 		s.RefreshENR()
@@ -254,6 +288,16 @@ func (s *Service) Stop() error {
 	if s.dv5Listener != nil {
 		s.dv5Listener.Close()
 	}
+	s.persistRandomSubnets()
+	if s.rpcRecorder != nil {
+		if err := s.rpcRecorder.Close(); err != nil {
+			log.WithError(err).Error("Failed to close req/resp recorder")
+		}
+	}
+	if s.peersDB != nil {
+		s.persistPeers()
+		return s.peersDB.Close()
+	}
 	return nil
 }
 
@@ -294,8 +338,17 @@ func (s *Service) Host() host.Host {
 }
 
 // SetStreamHandler sets the protocol handler on the p2p host multiplexer.
-// This method is a pass through to libp2pcore.Host.SetStreamHandler.
+// This method is a pass through to libp2pcore.Host.SetStreamHandler, which
+// records the handled exchanges if req/resp tracing is enabled.
 func (s *Service) SetStreamHandler(topic string, handler network.StreamHandler) {
+	if s.rpcRecorder != nil {
+		recorder, traced := s.rpcRecorder, handler
+		handler = func(stream network.Stream) {
+			tracedStream := recorder.TraceStream(stream, dbpb.RPCTraceRecord_INBOUND)
+			defer tracedStream.Finish()
+			traced(tracedStream)
+		}
+	}
 	s.host.SetStreamHandler(protocol.ID(topic), handler)
 }
 
@@ -79,7 +79,7 @@ func createHost(t *testing.T, port int) (host.Host, *ecdsa.PrivateKey, net.IP) {
 }
 
 func TestService_Stop_SetsStartedToFalse(t *testing.T) {
-	s, err := NewService(context.Background(), &Config{StateNotifier: &mock.MockStateNotifier{}})
+	s, err := NewService(context.Background(), &Config{StateNotifier: &mock.MockStateNotifier{}, DataDir: t.TempDir()})
 	require.NoError(t, err)
 	s.started = true
 	s.dv5Listener = &mockListener{}
@@ -88,7 +88,7 @@ func TestService_Stop_SetsStartedToFalse(t *testing.T) {
 }
 
 func TestService_Stop_DontPanicIfDv5ListenerIsNotInited(t *testing.T) {
-	s, err := NewService(context.Background(), &Config{StateNotifier: &mock.MockStateNotifier{}})
+	s, err := NewService(context.Background(), &Config{StateNotifier: &mock.MockStateNotifier{}, DataDir: t.TempDir()})
 	require.NoError(t, err)
 	assert.NoError(t, s.Stop())
 }
@@ -101,6 +101,7 @@ func TestService_Start_OnlyStartsOnce(t *testing.T) {
 		UDPPort:       2000,
 		StateNotifier: &mock.MockStateNotifier{},
 	}
+	cfg.DataDir = t.TempDir()
 	s, err := NewService(context.Background(), cfg)
 	require.NoError(t, err)
 	s.stateNotifier = &mock.MockStateNotifier{}
@@ -204,6 +205,7 @@ func TestListenForNewNodes(t *testing.T) {
 	cfg.UDPPort = 14000
 	cfg.TCPPort = 14001
 
+	cfg.DataDir = t.TempDir()
 	s, err = NewService(context.Background(), cfg)
 	require.NoError(t, err)
 	exitRoutine := make(chan bool)
@@ -262,7 +264,7 @@ func TestPeer_Disconnect(t *testing.T) {
 func TestService_JoinLeaveTopic(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
-	s, err := NewService(ctx, &Config{StateNotifier: &mock.MockStateNotifier{}})
+	s, err := NewService(ctx, &Config{StateNotifier: &mock.MockStateNotifier{}, DataDir: t.TempDir()})
 	require.NoError(t, err)
 
 	go s.awaitStateInitialized()
@@ -98,7 +98,7 @@ func (s *Service) hasPeerWithSubnet(topic string) bool {
 // Updates the service's discv5 listener record's attestation subnet
 // with a new value for a bitfield of subnets tracked. It also updates
 // the node's metadata by increasing the sequence number and the
-// subnets tracked by the node.
+// subnets tracked by the node, and saves it to disk.
 func (s *Service) updateSubnetRecordWithMetadata(bitV bitfield.Bitvector64) {
 	entry := enr.WithEntry(attSubnetEnrKey, &bitV)
 	s.dv5Listener.LocalNode().Set(entry)
@@ -106,6 +106,7 @@ func (s *Service) updateSubnetRecordWithMetadata(bitV bitfield.Bitvector64) {
 		SeqNumber: s.metaData.SeqNumber + 1,
 		Attnets:   bitV,
 	}
+	s.persistMetadata()
 }
 
 // Initializes a bitvector of attestation subnets beacon nodes is subscribed to
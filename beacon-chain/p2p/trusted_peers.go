@@ -0,0 +1,93 @@
+package p2p
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/kv"
+)
+
+// Period at which the connections to trusted peers are checked,
+// to reconnect to the disconnected ones.
+var trustedPeersReconnectPeriod = 15 * time.Second
+
+// Period at which the status of the peers is saved to disk.
+var persistPeersPeriod = 5 * time.Minute
+
+// Opens the peers database in the data directory and restores the peers saved
+// by the previous run.
+func (s *Service) restorePeers() error {
+	db, err := kv.NewStore(s.cfg.DataDir)
+	if err != nil {
+		return err
+	}
+	s.peersDB = db
+	records, err := db.Peers(s.ctx)
+	if err != nil {
+		return err
+	}
+	restored, err := s.peers.LoadRecords(records)
+	if err != nil {
+		log.WithError(err).Error("Could not restore some peers")
+	}
+	log.WithField("peers", restored).Debug("Restored peers from disk")
+	return nil
+}
+
+// Saves the status of the known peers to disk.
+func (s *Service) persistPeers() {
+	if s.peersDB == nil {
+		return
+	}
+	records, err := s.peers.Records()
+	if err != nil {
+		log.WithError(err).Error("Could not export peers")
+		return
+	}
+	if err := s.peersDB.SavePeers(s.ctx, records); err != nil {
+		log.WithError(err).Error("Could not save peers to disk")
+	}
+}
+
+// Marks the peers of the configured multiaddresses as trusted.
+func (s *Service) trustConfiguredPeers() {
+	addrs, err := peersFromStringAddrs(s.cfg.TrustedPeers)
+	if err != nil {
+		log.WithError(err).Error("Could not parse trusted peers")
+		return
+	}
+	for _, addr := range addrs {
+		info, err := peer.AddrInfoFromP2pAddr(addr)
+		if err != nil {
+			log.WithError(err).Errorf("Could not get peer ID of trusted peer %s", addr)
+			continue
+		}
+		var address multiaddr.Multiaddr
+		if len(info.Addrs) > 0 {
+			address = info.Addrs[0]
+		}
+		s.peers.AddTrusted(info.ID, address)
+	}
+}
+
+// Dials the trusted peers which are not connected. Trusted peers
+// are dialed regardless of the peer limits.
+func (s *Service) connectToTrustedPeers() {
+	for _, pid := range s.peers.Trusted() {
+		if pid == s.host.ID() || s.host.Network().Connectedness(pid) == network.Connected {
+			continue
+		}
+		address, err := s.peers.Address(pid)
+		if err != nil || address == nil {
+			log.WithField("peer", pid).Debug("No known address for trusted peer")
+			continue
+		}
+		go func(info peer.AddrInfo) {
+			if err := connectWithTimeout(s.ctx, s.host, &info); err != nil {
+				log.WithField("peer", info.ID).WithError(err).Debug("Could not connect to trusted peer")
+			}
+		}(peer.AddrInfo{ID: pid, Addrs: []multiaddr.Multiaddr{address}})
+	}
+}
@@ -256,7 +256,12 @@ func (s *Service) Start() {
 		if err != nil {
 			log.Fatal(err)
 		}
-		if genState == nil {
+		// A node started from a checkpoint has no genesis state, but does not need to create it.
+		originRoot, err := s.beaconDB.OriginBlockRoot(s.ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if genState == nil && originRoot == params.BeaconConfig().ZeroHash {
 			log.Fatal("cannot create genesis state: no eth1 http endpoint defined")
 		}
 	}
@@ -573,7 +578,10 @@ func (s *Service) initDepositCaches(ctx context.Context, ctrs []*protodb.Deposit
 	}
 	// Default to all deposits post-genesis deposits in
 	// the event we cannot find a finalized state.
-	currIndex := genesisState.Eth1DepositIndex()
+	currIndex := uint64(0)
+	if genesisState != nil {
+		currIndex = genesisState.Eth1DepositIndex()
+	}
 	chkPt, err := s.beaconDB.FinalizedCheckpoint(ctx)
 	if err != nil {
 		return err
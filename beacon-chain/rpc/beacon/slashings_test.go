@@ -30,8 +30,9 @@ func TestServer_SubmitProposerSlashing(t *testing.T) {
 		HeadFetcher: &mock.ChainService{
 			State: st,
 		},
-		SlashingsPool: slashings.NewPool(),
-		Broadcaster:   mb,
+		SlashingsPool:       slashings.NewPool(),
+		Broadcaster:         mb,
+		AttestationNotifier: &mock.MockOperationNotifier{},
 	}
 
 	// We want a proposer slashing for validator with index 2 to
@@ -60,8 +61,9 @@ func TestServer_SubmitAttesterSlashing(t *testing.T) {
 		HeadFetcher: &mock.ChainService{
 			State: st,
 		},
-		SlashingsPool: slashings.NewPool(),
-		Broadcaster:   mb,
+		SlashingsPool:       slashings.NewPool(),
+		Broadcaster:         mb,
+		AttestationNotifier: &mock.MockOperationNotifier{},
 	}
 
 	slashing, err := testutil.GenerateAttesterSlashingForValidator(st, privs[2], uint64(2))
@@ -91,8 +93,9 @@ func TestServer_SubmitProposerSlashing_DontBroadcast(t *testing.T) {
 		HeadFetcher: &mock.ChainService{
 			State: st,
 		},
-		SlashingsPool: slashings.NewPool(),
-		Broadcaster:   mb,
+		SlashingsPool:       slashings.NewPool(),
+		Broadcaster:         mb,
+		AttestationNotifier: &mock.MockOperationNotifier{},
 	}
 
 	// We want a proposer slashing for validator with index 2 to
@@ -138,8 +141,9 @@ func TestServer_SubmitAttesterSlashing_DontBroadcast(t *testing.T) {
 		HeadFetcher: &mock.ChainService{
 			State: st,
 		},
-		SlashingsPool: slashings.NewPool(),
-		Broadcaster:   mb,
+		SlashingsPool:       slashings.NewPool(),
+		Broadcaster:         mb,
+		AttestationNotifier: &mock.MockOperationNotifier{},
 	}
 
 	slashing, err := testutil.GenerateAttesterSlashingForValidator(st, privs[2], uint64(2))
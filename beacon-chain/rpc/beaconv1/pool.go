@@ -2,57 +2,285 @@ package beaconv1
 
 import (
 	"context"
-	"errors"
 
 	ptypes "github.com/gogo/protobuf/types"
-
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // ListPoolAttestations retrieves attestations known by the node but
 // not necessarily incorporated into any block.
 func (bs *Server) ListPoolAttestations(ctx context.Context, req *ethpb.AttestationsPoolRequest) (*ethpb.AttestationsPoolResponse, error) {
-	return nil, errors.New("unimplemented")
+	unaggregatedAtts, err := bs.AttestationsPool.UnaggregatedAttestations()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get unaggregated attestations: %v", err)
+	}
+	atts := append(bs.AttestationsPool.AggregatedAttestations(), unaggregatedAtts...)
+
+	filteredAtts := make([]*ethpb.Attestation, 0, len(atts))
+	for _, att := range atts {
+		// Zero values of the slot and committee index filters are treated as unset.
+		if req.Slot != 0 && att.Data.Slot != req.Slot {
+			continue
+		}
+		if req.CommitteeIndex != 0 && att.Data.CommitteeIndex != req.CommitteeIndex {
+			continue
+		}
+		v1Att, err := migration.V1Alpha1ToV1Attestation(att)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not convert attestation: %v", err)
+		}
+		filteredAtts = append(filteredAtts, v1Att)
+	}
+
+	return &ethpb.AttestationsPoolResponse{Data: filteredAtts}, nil
 }
 
 // SubmitAttestation submits Attestation object to node. If attestation passes all validation
-// constraints, node MUST publish attestation on appropriate subnet.
+// constraints, node MUST publish attestation on appropriate subnet. Attestations are validated
+// the same way as unaggregated attestations received over gossip.
 func (bs *Server) SubmitAttestation(ctx context.Context, req *ethpb.Attestation) (*ptypes.Empty, error) {
-	return nil, errors.New("unimplemented")
+	att, err := migration.V1ToV1Alpha1Attestation(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not convert attestation: %v", err)
+	}
+	if err := bs.AttestationValidator.ValidateAttestation(ctx, att); err != nil {
+		return nil, validationFailuresError("attestation", fieldViolation("attestation", err.Error()))
+	}
+	headState, err := bs.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve head state: %v", err)
+	}
+
+	valCount, err := helpers.ActiveValidatorCount(headState, helpers.SlotToEpoch(att.Data.Slot))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve active validator count: %v", err)
+	}
+	subnet := helpers.ComputeSubnetForAttestation(valCount, att)
+	if err := bs.Broadcaster.BroadcastAttestation(ctx, subnet, att); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not broadcast attestation: %v", err)
+	}
+
+	bs.AttestationNotifier.OperationFeed().Send(&feed.Event{
+		Type: operation.UnaggregatedAttReceived,
+		Data: &operation.UnAggregatedAttReceivedData{
+			Attestation: att,
+		},
+	})
+	if err := bs.AttestationsPool.SaveUnaggregatedAttestation(att); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save unaggregated attestation: %v", err)
+	}
+
+	return &ptypes.Empty{}, nil
 }
 
 // ListPoolAttesterSlashings retrieves attester slashings known by the node but
 // not necessarily incorporated into any block.
 func (bs *Server) ListPoolAttesterSlashings(ctx context.Context, req *ptypes.Empty) (*ethpb.AttesterSlashingsPoolResponse, error) {
-	return nil, errors.New("unimplemented")
+	headState, err := bs.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve head state: %v", err)
+	}
+	sourceSlashings := bs.SlashingsPool.PendingAttesterSlashings(ctx, headState, true /* return unlimited slashings */)
+
+	slashings := make([]*ethpb.AttesterSlashing, len(sourceSlashings))
+	for i, s := range sourceSlashings {
+		slashings[i], err = migration.V1Alpha1ToV1AttesterSlashing(s)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not convert attester slashing: %v", err)
+		}
+	}
+
+	return &ethpb.AttesterSlashingsPoolResponse{Data: slashings}, nil
 }
 
 // SubmitAttesterSlashing submits AttesterSlashing object to node's pool and
 // if passes validation node MUST broadcast it to network.
 func (bs *Server) SubmitAttesterSlashing(ctx context.Context, req *ethpb.AttesterSlashing) (*ptypes.Empty, error) {
-	return nil, errors.New("unimplemented")
+	slashing, err := migration.V1ToV1Alpha1AttesterSlashing(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not convert attester slashing: %v", err)
+	}
+	headState, err := bs.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve head state: %v", err)
+	}
+
+	var failures []*errdetails.BadRequest_FieldViolation
+	if slashing.Attestation_1 == nil || slashing.Attestation_2 == nil {
+		failures = append(failures, fieldViolation("attester_slashing", "attestations must not be empty"))
+	} else {
+		if err := blocks.VerifyIndexedAttestation(ctx, headState, slashing.Attestation_1); err != nil {
+			failures = append(failures, fieldViolation("attestation_1", err.Error()))
+		}
+		if err := blocks.VerifyIndexedAttestation(ctx, headState, slashing.Attestation_2); err != nil {
+			failures = append(failures, fieldViolation("attestation_2", err.Error()))
+		}
+		if failures == nil {
+			if err := blocks.VerifyAttesterSlashing(ctx, headState, slashing); err != nil {
+				failures = append(failures, fieldViolation("attester_slashing", err.Error()))
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return nil, validationFailuresError("attester slashing", failures...)
+	}
+
+	if err := bs.SlashingsPool.InsertAttesterSlashing(ctx, headState, slashing); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not insert attester slashing into pool: %v", err)
+	}
+	bs.AttestationNotifier.OperationFeed().Send(&feed.Event{
+		Type: operation.AttesterSlashingReceived,
+		Data: &operation.AttesterSlashingReceivedData{
+			AttesterSlashing: slashing,
+		},
+	})
+	if !featureconfig.Get().DisableBroadcastSlashings {
+		if err := bs.Broadcaster.Broadcast(ctx, slashing); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not broadcast attester slashing: %v", err)
+		}
+	}
+
+	return &ptypes.Empty{}, nil
 }
 
 // ListPoolProposerSlashings retrieves proposer slashings known by the node
 // but not necessarily incorporated into any block.
 func (bs *Server) ListPoolProposerSlashings(ctx context.Context, req *ptypes.Empty) (*ethpb.ProposerSlashingPoolResponse, error) {
-	return nil, errors.New("unimplemented")
+	headState, err := bs.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve head state: %v", err)
+	}
+	sourceSlashings := bs.SlashingsPool.PendingProposerSlashings(ctx, headState, true /* return unlimited slashings */)
+
+	slashings := make([]*ethpb.ProposerSlashing, len(sourceSlashings))
+	for i, s := range sourceSlashings {
+		slashings[i], err = migration.V1Alpha1ToV1ProposerSlashing(s)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not convert proposer slashing: %v", err)
+		}
+	}
+
+	return &ethpb.ProposerSlashingPoolResponse{Data: slashings}, nil
 }
 
 // SubmitProposerSlashing submits AttesterSlashing object to node's pool and if
 // passes validation node MUST broadcast it to network.
 func (bs *Server) SubmitProposerSlashing(ctx context.Context, req *ethpb.ProposerSlashing) (*ptypes.Empty, error) {
-	return nil, errors.New("unimplemented")
+	slashing, err := migration.V1ToV1Alpha1ProposerSlashing(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not convert proposer slashing: %v", err)
+	}
+	headState, err := bs.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve head state: %v", err)
+	}
+
+	if slashing.Header_1 == nil || slashing.Header_2 == nil {
+		return nil, validationFailuresError("proposer slashing", fieldViolation("proposer_slashing", "headers must not be empty"))
+	}
+	if err := blocks.VerifyProposerSlashing(headState, slashing); err != nil {
+		return nil, validationFailuresError("proposer slashing", fieldViolation("proposer_slashing", err.Error()))
+	}
+
+	if err := bs.SlashingsPool.InsertProposerSlashing(ctx, headState, slashing); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not insert proposer slashing into pool: %v", err)
+	}
+	bs.AttestationNotifier.OperationFeed().Send(&feed.Event{
+		Type: operation.ProposerSlashingReceived,
+		Data: &operation.ProposerSlashingReceivedData{
+			ProposerSlashing: slashing,
+		},
+	})
+	if !featureconfig.Get().DisableBroadcastSlashings {
+		if err := bs.Broadcaster.Broadcast(ctx, slashing); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not broadcast proposer slashing: %v", err)
+		}
+	}
+
+	return &ptypes.Empty{}, nil
 }
 
 // ListPoolVoluntaryExits retrieves voluntary exits known by the node but
 // not necessarily incorporated into any block.
 func (bs *Server) ListPoolVoluntaryExits(ctx context.Context, req *ptypes.Empty) (*ethpb.VoluntaryExitsPoolResponse, error) {
-	return nil, errors.New("unimplemented")
+	headState, err := bs.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve head state: %v", err)
+	}
+	sourceExits := bs.VoluntaryExitsPool.PendingExits(headState, headState.Slot(), true /* return unlimited exits */)
+
+	exits := make([]*ethpb.SignedVoluntaryExit, len(sourceExits))
+	for i, e := range sourceExits {
+		exits[i], err = migration.V1Alpha1ToV1Exit(e)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not convert voluntary exit: %v", err)
+		}
+	}
+
+	return &ethpb.VoluntaryExitsPoolResponse{Data: exits}, nil
 }
 
 // SubmitVoluntaryExit submits SignedVoluntaryExit object to node's pool
 // and if passes validation node MUST broadcast it to network.
 func (bs *Server) SubmitVoluntaryExit(ctx context.Context, req *ethpb.SignedVoluntaryExit) (*ptypes.Empty, error) {
-	return nil, errors.New("unimplemented")
+	exit, err := migration.V1ToV1Alpha1Exit(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not convert voluntary exit: %v", err)
+	}
+	headState, err := bs.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve head state: %v", err)
+	}
+
+	if exit.Exit == nil {
+		return nil, validationFailuresError("voluntary exit", fieldViolation("exit", "voluntary exit must not be empty"))
+	}
+	val, err := headState.ValidatorAtIndexReadOnly(exit.Exit.ValidatorIndex)
+	if err != nil {
+		return nil, validationFailuresError("voluntary exit", fieldViolation("exit.validator_index", err.Error()))
+	}
+	if err := blocks.VerifyExitAndSignature(val, headState.Slot(), headState.Fork(), exit, headState.GenesisValidatorRoot()); err != nil {
+		return nil, validationFailuresError("voluntary exit", fieldViolation("voluntary_exit", err.Error()))
+	}
+
+	bs.AttestationNotifier.OperationFeed().Send(&feed.Event{
+		Type: operation.ExitReceived,
+		Data: &operation.ExitReceivedData{
+			Exit: exit,
+		},
+	})
+	bs.VoluntaryExitsPool.InsertVoluntaryExit(ctx, headState, exit)
+	if err := bs.Broadcaster.Broadcast(ctx, exit); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not broadcast voluntary exit: %v", err)
+	}
+
+	return &ptypes.Empty{}, nil
+}
+
+// fieldViolation describes why the given field of a submitted operation failed validation.
+func fieldViolation(field, description string) *errdetails.BadRequest_FieldViolation {
+	return &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: description,
+	}
+}
+
+// validationFailuresError returns an InvalidArgument status carrying the given field
+// violations as structured error details.
+func validationFailuresError(operationName string, failures ...*errdetails.BadRequest_FieldViolation) error {
+	st := status.Newf(codes.InvalidArgument, "Invalid %s", operationName)
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: failures})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
 }
@@ -0,0 +1,241 @@
+package beaconv1
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	ethpb_alpha "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	mockp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+)
+
+func poolServer(t *testing.T, numValidators uint64, slot uint64) (*Server, *mockp2p.MockBroadcaster) {
+	helpers.ClearCache()
+	st, _ := testutil.DeterministicGenesisState(t, numValidators)
+	require.NoError(t, st.SetSlot(slot))
+	genesis := time.Now().Add(-time.Duration(slot*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	chainService := &mock.ChainService{State: st, Genesis: genesis}
+	broadcaster := &mockp2p.MockBroadcaster{}
+	return &Server{
+		ChainInfoFetcher:    chainService,
+		GenesisTimeFetcher:  chainService,
+		AttestationNotifier: chainService.OperationNotifier(),
+		Broadcaster:         broadcaster,
+		AttestationsPool:    attestations.NewPool(),
+		SlashingsPool:       slashings.NewPool(),
+		VoluntaryExitsPool:  voluntaryexits.NewPool(),
+	}, broadcaster
+}
+
+func TestServer_ListPoolAttestations(t *testing.T) {
+	ctx := context.Background()
+	s, _ := poolServer(t, 64, 0)
+
+	att1 := testutil.NewAttestation()
+	att1.Data.Slot = 1
+	att1.Data.CommitteeIndex = 1
+	att1.AggregationBits = bitfield.Bitlist{0b101}
+	att2 := testutil.NewAttestation()
+	att2.Data.Slot = 2
+	att2.Data.CommitteeIndex = 1
+	att2.AggregationBits = bitfield.Bitlist{0b110}
+	att3 := testutil.NewAttestation()
+	att3.Data.Slot = 2
+	att3.Data.CommitteeIndex = 3
+	att3.AggregationBits = []byte{0b1011}
+	require.NoError(t, s.AttestationsPool.SaveUnaggregatedAttestations([]*ethpb_alpha.Attestation{att1, att2}))
+	require.NoError(t, s.AttestationsPool.SaveAggregatedAttestation(att3))
+
+	resp, err := s.ListPoolAttestations(ctx, &ethpb.AttestationsPoolRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(resp.Data))
+
+	resp, err = s.ListPoolAttestations(ctx, &ethpb.AttestationsPoolRequest{Slot: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(resp.Data))
+
+	resp, err = s.ListPoolAttestations(ctx, &ethpb.AttestationsPoolRequest{Slot: 2, CommitteeIndex: 1})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Data))
+	assert.Equal(t, uint64(2), resp.Data[0].Data.Slot)
+	assert.Equal(t, uint64(1), resp.Data[0].Data.CommitteeIndex)
+}
+
+type mockAttestationValidator struct {
+	err error
+}
+
+func (m *mockAttestationValidator) ValidateAttestation(_ context.Context, _ *ethpb_alpha.Attestation) error {
+	return m.err
+}
+
+func TestServer_SubmitAttestation(t *testing.T) {
+	ctx := context.Background()
+	s, broadcaster := poolServer(t, 64, 1)
+	headState, err := s.ChainInfoFetcher.HeadState(ctx)
+	require.NoError(t, err)
+	_, keys, err := testutil.DeterministicDepositsAndKeys(64)
+	require.NoError(t, err)
+	atts, err := testutil.GenerateAttestations(headState, keys, 1, 0, false)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(atts))
+	// Only unaggregated attestations are accepted.
+	bits := bitfield.NewBitlist(atts[0].AggregationBits.Len())
+	bits.SetBitAt(0, true)
+	atts[0].AggregationBits = bits
+	att, err := migration.V1Alpha1ToV1Attestation(atts[0])
+	require.NoError(t, err)
+
+	t.Run("Failed validation", func(t *testing.T) {
+		s.AttestationValidator = &mockAttestationValidator{err: errors.New("attestation must have exactly one participating validator")}
+		_, err := s.SubmitAttestation(ctx, att)
+		require.ErrorContains(t, "Invalid attestation", err)
+		st, ok := status.FromError(err)
+		require.Equal(t, true, ok)
+		require.Equal(t, 1, len(st.Details()))
+		badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+		require.Equal(t, true, ok)
+		require.Equal(t, 1, len(badRequest.FieldViolations))
+		assert.Equal(t, "attestation", badRequest.FieldViolations[0].Field)
+		assert.Equal(t, "attestation must have exactly one participating validator", badRequest.FieldViolations[0].Description)
+		assert.Equal(t, false, broadcaster.BroadcastCalled)
+	})
+
+	s.AttestationValidator = &mockAttestationValidator{}
+	_, err = s.SubmitAttestation(ctx, att)
+	require.NoError(t, err)
+	assert.Equal(t, true, broadcaster.BroadcastCalled)
+	assert.Equal(t, 1, s.AttestationsPool.UnaggregatedAttestationCount())
+}
+
+func TestServer_SubmitAndListAttesterSlashings(t *testing.T) {
+	ctx := context.Background()
+	s, broadcaster := poolServer(t, 64, 0)
+	headState, err := s.ChainInfoFetcher.HeadState(ctx)
+	require.NoError(t, err)
+	_, keys, err := testutil.DeterministicDepositsAndKeys(64)
+	require.NoError(t, err)
+	slashing, err := testutil.GenerateAttesterSlashingForValidator(headState, keys[5], 5)
+	require.NoError(t, err)
+	v1Slashing, err := migration.V1Alpha1ToV1AttesterSlashing(slashing)
+	require.NoError(t, err)
+
+	t.Run("Invalid signature", func(t *testing.T) {
+		badSlashing, err := migration.V1Alpha1ToV1AttesterSlashing(slashing)
+		require.NoError(t, err)
+		badSlashing.Attestation_2.Signature = make([]byte, params.BeaconConfig().BLSSignatureLength)
+		_, err = s.SubmitAttesterSlashing(ctx, badSlashing)
+		require.ErrorContains(t, "Invalid attester slashing", err)
+		st, ok := status.FromError(err)
+		require.Equal(t, true, ok)
+		badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+		require.Equal(t, true, ok)
+		require.Equal(t, 1, len(badRequest.FieldViolations))
+		assert.Equal(t, "attestation_2", badRequest.FieldViolations[0].Field)
+	})
+
+	_, err = s.SubmitAttesterSlashing(ctx, v1Slashing)
+	require.NoError(t, err)
+	assert.Equal(t, true, broadcaster.BroadcastCalled)
+
+	resp, err := s.ListPoolAttesterSlashings(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Data))
+	assert.DeepEqual(t, v1Slashing, resp.Data[0])
+}
+
+func TestServer_SubmitAndListProposerSlashings(t *testing.T) {
+	ctx := context.Background()
+	s, broadcaster := poolServer(t, 64, 0)
+	headState, err := s.ChainInfoFetcher.HeadState(ctx)
+	require.NoError(t, err)
+	_, keys, err := testutil.DeterministicDepositsAndKeys(64)
+	require.NoError(t, err)
+	slashing, err := testutil.GenerateProposerSlashingForValidator(headState, keys[3], 3)
+	require.NoError(t, err)
+	v1Slashing, err := migration.V1Alpha1ToV1ProposerSlashing(slashing)
+	require.NoError(t, err)
+
+	t.Run("Same headers", func(t *testing.T) {
+		badSlashing, err := migration.V1Alpha1ToV1ProposerSlashing(slashing)
+		require.NoError(t, err)
+		badSlashing.Header_2 = badSlashing.Header_1
+		_, err = s.SubmitProposerSlashing(ctx, badSlashing)
+		require.ErrorContains(t, "Invalid proposer slashing", err)
+	})
+
+	_, err = s.SubmitProposerSlashing(ctx, v1Slashing)
+	require.NoError(t, err)
+	assert.Equal(t, true, broadcaster.BroadcastCalled)
+
+	resp, err := s.ListPoolProposerSlashings(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Data))
+	assert.DeepEqual(t, v1Slashing, resp.Data[0])
+}
+
+func TestServer_SubmitAndListVoluntaryExits(t *testing.T) {
+	ctx := context.Background()
+	epoch := params.BeaconConfig().ShardCommitteePeriod
+	s, broadcaster := poolServer(t, 64, epoch*params.BeaconConfig().SlotsPerEpoch)
+	headState, err := s.ChainInfoFetcher.HeadState(ctx)
+	require.NoError(t, err)
+	_, keys, err := testutil.DeterministicDepositsAndKeys(64)
+	require.NoError(t, err)
+	exit := &ethpb_alpha.SignedVoluntaryExit{
+		Exit: &ethpb_alpha.VoluntaryExit{
+			Epoch:          epoch,
+			ValidatorIndex: 7,
+		},
+	}
+	exit.Signature, err = helpers.ComputeDomainAndSign(headState, epoch, exit.Exit, params.BeaconConfig().DomainVoluntaryExit, keys[7])
+	require.NoError(t, err)
+	v1Exit, err := migration.V1Alpha1ToV1Exit(exit)
+	require.NoError(t, err)
+
+	t.Run("Unknown validator", func(t *testing.T) {
+		badExit, err := migration.V1Alpha1ToV1Exit(exit)
+		require.NoError(t, err)
+		badExit.Exit.ValidatorIndex = 1000
+		_, err = s.SubmitVoluntaryExit(ctx, badExit)
+		require.ErrorContains(t, "Invalid voluntary exit", err)
+		st, ok := status.FromError(err)
+		require.Equal(t, true, ok)
+		badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+		require.Equal(t, true, ok)
+		assert.Equal(t, "exit.validator_index", badRequest.FieldViolations[0].Field)
+	})
+
+	opChannel := make(chan *feed.Event, 1)
+	opSub := s.AttestationNotifier.OperationFeed().Subscribe(opChannel)
+	defer opSub.Unsubscribe()
+
+	_, err = s.SubmitVoluntaryExit(ctx, v1Exit)
+	require.NoError(t, err)
+	assert.Equal(t, true, broadcaster.BroadcastCalled)
+	event := <-opChannel
+	assert.Equal(t, feed.EventType(operation.ExitReceived), event.Type)
+
+	resp, err := s.ListPoolVoluntaryExits(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Data))
+	assert.DeepEqual(t, v1Exit, resp.Data[0])
+}
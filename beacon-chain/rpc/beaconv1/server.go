@@ -15,6 +15,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
@@ -26,22 +27,24 @@ import (
 // providing RPC endpoints to access data relevant to the Ethereum 2.0 phase 0
 // beacon chain.
 type Server struct {
-	BeaconDB            db.ReadOnlyDatabase
-	Ctx                 context.Context
-	ChainStartFetcher   powchain.ChainStartFetcher
-	ChainInfoFetcher    blockchain.ChainInfoFetcher
-	DepositFetcher      depositcache.DepositFetcher
-	BlockFetcher        powchain.POWBlockFetcher
-	GenesisTimeFetcher  blockchain.TimeFetcher
-	BlockReceiver       blockchain.BlockReceiver
-	StateNotifier       statefeed.Notifier
-	BlockNotifier       blockfeed.Notifier
-	AttestationNotifier operation.Notifier
-	Broadcaster         p2p.Broadcaster
-	AttestationsPool    attestations.Pool
-	SlashingsPool       *slashings.Pool
-	CanonicalStateChan  chan *pbp2p.BeaconState
-	ChainStartChan      chan time.Time
-	StateGen            *stategen.State
-	SyncChecker         sync.Checker
+	BeaconDB             db.ReadOnlyDatabase
+	Ctx                  context.Context
+	ChainStartFetcher    powchain.ChainStartFetcher
+	ChainInfoFetcher     blockchain.ChainInfoFetcher
+	DepositFetcher       depositcache.DepositFetcher
+	BlockFetcher         powchain.POWBlockFetcher
+	GenesisTimeFetcher   blockchain.TimeFetcher
+	BlockReceiver        blockchain.BlockReceiver
+	StateNotifier        statefeed.Notifier
+	BlockNotifier        blockfeed.Notifier
+	AttestationNotifier  operation.Notifier
+	Broadcaster          p2p.Broadcaster
+	AttestationsPool     attestations.Pool
+	SlashingsPool        *slashings.Pool
+	VoluntaryExitsPool   *voluntaryexits.Pool
+	CanonicalStateChan   chan *pbp2p.BeaconState
+	ChainStartChan       chan time.Time
+	StateGen             *stategen.State
+	SyncChecker          sync.Checker
+	AttestationValidator sync.AttestationValidator
 }
@@ -1,30 +1,121 @@
 package beaconv1
 
 import (
+	"bytes"
 	"context"
-	"errors"
 
 	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/statefetcher"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // GetGenesis retrieves details of the chain's genesis which can be used to identify chain.
 func (bs *Server) GetGenesis(ctx context.Context, _ *ptypes.Empty) (*ethpb.GenesisResponse, error) {
-	return nil, errors.New("unimplemented")
+	genesisTime := bs.GenesisTimeFetcher.GenesisTime()
+	if genesisTime.IsZero() {
+		return nil, status.Errorf(codes.NotFound, "Chain genesis info is not yet known")
+	}
+	validatorRoot := bs.ChainInfoFetcher.GenesisValidatorRoot()
+	if bytes.Equal(validatorRoot[:], params.BeaconConfig().ZeroHash[:]) {
+		return nil, status.Errorf(codes.NotFound, "Chain genesis info is not yet known")
+	}
+	genesisTimestamp, err := ptypes.TimestampProto(genesisTime)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not convert genesis time: %v", err)
+	}
+
+	return &ethpb.GenesisResponse{
+		GenesisTime:           genesisTimestamp,
+		GenesisValidatorsRoot: validatorRoot[:],
+		GenesisForkVersion:    params.BeaconConfig().GenesisForkVersion,
+	}, nil
 }
 
 // GetStateRoot calculates HashTreeRoot for state with given 'stateId'. If stateId is root, same value will be returned.
 func (bs *Server) GetStateRoot(ctx context.Context, req *ethpb.StateRequest) (*ethpb.StateRootResponse, error) {
-	return nil, errors.New("unimplemented")
+	st, err := bs.requestedState(ctx, req.StateId)
+	if err != nil {
+		return nil, err
+	}
+	root, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not hash state: %v", err)
+	}
+
+	return &ethpb.StateRootResponse{
+		StateRoot: root[:],
+	}, nil
 }
 
 // GetStateFork returns Fork object for state with given 'stateId'.
 func (bs *Server) GetStateFork(ctx context.Context, req *ethpb.StateRequest) (*ethpb.StateForkResponse, error) {
-	return nil, errors.New("unimplemented")
+	st, err := bs.requestedState(ctx, req.StateId)
+	if err != nil {
+		return nil, err
+	}
+	fork := st.Fork()
+
+	return &ethpb.StateForkResponse{
+		Fork: &ethpb.Fork{
+			PreviousVersion: fork.PreviousVersion,
+			CurrentVersion:  fork.CurrentVersion,
+			Epoch:           fork.Epoch,
+		},
+	}, nil
 }
 
 // GetFinalityCheckpoints returns finality checkpoints for state with given 'stateId'. In case finality is
 // not yet achieved, checkpoint should return epoch 0 and ZERO_HASH as root.
 func (bs *Server) GetFinalityCheckpoints(ctx context.Context, req *ethpb.StateRequest) (*ethpb.StateFinalityCheckpointResponse, error) {
-	return nil, errors.New("unimplemented")
+	st, err := bs.requestedState(ctx, req.StateId)
+	if err != nil {
+		return nil, err
+	}
+	prevJustified := st.PreviousJustifiedCheckpoint()
+	currJustified := st.CurrentJustifiedCheckpoint()
+	finalized := st.FinalizedCheckpoint()
+
+	return &ethpb.StateFinalityCheckpointResponse{
+		PreviousJustified: &ethpb.Checkpoint{
+			Epoch: prevJustified.Epoch,
+			Root:  prevJustified.Root,
+		},
+		CurrentJustified: &ethpb.Checkpoint{
+			Epoch: currJustified.Epoch,
+			Root:  currJustified.Root,
+		},
+		Finalized: &ethpb.Checkpoint{
+			Epoch: finalized.Epoch,
+			Root:  finalized.Root,
+		},
+	}, nil
+}
+
+// requestedState resolves the state for the given state ID and converts any failure
+// into the appropriate gRPC status error.
+func (bs *Server) requestedState(ctx context.Context, stateId []byte) (*stateTrie.BeaconState, error) {
+	st, err := bs.stateProvider().State(ctx, stateId)
+	if err != nil {
+		if errors.Is(err, statefetcher.ErrInvalidStateID) {
+			return nil, status.Errorf(codes.InvalidArgument, "Could not get state from state ID: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "Could not get state from state ID: %v", err)
+	}
+	if st == nil {
+		return nil, status.Errorf(codes.NotFound, "Could not find requested state")
+	}
+	return st, nil
+}
+
+func (bs *Server) stateProvider() *statefetcher.StateProvider {
+	return &statefetcher.StateProvider{
+		BeaconDB:         bs.BeaconDB,
+		ChainInfoFetcher: bs.ChainInfoFetcher,
+		StateGen:         bs.StateGen,
+	}
 }
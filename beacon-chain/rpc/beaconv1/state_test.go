@@ -0,0 +1,240 @@
+package beaconv1
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	ethpb_alpha "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_GetGenesis(t *testing.T) {
+	ctx := context.Background()
+	genesis := time.Unix(1606824023, 0)
+	validatorsRoot := [32]byte{'a'}
+	chainService := &mock.ChainService{Genesis: genesis, ValidatorsRoot: validatorsRoot}
+	s := &Server{
+		GenesisTimeFetcher: chainService,
+		ChainInfoFetcher:   chainService,
+	}
+
+	resp, err := s.GetGenesis(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, genesis.Unix(), resp.GenesisTime.Seconds)
+	assert.DeepEqual(t, validatorsRoot[:], resp.GenesisValidatorsRoot)
+	assert.DeepEqual(t, params.BeaconConfig().GenesisForkVersion, resp.GenesisForkVersion)
+
+	t.Run("No genesis time", func(t *testing.T) {
+		chainService := &mock.ChainService{ValidatorsRoot: validatorsRoot}
+		s := &Server{
+			GenesisTimeFetcher: chainService,
+			ChainInfoFetcher:   chainService,
+		}
+		_, err := s.GetGenesis(ctx, &ptypes.Empty{})
+		assert.ErrorContains(t, "Chain genesis info is not yet known", err)
+	})
+
+	t.Run("No validators root", func(t *testing.T) {
+		chainService := &mock.ChainService{Genesis: genesis}
+		s := &Server{
+			GenesisTimeFetcher: chainService,
+			ChainInfoFetcher:   chainService,
+		}
+		_, err := s.GetGenesis(ctx, &ptypes.Empty{})
+		assert.ErrorContains(t, "Chain genesis info is not yet known", err)
+	})
+}
+
+// setupStateChain saves a genesis block and state plus a chain of blocks with
+// matching states, returning the server configured with the last block as head.
+func setupStateChain(ctx context.Context, t *testing.T, count uint64) (*Server, []*ethpb_alpha.SignedBeaconBlock, [][32]byte) {
+	beaconDB := dbTest.SetupDB(t)
+	st, _ := testutil.DeterministicGenesisState(t, 32)
+
+	genBlk := testutil.NewBeaconBlock()
+	genRoot, err := genBlk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, genBlk))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genRoot))
+	require.NoError(t, beaconDB.SaveState(ctx, st, genRoot))
+
+	blks := []*ethpb_alpha.SignedBeaconBlock{genBlk}
+	roots := [][32]byte{genRoot}
+	prevState := st
+	for i := uint64(1); i <= count; i++ {
+		prevStateRoot, err := prevState.HashTreeRoot(ctx)
+		require.NoError(t, err)
+		blkState := prevState.Copy()
+		require.NoError(t, blkState.UpdateStateRootAtIndex((i-1)%params.BeaconConfig().SlotsPerHistoricalRoot, prevStateRoot))
+		require.NoError(t, blkState.SetSlot(i))
+		stateRoot, err := blkState.HashTreeRoot(ctx)
+		require.NoError(t, err)
+
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = i
+		parentRoot := roots[i-1]
+		b.Block.ParentRoot = parentRoot[:]
+		b.Block.StateRoot = stateRoot[:]
+		root, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, beaconDB.SaveBlock(ctx, b))
+		require.NoError(t, beaconDB.SaveState(ctx, blkState, root))
+		require.NoError(t, beaconDB.SaveStateSummary(ctx, &pbp2p.StateSummary{Slot: i, Root: root[:]}))
+
+		blks = append(blks, b)
+		roots = append(roots, root)
+		prevState = blkState
+	}
+	headRoot := roots[len(roots)-1]
+	require.NoError(t, beaconDB.SaveHeadBlockRoot(ctx, headRoot))
+	headState, err := beaconDB.State(ctx, headRoot)
+	require.NoError(t, err)
+
+	chainService := &mock.ChainService{
+		DB:                         beaconDB,
+		State:                      headState,
+		Root:                       headRoot[:],
+		Block:                      blks[len(blks)-1],
+		FinalizedCheckPoint:        &ethpb_alpha.Checkpoint{Root: roots[1][:]},
+		CurrentJustifiedCheckPoint: &ethpb_alpha.Checkpoint{Root: roots[2][:]},
+	}
+	s := &Server{
+		BeaconDB:           beaconDB,
+		ChainInfoFetcher:   chainService,
+		GenesisTimeFetcher: chainService,
+		StateGen:           stategen.New(beaconDB),
+	}
+	return s, blks, roots
+}
+
+func TestServer_GetStateRoot(t *testing.T) {
+	ctx := context.Background()
+	s, blks, _ := setupStateChain(ctx, t, 4)
+	genState, err := s.BeaconDB.GenesisState(ctx)
+	require.NoError(t, err)
+	genStateRoot, err := genState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		stateId []byte
+		want    []byte
+	}{
+		{
+			name:    "head",
+			stateId: []byte("head"),
+			want:    blks[4].Block.StateRoot,
+		},
+		{
+			name:    "genesis",
+			stateId: []byte("genesis"),
+			want:    genStateRoot[:],
+		},
+		{
+			name:    "finalized",
+			stateId: []byte("finalized"),
+			want:    blks[1].Block.StateRoot,
+		},
+		{
+			name:    "justified",
+			stateId: []byte("justified"),
+			want:    blks[2].Block.StateRoot,
+		},
+		{
+			name:    "slot",
+			stateId: []byte(strconv.Itoa(3)),
+			want:    blks[3].Block.StateRoot,
+		},
+		{
+			name:    "raw root",
+			stateId: blks[2].Block.StateRoot,
+			want:    blks[2].Block.StateRoot,
+		},
+		{
+			name:    "genesis root",
+			stateId: genStateRoot[:],
+			want:    genStateRoot[:],
+		},
+		{
+			name:    "hex root",
+			stateId: []byte(fmt.Sprintf("%#x", blks[3].Block.StateRoot)),
+			want:    blks[3].Block.StateRoot,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.GetStateRoot(ctx, &ethpb.StateRequest{StateId: tt.stateId})
+			require.NoError(t, err)
+			assert.DeepEqual(t, tt.want, resp.StateRoot)
+		})
+	}
+
+	t.Run("Unknown root", func(t *testing.T) {
+		root := [32]byte{'u', 'n', 'k', 'n', 'o', 'w', 'n'}
+		_, err := s.GetStateRoot(ctx, &ethpb.StateRequest{StateId: root[:]})
+		assert.ErrorContains(t, "Could not find requested state", err)
+	})
+	t.Run("Future slot", func(t *testing.T) {
+		_, err := s.GetStateRoot(ctx, &ethpb.StateRequest{StateId: []byte("100")})
+		assert.ErrorContains(t, "Could not find requested state", err)
+	})
+	t.Run("Invalid state ID", func(t *testing.T) {
+		_, err := s.GetStateRoot(ctx, &ethpb.StateRequest{StateId: []byte("foo")})
+		assert.ErrorContains(t, "invalid state ID", err)
+	})
+}
+
+func TestServer_GetStateFork(t *testing.T) {
+	ctx := context.Background()
+	s, _, _ := setupStateChain(ctx, t, 2)
+	headState, err := s.ChainInfoFetcher.HeadState(ctx)
+	require.NoError(t, err)
+	fork := &pbp2p.Fork{
+		PreviousVersion: []byte{0, 0, 0, 0},
+		CurrentVersion:  []byte{1, 0, 0, 0},
+		Epoch:           5,
+	}
+	require.NoError(t, headState.SetFork(fork))
+
+	resp, err := s.GetStateFork(ctx, &ethpb.StateRequest{StateId: []byte("head")})
+	require.NoError(t, err)
+	assert.DeepEqual(t, fork.PreviousVersion, resp.Fork.PreviousVersion)
+	assert.DeepEqual(t, fork.CurrentVersion, resp.Fork.CurrentVersion)
+	assert.Equal(t, fork.Epoch, resp.Fork.Epoch)
+}
+
+func TestServer_GetFinalityCheckpoints(t *testing.T) {
+	ctx := context.Background()
+	s, _, roots := setupStateChain(ctx, t, 2)
+	headState, err := s.ChainInfoFetcher.HeadState(ctx)
+	require.NoError(t, err)
+
+	resp, err := s.GetFinalityCheckpoints(ctx, &ethpb.StateRequest{StateId: []byte("head")})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), resp.Finalized.Epoch)
+	assert.DeepEqual(t, params.BeaconConfig().ZeroHash[:], resp.Finalized.Root)
+
+	require.NoError(t, headState.SetPreviousJustifiedCheckpoint(&ethpb_alpha.Checkpoint{Epoch: 1, Root: roots[0][:]}))
+	require.NoError(t, headState.SetCurrentJustifiedCheckpoint(&ethpb_alpha.Checkpoint{Epoch: 2, Root: roots[1][:]}))
+	require.NoError(t, headState.SetFinalizedCheckpoint(&ethpb_alpha.Checkpoint{Epoch: 1, Root: roots[0][:]}))
+	resp, err = s.GetFinalityCheckpoints(ctx, &ethpb.StateRequest{StateId: []byte("head")})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), resp.PreviousJustified.Epoch)
+	assert.DeepEqual(t, roots[0][:], resp.PreviousJustified.Root)
+	assert.Equal(t, uint64(2), resp.CurrentJustified.Epoch)
+	assert.DeepEqual(t, roots[1][:], resp.CurrentJustified.Root)
+	assert.Equal(t, uint64(1), resp.Finalized.Epoch)
+	assert.DeepEqual(t, roots[0][:], resp.Finalized.Root)
+}
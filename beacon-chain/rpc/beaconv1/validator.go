@@ -2,27 +2,326 @@ package beaconv1
 
 import (
 	"context"
-	"errors"
+	"encoding/hex"
+	"strconv"
+	"strings"
 
+	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// Validator statuses as defined by the standard beacon node API.
+const (
+	statusPendingInitialized = "pending_initialized"
+	statusPendingQueued      = "pending_queued"
+	statusActiveOngoing      = "active_ongoing"
+	statusActiveExiting      = "active_exiting"
+	statusActiveSlashed      = "active_slashed"
+	statusExitedUnslashed    = "exited_unslashed"
+	statusExitedSlashed      = "exited_slashed"
+	statusWithdrawalPossible = "withdrawal_possible"
+	statusWithdrawalDone     = "withdrawal_done"
+)
+
+// validatorStatuses lists every valid status filter, including the general
+// "pending", "active", "exited" and "withdrawal" groups.
+var validatorStatuses = map[string]bool{
+	statusPendingInitialized: true,
+	statusPendingQueued:      true,
+	statusActiveOngoing:      true,
+	statusActiveExiting:      true,
+	statusActiveSlashed:      true,
+	statusExitedUnslashed:    true,
+	statusExitedSlashed:      true,
+	statusWithdrawalPossible: true,
+	statusWithdrawalDone:     true,
+	"pending":                true,
+	"active":                 true,
+	"exited":                 true,
+	"withdrawal":             true,
+}
+
 // GetValidator returns a validator specified by state and id or public key along with status and balance.
 func (bs *Server) GetValidator(ctx context.Context, req *ethpb.StateValidatorRequest) (*ethpb.StateValidatorResponse, error) {
-	return nil, errors.New("unimplemented")
+	st, err := bs.requestedState(ctx, req.StateId)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.ValidatorId) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Validator ID is required")
+	}
+	idx, ok, err := validatorIndexFromID(st, req.ValidatorId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not decode validator ID: %v", err)
+	}
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Could not find requested validator")
+	}
+	container, err := validatorContainer(st, idx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get validator: %v", err)
+	}
+
+	return &ethpb.StateValidatorResponse{Data: container}, nil
 }
 
 // ListValidators returns filterable list of validators with their balance, status and index.
 func (bs *Server) ListValidators(ctx context.Context, req *ethpb.StateValidatorsRequest) (*ethpb.StateValidatorsResponse, error) {
-	return nil, errors.New("unimplemented")
+	st, err := bs.requestedState(ctx, req.StateId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Status != "" && !validatorStatuses[req.Status] {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid validator status %q", req.Status)
+	}
+
+	indices, err := validatorIndicesFromIDs(st, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not decode validator ID: %v", err)
+	}
+	containers := make([]*ethpb.ValidatorContainer, 0, len(indices))
+	for _, idx := range indices {
+		container, err := validatorContainer(st, idx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get validator: %v", err)
+		}
+		if !statusMatches(container.Status, req.Status) {
+			continue
+		}
+		containers = append(containers, container)
+	}
+
+	return &ethpb.StateValidatorsResponse{Data: containers}, nil
 }
 
 // ListValidatorBalances returns a filterable list of validator balances.
 func (bs *Server) ListValidatorBalances(ctx context.Context, req *ethpb.ValidatorBalancesRequest) (*ethpb.ValidatorBalancesResponse, error) {
-	return nil, errors.New("unimplemented")
+	st, err := bs.requestedState(ctx, req.StateId)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([][]byte, len(req.Id))
+	for i, id := range req.Id {
+		ids[i] = []byte(id)
+	}
+	indices, err := validatorIndicesFromIDs(st, ids)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not decode validator ID: %v", err)
+	}
+	balances := make([]*ethpb.ValidatorBalance, len(indices))
+	for i, idx := range indices {
+		balance, err := st.BalanceAtIndex(idx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get balance of validator %d: %v", idx, err)
+		}
+		balances[i] = &ethpb.ValidatorBalance{
+			Index:   idx,
+			Balance: balance,
+		}
+	}
+
+	return &ethpb.ValidatorBalancesResponse{Data: balances}, nil
 }
 
 // ListCommittees retrieves the committees for the given state at the given epoch.
+// Zero values of the epoch, slot and committee index filters are treated as unset. Without an
+// epoch, committees are retrieved at the epoch of the requested slot, or else at the current
+// epoch of the state.
 func (bs *Server) ListCommittees(ctx context.Context, req *ethpb.StateCommitteesRequest) (*ethpb.StateCommitteesResponse, error) {
-	return nil, errors.New("unimplemented")
+	st, err := bs.requestedState(ctx, req.StateId)
+	if err != nil {
+		return nil, err
+	}
+
+	stateEpoch := helpers.CurrentEpoch(st)
+	epoch := req.Epoch
+	if epoch == 0 {
+		if req.Slot != 0 {
+			epoch = helpers.SlotToEpoch(req.Slot)
+		} else {
+			epoch = stateEpoch
+		}
+	}
+	if epoch > stateEpoch+1 {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot retrieve committees more than one epoch ahead of state, state epoch %d, requesting %d",
+			stateEpoch,
+			epoch,
+		)
+	}
+	// The seed of an epoch is derived from the randao mix of an earlier epoch, which is
+	// overwritten in the state once it falls out of the historical vector.
+	cfg := params.BeaconConfig()
+	if epoch+cfg.EpochsPerHistoricalVector <= stateEpoch+cfg.MinSeedLookahead+1 {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot retrieve committees for epoch %d, its randao mix is no longer held by the state at epoch %d",
+			epoch,
+			stateEpoch,
+		)
+	}
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not get start slot of epoch %d: %v", epoch, err)
+	}
+	if req.Slot != 0 && helpers.SlotToEpoch(req.Slot) != epoch {
+		return nil, status.Errorf(codes.InvalidArgument, "Slot %d is not in epoch %d", req.Slot, epoch)
+	}
+
+	activeIndices, err := helpers.ActiveValidatorIndices(st, epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get active validator indices: %v", err)
+	}
+	seed, err := helpers.Seed(st, epoch, params.BeaconConfig().DomainBeaconAttester)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get attester seed: %v", err)
+	}
+	countAtSlot := helpers.SlotCommitteeCount(uint64(len(activeIndices)))
+
+	committees := make([]*ethpb.Committee, 0)
+	for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+		if req.Slot != 0 && slot != req.Slot {
+			continue
+		}
+		for committeeIndex := uint64(0); committeeIndex < countAtSlot; committeeIndex++ {
+			if req.Index != 0 && committeeIndex != req.Index {
+				continue
+			}
+			committee, err := helpers.BeaconCommittee(activeIndices, seed, slot, committeeIndex)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not compute committee for slot %d: %v", slot, err)
+			}
+			committees = append(committees, &ethpb.Committee{
+				Index:      committeeIndex,
+				Slot:       slot,
+				Validators: committee,
+			})
+		}
+	}
+
+	return &ethpb.StateCommitteesResponse{Data: committees}, nil
+}
+
+// validatorIndicesFromIDs resolves the given validator IDs into validator indices, skipping
+// IDs which do not match any validator. All validators are returned when no IDs are given.
+func validatorIndicesFromIDs(st *stateTrie.BeaconState, ids [][]byte) ([]uint64, error) {
+	if len(ids) == 0 {
+		indices := make([]uint64, st.NumValidators())
+		for i := range indices {
+			indices[i] = uint64(i)
+		}
+		return indices, nil
+	}
+	indices := make([]uint64, 0, len(ids))
+	for _, id := range ids {
+		idx, ok, err := validatorIndexFromID(st, id)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		indices = append(indices, idx)
+	}
+	return indices, nil
+}
+
+// validatorIndexFromID resolves a validator ID, which is either a decimal validator index or
+// a public key given as 48 raw bytes or as a 0x-prefixed hex string.
+func validatorIndexFromID(st *stateTrie.BeaconState, id []byte) (uint64, bool, error) {
+	pubkey := id
+	if strings.HasPrefix(string(id), "0x") {
+		decoded, err := hex.DecodeString(string(id[2:]))
+		if err != nil {
+			return 0, false, errors.Wrapf(err, "could not decode public key %s", id)
+		}
+		pubkey = decoded
+	} else if len(id) != params.BeaconConfig().BLSPubkeyLength {
+		idx, err := strconv.ParseUint(string(id), 10, 64)
+		if err != nil {
+			return 0, false, errors.Wrapf(err, "could not decode validator index %q", id)
+		}
+		return idx, idx < uint64(st.NumValidators()), nil
+	}
+	if len(pubkey) != params.BeaconConfig().BLSPubkeyLength {
+		return 0, false, errors.Errorf("public key has length %d, wanted %d", len(pubkey), params.BeaconConfig().BLSPubkeyLength)
+	}
+	idx, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubkey))
+	return idx, ok, nil
+}
+
+// validatorContainer builds the API representation of the validator at the given index.
+func validatorContainer(st *stateTrie.BeaconState, idx uint64) (*ethpb.ValidatorContainer, error) {
+	v, err := st.ValidatorAtIndexReadOnly(idx)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := st.BalanceAtIndex(idx)
+	if err != nil {
+		return nil, err
+	}
+	pubkey := v.PublicKey()
+	return &ethpb.ValidatorContainer{
+		Index:   idx,
+		Balance: balance,
+		Status:  validatorStatus(v, balance, helpers.CurrentEpoch(st)),
+		Validator: &ethpb.Validator{
+			PublicKey:                  pubkey[:],
+			WithdrawalCredentials:      v.WithdrawalCredentials(),
+			EffectiveBalance:           v.EffectiveBalance(),
+			Slashed:                    v.Slashed(),
+			ActivationEligibilityEpoch: v.ActivationEligibilityEpoch(),
+			ActivationEpoch:            v.ActivationEpoch(),
+			ExitEpoch:                  v.ExitEpoch(),
+			WithdrawableEpoch:          v.WithdrawableEpoch(),
+		},
+	}, nil
+}
+
+// validatorStatus returns the status of a validator at the given epoch, following the
+// status definitions of the standard beacon node API.
+func validatorStatus(v stateTrie.ReadOnlyValidator, balance, epoch uint64) string {
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+	switch {
+	case v.ActivationEpoch() > epoch:
+		if v.ActivationEligibilityEpoch() == farFutureEpoch {
+			return statusPendingInitialized
+		}
+		return statusPendingQueued
+	case epoch < v.ExitEpoch():
+		if v.Slashed() {
+			return statusActiveSlashed
+		}
+		if v.ExitEpoch() == farFutureEpoch {
+			return statusActiveOngoing
+		}
+		return statusActiveExiting
+	case epoch < v.WithdrawableEpoch():
+		if v.Slashed() {
+			return statusExitedSlashed
+		}
+		return statusExitedUnslashed
+	default:
+		if balance == 0 {
+			return statusWithdrawalDone
+		}
+		return statusWithdrawalPossible
+	}
+}
+
+// statusMatches returns whether a validator status satisfies the requested status filter,
+// which may either be an exact status or a general status group such as "active".
+func statusMatches(validatorStatus, filter string) bool {
+	if filter == "" || validatorStatus == filter {
+		return true
+	}
+	return strings.HasPrefix(validatorStatus, filter+"_")
 }
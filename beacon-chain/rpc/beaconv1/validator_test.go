@@ -0,0 +1,291 @@
+package beaconv1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	ethpb_alpha "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func headStateServer(st *stateTrie.BeaconState) *Server {
+	chainService := &mock.ChainService{State: st}
+	return &Server{
+		ChainInfoFetcher:   chainService,
+		GenesisTimeFetcher: chainService,
+	}
+}
+
+func TestServer_GetValidator(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 8)
+	s := headStateServer(st)
+	val, err := st.ValidatorAtIndex(3)
+	require.NoError(t, err)
+
+	t.Run("By index", func(t *testing.T) {
+		resp, err := s.GetValidator(ctx, &ethpb.StateValidatorRequest{StateId: []byte("head"), ValidatorId: []byte("3")})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(3), resp.Data.Index)
+		assert.Equal(t, statusActiveOngoing, resp.Data.Status)
+		assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance, resp.Data.Balance)
+		assert.DeepEqual(t, val.PublicKey, resp.Data.Validator.PublicKey)
+	})
+	t.Run("By raw pubkey", func(t *testing.T) {
+		resp, err := s.GetValidator(ctx, &ethpb.StateValidatorRequest{StateId: []byte("head"), ValidatorId: val.PublicKey})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(3), resp.Data.Index)
+	})
+	t.Run("By hex pubkey", func(t *testing.T) {
+		id := []byte(fmt.Sprintf("%#x", val.PublicKey))
+		resp, err := s.GetValidator(ctx, &ethpb.StateValidatorRequest{StateId: []byte("head"), ValidatorId: id})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(3), resp.Data.Index)
+	})
+	t.Run("Unknown index", func(t *testing.T) {
+		_, err := s.GetValidator(ctx, &ethpb.StateValidatorRequest{StateId: []byte("head"), ValidatorId: []byte("100")})
+		assert.ErrorContains(t, "Could not find requested validator", err)
+	})
+	t.Run("Invalid ID", func(t *testing.T) {
+		_, err := s.GetValidator(ctx, &ethpb.StateValidatorRequest{StateId: []byte("head"), ValidatorId: []byte("foo")})
+		assert.ErrorContains(t, "Could not decode validator ID", err)
+	})
+}
+
+func TestServer_ListValidators(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 8)
+	vals := st.Validators()
+	vals[1].Slashed = true
+	vals[1].ExitEpoch = 10
+	vals[2].ActivationEpoch = params.BeaconConfig().FarFutureEpoch
+	vals[2].ActivationEligibilityEpoch = params.BeaconConfig().FarFutureEpoch
+	require.NoError(t, st.SetValidators(vals))
+	s := headStateServer(st)
+
+	resp, err := s.ListValidators(ctx, &ethpb.StateValidatorsRequest{StateId: []byte("head")})
+	require.NoError(t, err)
+	require.Equal(t, 8, len(resp.Data))
+	for i, container := range resp.Data {
+		assert.Equal(t, uint64(i), container.Index)
+	}
+
+	resp, err = s.ListValidators(ctx, &ethpb.StateValidatorsRequest{
+		StateId: []byte("head"),
+		Id:      [][]byte{[]byte("0"), vals[4].PublicKey, []byte("100")},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Data))
+	assert.Equal(t, uint64(0), resp.Data[0].Index)
+	assert.Equal(t, uint64(4), resp.Data[1].Index)
+
+	resp, err = s.ListValidators(ctx, &ethpb.StateValidatorsRequest{StateId: []byte("head"), Status: statusActiveSlashed})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Data))
+	assert.Equal(t, uint64(1), resp.Data[0].Index)
+
+	resp, err = s.ListValidators(ctx, &ethpb.StateValidatorsRequest{StateId: []byte("head"), Status: "active"})
+	require.NoError(t, err)
+	assert.Equal(t, 7, len(resp.Data))
+
+	resp, err = s.ListValidators(ctx, &ethpb.StateValidatorsRequest{StateId: []byte("head"), Status: statusPendingInitialized})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Data))
+	assert.Equal(t, uint64(2), resp.Data[0].Index)
+
+	_, err = s.ListValidators(ctx, &ethpb.StateValidatorsRequest{StateId: []byte("head"), Status: "foo"})
+	assert.ErrorContains(t, "Invalid validator status", err)
+}
+
+func TestServer_ListValidatorBalances(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 4)
+	balances := []uint64{1, 2, 3, 4}
+	require.NoError(t, st.SetBalances(balances))
+	s := headStateServer(st)
+
+	resp, err := s.ListValidatorBalances(ctx, &ethpb.ValidatorBalancesRequest{StateId: []byte("head")})
+	require.NoError(t, err)
+	require.Equal(t, 4, len(resp.Data))
+	for i, balance := range resp.Data {
+		assert.Equal(t, uint64(i), balance.Index)
+		assert.Equal(t, balances[i], balance.Balance)
+	}
+
+	pubkey := st.PubkeyAtIndex(2)
+	resp, err = s.ListValidatorBalances(ctx, &ethpb.ValidatorBalancesRequest{
+		StateId: []byte("head"),
+		Id:      []string{"1", fmt.Sprintf("%#x", pubkey)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Data))
+	assert.Equal(t, uint64(2), resp.Data[0].Balance)
+	assert.Equal(t, uint64(3), resp.Data[1].Balance)
+}
+
+func TestServer_ListCommittees(t *testing.T) {
+	ctx := context.Background()
+	helpers.ClearCache()
+	st, _ := testutil.DeterministicGenesisState(t, 128)
+	s := headStateServer(st)
+
+	resp, err := s.ListCommittees(ctx, &ethpb.StateCommitteesRequest{StateId: []byte("head")})
+	require.NoError(t, err)
+	assert.Equal(t, int(params.BeaconConfig().SlotsPerEpoch), len(resp.Data))
+	seen := make(map[uint64]bool)
+	for _, committee := range resp.Data {
+		assert.Equal(t, uint64(0), committee.Index)
+		wanted, err := helpers.BeaconCommitteeFromState(st, committee.Slot, committee.Index)
+		require.NoError(t, err)
+		assert.DeepEqual(t, wanted, committee.Validators)
+		for _, idx := range committee.Validators {
+			seen[idx] = true
+		}
+	}
+	assert.Equal(t, 128, len(seen))
+
+	resp, err = s.ListCommittees(ctx, &ethpb.StateCommitteesRequest{StateId: []byte("head"), Slot: 5})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Data))
+	assert.Equal(t, uint64(5), resp.Data[0].Slot)
+
+	// Without an epoch, the epoch of the requested slot is used.
+	nextEpochSlot := params.BeaconConfig().SlotsPerEpoch + 1
+	resp, err = s.ListCommittees(ctx, &ethpb.StateCommitteesRequest{StateId: []byte("head"), Slot: nextEpochSlot})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Data))
+	assert.Equal(t, nextEpochSlot, resp.Data[0].Slot)
+	wanted, err := helpers.BeaconCommitteeFromState(st, nextEpochSlot, 0)
+	require.NoError(t, err)
+	assert.DeepEqual(t, wanted, resp.Data[0].Validators)
+
+	_, err = s.ListCommittees(ctx, &ethpb.StateCommitteesRequest{StateId: []byte("head"), Epoch: 1, Slot: 5})
+	assert.ErrorContains(t, "Slot 5 is not in epoch 1", err)
+
+	_, err = s.ListCommittees(ctx, &ethpb.StateCommitteesRequest{StateId: []byte("head"), Epoch: 2})
+	assert.ErrorContains(t, "Cannot retrieve committees more than one epoch ahead", err)
+
+	require.NoError(t, st.SetSlot(params.BeaconConfig().EpochsPerHistoricalVector*params.BeaconConfig().SlotsPerEpoch))
+	_, err = s.ListCommittees(ctx, &ethpb.StateCommitteesRequest{StateId: []byte("head"), Epoch: 1})
+	assert.ErrorContains(t, "its randao mix is no longer held by the state", err)
+
+	// Without an epoch or a slot, the current epoch of the state is used.
+	resp, err = s.ListCommittees(ctx, &ethpb.StateCommitteesRequest{StateId: []byte("head")})
+	require.NoError(t, err)
+	require.Equal(t, int(params.BeaconConfig().SlotsPerEpoch), len(resp.Data))
+	assert.Equal(t, st.Slot(), resp.Data[0].Slot)
+}
+
+func TestValidatorStatus(t *testing.T) {
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	tests := []struct {
+		name      string
+		validator *ethpb_alpha.Validator
+		balance   uint64
+		want      string
+	}{
+		{
+			name: "pending initialized",
+			validator: &ethpb_alpha.Validator{
+				ActivationEligibilityEpoch: farFuture,
+				ActivationEpoch:            farFuture,
+				ExitEpoch:                  farFuture,
+				WithdrawableEpoch:          farFuture,
+			},
+			want: statusPendingInitialized,
+		},
+		{
+			name: "pending queued",
+			validator: &ethpb_alpha.Validator{
+				ActivationEligibilityEpoch: 2,
+				ActivationEpoch:            10,
+				ExitEpoch:                  farFuture,
+				WithdrawableEpoch:          farFuture,
+			},
+			want: statusPendingQueued,
+		},
+		{
+			name: "active ongoing",
+			validator: &ethpb_alpha.Validator{
+				ActivationEpoch:   3,
+				ExitEpoch:         farFuture,
+				WithdrawableEpoch: farFuture,
+			},
+			want: statusActiveOngoing,
+		},
+		{
+			name: "active exiting",
+			validator: &ethpb_alpha.Validator{
+				ActivationEpoch:   3,
+				ExitEpoch:         6,
+				WithdrawableEpoch: 10,
+			},
+			want: statusActiveExiting,
+		},
+		{
+			name: "active slashed",
+			validator: &ethpb_alpha.Validator{
+				ActivationEpoch:   3,
+				ExitEpoch:         6,
+				WithdrawableEpoch: 10,
+				Slashed:           true,
+			},
+			want: statusActiveSlashed,
+		},
+		{
+			name: "exited unslashed",
+			validator: &ethpb_alpha.Validator{
+				ActivationEpoch:   1,
+				ExitEpoch:         3,
+				WithdrawableEpoch: 10,
+			},
+			want: statusExitedUnslashed,
+		},
+		{
+			name: "exited slashed",
+			validator: &ethpb_alpha.Validator{
+				ActivationEpoch:   1,
+				ExitEpoch:         3,
+				WithdrawableEpoch: 10,
+				Slashed:           true,
+			},
+			want: statusExitedSlashed,
+		},
+		{
+			name: "withdrawal possible",
+			validator: &ethpb_alpha.Validator{
+				ActivationEpoch:   1,
+				ExitEpoch:         2,
+				WithdrawableEpoch: 3,
+			},
+			balance: 1,
+			want:    statusWithdrawalPossible,
+		},
+		{
+			name: "withdrawal done",
+			validator: &ethpb_alpha.Validator{
+				ActivationEpoch:   1,
+				ExitEpoch:         2,
+				WithdrawableEpoch: 3,
+			},
+			want: statusWithdrawalDone,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := testutil.NewBeaconState()
+			require.NoError(t, st.SetValidators([]*ethpb_alpha.Validator{tt.validator}))
+			v, err := st.ValidatorAtIndexReadOnly(0)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, validatorStatus(v, tt.balance, 5))
+		})
+	}
+}
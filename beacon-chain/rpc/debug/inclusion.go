@@ -0,0 +1,221 @@
+package debug
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxInclusionEpochs is the maximum number of epochs which can be analyzed in a single request,
+// as every epoch requires replaying a state.
+const maxInclusionEpochs = 32
+
+// ListAttestationInclusions reports, for every requested validator and epoch, whether and how the
+// attestation of the validator was included in the canonical chain: the inclusion slot and delay,
+// the correctness of its votes and the block and aggregate which carried it.
+//
+// The attestations of an epoch are pending attestations of the previous epoch in the state at the
+// last slot of the next epoch, which is replayed from the database and fed to the epoch precompute.
+func (ds *Server) ListAttestationInclusions(
+	ctx context.Context,
+	req *pbrpc.AttestationInclusionsRequest,
+) (*pbrpc.AttestationInclusionsResponse, error) {
+	if req.StartEpoch > req.EndEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Start epoch %d cannot be greater than end epoch %d",
+			req.StartEpoch,
+			req.EndEpoch,
+		)
+	}
+	if req.EndEpoch-req.StartEpoch >= maxInclusionEpochs {
+		return nil, status.Errorf(codes.InvalidArgument, "Cannot analyze more than %d epochs at once", maxInclusionEpochs)
+	}
+	// Attestations have until the end of the next epoch to be included.
+	currentEpoch := helpers.SlotToEpoch(ds.GenesisTimeFetcher.CurrentSlot())
+	if req.EndEpoch+1 >= currentEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Attestations of epoch %d can still be included, current epoch %d",
+			req.EndEpoch,
+			currentEpoch,
+		)
+	}
+	if len(req.Indices) == 0 && len(req.PublicKeys) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Need to specify at least one validator index or public key")
+	}
+
+	inclusions := make([]*pbrpc.AttestationInclusion, 0)
+	for epoch := req.StartEpoch; epoch <= req.EndEpoch; epoch++ {
+		epochInclusions, err := ds.epochInclusions(ctx, epoch, req.Indices, req.PublicKeys)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not compute inclusions of epoch %d: %v", epoch, err)
+		}
+		inclusions = append(inclusions, epochInclusions...)
+	}
+	return &pbrpc.AttestationInclusionsResponse{Inclusions: inclusions}, nil
+}
+
+// epochInclusions computes the inclusions of the requested validators for the attestations of an epoch.
+// Validators which are not in the registry yet are left out.
+func (ds *Server) epochInclusions(
+	ctx context.Context,
+	epoch uint64,
+	indices []uint64,
+	pubKeys [][]byte,
+) ([]*pbrpc.AttestationInclusion, error) {
+	nextEpochStart, err := helpers.StartSlot(epoch + 2)
+	if err != nil {
+		return nil, err
+	}
+	st, err := ds.StateGen.StateBySlot(ctx, nextEpochStart-1)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not replay state")
+	}
+	vp, bp, err := precompute.New(ctx, st)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not set up pre compute instance")
+	}
+	vp, _, err = precompute.ProcessAttestations(ctx, st, vp, bp)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not pre compute attestations")
+	}
+
+	validatorIndices := make(map[uint64]bool)
+	for _, index := range indices {
+		if index < uint64(len(vp)) {
+			validatorIndices[index] = true
+		}
+	}
+	for _, pubKey := range pubKeys {
+		if index, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubKey)); ok {
+			validatorIndices[index] = true
+		}
+	}
+	sortedIndices := make([]uint64, 0, len(validatorIndices))
+	for index := range validatorIndices {
+		sortedIndices = append(sortedIndices, index)
+	}
+	sort.Slice(sortedIndices, func(i, j int) bool {
+		return sortedIndices[i] < sortedIndices[j]
+	})
+
+	blocks := make(map[uint64]*ethpb.SignedBeaconBlock)
+	inclusions := make([]*pbrpc.AttestationInclusion, 0, len(sortedIndices))
+	for _, index := range sortedIndices {
+		v := vp[index]
+		pubKey := st.PubkeyAtIndex(index)
+		inclusion := &pbrpc.AttestationInclusion{
+			Epoch:          epoch,
+			ValidatorIndex: index,
+			PublicKey:      pubKey[:],
+			Active:         v.IsActivePrevEpoch,
+			Included:       v.IsPrevEpochAttester,
+			CorrectSource:  v.IsPrevEpochAttester,
+			CorrectTarget:  v.IsPrevEpochTargetAttester,
+			CorrectHead:    v.IsPrevEpochHeadAttester,
+		}
+		if v.IsPrevEpochAttester {
+			inclusion.AttestationSlot = v.InclusionSlot - v.InclusionDistance
+			inclusion.InclusionSlot = v.InclusionSlot
+			inclusion.InclusionDelay = v.InclusionDistance
+			inclusion.ProposerIndex = v.ProposerIndex
+			blk, ok := blocks[v.InclusionSlot]
+			if !ok {
+				blk, err = ds.canonicalBlockAtSlot(ctx, st, v.InclusionSlot)
+				if err != nil {
+					return nil, err
+				}
+				blocks[v.InclusionSlot] = blk
+			}
+			if err := carryingAggregate(st, blk, index, inclusion); err != nil {
+				return nil, err
+			}
+		}
+		inclusions = append(inclusions, inclusion)
+	}
+	return inclusions, nil
+}
+
+// canonicalBlockAtSlot retrieves the block at the given slot of the chain the state was built on.
+func (ds *Server) canonicalBlockAtSlot(
+	ctx context.Context,
+	st *stateTrie.BeaconState,
+	slot uint64,
+) (*ethpb.SignedBeaconBlock, error) {
+	var root []byte
+	var err error
+	if slot < st.Slot() {
+		root, err = helpers.BlockRootAtSlot(st, slot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get block root at slot %d", slot)
+		}
+	} else {
+		// The block of the state slot is the latest block header, whose state root is only
+		// filled in when processing the next slot.
+		header := stateTrie.CopyBeaconBlockHeader(st.LatestBlockHeader())
+		if header.Slot != slot {
+			return nil, errors.Errorf("no block at slot %d", slot)
+		}
+		stateRoot, err := st.HashTreeRoot(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute state root")
+		}
+		header.StateRoot = stateRoot[:]
+		headerRoot, err := header.HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute block root")
+		}
+		root = headerRoot[:]
+	}
+	blk, err := ds.BeaconDB.Block(ctx, bytesutil.ToBytes32(root))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get block at slot %d", slot)
+	}
+	if blk == nil || blk.Block == nil || blk.Block.Body == nil {
+		return nil, errors.Errorf("block at slot %d not found", slot)
+	}
+	return blk, nil
+}
+
+// carryingAggregate sets the root of the block and the aggregate of the block which carried the
+// attestation of the validator at the inclusion's attestation slot.
+func carryingAggregate(
+	st *stateTrie.BeaconState,
+	blk *ethpb.SignedBeaconBlock,
+	validatorIndex uint64,
+	inclusion *pbrpc.AttestationInclusion,
+) error {
+	blockRoot, err := blk.Block.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not compute block root")
+	}
+	inclusion.BlockRoot = blockRoot[:]
+	for i, att := range blk.Block.Body.Attestations {
+		if att.Data.Slot != inclusion.AttestationSlot {
+			continue
+		}
+		committee, err := helpers.BeaconCommitteeFromState(st, att.Data.Slot, att.Data.CommitteeIndex)
+		if err != nil {
+			return errors.Wrap(err, "could not get committee")
+		}
+		for _, attester := range attestationutil.AttestingIndices(att.AggregationBits, committee) {
+			if attester == validatorIndex {
+				inclusion.AggregateIndex = uint64(i)
+				inclusion.AggregationBits = att.AggregationBits
+				return nil
+			}
+		}
+	}
+	return errors.Errorf("no attestation of validator %d in block at slot %d", validatorIndex, blk.Block.Slot)
+}
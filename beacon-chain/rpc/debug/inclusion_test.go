@@ -0,0 +1,129 @@
+package debug
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_ListAttestationInclusions(t *testing.T) {
+	helpers.ClearCache()
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	genesisState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	genesisStateRoot, err := genesisState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis := blocks.NewGenesisBlock(genesisStateRoot[:])
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, genesisState, genesisRoot))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+
+	// Build the first two epochs, skipping the block which would include the attestations of slot 2.
+	const skippedSlot = 3
+	blockRoots := make(map[uint64][32]byte)
+	st := genesisState.Copy()
+	for slot := uint64(1); slot < 2*params.BeaconConfig().SlotsPerEpoch; slot++ {
+		if slot == skippedSlot {
+			continue
+		}
+		conf := testutil.DefaultBlockGenConfig()
+		if slot%params.BeaconConfig().SlotsPerEpoch == 0 {
+			// Attestations of the previous slot cannot be generated across the epoch boundary.
+			conf.NumAttestations = 0
+		}
+		blk, err := testutil.GenerateFullBlock(st, privKeys, conf, slot)
+		require.NoError(t, err)
+		st, err = state.ExecuteStateTransition(ctx, st, blk)
+		require.NoError(t, err)
+		require.NoError(t, db.SaveBlock(ctx, blk))
+		blockRoots[slot], err = blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+	}
+
+	ds := &Server{
+		BeaconDB: db,
+		StateGen: stategen.New(db),
+		GenesisTimeFetcher: &mock.ChainService{Genesis: time.Now().Add(time.Duration(-1*int64(
+			3*params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot)) * time.Second)},
+	}
+	committee, err := helpers.BeaconCommitteeFromState(genesisState, 0, 0)
+	require.NoError(t, err)
+	missedCommittee, err := helpers.BeaconCommitteeFromState(genesisState, skippedSlot-1, 0)
+	require.NoError(t, err)
+	pubKey := genesisState.PubkeyAtIndex(missedCommittee[0])
+
+	res, err := ds.ListAttestationInclusions(ctx, &pbrpc.AttestationInclusionsRequest{
+		StartEpoch: 0,
+		EndEpoch:   0,
+		Indices:    []uint64{committee[0]},
+		PublicKeys: [][]byte{pubKey[:]},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Inclusions))
+
+	inclusions := make(map[uint64]*pbrpc.AttestationInclusion)
+	for _, inclusion := range res.Inclusions {
+		inclusions[inclusion.ValidatorIndex] = inclusion
+	}
+	included := inclusions[committee[0]]
+	require.NotNil(t, included)
+	assert.Equal(t, true, included.Active)
+	assert.Equal(t, true, included.Included)
+	assert.Equal(t, uint64(0), included.AttestationSlot)
+	assert.Equal(t, uint64(1), included.InclusionSlot)
+	assert.Equal(t, uint64(1), included.InclusionDelay)
+	assert.Equal(t, true, included.CorrectSource)
+	assert.Equal(t, true, included.CorrectTarget)
+	assert.Equal(t, true, included.CorrectHead)
+	wantedRoot := blockRoots[1]
+	assert.DeepEqual(t, wantedRoot[:], included.BlockRoot)
+	assert.Equal(t, uint64(0), included.AggregateIndex)
+	assert.NotEqual(t, 0, len(included.AggregationBits))
+
+	missed := inclusions[missedCommittee[0]]
+	require.NotNil(t, missed)
+	assert.DeepEqual(t, pubKey[:], missed.PublicKey)
+	assert.Equal(t, true, missed.Active)
+	assert.Equal(t, false, missed.Included)
+	assert.Equal(t, 0, len(missed.BlockRoot))
+
+	// The block at the slot of the replayed state is only referenced by the latest block header.
+	lastSlot := st.Slot()
+	blk, err := ds.canonicalBlockAtSlot(ctx, st, lastSlot)
+	require.NoError(t, err)
+	lastRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, blockRoots[lastSlot], lastRoot)
+}
+
+func TestServer_ListAttestationInclusions_InvalidRequest(t *testing.T) {
+	ctx := context.Background()
+	ds := &Server{
+		GenesisTimeFetcher: &mock.ChainService{Genesis: time.Now().Add(time.Duration(-1*int64(
+			3*params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot)) * time.Second)},
+	}
+
+	_, err := ds.ListAttestationInclusions(ctx, &pbrpc.AttestationInclusionsRequest{StartEpoch: 1, EndEpoch: 0, Indices: []uint64{0}})
+	assert.ErrorContains(t, "cannot be greater than end epoch", err)
+	_, err = ds.ListAttestationInclusions(ctx, &pbrpc.AttestationInclusionsRequest{StartEpoch: 0, EndEpoch: 100, Indices: []uint64{0}})
+	assert.ErrorContains(t, "Cannot analyze more than", err)
+	_, err = ds.ListAttestationInclusions(ctx, &pbrpc.AttestationInclusionsRequest{StartEpoch: 2, EndEpoch: 2, Indices: []uint64{0}})
+	assert.ErrorContains(t, "can still be included", err)
+	_, err = ds.ListAttestationInclusions(ctx, &pbrpc.AttestationInclusionsRequest{StartEpoch: 0, EndEpoch: 0})
+	assert.ErrorContains(t, "Need to specify at least one validator", err)
+}
@@ -6,8 +6,10 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -36,6 +38,58 @@ func (ds *Server) ListPeers(_ context.Context, _ *types.Empty) (*pbrpc.DebugPeer
 	return &pbrpc.DebugPeerResponses{Responses: responses}, nil
 }
 
+// AddTrustedPeer marks the peer of the provided multiaddress as trusted and connects to it.
+// Trusted peers are kept connected regardless of the peer limits and their score.
+func (ds *Server) AddTrustedPeer(ctx context.Context, req *pbrpc.AddTrustedPeerRequest) (*types.Empty, error) {
+	info, err := p2p.MakePeer(req.Addr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided multiaddress: %v", err)
+	}
+	var address multiaddr.Multiaddr
+	if len(info.Addrs) > 0 {
+		address = info.Addrs[0]
+	}
+	ds.PeersFetcher.Peers().AddTrusted(info.ID, address)
+	go func() {
+		// The connection is retried periodically by the p2p service if it fails.
+		if err := ds.PeerManager.Host().Connect(context.Background(), *info); err != nil {
+			log.WithError(err).WithField("peer", info.ID).Debug("Could not connect to trusted peer")
+		}
+	}()
+	return &types.Empty{}, nil
+}
+
+// RemoveTrustedPeer removes the trusted mark of the peer defined by the provided peer id and
+// disconnects from it.
+func (ds *Server) RemoveTrustedPeer(_ context.Context, peerReq *ethpb.PeerRequest) (*types.Empty, error) {
+	pid, err := peer.Decode(peerReq.PeerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided peer id: %v", err)
+	}
+	if !ds.PeersFetcher.Peers().IsTrusted(pid) {
+		return nil, status.Errorf(codes.NotFound, "Peer %s is not trusted", pid)
+	}
+	ds.PeersFetcher.Peers().RemoveTrusted(pid)
+	if err := ds.PeerManager.Disconnect(pid); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not disconnect from peer: %v", err)
+	}
+	return &types.Empty{}, nil
+}
+
+// BanPeer bans the peer defined by the provided peer id and disconnects from it. The ban is
+// lifted when the peer is trusted again.
+func (ds *Server) BanPeer(_ context.Context, peerReq *ethpb.PeerRequest) (*types.Empty, error) {
+	pid, err := peer.Decode(peerReq.PeerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided peer id: %v", err)
+	}
+	ds.PeersFetcher.Peers().Ban(pid)
+	if err := ds.PeerManager.Disconnect(pid); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not disconnect from peer: %v", err)
+	}
+	return &types.Empty{}, nil
+}
+
 func (ds *Server) getPeer(pid peer.ID) (*pbrpc.DebugPeerResponse, error) {
 	peers := ds.PeersFetcher.Peers()
 	peerStore := ds.PeerManager.Host().Peerstore()
@@ -124,6 +178,10 @@ func (ds *Server) getPeer(pid peer.ID) (*pbrpc.DebugPeerResponse, error) {
 	if !lastUpdated.IsZero() {
 		unixTime = uint64(lastUpdated.Unix())
 	}
+	gossipScore, err := peers.GossipScore(pid)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Requested peer does not exist: %v", err)
+	}
 	return &pbrpc.DebugPeerResponse{
 		ListeningAddresses: stringAddrs,
 		Direction:          pbDirection,
@@ -133,5 +191,30 @@ func (ds *Server) getPeer(pid peer.ID) (*pbrpc.DebugPeerResponse, error) {
 		PeerInfo:           peerInfo,
 		PeerStatus:         pStatus,
 		LastUpdated:        unixTime,
+		Trusted:            peers.IsTrusted(pid),
+		Banned:             peers.IsBanned(pid),
+		GossipScore:        gossipScoreProto(gossipScore),
 	}, nil
 }
+
+func gossipScoreProto(score *peerdata.GossipScore) *pbrpc.GossipScore {
+	if score == nil {
+		return nil
+	}
+	topicScores := make(map[string]*pbrpc.TopicScoreSnapshot, len(score.TopicScores))
+	for topic, topicScore := range score.TopicScores {
+		topicScores[topic] = &pbrpc.TopicScoreSnapshot{
+			TimeInMesh:               uint64(topicScore.TimeInMesh.Milliseconds()),
+			FirstMessageDeliveries:   topicScore.FirstMessageDeliveries,
+			MeshMessageDeliveries:    topicScore.MeshMessageDeliveries,
+			InvalidMessageDeliveries: topicScore.InvalidMessageDeliveries,
+		}
+	}
+	return &pbrpc.GossipScore{
+		Score:              score.Score,
+		TopicScores:        topicScores,
+		AppSpecificScore:   score.AppSpecificScore,
+		IpColocationFactor: score.IPColocationFactor,
+		BehaviourPenalty:   score.BehaviourPenalty,
+	}
+}
@@ -3,10 +3,13 @@ package debug
 import (
 	"context"
 	"testing"
+	"time"
 
 	ptypes "github.com/gogo/protobuf/types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
 	mockP2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
@@ -49,3 +52,87 @@ func TestDebugServer_ListPeers(t *testing.T) {
 		t.Errorf("Expected 2nd peer to have a multiaddress, instead they have no addresses")
 	}
 }
+
+func TestDebugServer_ListPeers_GossipScore(t *testing.T) {
+	peersProvider := &mockP2p.MockPeersProvider{}
+	mP2P := mockP2p.NewTestP2P(t)
+	ds := &Server{
+		PeersFetcher: peersProvider,
+		PeerManager:  &mockP2p.MockPeerManager{BHost: mP2P.BHost},
+	}
+	topic := "/eth2/00000000/beacon_block/ssz_snappy"
+	scoredPeer := peersProvider.Peers().All()[0]
+	peersProvider.Peers().SetGossipScore(scoredPeer, &peerdata.GossipScore{
+		Score: -5,
+		TopicScores: map[string]*peerdata.TopicScore{
+			topic: {TimeInMesh: time.Second, InvalidMessageDeliveries: 2},
+		},
+		BehaviourPenalty: 1,
+	})
+	score := &pbrpc.GossipScore{
+		Score: -5,
+		TopicScores: map[string]*pbrpc.TopicScoreSnapshot{
+			topic: {TimeInMesh: 1000, InvalidMessageDeliveries: 2},
+		},
+		BehaviourPenalty: 1,
+	}
+
+	res, err := ds.ListPeers(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Responses))
+	for _, resp := range res.Responses {
+		if resp.PeerId == scoredPeer.String() {
+			assert.DeepEqual(t, score, resp.GossipScore)
+		} else {
+			assert.Equal(t, (*pbrpc.GossipScore)(nil), resp.GossipScore, "Expected peer not to be scored")
+		}
+	}
+}
+
+func TestDebugServer_TrustedPeers(t *testing.T) {
+	peersProvider := &mockP2p.MockPeersProvider{}
+	mP2P := mockP2p.NewTestP2P(t)
+	ds := &Server{
+		PeersFetcher: peersProvider,
+		PeerManager:  &mockP2p.MockPeerManager{BHost: mP2P.BHost},
+	}
+	firstPeer := peersProvider.Peers().All()[0]
+
+	_, err := ds.AddTrustedPeer(context.Background(), &pbrpc.AddTrustedPeerRequest{
+		Addr: "/ip4/127.0.0.1/tcp/1/p2p/" + firstPeer.String(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, true, peersProvider.Peers().IsTrusted(firstPeer))
+	res, err := ds.GetPeer(context.Background(), &ethpb.PeerRequest{PeerId: firstPeer.String()})
+	require.NoError(t, err)
+	assert.Equal(t, true, res.Trusted)
+
+	_, err = ds.RemoveTrustedPeer(context.Background(), &ethpb.PeerRequest{PeerId: firstPeer.String()})
+	require.NoError(t, err)
+	assert.Equal(t, false, peersProvider.Peers().IsTrusted(firstPeer))
+	_, err = ds.RemoveTrustedPeer(context.Background(), &ethpb.PeerRequest{PeerId: firstPeer.String()})
+	assert.ErrorContains(t, "is not trusted", err)
+
+	_, err = ds.AddTrustedPeer(context.Background(), &pbrpc.AddTrustedPeerRequest{Addr: "/ip4/127.0.0.1/tcp/1"})
+	assert.ErrorContains(t, "Unable to parse provided multiaddress", err)
+}
+
+func TestDebugServer_BanPeer(t *testing.T) {
+	peersProvider := &mockP2p.MockPeersProvider{}
+	mP2P := mockP2p.NewTestP2P(t)
+	ds := &Server{
+		PeersFetcher: peersProvider,
+		PeerManager:  &mockP2p.MockPeerManager{BHost: mP2P.BHost},
+	}
+	firstPeer := peersProvider.Peers().All()[0]
+
+	_, err := ds.BanPeer(context.Background(), &ethpb.PeerRequest{PeerId: firstPeer.String()})
+	require.NoError(t, err)
+	assert.Equal(t, true, peersProvider.Peers().IsBad(firstPeer))
+	res, err := ds.GetPeer(context.Background(), &ethpb.PeerRequest{PeerId: firstPeer.String()})
+	require.NoError(t, err)
+	assert.Equal(t, true, res.Banned)
+
+	_, err = ds.BanPeer(context.Background(), &ethpb.PeerRequest{PeerId: "foo"})
+	assert.ErrorContains(t, "Unable to parse provided peer id", err)
+}
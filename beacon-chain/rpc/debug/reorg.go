@@ -0,0 +1,46 @@
+package debug
+
+import (
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamChainReorgs sends every chain reorg observed by the beacon node over a stream,
+// including the replaced and new heads as well as their common ancestor.
+func (ds *Server) StreamChainReorgs(_ *ptypes.Empty, stream pbrpc.Debug_StreamChainReorgsServer) error {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := ds.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type != statefeed.Reorg {
+				continue
+			}
+			data, ok := event.Data.(*statefeed.ReorgData)
+			if !ok {
+				continue
+			}
+			res := &pbrpc.ChainReorg{
+				OldHeadSlot:        data.OldSlot,
+				OldHeadRoot:        data.OldHeadRoot[:],
+				NewHeadSlot:        data.NewSlot,
+				NewHeadRoot:        data.NewHeadRoot[:],
+				CommonAncestorSlot: data.CommonAncestorSlot,
+				CommonAncestorRoot: data.CommonAncestorRoot[:],
+				Depth:              data.Depth,
+			}
+			if err := stream.Send(res); err != nil {
+				return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+			}
+		case <-stateSub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+type reorgStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *pbrpc.ChainReorg
+}
+
+func (s *reorgStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *reorgStream) Send(r *pbrpc.ChainReorg) error {
+	s.sent <- r
+	return nil
+}
+
+func TestServer_StreamChainReorgs_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	chainService := &mock.ChainService{}
+	ds := &Server{StateNotifier: chainService.StateNotifier()}
+
+	cancel()
+	stream := &reorgStream{ctx: ctx, sent: make(chan *pbrpc.ChainReorg, 1)}
+	assert.ErrorContains(t, "Context canceled", ds.StreamChainReorgs(&ptypes.Empty{}, stream))
+}
+
+func TestServer_StreamChainReorgs_OnReorg(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	chainService := &mock.ChainService{}
+	ds := &Server{StateNotifier: chainService.StateNotifier()}
+	stream := &reorgStream{ctx: ctx, sent: make(chan *pbrpc.ChainReorg, 1)}
+
+	exitRoutine := make(chan error)
+	go func() {
+		exitRoutine <- ds.StreamChainReorgs(&ptypes.Empty{}, stream)
+	}()
+
+	data := &statefeed.ReorgData{
+		OldSlot:            5,
+		OldHeadRoot:        [32]byte{'a'},
+		NewSlot:            6,
+		NewHeadRoot:        [32]byte{'b'},
+		CommonAncestorSlot: 3,
+		CommonAncestorRoot: [32]byte{'c'},
+		Depth:              2,
+	}
+	// Send until the server has subscribed, other event types must be skipped.
+	var res *pbrpc.ChainReorg
+	for res == nil {
+		chainService.StateNotifier().StateFeed().Send(&feed.Event{Type: statefeed.NewHead, Data: &statefeed.NewHeadData{}})
+		chainService.StateNotifier().StateFeed().Send(&feed.Event{Type: statefeed.Reorg, Data: data})
+		select {
+		case res = <-stream.sent:
+		default:
+		}
+	}
+	assert.DeepEqual(t, &pbrpc.ChainReorg{
+		OldHeadSlot:        5,
+		OldHeadRoot:        data.OldHeadRoot[:],
+		NewHeadSlot:        6,
+		NewHeadRoot:        data.NewHeadRoot[:],
+		CommonAncestorSlot: 3,
+		CommonAncestorRoot: data.CommonAncestorRoot[:],
+		Depth:              2,
+	}, res)
+
+	cancel()
+	// Drain anything sent meanwhile so the server can observe the cancellation.
+	for {
+		select {
+		case <-stream.sent:
+		case err := <-exitRoutine:
+			require.ErrorContains(t, "Context canceled", err)
+			return
+		}
+	}
+}
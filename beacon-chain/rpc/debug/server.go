@@ -11,6 +11,7 @@ import (
 	ptypes "github.com/gogo/protobuf/types"
 	golog "github.com/ipfs/go-log/v2"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
@@ -20,6 +21,8 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+var log = logrus.WithField("prefix", "rpc/debug")
+
 // Server defines a server implementation of the gRPC Debug service,
 // providing RPC endpoints for runtime debugging of a node, this server is
 // gated behind the feature flag --enable-debug-rpc-endpoints.
@@ -28,8 +31,11 @@ type Server struct {
 	GenesisTimeFetcher blockchain.TimeFetcher
 	StateGen           *stategen.State
 	HeadFetcher        blockchain.HeadFetcher
+	ChainInfoFetcher   blockchain.ChainInfoFetcher
 	PeerManager        p2p.PeerManager
 	PeersFetcher       p2p.PeersProvider
+	MetadataProvider   p2p.MetadataProvider
+	StateNotifier      statefeed.Notifier
 }
 
 // SetLoggingLevel of a beacon node according to a request type,
@@ -0,0 +1,44 @@
+package debug
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// GetAttestationSubnets returns the attestation subnets advertised by the node, along with the
+// random subnet assignments of the attached validators which make up the subnet backbone.
+func (ds *Server) GetAttestationSubnets(_ context.Context, _ *ptypes.Empty) (*pbrpc.AttestationSubnetsResponse, error) {
+	metadata := ds.MetadataProvider.Metadata()
+	advertised := make([]uint64, 0)
+	for i := uint64(0); i < metadata.Attnets.Len(); i++ {
+		if metadata.Attnets.BitAt(i) {
+			advertised = append(advertised, i)
+		}
+	}
+
+	genesisTime := ds.GenesisTimeFetcher.GenesisTime()
+	assignments := cache.SubnetIDs.GetAllPersistentSubnets()
+	randomSubnets := make([]*pbrpc.ValidatorSubnetAssignment, len(assignments))
+	for i, assignment := range assignments {
+		expirationEpoch := uint64(0)
+		if assignment.Expiration.After(genesisTime) {
+			secondsSinceGenesis := uint64(assignment.Expiration.Sub(genesisTime).Seconds())
+			expirationEpoch = helpers.SlotToEpoch(secondsSinceGenesis / params.BeaconConfig().SecondsPerSlot)
+		}
+		randomSubnets[i] = &pbrpc.ValidatorSubnetAssignment{
+			PublicKey:       assignment.PublicKey,
+			Subnets:         assignment.Subnets,
+			ExpirationEpoch: expirationEpoch,
+		}
+	}
+	return &pbrpc.AttestationSubnetsResponse{
+		AdvertisedSubnets: advertised,
+		MetadataSeqNumber: metadata.SeqNumber,
+		RandomSubnets:     randomSubnets,
+	}, nil
+}
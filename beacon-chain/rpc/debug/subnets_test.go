@@ -0,0 +1,46 @@
+package debug
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	mockP2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_GetAttestationSubnets(t *testing.T) {
+	attnets := bitfield.NewBitvector64()
+	attnets.SetBitAt(2, true)
+	attnets.SetBitAt(9, true)
+	epochDuration := time.Duration(params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot) * time.Second
+	genesis := time.Now().Add(-10 * epochDuration)
+	pubkey := [48]byte{'s', 'u', 'b', 'n', 'e', 't', 's'}
+	cache.SubnetIDs.AddPersistentCommittee(pubkey[:], []uint64{2, 9}, 5*epochDuration+epochDuration/2)
+
+	ds := &Server{
+		GenesisTimeFetcher: &mock.ChainService{Genesis: genesis},
+		MetadataProvider:   &mockP2p.TestP2P{LocalMetadata: &pb.MetaData{SeqNumber: 3, Attnets: attnets}},
+	}
+	res, err := ds.GetAttestationSubnets(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{2, 9}, res.AdvertisedSubnets)
+	assert.Equal(t, uint64(3), res.MetadataSeqNumber)
+	found := false
+	for _, assignment := range res.RandomSubnets {
+		if string(assignment.PublicKey) != string(pubkey[:]) {
+			continue
+		}
+		found = true
+		assert.DeepEqual(t, []uint64{2, 9}, assignment.Subnets)
+		assert.Equal(t, uint64(15), assignment.ExpirationEpoch)
+	}
+	assert.Equal(t, true, found, "Expected random subnet assignment of validator")
+}
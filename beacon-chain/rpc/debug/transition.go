@@ -0,0 +1,110 @@
+package debug
+
+import (
+	"context"
+	"errors"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/statefetcher"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExecuteStateTransition runs the state transition of a signed block on a copy of the given
+// pre-state, reporting the duration of every step and the step which failed, if any.
+func (ds *Server) ExecuteStateTransition(
+	ctx context.Context,
+	req *pbrpc.StateTransitionRequest,
+) (*pbrpc.StateTransitionResponse, error) {
+	block := &ethpb.SignedBeaconBlock{}
+	if err := block.UnmarshalSSZ(req.SignedBlockSsz); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not decode signed block: %v", err)
+	}
+	if block.Block == nil || block.Block.Body == nil {
+		return nil, status.Error(codes.InvalidArgument, "Signed block is missing its block or body")
+	}
+	preState, err := ds.transitionPreState(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	preStateRoot, err := preState.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not hash pre-state: %v", err)
+	}
+
+	postState, steps, err := state.ExecuteStateTransitionWithSteps(ctx, preState, block)
+	res := &pbrpc.StateTransitionResponse{
+		PreStateRoot: preStateRoot[:],
+		Steps:        make([]*pbrpc.StateTransitionStep, len(steps)),
+	}
+	for i, step := range steps {
+		res.Steps[i] = transitionStep(step)
+	}
+	if err != nil {
+		stepErr := &state.TransitionStepError{}
+		if !errors.As(err, &stepErr) {
+			return nil, status.Errorf(codes.Internal, "Could not execute state transition: %v", err)
+		}
+		res.Error = &pbrpc.StateTransitionError{
+			Step:    transitionStep(stepErr.Step),
+			Message: stepErr.Err.Error(),
+		}
+	}
+	if postState != nil {
+		postStateRoot, err := postState.HashTreeRoot(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not hash post-state: %v", err)
+		}
+		res.PostStateRoot = postStateRoot[:]
+	}
+	return res, nil
+}
+
+// transitionPreState decodes the requested pre-state, or retrieves a copy of it by state ID.
+func (ds *Server) transitionPreState(ctx context.Context, req *pbrpc.StateTransitionRequest) (*stateTrie.BeaconState, error) {
+	switch q := req.PreState.(type) {
+	case *pbrpc.StateTransitionRequest_PreStateSsz:
+		st := &pb.BeaconState{}
+		if err := st.UnmarshalSSZ(q.PreStateSsz); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Could not decode pre-state: %v", err)
+		}
+		preState, err := stateTrie.InitializeFromProtoUnsafe(st)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Could not initialize pre-state: %v", err)
+		}
+		return preState, nil
+	case *pbrpc.StateTransitionRequest_StateId:
+		provider := &statefetcher.StateProvider{
+			BeaconDB:         ds.BeaconDB,
+			ChainInfoFetcher: ds.ChainInfoFetcher,
+			StateGen:         ds.StateGen,
+		}
+		preState, err := provider.State(ctx, []byte(q.StateId))
+		if err != nil {
+			if errors.Is(err, statefetcher.ErrInvalidStateID) {
+				return nil, status.Errorf(codes.InvalidArgument, "Could not get pre-state: %v", err)
+			}
+			return nil, status.Errorf(codes.Internal, "Could not get pre-state: %v", err)
+		}
+		if preState == nil {
+			return nil, status.Errorf(codes.NotFound, "Could not find pre-state %s", q.StateId)
+		}
+		// The transition mutates the state, which may be shared with the caches of the node.
+		return preState.Copy(), nil
+	default:
+		return nil, status.Error(codes.InvalidArgument, "Need to specify either an ssz-encoded pre-state or a state ID")
+	}
+}
+
+func transitionStep(step *state.TransitionStep) *pbrpc.StateTransitionStep {
+	return &pbrpc.StateTransitionStep{
+		Name:                step.Name,
+		Operation:           step.IsOperation(),
+		OperationIndex:      step.OperationIndex,
+		DurationNanoseconds: uint64(step.Duration.Nanoseconds()),
+	}
+}
@@ -0,0 +1,99 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_ExecuteStateTransition(t *testing.T) {
+	ctx := context.Background()
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	block, err := testutil.GenerateFullBlock(beaconState, privKeys, nil, 1)
+	require.NoError(t, err)
+	encodedBlock, err := block.MarshalSSZ()
+	require.NoError(t, err)
+	encodedState, err := beaconState.CloneInnerState().MarshalSSZ()
+	require.NoError(t, err)
+	preStateRoot, err := beaconState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+
+	ds := &Server{}
+	res, err := ds.ExecuteStateTransition(ctx, &pbrpc.StateTransitionRequest{
+		PreState:       &pbrpc.StateTransitionRequest_PreStateSsz{PreStateSsz: encodedState},
+		SignedBlockSsz: encodedBlock,
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, preStateRoot[:], res.PreStateRoot)
+	assert.DeepEqual(t, block.Block.StateRoot, res.PostStateRoot)
+	assert.Equal(t, (*pbrpc.StateTransitionError)(nil), res.Error)
+	require.NotEqual(t, 0, len(res.Steps))
+	assert.Equal(t, state.StepProcessSlots, res.Steps[0].Name)
+	assert.Equal(t, state.StepVerifyStateRoot, res.Steps[len(res.Steps)-1].Name)
+}
+
+func TestServer_ExecuteStateTransition_FailingOperation(t *testing.T) {
+	ctx := context.Background()
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	block, err := testutil.GenerateFullBlock(beaconState, privKeys, nil, 1)
+	require.NoError(t, err)
+	block.Block.Body.VoluntaryExits = []*ethpb.SignedVoluntaryExit{
+		{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 1}, Signature: make([]byte, 96)},
+	}
+	st := beaconState.Copy()
+	require.NoError(t, st.SetSlot(block.Block.Slot))
+	proposerIdx, err := helpers.BeaconProposerIndex(st)
+	require.NoError(t, err)
+	block.Signature, err = helpers.ComputeDomainAndSign(
+		beaconState,
+		helpers.CurrentEpoch(beaconState),
+		block.Block,
+		params.BeaconConfig().DomainBeaconProposer,
+		privKeys[proposerIdx],
+	)
+	require.NoError(t, err)
+	encodedBlock, err := block.MarshalSSZ()
+	require.NoError(t, err)
+
+	ds := &Server{
+		ChainInfoFetcher: &mock.ChainService{State: beaconState},
+	}
+	res, err := ds.ExecuteStateTransition(ctx, &pbrpc.StateTransitionRequest{
+		PreState:       &pbrpc.StateTransitionRequest_StateId{StateId: "head"},
+		SignedBlockSsz: encodedBlock,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, res.Error)
+	assert.Equal(t, state.StepVoluntaryExit, res.Error.Step.Name)
+	assert.Equal(t, true, res.Error.Step.Operation)
+	assert.Equal(t, uint64(0), res.Error.Step.OperationIndex)
+	assert.DeepEqual(t, res.Error.Step, res.Steps[len(res.Steps)-1])
+	assert.Equal(t, 0, len(res.PostStateRoot))
+	assert.Equal(t, uint64(0), beaconState.Slot(), "Head state should not be modified")
+}
+
+func TestServer_ExecuteStateTransition_InvalidRequest(t *testing.T) {
+	ctx := context.Background()
+	encodedBlock, err := testutil.NewBeaconBlock().MarshalSSZ()
+	require.NoError(t, err)
+	ds := &Server{}
+
+	_, err = ds.ExecuteStateTransition(ctx, &pbrpc.StateTransitionRequest{SignedBlockSsz: []byte{1, 2}})
+	assert.ErrorContains(t, "Could not decode signed block", err)
+	_, err = ds.ExecuteStateTransition(ctx, &pbrpc.StateTransitionRequest{SignedBlockSsz: encodedBlock})
+	assert.ErrorContains(t, "Need to specify either an ssz-encoded pre-state or a state ID", err)
+	_, err = ds.ExecuteStateTransition(ctx, &pbrpc.StateTransitionRequest{
+		PreState:       &pbrpc.StateTransitionRequest_StateId{StateId: "foo"},
+		SignedBlockSsz: encodedBlock,
+	})
+	assert.ErrorContains(t, "invalid state ID", err)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/logutil"
 	"github.com/prysmaticlabs/prysm/shared/version"
@@ -32,6 +33,7 @@ type Server struct {
 	LogsStreamer         logutil.Streamer
 	StreamLogsBufferSize int
 	SyncChecker          sync.Checker
+	BackfillFetcher      backfill.ProgressFetcher
 	Server               *grpc.Server
 	BeaconDB             db.ReadOnlyDatabase
 	PeersFetcher         p2p.PeersProvider
@@ -252,3 +254,20 @@ func (ns *Server) StreamBeaconLogs(_ *ptypes.Empty, stream pb.Health_StreamBeaco
 		}
 	}
 }
+
+// GetBackfillStatus retrieves the progress of the backfill of blocks below the checkpoint
+// the beacon node was started from.
+func (ns *Server) GetBackfillStatus(_ context.Context, _ *ptypes.Empty) (*pb.BackfillStatus, error) {
+	if ns.BackfillFetcher == nil {
+		return nil, status.Error(codes.Unavailable, "Backfill service is not available")
+	}
+	progress := ns.BackfillFetcher.Progress()
+	return &pb.BackfillStatus{
+		OriginSlot:  progress.OriginSlot,
+		OriginRoot:  progress.OriginRoot[:],
+		LowestSlot:  progress.LowestSlot,
+		LowestRoot:  progress.LowestRoot[:],
+		Complete:    progress.Complete,
+		Backfilling: progress.Backfilling,
+	}, nil
+}
@@ -17,6 +17,7 @@ import (
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	mockP2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -148,3 +149,33 @@ func TestNodeServer_ListPeers(t *testing.T) {
 	assert.Equal(t, int(ethpb.PeerDirection_INBOUND), int(res.Peers[0].Direction))
 	assert.Equal(t, ethpb.PeerDirection_OUTBOUND, res.Peers[1].Direction)
 }
+
+type mockBackfill struct {
+	progress *backfill.Progress
+}
+
+func (m *mockBackfill) Progress() *backfill.Progress {
+	return m.progress
+}
+
+func TestNodeServer_GetBackfillStatus(t *testing.T) {
+	ns := &Server{}
+	_, err := ns.GetBackfillStatus(context.Background(), &ptypes.Empty{})
+	assert.ErrorContains(t, "Backfill service is not available", err)
+
+	ns.BackfillFetcher = &mockBackfill{progress: &backfill.Progress{
+		OriginSlot:  64,
+		OriginRoot:  [32]byte{'a'},
+		LowestSlot:  32,
+		LowestRoot:  [32]byte{'b'},
+		Backfilling: true,
+	}}
+	res, err := ns.GetBackfillStatus(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(64), res.OriginSlot)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte{'a'}, 32), res.OriginRoot)
+	assert.Equal(t, uint64(32), res.LowestSlot)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte{'b'}, 32), res.LowestRoot)
+	assert.Equal(t, false, res.Complete)
+	assert.Equal(t, true, res.Backfilling)
+}
@@ -4,29 +4,100 @@ import (
 	"context"
 
 	ptypes "github.com/gogo/protobuf/types"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // GetIdentity retrieves data about the node's network presence.
 func (ns *Server) GetIdentity(ctx context.Context, _ *ptypes.Empty) (*ethpb.IdentityResponse, error) {
-	return nil, errors.New("unimplemented")
+	enr := ""
+	if record := ns.PeerManager.ENR(); record != nil {
+		var err error
+		enr, err = p2p.SerializeENR(record)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not serialize ENR: %v", err)
+		}
+	}
+
+	p2pAddresses := make([]string, 0)
+	peerID := ns.PeerManager.PeerID().String()
+	for _, addr := range ns.PeerManager.Host().Addrs() {
+		p2pAddresses = append(p2pAddresses, addr.String()+"/p2p/"+peerID)
+	}
+
+	discoveryAddresses := make([]string, 0)
+	discoveryAddr, err := ns.PeerManager.DiscoveryAddress()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not obtain discovery address: %v", err)
+	}
+	if discoveryAddr != nil {
+		discoveryAddresses = append(discoveryAddresses, discoveryAddr.String())
+	}
+
+	metadata := ns.MetadataProvider.Metadata()
+	return &ethpb.IdentityResponse{
+		Data: &ethpb.Identity{
+			PeerId:             peerID,
+			Enr:                enr,
+			P2PAddresses:       p2pAddresses,
+			DiscoveryAddresses: discoveryAddresses,
+			Metadata: &ethpb.Metadata{
+				SeqNumber: metadata.SeqNumber,
+				Attnets:   metadata.Attnets,
+			},
+		},
+	}, nil
 }
 
 // GetPeer retrieves data about the given peer.
 func (ns *Server) GetPeer(ctx context.Context, req *ethpb.PeerRequest) (*ethpb.PeerResponse, error) {
-	return nil, errors.New("unimplemented")
+	pid, err := peer.Decode(req.PeerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid peer ID %q: %v", req.PeerId, err)
+	}
+	p, err := ns.peerInfo(pid)
+	if errors.Is(err, peerdata.ErrPeerUnknown) {
+		return nil, status.Error(codes.NotFound, "Peer not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not obtain peer info: %v", err)
+	}
+	return &ethpb.PeerResponse{Data: p}, nil
 }
 
 // ListPeers retrieves data about the node's network peers.
 func (ns *Server) ListPeers(ctx context.Context, _ *ptypes.Empty) (*ethpb.PeersResponse, error) {
-	return nil, errors.New("unimplemented")
+	pids := ns.PeersFetcher.Peers().All()
+	allPeers := make([]*ethpb.Peer, 0, len(pids))
+	for _, pid := range pids {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		p, err := ns.peerInfo(pid)
+		if err != nil {
+			// The peer may have been pruned from the store in the meantime.
+			continue
+		}
+		allPeers = append(allPeers, p)
+	}
+	return &ethpb.PeersResponse{Data: allPeers}, nil
 }
 
 // GetVersion requests that the beacon node identify information about its implementation in a
 // format similar to a HTTP User-Agent field.
 func (ns *Server) GetVersion(ctx context.Context, _ *ptypes.Empty) (*ethpb.VersionResponse, error) {
-	return nil, errors.New("unimplemented")
+	return &ethpb.VersionResponse{
+		Data: &ethpb.Version{
+			Version: version.GetVersion(),
+		},
+	}, nil
 }
 
 // GetSyncStatus requests the beacon node to describe if it's currently syncing or not, and
@@ -46,3 +117,49 @@ func (ns *Server) GetSyncStatus(ctx context.Context, _ *ptypes.Empty) (*ethpb.Sy
 func (ns *Server) GetHealth(ctx context.Context, _ *ptypes.Empty) (*ptypes.Empty, error) {
 	return nil, errors.New("unimplemented")
 }
+
+// peerInfo builds the API representation of a peer from the data held in the peers status store.
+func (ns *Server) peerInfo(pid peer.ID) (*ethpb.Peer, error) {
+	peerStatus := ns.PeersFetcher.Peers()
+	addr, err := peerStatus.Address(pid)
+	if err != nil {
+		return nil, err
+	}
+	dir, err := peerStatus.Direction(pid)
+	if err != nil {
+		return nil, err
+	}
+	connState, err := peerStatus.ConnectionState(pid)
+	if err != nil {
+		return nil, err
+	}
+	record, err := peerStatus.ENR(pid)
+	if err != nil {
+		return nil, err
+	}
+	enr := ""
+	if record != nil {
+		enr, err = p2p.SerializeENR(record)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not serialize ENR")
+		}
+	}
+	address := ""
+	if addr != nil {
+		address = addr.String()
+	}
+	direction := ethpb.PeerDirection_UNKNOWN
+	switch dir {
+	case network.DirInbound:
+		direction = ethpb.PeerDirection_INBOUND
+	case network.DirOutbound:
+		direction = ethpb.PeerDirection_OUTBOUND
+	}
+	return &ethpb.Peer{
+		PeerId:    pid.String(),
+		Enr:       enr,
+		Address:   address,
+		State:     ethpb.ConnectionState(connState),
+		Direction: direction,
+	}, nil
+}
@@ -0,0 +1,106 @@
+package nodev1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	mockp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/version"
+)
+
+func TestServer_GetIdentity(t *testing.T) {
+	ctx := context.Background()
+	p2pService := mockp2p.NewTestP2P(t)
+	attnets := bitfield.NewBitvector64()
+	attnets.SetBitAt(1, true)
+	p2pService.LocalMetadata = &pb.MetaData{SeqNumber: 3, Attnets: attnets}
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	db, err := enode.OpenDB("")
+	require.NoError(t, err)
+	record := enode.NewLocalNode(db, key).Node().Record()
+	stringENR, err := p2p.SerializeENR(record)
+	require.NoError(t, err)
+	ns := &Server{
+		PeerManager:      &mockp2p.MockPeerManager{BHost: p2pService.BHost, Enr: record, PID: p2pService.BHost.ID()},
+		MetadataProvider: p2pService,
+	}
+
+	resp, err := ns.GetIdentity(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, p2pService.PeerID().String(), resp.Data.PeerId)
+	assert.Equal(t, stringENR, resp.Data.Enr)
+	require.Equal(t, len(p2pService.BHost.Addrs()), len(resp.Data.P2PAddresses))
+	for i, addr := range p2pService.BHost.Addrs() {
+		assert.Equal(t, fmt.Sprintf("%s/p2p/%s", addr, p2pService.PeerID()), resp.Data.P2PAddresses[i])
+	}
+	assert.Equal(t, 0, len(resp.Data.DiscoveryAddresses))
+	assert.Equal(t, uint64(3), resp.Data.Metadata.SeqNumber)
+	assert.DeepEqual(t, attnets, resp.Data.Metadata.Attnets)
+}
+
+func TestServer_GetPeer(t *testing.T) {
+	ctx := context.Background()
+	peersProvider := &mockp2p.MockPeersProvider{}
+	ns := &Server{
+		PeersFetcher: peersProvider,
+	}
+	firstPeer, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+
+	resp, err := ns.GetPeer(ctx, &ethpb.PeerRequest{PeerId: firstPeer.String()})
+	require.NoError(t, err)
+	assert.Equal(t, firstPeer.String(), resp.Data.PeerId)
+	assert.Equal(t, "/ip4/213.202.254.180/tcp/13000", resp.Data.Address)
+	assert.Equal(t, ethpb.ConnectionState_CONNECTED, resp.Data.State)
+	assert.Equal(t, ethpb.PeerDirection_INBOUND, resp.Data.Direction)
+	assert.NotEqual(t, "", resp.Data.Enr)
+
+	t.Run("Invalid ID", func(t *testing.T) {
+		_, err := ns.GetPeer(ctx, &ethpb.PeerRequest{PeerId: "foo"})
+		assert.ErrorContains(t, "Invalid peer ID", err)
+	})
+	t.Run("Peer not found", func(t *testing.T) {
+		pid, err := peer.Decode("16Uiu2HAmQqFdEcHbSmQTQuLoAhnMUrgoWoraKK4cUJT6FuuqHqTU")
+		require.NoError(t, err)
+		_, err = ns.GetPeer(ctx, &ethpb.PeerRequest{PeerId: pid.String()})
+		assert.ErrorContains(t, "Peer not found", err)
+	})
+}
+
+func TestServer_ListPeers(t *testing.T) {
+	ctx := context.Background()
+	peersProvider := &mockp2p.MockPeersProvider{}
+	ns := &Server{
+		PeersFetcher: peersProvider,
+	}
+
+	resp, err := ns.ListPeers(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Data))
+	directions := make(map[ethpb.PeerDirection]bool)
+	for _, p := range resp.Data {
+		assert.Equal(t, ethpb.ConnectionState_CONNECTED, p.State)
+		directions[p.Direction] = true
+	}
+	assert.Equal(t, true, directions[ethpb.PeerDirection_INBOUND])
+	assert.Equal(t, true, directions[ethpb.PeerDirection_OUTBOUND])
+}
+
+func TestServer_GetVersion(t *testing.T) {
+	ns := &Server{}
+	resp, err := ns.GetVersion(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, version.GetVersion(), resp.Data.Version)
+}
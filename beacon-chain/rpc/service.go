@@ -31,9 +31,11 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/beaconv1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/debug"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/node"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/nodev1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/validator"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	chainSync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
@@ -78,6 +80,8 @@ type Service struct {
 	exitPool                *voluntaryexits.Pool
 	slashingsPool           *slashings.Pool
 	syncService             chainSync.Checker
+	attestationValidator    chainSync.AttestationValidator
+	backfillFetcher         backfill.ProgressFetcher
 	host                    string
 	port                    string
 	beaconMonitoringHost    string
@@ -92,6 +96,7 @@ type Service struct {
 	p2p                     p2p.Broadcaster
 	peersFetcher            p2p.PeersProvider
 	peerManager             p2p.PeerManager
+	metadataProvider        p2p.MetadataProvider
 	depositFetcher          depositcache.DepositFetcher
 	pendingDepositFetcher   depositcache.PendingDepositsFetcher
 	stateNotifier           statefeed.Notifier
@@ -129,9 +134,12 @@ type Config struct {
 	ExitPool                *voluntaryexits.Pool
 	SlashingsPool           *slashings.Pool
 	SyncService             chainSync.Checker
+	AttestationValidator    chainSync.AttestationValidator
+	BackfillFetcher         backfill.ProgressFetcher
 	Broadcaster             p2p.Broadcaster
 	PeersFetcher            p2p.PeersProvider
 	PeerManager             p2p.PeerManager
+	MetadataProvider        p2p.MetadataProvider
 	DepositFetcher          depositcache.DepositFetcher
 	PendingDepositFetcher   depositcache.PendingDepositsFetcher
 	StateNotifier           statefeed.Notifier
@@ -161,6 +169,7 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		p2p:                     cfg.Broadcaster,
 		peersFetcher:            cfg.PeersFetcher,
 		peerManager:             cfg.PeerManager,
+		metadataProvider:        cfg.MetadataProvider,
 		powChainService:         cfg.POWChainService,
 		chainStartFetcher:       cfg.ChainStartFetcher,
 		mockEth1Votes:           cfg.MockEth1Votes,
@@ -168,6 +177,8 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		exitPool:                cfg.ExitPool,
 		slashingsPool:           cfg.SlashingsPool,
 		syncService:             cfg.SyncService,
+		attestationValidator:    cfg.AttestationValidator,
+		backfillFetcher:         cfg.BackfillFetcher,
 		host:                    cfg.Host,
 		port:                    cfg.Port,
 		beaconMonitoringHost:    cfg.BeaconMonitoringHost,
@@ -265,6 +276,7 @@ func (s *Service) Start() {
 		BeaconDB:             s.beaconDB,
 		Server:               s.grpcServer,
 		SyncChecker:          s.syncService,
+		BackfillFetcher:      s.backfillFetcher,
 		GenesisTimeFetcher:   s.genesisTimeFetcher,
 		PeersFetcher:         s.peersFetcher,
 		PeerManager:          s.peerManager,
@@ -272,6 +284,16 @@ func (s *Service) Start() {
 		BeaconMonitoringHost: s.beaconMonitoringHost,
 		BeaconMonitoringPort: s.beaconMonitoringPort,
 	}
+	nodeServerV1 := &nodev1.Server{
+		BeaconDB:           s.beaconDB,
+		Server:             s.grpcServer,
+		SyncChecker:        s.syncService,
+		GenesisTimeFetcher: s.genesisTimeFetcher,
+		PeersFetcher:       s.peersFetcher,
+		PeerManager:        s.peerManager,
+		MetadataProvider:   s.metadataProvider,
+		GenesisFetcher:     s.genesisFetcher,
+	}
 	beaconChainServer := &beacon.Server{
 		Ctx:                         s.ctx,
 		BeaconDB:                    s.beaconDB,
@@ -295,24 +317,27 @@ func (s *Service) Start() {
 		CollectedAttestationsBuffer: make(chan []*ethpb.Attestation, attestationBufferSize),
 	}
 	beaconChainServerV1 := &beaconv1.Server{
-		Ctx:                 s.ctx,
-		BeaconDB:            s.beaconDB,
-		AttestationsPool:    s.attestationsPool,
-		SlashingsPool:       s.slashingsPool,
-		ChainInfoFetcher:    s.chainInfoFetcher,
-		ChainStartFetcher:   s.chainStartFetcher,
-		DepositFetcher:      s.depositFetcher,
-		BlockFetcher:        s.powChainService,
-		CanonicalStateChan:  s.canonicalStateChan,
-		GenesisTimeFetcher:  s.genesisTimeFetcher,
-		StateNotifier:       s.stateNotifier,
-		BlockNotifier:       s.blockNotifier,
-		AttestationNotifier: s.operationNotifier,
-		Broadcaster:         s.p2p,
-		StateGen:            s.stateGen,
-		SyncChecker:         s.syncService,
+		Ctx:                  s.ctx,
+		BeaconDB:             s.beaconDB,
+		AttestationsPool:     s.attestationsPool,
+		SlashingsPool:        s.slashingsPool,
+		VoluntaryExitsPool:   s.exitPool,
+		ChainInfoFetcher:     s.chainInfoFetcher,
+		ChainStartFetcher:    s.chainStartFetcher,
+		DepositFetcher:       s.depositFetcher,
+		BlockFetcher:         s.powChainService,
+		CanonicalStateChan:   s.canonicalStateChan,
+		GenesisTimeFetcher:   s.genesisTimeFetcher,
+		StateNotifier:        s.stateNotifier,
+		BlockNotifier:        s.blockNotifier,
+		AttestationNotifier:  s.operationNotifier,
+		Broadcaster:          s.p2p,
+		StateGen:             s.stateGen,
+		SyncChecker:          s.syncService,
+		AttestationValidator: s.attestationValidator,
 	}
 	ethpb.RegisterNodeServer(s.grpcServer, nodeServer)
+	ethpbv1.RegisterBeaconNodeServer(s.grpcServer, nodeServerV1)
 	pbrpc.RegisterHealthServer(s.grpcServer, nodeServer)
 	ethpb.RegisterBeaconChainServer(s.grpcServer, beaconChainServer)
 	ethpbv1.RegisterBeaconChainServer(s.grpcServer, beaconChainServerV1)
@@ -323,8 +348,11 @@ func (s *Service) Start() {
 			BeaconDB:           s.beaconDB,
 			StateGen:           s.stateGen,
 			HeadFetcher:        s.headFetcher,
+			ChainInfoFetcher:   s.chainInfoFetcher,
 			PeerManager:        s.peerManager,
 			PeersFetcher:       s.peersFetcher,
+			MetadataProvider:   s.metadataProvider,
+			StateNotifier:      s.stateNotifier,
 		}
 		pbrpc.RegisterDebugServer(s.grpcServer, debugServer)
 	}
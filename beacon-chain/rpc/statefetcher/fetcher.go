@@ -0,0 +1,122 @@
+// Package statefetcher resolves the state IDs accepted by the beacon node APIs into beacon states.
+package statefetcher
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// ErrInvalidStateID is returned when the requested state ID is neither a known
+// keyword, a slot number nor a 32 byte state root.
+var ErrInvalidStateID = errors.New("invalid state ID")
+
+// StateProvider retrieves beacon states by state ID.
+type StateProvider struct {
+	BeaconDB         db.ReadOnlyDatabase
+	ChainInfoFetcher blockchain.ChainInfoFetcher
+	StateGen         *stategen.State
+}
+
+// State retrieves the state matching the given state ID. The ID may be one of
+// "head", "genesis", "finalized", "justified", a slot number, or a state root given either
+// as 32 raw bytes or as a 0x-prefixed hex string. A nil state is returned if no state matches.
+func (p *StateProvider) State(ctx context.Context, stateId []byte) (*state.BeaconState, error) {
+	switch string(stateId) {
+	case "head":
+		st, err := p.ChainInfoFetcher.HeadState(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not retrieve head state")
+		}
+		return st, nil
+	case "genesis":
+		st, err := p.BeaconDB.GenesisState(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not retrieve genesis state")
+		}
+		return st, nil
+	case "finalized":
+		finalized := p.ChainInfoFetcher.FinalizedCheckpt()
+		st, err := p.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(finalized.Root))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not retrieve finalized state")
+		}
+		return st, nil
+	case "justified":
+		justified := p.ChainInfoFetcher.CurrentJustifiedCheckpt()
+		st, err := p.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(justified.Root))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not retrieve justified state")
+		}
+		return st, nil
+	}
+
+	id := string(stateId)
+	if strings.HasPrefix(id, "0x") {
+		root, err := hex.DecodeString(id[2:])
+		if err != nil || len(root) != 32 {
+			return nil, errors.Wrapf(ErrInvalidStateID, "could not decode state root %s", id)
+		}
+		return p.stateByStateRoot(ctx, root)
+	}
+	if len(stateId) == 32 {
+		return p.stateByStateRoot(ctx, stateId)
+	}
+	slot, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidStateID, "could not decode state id %q", id)
+	}
+	if slot > p.ChainInfoFetcher.HeadSlot() {
+		return nil, nil
+	}
+	st, err := p.StateGen.StateBySlot(ctx, slot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not retrieve state for slot %d", slot)
+	}
+	return st, nil
+}
+
+// stateByStateRoot retrieves the state with the given state root. Only the head state and the
+// states whose roots are still held in the state roots of the head state are looked up, so
+// that unknown roots do not cause any database reads.
+func (p *StateProvider) stateByStateRoot(ctx context.Context, stateRoot []byte) (*state.BeaconState, error) {
+	headState, err := p.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve head state")
+	}
+	if headState == nil {
+		return nil, nil
+	}
+	headStateRoot, err := headState.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not hash head state")
+	}
+	if bytes.Equal(headStateRoot[:], stateRoot) {
+		return headState, nil
+	}
+
+	stateRoots := headState.StateRoots()
+	historyLength := params.BeaconConfig().SlotsPerHistoricalRoot
+	for i := uint64(1); i <= historyLength && i <= headState.Slot(); i++ {
+		slot := headState.Slot() - i
+		if !bytes.Equal(stateRoots[slot%historyLength], stateRoot) {
+			continue
+		}
+		st, err := p.StateGen.StateBySlot(ctx, slot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not retrieve state for slot %d", slot)
+		}
+		return st, nil
+	}
+	return nil, nil
+}
@@ -5,3 +5,7 @@ import "errors"
 var errUnknownBoundaryState = errors.New("unknown boundary state")
 var errUnknownState = errors.New("unknown state")
 var errUnknownBlock = errors.New("unknown block")
+
+// ErrUnknownStateSummary is returned when a state is requested by the root of a block,
+// for which neither a state summary nor the block itself is known.
+var ErrUnknownStateSummary = errors.New("unknown state summary")
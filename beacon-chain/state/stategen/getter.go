@@ -138,7 +138,7 @@ func (s *State) RecoverStateSummary(ctx context.Context, blockRoot [32]byte) (*p
 		}
 		return summary, nil
 	}
-	return nil, errors.New("could not find block in DB")
+	return nil, errors.Wrap(ErrUnknownStateSummary, "could not find block in DB")
 }
 
 // This loads a beacon state from either the cache or DB then replay blocks up the requested block root.
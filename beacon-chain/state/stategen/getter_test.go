@@ -2,6 +2,7 @@ package stategen
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
@@ -102,6 +103,16 @@ func TestStateByRoot_HotStateCached(t *testing.T) {
 	}
 }
 
+func TestStateByRoot_UnknownRoot(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+
+	service := New(beaconDB)
+
+	_, err := service.StateByRoot(ctx, [32]byte{'A'})
+	assert.Equal(t, true, errors.Is(err, ErrUnknownStateSummary), "Unexpected error %v", err)
+}
+
 func TestStateByRootInitialSync_UseEpochStateCache(t *testing.T) {
 	ctx := context.Background()
 	beaconDB := testDB.SetupDB(t)
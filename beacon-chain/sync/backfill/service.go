@@ -0,0 +1,335 @@
+// Package backfill downloads the blocks below the origin block of a beacon node started
+// from a trusted checkpoint, walking backwards towards genesis while the node follows the
+// chain head, so that the node can serve the full block history to its peers.
+package backfill
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/rand"
+	"github.com/sirupsen/logrus"
+)
+
+var _ shared.Service = (*Service)(nil)
+
+// pollingInterval is the interval at which the service waits for initial sync to complete,
+// or for suitable peers to connect, before requesting the next batch of blocks.
+const pollingInterval = 5 * time.Second
+
+var errInvalidBatch = errors.New("blocks do not extend the backfilled chain")
+
+// Config to set up the backfill service.
+type Config struct {
+	P2P         p2p.P2P
+	DB          db.NoHeadAccessDatabase
+	InitialSync prysmsync.Checker
+}
+
+// Progress of the backfill of blocks below the origin block.
+type Progress struct {
+	OriginSlot  uint64
+	OriginRoot  [32]byte
+	LowestSlot  uint64
+	LowestRoot  [32]byte
+	Complete    bool
+	Backfilling bool
+}
+
+// ProgressFetcher retrieves the progress of the backfill service.
+type ProgressFetcher interface {
+	Progress() *Progress
+}
+
+// Service backfills the blocks below the origin block of a node started from a checkpoint.
+type Service struct {
+	ctx         context.Context
+	cancel      context.CancelFunc
+	p2p         p2p.P2P
+	db          db.NoHeadAccessDatabase
+	initialSync prysmsync.Checker
+	batchSize   uint64
+	rand        *rand.Rand
+
+	lock     sync.RWMutex
+	progress *Progress
+	// lowestParent is the parent root of the lowest backfilled block, which the next
+	// backfilled block must hash to.
+	lowestParent [32]byte
+	// cursor is the slot below which the next batch of blocks is requested. It may lie below
+	// the lowest backfilled block when the slots in between were found to be empty.
+	cursor uint64
+	runErr error
+}
+
+// NewService initializes the backfill service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:         ctx,
+		cancel:      cancel,
+		p2p:         cfg.P2P,
+		db:          cfg.DB,
+		initialSync: cfg.InitialSync,
+		batchSize:   uint64(flags.Get().BlockBatchLimit),
+		rand:        rand.NewGenerator(),
+		progress:    &Progress{},
+	}
+}
+
+// Start the backfill service.
+func (s *Service) Start() {
+	if err := s.initialize(s.ctx); err != nil {
+		log.WithError(err).Error("Could not initialize backfill")
+		s.setErr(err)
+		return
+	}
+	if s.Progress().Complete {
+		return
+	}
+	go s.run()
+}
+
+// Stop the backfill service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the backfill service.
+func (s *Service) Status() error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.runErr
+}
+
+// Progress returns a copy of the current backfill progress.
+func (s *Service) Progress() *Progress {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	p := *s.progress
+	return &p
+}
+
+func (s *Service) setErr(err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.runErr = err
+}
+
+// initialize loads the origin block and the lowest backfilled block from the database, so
+// that backfill resumes where it stopped before a restart.
+func (s *Service) initialize(ctx context.Context) error {
+	originRoot, err := s.db.OriginBlockRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get origin block root")
+	}
+	if originRoot == [32]byte{} {
+		// The node was synced from genesis, there is nothing to backfill.
+		s.lock.Lock()
+		s.progress.Complete = true
+		s.lock.Unlock()
+		return nil
+	}
+	origin, err := s.db.Block(ctx, originRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get origin block")
+	}
+	if origin == nil || origin.Block == nil {
+		return errors.New("origin block not found in db")
+	}
+	lowestRoot, err := s.db.BackfillBlockRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get backfill block root")
+	}
+	lowest := origin
+	if lowestRoot == [32]byte{} {
+		lowestRoot = originRoot
+	} else {
+		lowest, err = s.db.Block(ctx, lowestRoot)
+		if err != nil {
+			return errors.Wrap(err, "could not get lowest backfilled block")
+		}
+		if lowest == nil || lowest.Block == nil {
+			return errors.New("lowest backfilled block not found in db")
+		}
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.progress = &Progress{
+		OriginSlot: origin.Block.Slot,
+		OriginRoot: originRoot,
+		LowestSlot: lowest.Block.Slot,
+		LowestRoot: lowestRoot,
+		Complete:   lowest.Block.Slot == 0,
+	}
+	copy(s.lowestParent[:], lowest.Block.ParentRoot)
+	s.cursor = lowest.Block.Slot
+	return nil
+}
+
+func (s *Service) run() {
+	s.lock.Lock()
+	s.progress.Backfilling = true
+	s.lock.Unlock()
+	defer func() {
+		s.lock.Lock()
+		s.progress.Backfilling = false
+		s.lock.Unlock()
+	}()
+
+	progress := s.Progress()
+	log.WithFields(logrus.Fields{
+		"originSlot": progress.OriginSlot,
+		"lowestSlot": progress.LowestSlot,
+	}).Info("Backfilling blocks below the checkpoint origin")
+	ticker := time.NewTicker(pollingInterval)
+	defer ticker.Stop()
+	for !s.Progress().Complete {
+		if s.initialSync == nil || !s.initialSync.Syncing() {
+			if pid, ok := s.selectPeer(); ok {
+				if err := s.backfillBatch(s.ctx, pid); err != nil {
+					if s.ctx.Err() != nil {
+						return
+					}
+					log.WithError(err).WithField("peer", pid).Debug("Could not backfill blocks")
+				}
+				continue
+			}
+		}
+		select {
+		case <-ticker.C:
+		case <-s.ctx.Done():
+			return
+		}
+	}
+	log.WithField("originSlot", progress.OriginSlot).Info("Backfilled all blocks down to genesis")
+}
+
+// selectPeer picks a random connected peer which is not marked as bad.
+func (s *Service) selectPeer() (peer.ID, bool) {
+	var candidates []peer.ID
+	for _, pid := range s.p2p.Peers().Connected() {
+		if !s.p2p.Peers().IsBad(pid) {
+			candidates = append(candidates, pid)
+		}
+	}
+	if len(candidates) == 0 {
+		return "", false
+	}
+	return candidates[s.rand.Intn(len(candidates))], true
+}
+
+// backfillBatch requests the batch of blocks below the cursor from the given peer, verifies
+// that they extend the parent chain of the lowest backfilled block and saves them.
+func (s *Service) backfillBatch(ctx context.Context, pid peer.ID) error {
+	s.lock.RLock()
+	cursor, expectedRoot, lowestSlot := s.cursor, s.lowestParent, s.progress.LowestSlot
+	s.lock.RUnlock()
+	if cursor == 0 {
+		// The requested slots were all reported empty, yet no block links to genesis.
+		s.resetCursor(lowestSlot)
+		return errInvalidBatch
+	}
+	start := uint64(0)
+	if cursor > s.batchSize {
+		start = cursor - s.batchSize
+	}
+	req := &pb.BeaconBlocksByRangeRequest{
+		StartSlot: start,
+		Count:     cursor - start,
+		Step:      1,
+	}
+	blks, err := prysmsync.SendBeaconBlocksByRangeRequest(ctx, s.p2p, pid, req, nil)
+	if err != nil {
+		return errors.Wrap(err, "could not request blocks")
+	}
+	if len(blks) == 0 {
+		// The slots in the requested range may all be skipped. This is confirmed once a lower
+		// block links to the lowest backfilled block, otherwise the cursor is reset.
+		s.lock.Lock()
+		s.cursor = start
+		s.lock.Unlock()
+		return nil
+	}
+
+	lowestRoot, err := verifyBatch(blks, expectedRoot, cursor)
+	if err != nil {
+		// Below the lowest backfilled block, the slots in between were reported empty by a
+		// peer, which may have withheld the blocks linking this batch. The peer is only
+		// penalized for a batch requested right below the lowest backfilled block.
+		if cursor == lowestSlot {
+			s.p2p.Peers().Scorers().BadResponsesScorer().Increment(pid)
+		}
+		s.resetCursor(lowestSlot)
+		return err
+	}
+	if err := s.db.SaveBlocks(ctx, blks); err != nil {
+		return errors.Wrap(err, "could not save blocks")
+	}
+	if err := s.db.SaveBackfillBlockRoot(ctx, lowestRoot); err != nil {
+		return errors.Wrap(err, "could not save backfill block root")
+	}
+	lowest := blks[0].Block
+	if lowest.Slot == 0 {
+		if err := s.db.SaveGenesisBlockRoot(ctx, lowestRoot); err != nil {
+			return errors.Wrap(err, "could not save genesis block root")
+		}
+	}
+
+	s.lock.Lock()
+	s.progress.LowestSlot = lowest.Slot
+	s.progress.LowestRoot = lowestRoot
+	s.progress.Complete = lowest.Slot == 0
+	copy(s.lowestParent[:], lowest.ParentRoot)
+	s.cursor = lowest.Slot
+	s.lock.Unlock()
+	log.WithFields(logrus.Fields{
+		"lowestSlot": lowest.Slot,
+		"blocks":     len(blks),
+	}).Debug("Backfilled blocks")
+	return nil
+}
+
+func (s *Service) resetCursor(slot uint64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.cursor = slot
+}
+
+// verifyBatch checks that the blocks, sorted by slot, are all below the given slot and form a
+// chain whose highest block has the expected root. It returns the root of the lowest block.
+func verifyBatch(blks []*ethpb.SignedBeaconBlock, expectedRoot [32]byte, belowSlot uint64) ([32]byte, error) {
+	var root [32]byte
+	for i := len(blks) - 1; i >= 0; i-- {
+		if blks[i] == nil || blks[i].Block == nil {
+			return [32]byte{}, errors.Wrap(errInvalidBatch, "nil block")
+		}
+		blk := blks[i].Block
+		if blk.Slot >= belowSlot {
+			return [32]byte{}, errors.Wrapf(errInvalidBatch, "block at slot %d is not below slot %d", blk.Slot, belowSlot)
+		}
+		var err error
+		root, err = blk.HashTreeRoot()
+		if err != nil {
+			return [32]byte{}, errors.Wrap(err, "could not compute block root")
+		}
+		if root != expectedRoot {
+			return [32]byte{}, errors.Wrapf(errInvalidBatch, "block at slot %d has root %#x, expected %#x", blk.Slot, root, expectedRoot)
+		}
+		copy(expectedRoot[:], blk.ParentRoot)
+		belowSlot = blk.Slot
+	}
+	return root, nil
+}
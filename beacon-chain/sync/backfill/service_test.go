@@ -0,0 +1,219 @@
+package backfill
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// makeChain returns a chain of blocks from genesis up to the given slot, skipping every
+// fifth slot.
+func makeChain(t *testing.T, highestSlot uint64) []*ethpb.SignedBeaconBlock {
+	genesis := testutil.NewBeaconBlock()
+	parentRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	chain := []*ethpb.SignedBeaconBlock{genesis}
+	for slot := uint64(1); slot <= highestSlot; slot++ {
+		if slot%5 == 0 && slot != highestSlot {
+			continue
+		}
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = slot
+		blk.Block.ParentRoot = make([]byte, 32)
+		copy(blk.Block.ParentRoot, parentRoot[:])
+		chain = append(chain, blk)
+		parentRoot, err = blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+	}
+	return chain
+}
+
+// connectServingPeer connects a peer serving blocks by range from the given chain.
+func connectServingPeer(t *testing.T, p1 *p2ptest.TestP2P, chain []*ethpb.SignedBeaconBlock) *p2ptest.TestP2P {
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	p1.Peers().Add(nil, p2.PeerID(), nil, network.DirOutbound)
+	p1.Peers().SetConnectionState(p2.PeerID(), peers.PeerConnected)
+	pcl := fmt.Sprintf("%s/ssz_snappy", p2p.RPCBlocksByRangeTopic)
+	p2.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer func() {
+			assert.NoError(t, stream.Close())
+		}()
+		req := &pb.BeaconBlocksByRangeRequest{}
+		assert.NoError(t, p2.Encoding().DecodeWithMaxLength(stream, req))
+		for _, blk := range chain {
+			if blk.Block.Slot >= req.StartSlot && blk.Block.Slot < req.StartSlot+req.Count {
+				assert.NoError(t, prysmsync.WriteChunk(stream, p2.Encoding(), blk))
+			}
+		}
+	})
+	return p2
+}
+
+func setupOrigin(t *testing.T, beaconDB db.Database, origin *ethpb.SignedBeaconBlock) [32]byte {
+	ctx := context.Background()
+	root, err := origin.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, origin))
+	require.NoError(t, beaconDB.SaveOriginBlockRoot(ctx, root))
+	return root
+}
+
+func TestService_Initialize_NoOrigin(t *testing.T) {
+	beaconDB := dbtest.SetupDB(t)
+	s := NewService(context.Background(), &Config{DB: beaconDB, P2P: p2ptest.NewTestP2P(t)})
+	require.NoError(t, s.initialize(context.Background()))
+	assert.Equal(t, true, s.Progress().Complete, "Expected backfill to be complete without an origin block")
+}
+
+func TestService_BackfillsToGenesis(t *testing.T) {
+	flags.Init(&flags.GlobalFlags{BlockBatchLimit: 16})
+	defer flags.Init(&flags.GlobalFlags{})
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	chain := makeChain(t, 70)
+	originRoot := setupOrigin(t, beaconDB, chain[len(chain)-1])
+
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := connectServingPeer(t, p1, chain)
+	s := NewService(ctx, &Config{DB: beaconDB, P2P: p1})
+	require.NoError(t, s.initialize(ctx))
+	progress := s.Progress()
+	assert.Equal(t, uint64(70), progress.OriginSlot)
+	assert.Equal(t, originRoot, progress.OriginRoot)
+	assert.Equal(t, uint64(70), progress.LowestSlot)
+
+	for i := 0; i < 10 && !s.Progress().Complete; i++ {
+		require.NoError(t, s.backfillBatch(ctx, p2.PeerID()))
+	}
+	progress = s.Progress()
+	require.Equal(t, true, progress.Complete, "Backfill did not complete")
+	assert.Equal(t, uint64(0), progress.LowestSlot)
+
+	genesisRoot, err := chain[0].Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, genesisRoot, progress.LowestRoot)
+	savedGenesis, err := beaconDB.GenesisBlock(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, chain[0], savedGenesis)
+	for _, blk := range chain[:len(chain)-1] {
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, true, beaconDB.HasBlock(ctx, root), "Block at slot %d was not saved", blk.Block.Slot)
+		assert.Equal(t, true, beaconDB.IsFinalizedBlock(ctx, root), "Block at slot %d was not indexed as finalized", blk.Block.Slot)
+	}
+}
+
+func TestService_ResumesFromLowestBackfilledBlock(t *testing.T) {
+	flags.Init(&flags.GlobalFlags{BlockBatchLimit: 16})
+	defer flags.Init(&flags.GlobalFlags{})
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	chain := makeChain(t, 70)
+	setupOrigin(t, beaconDB, chain[len(chain)-1])
+
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := connectServingPeer(t, p1, chain)
+	s := NewService(ctx, &Config{DB: beaconDB, P2P: p1})
+	require.NoError(t, s.initialize(ctx))
+	require.NoError(t, s.backfillBatch(ctx, p2.PeerID()))
+	lowest := s.Progress()
+	require.Equal(t, uint64(54), lowest.LowestSlot)
+
+	// A restarted service resumes from the lowest backfilled block.
+	s = NewService(ctx, &Config{DB: beaconDB, P2P: p1})
+	require.NoError(t, s.initialize(ctx))
+	progress := s.Progress()
+	assert.Equal(t, lowest.LowestSlot, progress.LowestSlot)
+	assert.Equal(t, lowest.LowestRoot, progress.LowestRoot)
+	assert.Equal(t, false, progress.Complete)
+}
+
+func TestService_RejectsUnlinkedBlocks(t *testing.T) {
+	flags.Init(&flags.GlobalFlags{BlockBatchLimit: 16})
+	defer flags.Init(&flags.GlobalFlags{})
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	chain := makeChain(t, 70)
+	setupOrigin(t, beaconDB, chain[len(chain)-1])
+
+	// The peer serves a different chain, which the origin block does not descend from.
+	fork := makeChain(t, 69)
+	for _, blk := range fork {
+		blk.Block.ProposerIndex = 1
+	}
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := connectServingPeer(t, p1, fork)
+	s := NewService(ctx, &Config{DB: beaconDB, P2P: p1})
+	require.NoError(t, s.initialize(ctx))
+	err := s.backfillBatch(ctx, p2.PeerID())
+	assert.ErrorContains(t, errInvalidBatch.Error(), err)
+	count, err := p1.Peers().Scorers().BadResponsesScorer().Count(p2.PeerID())
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, uint64(70), s.Progress().LowestSlot)
+}
+
+func TestService_EmptyBatchDoesNotPenalizeNextPeer(t *testing.T) {
+	flags.Init(&flags.GlobalFlags{BlockBatchLimit: 16})
+	defer flags.Init(&flags.GlobalFlags{})
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	chain := makeChain(t, 70)
+	setupOrigin(t, beaconDB, chain[len(chain)-1])
+
+	p1 := p2ptest.NewTestP2P(t)
+	// The first peer claims to have no blocks below the origin block.
+	liar := connectServingPeer(t, p1, nil)
+	honest := connectServingPeer(t, p1, chain)
+	s := NewService(ctx, &Config{DB: beaconDB, P2P: p1})
+	require.NoError(t, s.initialize(ctx))
+
+	require.NoError(t, s.backfillBatch(ctx, liar.PeerID()))
+	assert.Equal(t, uint64(70), s.Progress().LowestSlot)
+
+	// The honest batch below the skipped range does not link to the lowest backfilled block.
+	err := s.backfillBatch(ctx, honest.PeerID())
+	assert.ErrorContains(t, errInvalidBatch.Error(), err)
+	count, err := p1.Peers().Scorers().BadResponsesScorer().Count(honest.PeerID())
+	require.NoError(t, err)
+	assert.Equal(t, 0, count, "Honest peer should not be penalized")
+
+	// The cursor is reset, so the skipped range is requested again.
+	require.NoError(t, s.backfillBatch(ctx, honest.PeerID()))
+	assert.Equal(t, uint64(54), s.Progress().LowestSlot)
+}
+
+func TestVerifyBatch(t *testing.T) {
+	chain := makeChain(t, 12)
+	top, err := chain[len(chain)-1].Block.HashTreeRoot()
+	require.NoError(t, err)
+	bottom, err := chain[0].Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	root, err := verifyBatch(chain, top, 13)
+	require.NoError(t, err)
+	assert.Equal(t, bottom, root)
+
+	_, err = verifyBatch(chain, top, 12)
+	assert.ErrorContains(t, "is not below slot 12", err)
+	_, err = verifyBatch(chain, bytesutil.ToBytes32([]byte{'x'}), 13)
+	assert.ErrorContains(t, errInvalidBatch.Error(), err)
+	_, err = verifyBatch(append(chain[:3:3], chain[4:]...), top, 13)
+	assert.ErrorContains(t, errInvalidBatch.Error(), err)
+}
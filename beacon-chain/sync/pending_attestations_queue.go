@@ -98,7 +98,10 @@ func (s *Service) processPendingAtts(ctx context.Context) error {
 						log.WithError(err).Debug("Could not retrieve attestation prestate")
 						continue
 					}
-					valid := s.validateUnaggregatedAttWithState(ctx, att.Aggregate, preState)
+					valid, err := s.validateUnaggregatedAttWithState(ctx, att.Aggregate, preState)
+					if err != nil {
+						log.WithError(err).Debug("Could not validate unaggregated attestation")
+					}
 					if valid == pubsub.ValidationAccept {
 						if err := s.attPool.SaveUnaggregatedAttestation(att.Aggregate); err != nil {
 							log.WithError(err).Debug("Could not save unaggregated attestation")
@@ -55,6 +55,12 @@ func TestRateLimiter_ExceedCapacity(t *testing.T) {
 	// Attempt to create an error, rate limit and lead to disconnect
 	err = rlimiter.validateRequest(stream, 1000)
 	require.NotNil(t, err, "could not get error from leaky bucket")
+	badResponses, err := p1.Peers().Scorers().BadResponsesScorer().Count(p2.PeerID())
+	require.NoError(t, err)
+	assert.Equal(t, 1, badResponses, "Rate limit violation should be counted as a bad response")
+	counts, err := p1.Peers().Scorers().GossipScorer().MessageCounts(p2.PeerID(), topic)
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, counts.Rejected, "Rate limit violation should not be counted as a gossip message")
 
 	require.NoError(t, stream.Close(), "could not close stream")
 
@@ -138,8 +138,11 @@ func (s *Service) writeBlockRangeToStream(ctx context.Context, startSlot, endSlo
 			traceutil.AnnotateError(span, err)
 			return err
 		}
-		blks = append([]*ethpb.SignedBeaconBlock{genBlock}, blks...)
-		roots = append([][32]byte{genRoot}, roots...)
+		// A node started from a checkpoint does not have the genesis block.
+		if genBlock != nil {
+			blks = append([]*ethpb.SignedBeaconBlock{genBlock}, blks...)
+			roots = append([][32]byte{genRoot}, roots...)
+		}
 	}
 	// Filter and sort our retrieved blocks, so that
 	// we only return valid sets of blocks.
@@ -246,6 +249,9 @@ func (s *Service) retrieveGenesisBlock(ctx context.Context) (*ethpb.SignedBeacon
 	if err != nil {
 		return nil, [32]byte{}, err
 	}
+	if genBlock == nil || genBlock.Block == nil {
+		return nil, [32]byte{}, nil
+	}
 	genRoot, err := genBlock.Block.HashTreeRoot()
 	if err != nil {
 		return nil, [32]byte{}, err
@@ -298,3 +298,9 @@ type Checker interface {
 	Status() error
 	Resync() error
 }
+
+// AttestationValidator defines a struct which can validate attestations received outside of
+// gossip, e.g. over the API, the same way as attestations received over gossip.
+type AttestationValidator interface {
+	ValidateAttestation(ctx context.Context, att *ethpb.Attestation) error
+}
@@ -179,6 +179,7 @@ func (s *Service) wrapAndReportValidation(topic string, v pubsub.ValidatorEx) (s
 		messageReceivedCounter.WithLabelValues(topic).Inc()
 		if msg.Topic == nil {
 			messageFailedValidationCounter.WithLabelValues(topic).Inc()
+			s.scoreGossipMessage(pid, topic, pubsub.ValidationReject)
 			return pubsub.ValidationReject
 		}
 		// Ignore any messages received before chainstart.
@@ -190,10 +191,28 @@ func (s *Service) wrapAndReportValidation(topic string, v pubsub.ValidatorEx) (s
 		if b == pubsub.ValidationReject {
 			messageFailedValidationCounter.WithLabelValues(topic).Inc()
 		}
+		s.scoreGossipMessage(pid, topic, b)
 		return b
 	}
 }
 
+// scoreGossipMessage records the validation result of a gossip message with the gossip scorer.
+// Messages published by the node itself are validated as well, but are not scored.
+func (s *Service) scoreGossipMessage(pid peer.ID, topic string, res pubsub.ValidationResult) {
+	if pid == s.p2p.PeerID() {
+		return
+	}
+	scorer := s.p2p.Peers().Scorers().GossipScorer()
+	switch res {
+	case pubsub.ValidationAccept:
+		scorer.IncrementAccepted(pid, topic)
+	case pubsub.ValidationIgnore:
+		scorer.IncrementIgnored(pid, topic)
+	case pubsub.ValidationReject:
+		scorer.IncrementRejected(pid, topic)
+	}
+}
+
 // subscribe to a static subnet  with the given topic and index.A given validator and subscription handler is
 // used to handle messages from the subnet. The base protobuf message is used to initialize new messages for decoding.
 func (s *Service) subscribeStaticWithSubnets(topic string, validator pubsub.ValidatorEx, handle subHandler) {
@@ -18,6 +18,7 @@ import (
 	db "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
 	"github.com/prysmaticlabs/prysm/shared/abool"
@@ -85,6 +86,7 @@ func TestSubscribe_ReceivesAttesterSlashing(t *testing.T) {
 		db:                        d,
 		seenAttesterSlashingCache: c,
 		chainStarted:              abool.New(),
+		attestationNotifier:       chainService.OperationNotifier(),
 	}
 	topic := "/eth2/%x/attester_slashing"
 	var wg sync.WaitGroup
@@ -137,6 +139,7 @@ func TestSubscribe_ReceivesProposerSlashing(t *testing.T) {
 		db:                        d,
 		seenProposerSlashingCache: c,
 		chainStarted:              abool.New(),
+		attestationNotifier:       chainService.OperationNotifier(),
 	}
 	topic := "/eth2/%x/proposer_slashing"
 	var wg sync.WaitGroup
@@ -266,9 +269,10 @@ func Test_wrapAndReportValidation(t *testing.T) {
 		msg          *pubsub.Message
 	}
 	tests := []struct {
-		name string
-		args args
-		want pubsub.ValidationResult
+		name       string
+		args       args
+		want       pubsub.ValidationResult
+		wantCounts *peerdata.GossipMessageCounts
 	}{
 		{
 			name: "validator Before chainstart",
@@ -325,7 +329,28 @@ func Test_wrapAndReportValidation(t *testing.T) {
 					},
 				},
 			},
-			want: pubsub.ValidationAccept,
+			want:       pubsub.ValidationAccept,
+			wantCounts: &peerdata.GossipMessageCounts{Accepted: 1},
+		},
+		{
+			name: "validator rejected",
+			args: args{
+				topic: "foo",
+				v: func(ctx context.Context, id peer.ID, message *pubsub.Message) pubsub.ValidationResult {
+					return pubsub.ValidationReject
+				},
+				chainstarted: true,
+				msg: &pubsub.Message{
+					Message: &pubsubpb.Message{
+						Topic: func() *string {
+							s := "foo"
+							return &s
+						}(),
+					},
+				},
+			},
+			want:       pubsub.ValidationReject,
+			wantCounts: &peerdata.GossipMessageCounts{Rejected: 1},
 		},
 		{
 			name: "nil topic",
@@ -340,7 +365,8 @@ func Test_wrapAndReportValidation(t *testing.T) {
 					},
 				},
 			},
-			want: pubsub.ValidationReject,
+			want:       pubsub.ValidationReject,
+			wantCounts: &peerdata.GossipMessageCounts{Rejected: 1},
 		},
 	}
 	for _, tt := range tests {
@@ -348,6 +374,7 @@ func Test_wrapAndReportValidation(t *testing.T) {
 			chainStarted := abool.New()
 			chainStarted.SetTo(tt.args.chainstarted)
 			s := &Service{
+				p2p:          p2ptest.NewTestP2P(t),
 				chainStarted: chainStarted,
 			}
 			_, v := s.wrapAndReportValidation(tt.args.topic, tt.args.v)
@@ -355,6 +382,33 @@ func Test_wrapAndReportValidation(t *testing.T) {
 			if got != tt.want {
 				t.Errorf("wrapAndReportValidation() got = %v, want %v", got, tt.want)
 			}
+			if tt.wantCounts != nil {
+				counts, err := s.p2p.Peers().Scorers().GossipScorer().MessageCounts(tt.args.pid, tt.args.topic)
+				require.NoError(t, err)
+				assert.DeepEqual(t, tt.wantCounts, counts)
+			}
 		})
 	}
 }
+
+func Test_wrapAndReportValidation_OwnMessage(t *testing.T) {
+	chainStarted := abool.New()
+	chainStarted.Set()
+	p := p2ptest.NewTestP2P(t)
+	s := &Service{
+		p2p:          p,
+		chainStarted: chainStarted,
+	}
+	topic := "foo"
+	_, v := s.wrapAndReportValidation(topic, func(ctx context.Context, id peer.ID, message *pubsub.Message) pubsub.ValidationResult {
+		return pubsub.ValidationAccept
+	})
+	msg := &pubsub.Message{
+		Message: &pubsubpb.Message{
+			Topic: &topic,
+		},
+	}
+	assert.Equal(t, pubsub.ValidationAccept, v(context.Background(), p.PeerID(), msg))
+	_, err := p.Peers().Scorers().GossipScorer().MessageCounts(p.PeerID(), topic)
+	assert.ErrorContains(t, peerdata.ErrPeerUnknown.Error(), err, "Own messages should not be scored")
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/pkg/errors"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
@@ -59,33 +60,72 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 		return pubsub.ValidationReject
 	}
 
-	if att.Data == nil {
-		return pubsub.ValidationReject
+	validationRes, err := s.validateBeaconAttestation(ctx, att, originalTopic)
+	if err != nil {
+		log.WithError(err).Debug("Could not validate attestation")
+		traceutil.AnnotateError(span, err)
+	}
+	if validationRes != pubsub.ValidationAccept {
+		return validationRes
+	}
+
+	msg.ValidatorData = att
+
+	return pubsub.ValidationAccept
+}
+
+// ValidateAttestation runs the gossip validation of an unaggregated attestation which was not
+// received over gossip, e.g. one submitted over the API, returning an error describing the
+// failed condition unless the attestation is accepted. The subnet topic is not checked, as
+// such an attestation is published on the subnet computed from it.
+func (s *Service) ValidateAttestation(ctx context.Context, att *eth.Attestation) error {
+	if s.initialSync.Syncing() {
+		return errors.New("node is syncing")
+	}
+	ctx, span := trace.StartSpan(ctx, "sync.ValidateAttestation")
+	defer span.End()
+
+	validationRes, err := s.validateBeaconAttestation(ctx, att, nil /* topic */)
+	if validationRes == pubsub.ValidationAccept {
+		return nil
+	}
+	if err == nil {
+		err = errors.New("attestation did not pass validation")
+	}
+	traceutil.AnnotateError(span, err)
+	return err
+}
+
+// validateBeaconAttestation validates a decoded unaggregated attestation, received on the given
+// topic, if any. An error describing the failed condition is returned along with any result
+// other than accept.
+func (s *Service) validateBeaconAttestation(ctx context.Context, att *eth.Attestation, topic *string) (pubsub.ValidationResult, error) {
+	if att.Data == nil || att.Data.Source == nil || att.Data.Target == nil {
+		return pubsub.ValidationReject, errors.New("attestation data must not be empty")
 	}
 	// Attestation aggregation bits must exist.
 	if att.AggregationBits == nil {
-		return pubsub.ValidationReject
+		return pubsub.ValidationReject, errors.New("aggregation bits must not be empty")
 	}
 
 	// Attestation's slot is within ATTESTATION_PROPAGATION_SLOT_RANGE.
 	if err := helpers.ValidateAttestationTime(att.Data.Slot, s.chain.GenesisTime()); err != nil {
-		traceutil.AnnotateError(span, err)
-		return pubsub.ValidationIgnore
+		return pubsub.ValidationIgnore, err
 	}
 	if helpers.SlotToEpoch(att.Data.Slot) != att.Data.Target.Epoch {
-		return pubsub.ValidationReject
+		return pubsub.ValidationReject, fmt.Errorf("target epoch %d does not match attestation slot %d", att.Data.Target.Epoch, att.Data.Slot)
 	}
 
 	// Verify this the first attestation received for the participating validator for the slot.
 	if s.hasSeenCommitteeIndicesSlot(att.Data.Slot, att.Data.CommitteeIndex, att.AggregationBits) {
-		return pubsub.ValidationIgnore
+		return pubsub.ValidationIgnore, errors.New("attestation was already seen for the participating validator")
 	}
 
 	// Reject an attestation if it references an invalid block.
 	if s.hasBadBlock(bytesutil.ToBytes32(att.Data.BeaconBlockRoot)) ||
 		s.hasBadBlock(bytesutil.ToBytes32(att.Data.Target.Root)) ||
 		s.hasBadBlock(bytesutil.ToBytes32(att.Data.Source.Root)) {
-		return pubsub.ValidationReject
+		return pubsub.ValidationReject, errors.New("attestation references an invalid block")
 	}
 
 	// Verify the block being voted and the processed state is in DB and. The block should have passed validation if it's in the DB.
@@ -93,44 +133,41 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 	if !s.hasBlockAndState(ctx, blockRoot) {
 		// A node doesn't have the block, it'll request from peer while saving the pending attestation to a queue.
 		s.savePendingAtt(&eth.SignedAggregateAttestationAndProof{Message: &eth.AggregateAttestationAndProof{Aggregate: att}})
-		return pubsub.ValidationIgnore
+		return pubsub.ValidationIgnore, fmt.Errorf("block %#x is not known yet", blockRoot)
 	}
 
 	if err := s.chain.VerifyFinalizedConsistency(ctx, att.Data.BeaconBlockRoot); err != nil {
-		traceutil.AnnotateError(span, err)
-		return pubsub.ValidationReject
+		return pubsub.ValidationReject, err
 	}
 	if err := s.chain.VerifyLmdFfgConsistency(ctx, att); err != nil {
-		traceutil.AnnotateError(span, err)
-		return pubsub.ValidationReject
+		return pubsub.ValidationReject, err
 	}
 
 	preState, err := s.chain.AttestationPreState(ctx, att)
 	if err != nil {
 		log.WithError(err).Error("Could not to retrieve pre state")
-		traceutil.AnnotateError(span, err)
-		return pubsub.ValidationIgnore
+		return pubsub.ValidationIgnore, err
 	}
 
-	validationRes := s.validateUnaggregatedAttTopic(ctx, att, preState, *originalTopic)
-	if validationRes != pubsub.ValidationAccept {
-		return validationRes
+	if topic != nil {
+		validationRes, err := s.validateUnaggregatedAttTopic(ctx, att, preState, *topic)
+		if validationRes != pubsub.ValidationAccept {
+			return validationRes, err
+		}
 	}
 
-	validationRes = s.validateUnaggregatedAttWithState(ctx, att, preState)
+	validationRes, err := s.validateUnaggregatedAttWithState(ctx, att, preState)
 	if validationRes != pubsub.ValidationAccept {
-		return validationRes
+		return validationRes, err
 	}
 
 	s.setSeenCommitteeIndicesSlot(att.Data.Slot, att.Data.CommitteeIndex, att.AggregationBits)
 
-	msg.ValidatorData = att
-
-	return pubsub.ValidationAccept
+	return pubsub.ValidationAccept, nil
 }
 
 // This validates beacon unaggregated attestation has correct topic string.
-func (s *Service) validateUnaggregatedAttTopic(ctx context.Context, a *eth.Attestation, bs *state.BeaconState, t string) pubsub.ValidationResult {
+func (s *Service) validateUnaggregatedAttTopic(ctx context.Context, a *eth.Attestation, bs *state.BeaconState, t string) (pubsub.ValidationResult, error) {
 	ctx, span := trace.StartSpan(ctx, "sync.validateUnaggregatedAttTopic")
 	defer span.End()
 
@@ -138,11 +175,11 @@ func (s *Service) validateUnaggregatedAttTopic(ctx context.Context, a *eth.Attes
 	if err != nil {
 		log.WithError(err).Error("Could not retrieve active validator count")
 		traceutil.AnnotateError(span, err)
-		return pubsub.ValidationIgnore
+		return pubsub.ValidationIgnore, err
 	}
 	count := helpers.SlotCommitteeCount(valCount)
 	if a.Data.CommitteeIndex > count {
-		return pubsub.ValidationReject
+		return pubsub.ValidationReject, fmt.Errorf("committee index %d is out of range", a.Data.CommitteeIndex)
 	}
 	subnet := helpers.ComputeSubnetForAttestation(valCount, a)
 	format := p2p.GossipTypeMapping[reflect.TypeOf(&eth.Attestation{})]
@@ -150,46 +187,46 @@ func (s *Service) validateUnaggregatedAttTopic(ctx context.Context, a *eth.Attes
 	if err != nil {
 		log.WithError(err).Error("Could not compute fork digest")
 		traceutil.AnnotateError(span, err)
-		return pubsub.ValidationIgnore
+		return pubsub.ValidationIgnore, err
 	}
 	if !strings.HasPrefix(t, fmt.Sprintf(format, digest, subnet)) {
-		return pubsub.ValidationReject
+		return pubsub.ValidationReject, fmt.Errorf("attestation was received on topic %s instead of subnet %d", t, subnet)
 	}
 
-	return pubsub.ValidationAccept
+	return pubsub.ValidationAccept, nil
 }
 
 // This validates beacon unaggregated attestation using the given state, the validation consists of bitfield length and count consistency
 // and signature verification.
-func (s *Service) validateUnaggregatedAttWithState(ctx context.Context, a *eth.Attestation, bs *state.BeaconState) pubsub.ValidationResult {
+func (s *Service) validateUnaggregatedAttWithState(ctx context.Context, a *eth.Attestation, bs *state.BeaconState) (pubsub.ValidationResult, error) {
 	ctx, span := trace.StartSpan(ctx, "sync.validateUnaggregatedAttWithState")
 	defer span.End()
 
 	committee, err := helpers.BeaconCommitteeFromState(bs, a.Data.Slot, a.Data.CommitteeIndex)
 	if err != nil {
 		traceutil.AnnotateError(span, err)
-		return pubsub.ValidationIgnore
+		return pubsub.ValidationIgnore, err
 	}
 
 	// Verify number of aggregation bits matches the committee size.
 	if err := helpers.VerifyBitfieldLength(a.AggregationBits, uint64(len(committee))); err != nil {
-		return pubsub.ValidationReject
+		return pubsub.ValidationReject, err
 	}
 
 	// Attestation must be unaggregated and the bit index must exist in the range of committee indices.
 	// Note: eth2 spec suggests (len(get_attesting_indices(state, attestation.data, attestation.aggregation_bits)) == 1)
 	// however this validation can be achieved without use of get_attesting_indices which is an O(n) lookup.
 	if a.AggregationBits.Count() != 1 || a.AggregationBits.BitIndices()[0] >= len(committee) {
-		return pubsub.ValidationReject
+		return pubsub.ValidationReject, errors.New("attestation must have exactly one participating validator")
 	}
 
 	if err := blocks.VerifyAttestationSignature(ctx, bs, a); err != nil {
 		log.WithError(err).Debug("Could not verify attestation")
 		traceutil.AnnotateError(span, err)
-		return pubsub.ValidationReject
+		return pubsub.ValidationReject, err
 	}
 
-	return pubsub.ValidationAccept
+	return pubsub.ValidationAccept, nil
 }
 
 // Returns true if the attestation was already seen for the participating validator for the slot.
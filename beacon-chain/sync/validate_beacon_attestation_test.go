@@ -20,6 +20,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
 
@@ -290,3 +291,67 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 		})
 	}
 }
+
+func TestService_ValidateAttestation(t *testing.T) {
+	ctx := context.Background()
+	p := p2ptest.NewTestP2P(t)
+	db := dbtest.SetupDB(t)
+	chain := &mockChain.ChainService{
+		// 1 slot ago.
+		Genesis:          time.Now().Add(time.Duration(-1*int64(params.BeaconConfig().SecondsPerSlot)) * time.Second),
+		ValidatorsRoot:   [32]byte{'A'},
+		ValidAttestation: true,
+	}
+	c, err := lru.New(10)
+	require.NoError(t, err)
+	s := &Service{
+		initialSync:          &mockSync.Sync{IsSyncing: false},
+		p2p:                  p,
+		db:                   db,
+		chain:                chain,
+		blkRootToPendingAtts: make(map[[32]byte][]*ethpb.SignedAggregateAttestationAndProof),
+		seenAttestationCache: c,
+	}
+	require.NoError(t, s.initCaches())
+
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 1
+	require.NoError(t, db.SaveBlock(ctx, blk))
+	validBlockRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	chain.FinalizedCheckPoint = &ethpb.Checkpoint{Root: validBlockRoot[:]}
+	savedState, keys := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, savedState.SetSlot(1))
+	require.NoError(t, db.SaveState(ctx, savedState, validBlockRoot))
+	chain.State = savedState
+
+	helpers.ClearCache()
+	att := &ethpb.Attestation{
+		AggregationBits: bitfield.Bitlist{0b101},
+		Data: &ethpb.AttestationData{
+			BeaconBlockRoot: validBlockRoot[:],
+			Slot:            1,
+			Target:          &ethpb.Checkpoint{Root: validBlockRoot[:]},
+			Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+		},
+	}
+	com, err := helpers.BeaconCommitteeFromState(savedState, att.Data.Slot, att.Data.CommitteeIndex)
+	require.NoError(t, err)
+	domain, err := helpers.Domain(savedState.Fork(), att.Data.Target.Epoch, params.BeaconConfig().DomainBeaconAttester, savedState.GenesisValidatorRoot())
+	require.NoError(t, err)
+	attRoot, err := helpers.ComputeSigningRoot(att.Data, domain)
+	require.NoError(t, err)
+
+	aggregated := *att
+	aggregated.AggregationBits = bitfield.Bitlist{0b111}
+	aggregated.Signature = keys[com[0]].Sign(attRoot[:]).Marshal()
+	assert.ErrorContains(t, "exactly one participating validator", s.ValidateAttestation(ctx, &aggregated))
+
+	att.Signature = keys[com[0]].Sign(attRoot[:]).Marshal()
+	require.NoError(t, s.ValidateAttestation(ctx, att))
+	// The attestation is seen once validated.
+	assert.ErrorContains(t, "already seen", s.ValidateAttestation(ctx, att))
+
+	s.initialSync = &mockSync.Sync{IsSyncing: true}
+	assert.ErrorContains(t, "node is syncing", s.ValidateAttestation(ctx, att))
+}
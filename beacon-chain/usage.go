@@ -116,6 +116,9 @@ var appHelpFlagGroups = []flagGroup{
 			flags.NetworkID,
 			flags.WeakSubjectivityCheckpt,
 			flags.Eth1HeaderReqLimit,
+			flags.CheckpointBlock,
+			flags.CheckpointState,
+			flags.CheckpointSyncProvider,
 		},
 	},
 	{
@@ -130,8 +133,11 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.P2PAllowList,
 			cmd.P2PDenyList,
 			cmd.StaticPeers,
+			cmd.TrustedPeers,
 			cmd.EnableUPnPFlag,
 			flags.MinSyncPeers,
+			flags.RPCTraceFile,
+			flags.RPCTraceMaxFileSize,
 		},
 	},
 	{
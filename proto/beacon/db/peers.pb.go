@@ -0,0 +1,1072 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/db/peers.proto
+
+package db
+
+import (
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type PeerRecord struct {
+	PeerId               []byte                          `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Address              []byte                          `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Enr                  []byte                          `protobuf:"bytes,3,opt,name=enr,proto3" json:"enr,omitempty"`
+	NextValidTime        int64                           `protobuf:"varint,4,opt,name=next_valid_time,json=nextValidTime,proto3" json:"next_valid_time,omitempty"`
+	BadResponses         uint64                          `protobuf:"varint,5,opt,name=bad_responses,json=badResponses,proto3" json:"bad_responses,omitempty"`
+	ProcessedBlocks      uint64                          `protobuf:"varint,6,opt,name=processed_blocks,json=processedBlocks,proto3" json:"processed_blocks,omitempty"`
+	BlockProviderUpdated int64                           `protobuf:"varint,7,opt,name=block_provider_updated,json=blockProviderUpdated,proto3" json:"block_provider_updated,omitempty"`
+	Trusted              bool                            `protobuf:"varint,8,opt,name=trusted,proto3" json:"trusted,omitempty"`
+	Banned               bool                            `protobuf:"varint,9,opt,name=banned,proto3" json:"banned,omitempty"`
+	GossipMessages       map[string]*GossipMessageCounts `protobuf:"bytes,10,rep,name=gossip_messages,json=gossipMessages,proto3" json:"gossip_messages,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *PeerRecord) Reset()         { *m = PeerRecord{} }
+func (m *PeerRecord) String() string { return proto.CompactTextString(m) }
+func (*PeerRecord) ProtoMessage()    {}
+func (*PeerRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751f31d42dc01314, []int{0}
+}
+func (m *PeerRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeerRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeerRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeerRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerRecord.Merge(m, src)
+}
+func (m *PeerRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeerRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerRecord proto.InternalMessageInfo
+
+func (m *PeerRecord) GetPeerId() []byte {
+	if m != nil {
+		return m.PeerId
+	}
+	return nil
+}
+
+func (m *PeerRecord) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *PeerRecord) GetEnr() []byte {
+	if m != nil {
+		return m.Enr
+	}
+	return nil
+}
+
+func (m *PeerRecord) GetNextValidTime() int64 {
+	if m != nil {
+		return m.NextValidTime
+	}
+	return 0
+}
+
+func (m *PeerRecord) GetBadResponses() uint64 {
+	if m != nil {
+		return m.BadResponses
+	}
+	return 0
+}
+
+func (m *PeerRecord) GetProcessedBlocks() uint64 {
+	if m != nil {
+		return m.ProcessedBlocks
+	}
+	return 0
+}
+
+func (m *PeerRecord) GetBlockProviderUpdated() int64 {
+	if m != nil {
+		return m.BlockProviderUpdated
+	}
+	return 0
+}
+
+func (m *PeerRecord) GetTrusted() bool {
+	if m != nil {
+		return m.Trusted
+	}
+	return false
+}
+
+func (m *PeerRecord) GetBanned() bool {
+	if m != nil {
+		return m.Banned
+	}
+	return false
+}
+
+func (m *PeerRecord) GetGossipMessages() map[string]*GossipMessageCounts {
+	if m != nil {
+		return m.GossipMessages
+	}
+	return nil
+}
+
+type GossipMessageCounts struct {
+	Accepted             float64  `protobuf:"fixed64,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	Ignored              float64  `protobuf:"fixed64,2,opt,name=ignored,proto3" json:"ignored,omitempty"`
+	Rejected             float64  `protobuf:"fixed64,3,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GossipMessageCounts) Reset()         { *m = GossipMessageCounts{} }
+func (m *GossipMessageCounts) String() string { return proto.CompactTextString(m) }
+func (*GossipMessageCounts) ProtoMessage()    {}
+func (*GossipMessageCounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751f31d42dc01314, []int{1}
+}
+func (m *GossipMessageCounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GossipMessageCounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GossipMessageCounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GossipMessageCounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GossipMessageCounts.Merge(m, src)
+}
+func (m *GossipMessageCounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *GossipMessageCounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_GossipMessageCounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GossipMessageCounts proto.InternalMessageInfo
+
+func (m *GossipMessageCounts) GetAccepted() float64 {
+	if m != nil {
+		return m.Accepted
+	}
+	return 0
+}
+
+func (m *GossipMessageCounts) GetIgnored() float64 {
+	if m != nil {
+		return m.Ignored
+	}
+	return 0
+}
+
+func (m *GossipMessageCounts) GetRejected() float64 {
+	if m != nil {
+		return m.Rejected
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PeerRecord)(nil), "prysm.beacon.db.PeerRecord")
+	proto.RegisterMapType((map[string]*GossipMessageCounts)(nil), "prysm.beacon.db.PeerRecord.GossipMessagesEntry")
+	proto.RegisterType((*GossipMessageCounts)(nil), "prysm.beacon.db.GossipMessageCounts")
+}
+
+func init() { proto.RegisterFile("proto/beacon/db/peers.proto", fileDescriptor_751f31d42dc01314) }
+
+var fileDescriptor_751f31d42dc01314 = []byte{
+	// 442 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xcf, 0x6a, 0xd4, 0x40,
+	0x1c, 0x66, 0x9a, 0x76, 0xbb, 0x9d, 0xb6, 0x6e, 0x19, 0xa5, 0x0e, 0x15, 0x96, 0x50, 0x45, 0xe2,
+	0x25, 0x81, 0xea, 0x41, 0x8a, 0xa7, 0x8a, 0x88, 0x07, 0xa1, 0x0c, 0x2a, 0xe2, 0x25, 0xcc, 0x64,
+	0x7e, 0xc4, 0xd8, 0x4d, 0x66, 0x98, 0xdf, 0x64, 0x71, 0xdf, 0xd0, 0xa3, 0x8f, 0x20, 0xfb, 0x0c,
+	0x3e, 0x80, 0xcc, 0x64, 0x53, 0xe9, 0xea, 0x6d, 0xbe, 0x7f, 0xf9, 0x92, 0x6f, 0x42, 0x1f, 0x59,
+	0x67, 0xbc, 0x29, 0x14, 0xc8, 0xca, 0x74, 0x85, 0x56, 0x85, 0x05, 0x70, 0x98, 0x47, 0x96, 0xcd,
+	0xac, 0x5b, 0x61, 0x9b, 0x0f, 0x62, 0xae, 0xd5, 0xf9, 0xef, 0x84, 0xd2, 0x6b, 0x00, 0x27, 0xa0,
+	0x32, 0x4e, 0xb3, 0x87, 0x74, 0x3f, 0xd8, 0xcb, 0x46, 0x73, 0x92, 0x92, 0xec, 0x48, 0x4c, 0x02,
+	0x7c, 0xa7, 0x19, 0xa7, 0xfb, 0x52, 0x6b, 0x07, 0x88, 0x7c, 0x27, 0x0a, 0x23, 0x64, 0x27, 0x34,
+	0x81, 0xce, 0xf1, 0x24, 0xb2, 0xe1, 0xc8, 0x9e, 0xd2, 0x59, 0x07, 0xdf, 0x7d, 0xb9, 0x94, 0x8b,
+	0x46, 0x97, 0xbe, 0x69, 0x81, 0xef, 0xa6, 0x24, 0x4b, 0xc4, 0x71, 0xa0, 0x3f, 0x05, 0xf6, 0x43,
+	0xd3, 0x02, 0x7b, 0x4c, 0x8f, 0x95, 0xd4, 0xa5, 0x03, 0xb4, 0xa6, 0x43, 0x40, 0xbe, 0x97, 0x92,
+	0x6c, 0x57, 0x1c, 0x29, 0xa9, 0xc5, 0xc8, 0xb1, 0x67, 0xf4, 0xc4, 0x3a, 0x53, 0x01, 0x22, 0xe8,
+	0x52, 0x2d, 0x4c, 0x75, 0x83, 0x7c, 0x12, 0x7d, 0xb3, 0x5b, 0xfe, 0x2a, 0xd2, 0xec, 0x05, 0x3d,
+	0x8d, 0x86, 0xd2, 0x3a, 0xb3, 0x6c, 0x34, 0xb8, 0xb2, 0xb7, 0x5a, 0x7a, 0xd0, 0x7c, 0x3f, 0xd6,
+	0x3f, 0x88, 0xea, 0xf5, 0x46, 0xfc, 0x38, 0x68, 0xe1, 0xcb, 0xbc, 0xeb, 0x31, 0xd8, 0xa6, 0x29,
+	0xc9, 0xa6, 0x62, 0x84, 0xec, 0x94, 0x4e, 0x94, 0xec, 0x3a, 0xd0, 0xfc, 0x20, 0x0a, 0x1b, 0xc4,
+	0x3e, 0xd3, 0x59, 0x6d, 0x10, 0x1b, 0x5b, 0xb6, 0x80, 0x28, 0x6b, 0x40, 0x4e, 0xd3, 0x24, 0x3b,
+	0xbc, 0x28, 0xf2, 0xad, 0x79, 0xf3, 0xbf, 0xd3, 0xe6, 0x6f, 0x63, 0xe4, 0xfd, 0x26, 0xf1, 0xa6,
+	0xf3, 0x6e, 0x25, 0xee, 0xd5, 0x77, 0xc8, 0xb3, 0x9a, 0xde, 0xff, 0x8f, 0x2d, 0x4c, 0x7c, 0x03,
+	0xab, 0x78, 0x23, 0x07, 0x22, 0x1c, 0xd9, 0x25, 0xdd, 0x5b, 0xca, 0x45, 0x0f, 0xf1, 0x32, 0x0e,
+	0x2f, 0x9e, 0xfc, 0x53, 0x7c, 0xe7, 0x31, 0xaf, 0x4d, 0xdf, 0x79, 0x14, 0x43, 0xe4, 0x72, 0xe7,
+	0x25, 0x39, 0xdf, 0x2e, 0x1a, 0x1c, 0xec, 0x8c, 0x4e, 0x65, 0x55, 0x81, 0x0d, 0x63, 0x84, 0x36,
+	0x22, 0x6e, 0x71, 0xd8, 0xa9, 0xa9, 0x3b, 0xe3, 0x40, 0xc7, 0x52, 0x22, 0x46, 0x18, 0x52, 0x0e,
+	0xbe, 0x41, 0x15, 0x52, 0xc9, 0x90, 0x1a, 0xf1, 0xd5, 0xab, 0x1f, 0xeb, 0x39, 0xf9, 0xb9, 0x9e,
+	0x93, 0x5f, 0xeb, 0x39, 0xf9, 0x92, 0xd7, 0x8d, 0xff, 0xda, 0xab, 0xbc, 0x32, 0x6d, 0x11, 0xdf,
+	0x58, 0xfa, 0xa6, 0x5a, 0x48, 0x85, 0x03, 0x2a, 0xb6, 0x7e, 0x5d, 0x35, 0x89, 0xc4, 0xf3, 0x3f,
+	0x03, 0x00, 0xff, 0x86, 0x92, 0x6b, 0xd4, 0x02, 0x00, 0x00,
+}
+
+func (m *PeerRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.GossipMessages) > 0 {
+		for k := range m.GossipMessages {
+			v := m.GossipMessages[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintPeers(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPeers(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPeers(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Banned {
+		i--
+		if m.Banned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Trusted {
+		i--
+		if m.Trusted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BlockProviderUpdated != 0 {
+		i = encodeVarintPeers(dAtA, i, uint64(m.BlockProviderUpdated))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ProcessedBlocks != 0 {
+		i = encodeVarintPeers(dAtA, i, uint64(m.ProcessedBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BadResponses != 0 {
+		i = encodeVarintPeers(dAtA, i, uint64(m.BadResponses))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NextValidTime != 0 {
+		i = encodeVarintPeers(dAtA, i, uint64(m.NextValidTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Enr) > 0 {
+		i -= len(m.Enr)
+		copy(dAtA[i:], m.Enr)
+		i = encodeVarintPeers(dAtA, i, uint64(len(m.Enr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPeers(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PeerId) > 0 {
+		i -= len(m.PeerId)
+		copy(dAtA[i:], m.PeerId)
+		i = encodeVarintPeers(dAtA, i, uint64(len(m.PeerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GossipMessageCounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GossipMessageCounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GossipMessageCounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Rejected != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Rejected))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.Ignored != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Ignored))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.Accepted != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Accepted))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPeers(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPeers(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PeerRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PeerId)
+	if l > 0 {
+		n += 1 + l + sovPeers(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPeers(uint64(l))
+	}
+	l = len(m.Enr)
+	if l > 0 {
+		n += 1 + l + sovPeers(uint64(l))
+	}
+	if m.NextValidTime != 0 {
+		n += 1 + sovPeers(uint64(m.NextValidTime))
+	}
+	if m.BadResponses != 0 {
+		n += 1 + sovPeers(uint64(m.BadResponses))
+	}
+	if m.ProcessedBlocks != 0 {
+		n += 1 + sovPeers(uint64(m.ProcessedBlocks))
+	}
+	if m.BlockProviderUpdated != 0 {
+		n += 1 + sovPeers(uint64(m.BlockProviderUpdated))
+	}
+	if m.Trusted {
+		n += 2
+	}
+	if m.Banned {
+		n += 2
+	}
+	if len(m.GossipMessages) > 0 {
+		for k, v := range m.GossipMessages {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPeers(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovPeers(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovPeers(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GossipMessageCounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Accepted != 0 {
+		n += 9
+	}
+	if m.Ignored != 0 {
+		n += 9
+	}
+	if m.Rejected != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovPeers(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPeers(x uint64) (n int) {
+	return sovPeers(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PeerRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPeers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPeers
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerId = append(m.PeerId[:0], dAtA[iNdEx:postIndex]...)
+			if m.PeerId == nil {
+				m.PeerId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPeers
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPeers
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Enr = append(m.Enr[:0], dAtA[iNdEx:postIndex]...)
+			if m.Enr == nil {
+				m.Enr = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextValidTime", wireType)
+			}
+			m.NextValidTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextValidTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadResponses", wireType)
+			}
+			m.BadResponses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BadResponses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedBlocks", wireType)
+			}
+			m.ProcessedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProcessedBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockProviderUpdated", wireType)
+			}
+			m.BlockProviderUpdated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockProviderUpdated |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trusted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Trusted = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Banned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Banned = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GossipMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPeers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GossipMessages == nil {
+				m.GossipMessages = make(map[string]*GossipMessageCounts)
+			}
+			var mapkey string
+			var mapvalue *GossipMessageCounts
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPeers
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPeers
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPeers
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPeers
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPeers
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPeers
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPeers
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &GossipMessageCounts{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPeers(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPeers
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.GossipMessages[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPeers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPeers
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPeers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GossipMessageCounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPeers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GossipMessageCounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GossipMessageCounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Accepted = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ignored", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Ignored = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rejected", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Rejected = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPeers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPeers
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPeers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPeers(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPeers
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPeers
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPeers
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPeers
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPeers
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPeers        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPeers          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPeers = fmt.Errorf("proto: unexpected end of group")
+)
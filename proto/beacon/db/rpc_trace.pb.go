@@ -0,0 +1,756 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/db/rpc_trace.proto
+
+package db
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type RPCTraceRecord_Direction int32
+
+const (
+	RPCTraceRecord_INBOUND  RPCTraceRecord_Direction = 0
+	RPCTraceRecord_OUTBOUND RPCTraceRecord_Direction = 1
+)
+
+var RPCTraceRecord_Direction_name = map[int32]string{
+	0: "INBOUND",
+	1: "OUTBOUND",
+}
+
+var RPCTraceRecord_Direction_value = map[string]int32{
+	"INBOUND":  0,
+	"OUTBOUND": 1,
+}
+
+func (x RPCTraceRecord_Direction) String() string {
+	return proto.EnumName(RPCTraceRecord_Direction_name, int32(x))
+}
+
+func (RPCTraceRecord_Direction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_57cfec15e95bc524, []int{0, 0}
+}
+
+type RPCTraceRecord struct {
+	Direction            RPCTraceRecord_Direction `protobuf:"varint,1,opt,name=direction,proto3,enum=prysm.beacon.db.RPCTraceRecord_Direction" json:"direction,omitempty"`
+	PeerId               string                   `protobuf:"bytes,2,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	ProtocolId           string                   `protobuf:"bytes,3,opt,name=protocol_id,json=protocolId,proto3" json:"protocol_id,omitempty"`
+	StartTime            int64                    `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	Duration             int64                    `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	Request              []byte                   `protobuf:"bytes,6,opt,name=request,proto3" json:"request,omitempty"`
+	Response             []byte                   `protobuf:"bytes,7,opt,name=response,proto3" json:"response,omitempty"`
+	ResponseCode         uint32                   `protobuf:"varint,8,opt,name=response_code,json=responseCode,proto3" json:"response_code,omitempty"`
+	Truncated            bool                     `protobuf:"varint,9,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	Error                string                   `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *RPCTraceRecord) Reset()         { *m = RPCTraceRecord{} }
+func (m *RPCTraceRecord) String() string { return proto.CompactTextString(m) }
+func (*RPCTraceRecord) ProtoMessage()    {}
+func (*RPCTraceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_57cfec15e95bc524, []int{0}
+}
+func (m *RPCTraceRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RPCTraceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RPCTraceRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RPCTraceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RPCTraceRecord.Merge(m, src)
+}
+func (m *RPCTraceRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *RPCTraceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RPCTraceRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RPCTraceRecord proto.InternalMessageInfo
+
+func (m *RPCTraceRecord) GetDirection() RPCTraceRecord_Direction {
+	if m != nil {
+		return m.Direction
+	}
+	return RPCTraceRecord_INBOUND
+}
+
+func (m *RPCTraceRecord) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
+func (m *RPCTraceRecord) GetProtocolId() string {
+	if m != nil {
+		return m.ProtocolId
+	}
+	return ""
+}
+
+func (m *RPCTraceRecord) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *RPCTraceRecord) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *RPCTraceRecord) GetRequest() []byte {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *RPCTraceRecord) GetResponse() []byte {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *RPCTraceRecord) GetResponseCode() uint32 {
+	if m != nil {
+		return m.ResponseCode
+	}
+	return 0
+}
+
+func (m *RPCTraceRecord) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
+func (m *RPCTraceRecord) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("prysm.beacon.db.RPCTraceRecord_Direction", RPCTraceRecord_Direction_name, RPCTraceRecord_Direction_value)
+	proto.RegisterType((*RPCTraceRecord)(nil), "prysm.beacon.db.RPCTraceRecord")
+}
+
+func init() { proto.RegisterFile("proto/beacon/db/rpc_trace.proto", fileDescriptor_57cfec15e95bc524) }
+
+var fileDescriptor_57cfec15e95bc524 = []byte{
+	// 349 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xcf, 0x8a, 0xdb, 0x30,
+	0x10, 0xc6, 0xab, 0xa6, 0x89, 0xed, 0xc9, 0x9f, 0x16, 0x51, 0xa8, 0x28, 0x6d, 0x62, 0x52, 0x28,
+	0xee, 0x45, 0x86, 0xf6, 0xda, 0x53, 0x12, 0x28, 0xb9, 0x24, 0x45, 0x24, 0x97, 0x5e, 0x8c, 0x2d,
+	0x0d, 0xad, 0x21, 0xb6, 0x5c, 0x59, 0x3e, 0xec, 0x03, 0xec, 0xbb, 0xed, 0x71, 0x1f, 0x61, 0xc9,
+	0x93, 0x2c, 0x96, 0x71, 0xc2, 0xe6, 0xe6, 0xdf, 0xf7, 0xcd, 0x0f, 0xc6, 0x23, 0x58, 0x54, 0x46,
+	0x5b, 0x1d, 0x67, 0x98, 0x4a, 0x5d, 0xc6, 0x2a, 0x8b, 0x4d, 0x25, 0x13, 0x6b, 0x52, 0x89, 0xdc,
+	0x35, 0xf4, 0x6d, 0x65, 0xee, 0xea, 0x82, 0x77, 0x03, 0x5c, 0x65, 0xcb, 0xfb, 0x01, 0xcc, 0xc4,
+	0xef, 0xf5, 0xa1, 0x9d, 0x11, 0x28, 0xb5, 0x51, 0xf4, 0x17, 0x04, 0x2a, 0x37, 0x28, 0x6d, 0xae,
+	0x4b, 0x46, 0x42, 0x12, 0xcd, 0xbe, 0x7f, 0xe3, 0x37, 0x1e, 0x7f, 0xe9, 0xf0, 0x4d, 0x2f, 0x88,
+	0xab, 0x4b, 0x3f, 0x80, 0x57, 0x21, 0x9a, 0x24, 0x57, 0xec, 0x75, 0x48, 0xa2, 0x40, 0x8c, 0x5a,
+	0xdc, 0x2a, 0xba, 0x80, 0xb1, 0x5b, 0x47, 0xea, 0x53, 0x5b, 0x0e, 0x5c, 0x09, 0x7d, 0xb4, 0x55,
+	0xf4, 0x33, 0x40, 0x6d, 0x53, 0x63, 0x13, 0x9b, 0x17, 0xc8, 0xde, 0x84, 0x24, 0x1a, 0x88, 0xc0,
+	0x25, 0x87, 0xbc, 0x40, 0xfa, 0x11, 0x7c, 0xd5, 0x98, 0xd4, 0x2d, 0x38, 0x74, 0xe5, 0x85, 0x29,
+	0x03, 0xcf, 0xe0, 0xff, 0x06, 0x6b, 0xcb, 0x46, 0x21, 0x89, 0x26, 0xa2, 0xc7, 0xd6, 0x32, 0x58,
+	0x57, 0xba, 0xac, 0x91, 0x79, 0xae, 0xba, 0x30, 0xfd, 0x02, 0xd3, 0xfe, 0x3b, 0x91, 0x5a, 0x21,
+	0xf3, 0x43, 0x12, 0x4d, 0xc5, 0xa4, 0x0f, 0xd7, 0x5a, 0x21, 0xfd, 0x04, 0x81, 0x35, 0x4d, 0x29,
+	0x53, 0x8b, 0x8a, 0x05, 0x21, 0x89, 0x7c, 0x71, 0x0d, 0xe8, 0x7b, 0x18, 0xa2, 0x31, 0xda, 0x30,
+	0x70, 0xbf, 0xd3, 0xc1, 0xf2, 0x2b, 0x04, 0x97, 0xdb, 0xd0, 0x31, 0x78, 0xdb, 0xdd, 0x6a, 0x7f,
+	0xdc, 0x6d, 0xde, 0xbd, 0xa2, 0x13, 0xf0, 0xf7, 0xc7, 0x43, 0x47, 0x64, 0xf5, 0xf3, 0xe1, 0x3c,
+	0x27, 0x8f, 0xe7, 0x39, 0x79, 0x3a, 0xcf, 0xc9, 0x1f, 0xfe, 0x37, 0xb7, 0xff, 0x9a, 0x8c, 0x4b,
+	0x5d, 0xc4, 0xee, 0xf2, 0xa9, 0xcd, 0xe5, 0x29, 0xcd, 0xea, 0x8e, 0xe2, 0x9b, 0x67, 0xce, 0x46,
+	0x2e, 0xf8, 0xf1, 0x3c, 0x00, 0xb2, 0x70, 0xae, 0x84, 0x00, 0x02, 0x00, 0x00,
+}
+
+func (m *RPCTraceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RPCTraceRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RPCTraceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintRpcTrace(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ResponseCode != 0 {
+		i = encodeVarintRpcTrace(dAtA, i, uint64(m.ResponseCode))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Response) > 0 {
+		i -= len(m.Response)
+		copy(dAtA[i:], m.Response)
+		i = encodeVarintRpcTrace(dAtA, i, uint64(len(m.Response)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Request) > 0 {
+		i -= len(m.Request)
+		copy(dAtA[i:], m.Request)
+		i = encodeVarintRpcTrace(dAtA, i, uint64(len(m.Request)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Duration != 0 {
+		i = encodeVarintRpcTrace(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintRpcTrace(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProtocolId) > 0 {
+		i -= len(m.ProtocolId)
+		copy(dAtA[i:], m.ProtocolId)
+		i = encodeVarintRpcTrace(dAtA, i, uint64(len(m.ProtocolId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PeerId) > 0 {
+		i -= len(m.PeerId)
+		copy(dAtA[i:], m.PeerId)
+		i = encodeVarintRpcTrace(dAtA, i, uint64(len(m.PeerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Direction != 0 {
+		i = encodeVarintRpcTrace(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRpcTrace(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRpcTrace(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RPCTraceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Direction != 0 {
+		n += 1 + sovRpcTrace(uint64(m.Direction))
+	}
+	l = len(m.PeerId)
+	if l > 0 {
+		n += 1 + l + sovRpcTrace(uint64(l))
+	}
+	l = len(m.ProtocolId)
+	if l > 0 {
+		n += 1 + l + sovRpcTrace(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovRpcTrace(uint64(m.StartTime))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovRpcTrace(uint64(m.Duration))
+	}
+	l = len(m.Request)
+	if l > 0 {
+		n += 1 + l + sovRpcTrace(uint64(l))
+	}
+	l = len(m.Response)
+	if l > 0 {
+		n += 1 + l + sovRpcTrace(uint64(l))
+	}
+	if m.ResponseCode != 0 {
+		n += 1 + sovRpcTrace(uint64(m.ResponseCode))
+	}
+	if m.Truncated {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovRpcTrace(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovRpcTrace(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRpcTrace(x uint64) (n int) {
+	return sovRpcTrace(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RPCTraceRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcTrace
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RPCTraceRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RPCTraceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcTrace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= RPCTraceRecord_Direction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcTrace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcTrace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcTrace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcTrace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcTrace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcTrace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcTrace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcTrace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcTrace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcTrace
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcTrace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Request = append(m.Request[:0], dAtA[iNdEx:postIndex]...)
+			if m.Request == nil {
+				m.Request = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcTrace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcTrace
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcTrace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Response = append(m.Response[:0], dAtA[iNdEx:postIndex]...)
+			if m.Response == nil {
+				m.Response = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseCode", wireType)
+			}
+			m.ResponseCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcTrace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseCode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcTrace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncated = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcTrace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcTrace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcTrace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcTrace(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcTrace
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcTrace
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRpcTrace(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRpcTrace
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRpcTrace
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRpcTrace
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRpcTrace
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRpcTrace
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRpcTrace
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRpcTrace        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRpcTrace          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRpcTrace = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,675 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/db/subnets.proto
+
+package db
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type RandomSubnetAssignments struct {
+	Assignments          []*RandomSubnetAssignment `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *RandomSubnetAssignments) Reset()         { *m = RandomSubnetAssignments{} }
+func (m *RandomSubnetAssignments) String() string { return proto.CompactTextString(m) }
+func (*RandomSubnetAssignments) ProtoMessage()    {}
+func (*RandomSubnetAssignments) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54fa28873ec2e687, []int{0}
+}
+func (m *RandomSubnetAssignments) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RandomSubnetAssignments) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RandomSubnetAssignments.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RandomSubnetAssignments) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RandomSubnetAssignments.Merge(m, src)
+}
+func (m *RandomSubnetAssignments) XXX_Size() int {
+	return m.Size()
+}
+func (m *RandomSubnetAssignments) XXX_DiscardUnknown() {
+	xxx_messageInfo_RandomSubnetAssignments.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RandomSubnetAssignments proto.InternalMessageInfo
+
+func (m *RandomSubnetAssignments) GetAssignments() []*RandomSubnetAssignment {
+	if m != nil {
+		return m.Assignments
+	}
+	return nil
+}
+
+type RandomSubnetAssignment struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Subnets              []uint64 `protobuf:"varint,2,rep,packed,name=subnets,proto3" json:"subnets,omitempty"`
+	Expiration           int64    `protobuf:"varint,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RandomSubnetAssignment) Reset()         { *m = RandomSubnetAssignment{} }
+func (m *RandomSubnetAssignment) String() string { return proto.CompactTextString(m) }
+func (*RandomSubnetAssignment) ProtoMessage()    {}
+func (*RandomSubnetAssignment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54fa28873ec2e687, []int{1}
+}
+func (m *RandomSubnetAssignment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RandomSubnetAssignment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RandomSubnetAssignment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RandomSubnetAssignment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RandomSubnetAssignment.Merge(m, src)
+}
+func (m *RandomSubnetAssignment) XXX_Size() int {
+	return m.Size()
+}
+func (m *RandomSubnetAssignment) XXX_DiscardUnknown() {
+	xxx_messageInfo_RandomSubnetAssignment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RandomSubnetAssignment proto.InternalMessageInfo
+
+func (m *RandomSubnetAssignment) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *RandomSubnetAssignment) GetSubnets() []uint64 {
+	if m != nil {
+		return m.Subnets
+	}
+	return nil
+}
+
+func (m *RandomSubnetAssignment) GetExpiration() int64 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*RandomSubnetAssignments)(nil), "prysm.beacon.db.RandomSubnetAssignments")
+	proto.RegisterType((*RandomSubnetAssignment)(nil), "prysm.beacon.db.RandomSubnetAssignment")
+}
+
+func init() { proto.RegisterFile("proto/beacon/db/subnets.proto", fileDescriptor_54fa28873ec2e687) }
+
+var fileDescriptor_54fa28873ec2e687 = []byte{
+	// 229 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xbd, 0x4a, 0x04, 0x31,
+	0x14, 0x85, 0x89, 0x23, 0x8a, 0x77, 0x05, 0x21, 0x85, 0xa6, 0xd9, 0x61, 0xd8, 0xc6, 0xa9, 0x12,
+	0xd0, 0xd6, 0x46, 0x3b, 0xb1, 0x8b, 0x9d, 0x8d, 0xe4, 0x4e, 0xc2, 0x1a, 0xdc, 0xfc, 0x38, 0xc9,
+	0x80, 0xf3, 0x86, 0x96, 0x3e, 0x82, 0xcc, 0x93, 0x08, 0x19, 0x65, 0x97, 0x65, 0xcb, 0xef, 0xcb,
+	0x39, 0x81, 0x73, 0x61, 0x19, 0xfb, 0x90, 0x83, 0x40, 0xa3, 0xba, 0xe0, 0x85, 0x46, 0x91, 0x06,
+	0xf4, 0x26, 0x27, 0x5e, 0x3c, 0xbd, 0x88, 0xfd, 0x98, 0x1c, 0x9f, 0x9f, 0xb9, 0xc6, 0x95, 0x86,
+	0x2b, 0xa9, 0xbc, 0x0e, 0xee, 0xb9, 0xe4, 0xee, 0x53, 0xb2, 0x6b, 0xef, 0x8c, 0xcf, 0x89, 0x3e,
+	0xc2, 0x42, 0x6d, 0x91, 0x91, 0xa6, 0x6a, 0x17, 0x37, 0xd7, 0x7c, 0xef, 0x07, 0x7e, 0xb8, 0x2e,
+	0x77, 0xbb, 0xab, 0x0f, 0xb8, 0x3c, 0x1c, 0xa3, 0x4b, 0x80, 0x38, 0xe0, 0xc6, 0x76, 0xaf, 0xef,
+	0x66, 0x64, 0xa4, 0x21, 0xed, 0xb9, 0x3c, 0x9b, 0xcd, 0x93, 0x19, 0x29, 0x83, 0xd3, 0xbf, 0x01,
+	0xec, 0xa8, 0xa9, 0xda, 0x63, 0xf9, 0x8f, 0xb4, 0x06, 0x30, 0x9f, 0xd1, 0xf6, 0x2a, 0xdb, 0xe0,
+	0x59, 0xd5, 0x90, 0xb6, 0x92, 0x3b, 0xe6, 0xe1, 0xee, 0x6b, 0xaa, 0xc9, 0xf7, 0x54, 0x93, 0x9f,
+	0xa9, 0x26, 0x2f, 0x7c, 0x6d, 0xf3, 0xdb, 0x80, 0xbc, 0x0b, 0x4e, 0x94, 0x01, 0x2a, 0xdb, 0x6e,
+	0xa3, 0x30, 0xcd, 0x24, 0xf6, 0xae, 0x86, 0x27, 0x45, 0xdc, 0xfe, 0x0e, 0x00, 0xd3, 0x77, 0xd3,
+	0x4d, 0x4f, 0x01, 0x00, 0x00,
+}
+
+func (m *RandomSubnetAssignments) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RandomSubnetAssignments) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RandomSubnetAssignments) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Assignments) > 0 {
+		for iNdEx := len(m.Assignments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assignments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubnets(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RandomSubnetAssignment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RandomSubnetAssignment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RandomSubnetAssignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Expiration != 0 {
+		i = encodeVarintSubnets(dAtA, i, uint64(m.Expiration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Subnets) > 0 {
+		dAtA2 := make([]byte, len(m.Subnets)*10)
+		var j1 int
+		for _, num := range m.Subnets {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintSubnets(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintSubnets(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSubnets(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSubnets(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RandomSubnetAssignments) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Assignments) > 0 {
+		for _, e := range m.Assignments {
+			l = e.Size()
+			n += 1 + l + sovSubnets(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RandomSubnetAssignment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovSubnets(uint64(l))
+	}
+	if len(m.Subnets) > 0 {
+		l = 0
+		for _, e := range m.Subnets {
+			l += sovSubnets(uint64(e))
+		}
+		n += 1 + sovSubnets(uint64(l)) + l
+	}
+	if m.Expiration != 0 {
+		n += 1 + sovSubnets(uint64(m.Expiration))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovSubnets(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSubnets(x uint64) (n int) {
+	return sovSubnets(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RandomSubnetAssignments) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubnets
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RandomSubnetAssignments: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RandomSubnetAssignments: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assignments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubnets
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubnets
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubnets
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assignments = append(m.Assignments, &RandomSubnetAssignment{})
+			if err := m.Assignments[len(m.Assignments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubnets(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubnets
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubnets
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RandomSubnetAssignment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubnets
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RandomSubnetAssignment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RandomSubnetAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubnets
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSubnets
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubnets
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubnets
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Subnets = append(m.Subnets, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubnets
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSubnets
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSubnets
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Subnets) == 0 {
+					m.Subnets = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubnets
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Subnets = append(m.Subnets, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subnets", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			m.Expiration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubnets
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubnets(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubnets
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSubnets
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSubnets(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSubnets
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSubnets
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSubnets
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSubnets
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSubnets
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSubnets
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSubnets        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSubnets          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSubnets = fmt.Errorf("proto: unexpected end of group")
+)
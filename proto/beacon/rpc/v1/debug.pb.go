@@ -5,6 +5,7 @@ package ethereum_beacon_rpc_v1
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -56,7 +57,180 @@ func (x LoggingLevelRequest_Level) String() string {
 }
 
 func (LoggingLevelRequest_Level) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{5, 0}
+	return fileDescriptor_851e5cb2de3d61dd, []int{8, 0}
+}
+
+type AddTrustedPeerRequest struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddTrustedPeerRequest) Reset()         { *m = AddTrustedPeerRequest{} }
+func (m *AddTrustedPeerRequest) String() string { return proto.CompactTextString(m) }
+func (*AddTrustedPeerRequest) ProtoMessage()    {}
+func (*AddTrustedPeerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{0}
+}
+func (m *AddTrustedPeerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddTrustedPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddTrustedPeerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddTrustedPeerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddTrustedPeerRequest.Merge(m, src)
+}
+func (m *AddTrustedPeerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddTrustedPeerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddTrustedPeerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddTrustedPeerRequest proto.InternalMessageInfo
+
+func (m *AddTrustedPeerRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+type AttestationSubnetsResponse struct {
+	AdvertisedSubnets    []uint64                     `protobuf:"varint,1,rep,packed,name=advertised_subnets,json=advertisedSubnets,proto3" json:"advertised_subnets,omitempty"`
+	MetadataSeqNumber    uint64                       `protobuf:"varint,2,opt,name=metadata_seq_number,json=metadataSeqNumber,proto3" json:"metadata_seq_number,omitempty"`
+	RandomSubnets        []*ValidatorSubnetAssignment `protobuf:"bytes,3,rep,name=random_subnets,json=randomSubnets,proto3" json:"random_subnets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *AttestationSubnetsResponse) Reset()         { *m = AttestationSubnetsResponse{} }
+func (m *AttestationSubnetsResponse) String() string { return proto.CompactTextString(m) }
+func (*AttestationSubnetsResponse) ProtoMessage()    {}
+func (*AttestationSubnetsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{1}
+}
+func (m *AttestationSubnetsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationSubnetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationSubnetsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationSubnetsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationSubnetsResponse.Merge(m, src)
+}
+func (m *AttestationSubnetsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationSubnetsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationSubnetsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationSubnetsResponse proto.InternalMessageInfo
+
+func (m *AttestationSubnetsResponse) GetAdvertisedSubnets() []uint64 {
+	if m != nil {
+		return m.AdvertisedSubnets
+	}
+	return nil
+}
+
+func (m *AttestationSubnetsResponse) GetMetadataSeqNumber() uint64 {
+	if m != nil {
+		return m.MetadataSeqNumber
+	}
+	return 0
+}
+
+func (m *AttestationSubnetsResponse) GetRandomSubnets() []*ValidatorSubnetAssignment {
+	if m != nil {
+		return m.RandomSubnets
+	}
+	return nil
+}
+
+type ValidatorSubnetAssignment struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Subnets              []uint64 `protobuf:"varint,2,rep,packed,name=subnets,proto3" json:"subnets,omitempty"`
+	ExpirationEpoch      uint64   `protobuf:"varint,3,opt,name=expiration_epoch,json=expirationEpoch,proto3" json:"expiration_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorSubnetAssignment) Reset()         { *m = ValidatorSubnetAssignment{} }
+func (m *ValidatorSubnetAssignment) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSubnetAssignment) ProtoMessage()    {}
+func (*ValidatorSubnetAssignment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{2}
+}
+func (m *ValidatorSubnetAssignment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSubnetAssignment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSubnetAssignment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSubnetAssignment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSubnetAssignment.Merge(m, src)
+}
+func (m *ValidatorSubnetAssignment) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSubnetAssignment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSubnetAssignment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSubnetAssignment proto.InternalMessageInfo
+
+func (m *ValidatorSubnetAssignment) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *ValidatorSubnetAssignment) GetSubnets() []uint64 {
+	if m != nil {
+		return m.Subnets
+	}
+	return nil
+}
+
+func (m *ValidatorSubnetAssignment) GetExpirationEpoch() uint64 {
+	if m != nil {
+		return m.ExpirationEpoch
+	}
+	return 0
 }
 
 type InclusionSlotRequest struct {
@@ -71,7 +245,7 @@ func (m *InclusionSlotRequest) Reset()         { *m = InclusionSlotRequest{} }
 func (m *InclusionSlotRequest) String() string { return proto.CompactTextString(m) }
 func (*InclusionSlotRequest) ProtoMessage()    {}
 func (*InclusionSlotRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{0}
+	return fileDescriptor_851e5cb2de3d61dd, []int{3}
 }
 func (m *InclusionSlotRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -125,7 +299,7 @@ func (m *InclusionSlotResponse) Reset()         { *m = InclusionSlotResponse{} }
 func (m *InclusionSlotResponse) String() string { return proto.CompactTextString(m) }
 func (*InclusionSlotResponse) ProtoMessage()    {}
 func (*InclusionSlotResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{1}
+	return fileDescriptor_851e5cb2de3d61dd, []int{4}
 }
 func (m *InclusionSlotResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -175,7 +349,7 @@ func (m *BeaconStateRequest) Reset()         { *m = BeaconStateRequest{} }
 func (m *BeaconStateRequest) String() string { return proto.CompactTextString(m) }
 func (*BeaconStateRequest) ProtoMessage()    {}
 func (*BeaconStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{2}
+	return fileDescriptor_851e5cb2de3d61dd, []int{5}
 }
 func (m *BeaconStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -260,7 +434,7 @@ func (m *BlockRequest) Reset()         { *m = BlockRequest{} }
 func (m *BlockRequest) String() string { return proto.CompactTextString(m) }
 func (*BlockRequest) ProtoMessage()    {}
 func (*BlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{3}
+	return fileDescriptor_851e5cb2de3d61dd, []int{6}
 }
 func (m *BlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -307,7 +481,7 @@ func (m *SSZResponse) Reset()         { *m = SSZResponse{} }
 func (m *SSZResponse) String() string { return proto.CompactTextString(m) }
 func (*SSZResponse) ProtoMessage()    {}
 func (*SSZResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{4}
+	return fileDescriptor_851e5cb2de3d61dd, []int{7}
 }
 func (m *SSZResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -354,7 +528,7 @@ func (m *LoggingLevelRequest) Reset()         { *m = LoggingLevelRequest{} }
 func (m *LoggingLevelRequest) String() string { return proto.CompactTextString(m) }
 func (*LoggingLevelRequest) ProtoMessage()    {}
 func (*LoggingLevelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{5}
+	return fileDescriptor_851e5cb2de3d61dd, []int{8}
 }
 func (m *LoggingLevelRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -405,7 +579,7 @@ func (m *ProtoArrayForkChoiceResponse) Reset()         { *m = ProtoArrayForkChoi
 func (m *ProtoArrayForkChoiceResponse) String() string { return proto.CompactTextString(m) }
 func (*ProtoArrayForkChoiceResponse) ProtoMessage()    {}
 func (*ProtoArrayForkChoiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{6}
+	return fileDescriptor_851e5cb2de3d61dd, []int{9}
 }
 func (m *ProtoArrayForkChoiceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,7 +661,7 @@ func (m *ProtoArrayNode) Reset()         { *m = ProtoArrayNode{} }
 func (m *ProtoArrayNode) String() string { return proto.CompactTextString(m) }
 func (*ProtoArrayNode) ProtoMessage()    {}
 func (*ProtoArrayNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{7}
+	return fileDescriptor_851e5cb2de3d61dd, []int{10}
 }
 func (m *ProtoArrayNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -583,7 +757,7 @@ func (m *DebugPeerResponses) Reset()         { *m = DebugPeerResponses{} }
 func (m *DebugPeerResponses) String() string { return proto.CompactTextString(m) }
 func (*DebugPeerResponses) ProtoMessage()    {}
 func (*DebugPeerResponses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{8}
+	return fileDescriptor_851e5cb2de3d61dd, []int{11}
 }
 func (m *DebugPeerResponses) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -628,6 +802,9 @@ type DebugPeerResponse struct {
 	PeerInfo             *DebugPeerResponse_PeerInfo `protobuf:"bytes,6,opt,name=peer_info,json=peerInfo,proto3" json:"peer_info,omitempty"`
 	PeerStatus           *v1.Status                  `protobuf:"bytes,7,opt,name=peer_status,json=peerStatus,proto3" json:"peer_status,omitempty"`
 	LastUpdated          uint64                      `protobuf:"varint,8,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	Trusted              bool                        `protobuf:"varint,9,opt,name=trusted,proto3" json:"trusted,omitempty"`
+	Banned               bool                        `protobuf:"varint,10,opt,name=banned,proto3" json:"banned,omitempty"`
+	GossipScore          *GossipScore                `protobuf:"bytes,11,opt,name=gossip_score,json=gossipScore,proto3" json:"gossip_score,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
 	XXX_unrecognized     []byte                      `json:"-"`
 	XXX_sizecache        int32                       `json:"-"`
@@ -637,7 +814,7 @@ func (m *DebugPeerResponse) Reset()         { *m = DebugPeerResponse{} }
 func (m *DebugPeerResponse) String() string { return proto.CompactTextString(m) }
 func (*DebugPeerResponse) ProtoMessage()    {}
 func (*DebugPeerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{9}
+	return fileDescriptor_851e5cb2de3d61dd, []int{12}
 }
 func (m *DebugPeerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -722,6 +899,27 @@ func (m *DebugPeerResponse) GetLastUpdated() uint64 {
 	return 0
 }
 
+func (m *DebugPeerResponse) GetTrusted() bool {
+	if m != nil {
+		return m.Trusted
+	}
+	return false
+}
+
+func (m *DebugPeerResponse) GetBanned() bool {
+	if m != nil {
+		return m.Banned
+	}
+	return false
+}
+
+func (m *DebugPeerResponse) GetGossipScore() *GossipScore {
+	if m != nil {
+		return m.GossipScore
+	}
+	return nil
+}
+
 type DebugPeerResponse_PeerInfo struct {
 	Metadata             *v1.MetaData `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	Protocols            []string     `protobuf:"bytes,2,rep,name=protocols,proto3" json:"protocols,omitempty"`
@@ -738,7 +936,7 @@ func (m *DebugPeerResponse_PeerInfo) Reset()         { *m = DebugPeerResponse_Pe
 func (m *DebugPeerResponse_PeerInfo) String() string { return proto.CompactTextString(m) }
 func (*DebugPeerResponse_PeerInfo) ProtoMessage()    {}
 func (*DebugPeerResponse_PeerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{9, 0}
+	return fileDescriptor_851e5cb2de3d61dd, []int{12, 0}
 }
 func (m *DebugPeerResponse_PeerInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -809,1281 +1007,5852 @@ func (m *DebugPeerResponse_PeerInfo) GetPeerLatency() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("ethereum.beacon.rpc.v1.LoggingLevelRequest_Level", LoggingLevelRequest_Level_name, LoggingLevelRequest_Level_value)
-	proto.RegisterType((*InclusionSlotRequest)(nil), "ethereum.beacon.rpc.v1.InclusionSlotRequest")
-	proto.RegisterType((*InclusionSlotResponse)(nil), "ethereum.beacon.rpc.v1.InclusionSlotResponse")
-	proto.RegisterType((*BeaconStateRequest)(nil), "ethereum.beacon.rpc.v1.BeaconStateRequest")
-	proto.RegisterType((*BlockRequest)(nil), "ethereum.beacon.rpc.v1.BlockRequest")
-	proto.RegisterType((*SSZResponse)(nil), "ethereum.beacon.rpc.v1.SSZResponse")
-	proto.RegisterType((*LoggingLevelRequest)(nil), "ethereum.beacon.rpc.v1.LoggingLevelRequest")
-	proto.RegisterType((*ProtoArrayForkChoiceResponse)(nil), "ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse")
-	proto.RegisterMapType((map[string]uint64)(nil), "ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse.IndicesEntry")
-	proto.RegisterType((*ProtoArrayNode)(nil), "ethereum.beacon.rpc.v1.ProtoArrayNode")
-	proto.RegisterType((*DebugPeerResponses)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponses")
-	proto.RegisterType((*DebugPeerResponse)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponse")
-	proto.RegisterType((*DebugPeerResponse_PeerInfo)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponse.PeerInfo")
+type GossipScore struct {
+	Score                float64                        `protobuf:"fixed64,1,opt,name=score,proto3" json:"score,omitempty"`
+	TopicScores          map[string]*TopicScoreSnapshot `protobuf:"bytes,2,rep,name=topic_scores,json=topicScores,proto3" json:"topic_scores,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	AppSpecificScore     float64                        `protobuf:"fixed64,3,opt,name=app_specific_score,json=appSpecificScore,proto3" json:"app_specific_score,omitempty"`
+	IpColocationFactor   float64                        `protobuf:"fixed64,4,opt,name=ip_colocation_factor,json=ipColocationFactor,proto3" json:"ip_colocation_factor,omitempty"`
+	BehaviourPenalty     float64                        `protobuf:"fixed64,5,opt,name=behaviour_penalty,json=behaviourPenalty,proto3" json:"behaviour_penalty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
-func init() { proto.RegisterFile("proto/beacon/rpc/v1/debug.proto", fileDescriptor_851e5cb2de3d61dd) }
-
-var fileDescriptor_851e5cb2de3d61dd = []byte{
-	// 1218 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0x1b, 0xc5,
-	0x17, 0xef, 0x3a, 0x71, 0x12, 0x1f, 0xfb, 0xef, 0xb8, 0xd3, 0xfe, 0x53, 0xe3, 0xb6, 0x49, 0xba,
-	0x2d, 0xfd, 0xa4, 0x6b, 0xc5, 0x70, 0x81, 0x2a, 0x24, 0x94, 0xaf, 0xa6, 0x91, 0x42, 0x5b, 0x36,
-	0x2d, 0x17, 0x54, 0x68, 0x35, 0xd9, 0x3d, 0xb6, 0x97, 0x6c, 0x66, 0xb6, 0x3b, 0xb3, 0x01, 0x97,
-	0xbb, 0x0a, 0xc1, 0x25, 0x17, 0x48, 0x5c, 0xf3, 0x18, 0x3c, 0x02, 0x97, 0x48, 0xbc, 0x00, 0x8a,
-	0x78, 0x0a, 0xae, 0xd0, 0xcc, 0xec, 0xae, 0x6d, 0x62, 0x97, 0x80, 0xb8, 0x9b, 0xf3, 0x9b, 0xdf,
-	0xf9, 0x98, 0x73, 0xce, 0xcc, 0x19, 0x58, 0x89, 0x13, 0x2e, 0x79, 0xfb, 0x00, 0xa9, 0xcf, 0x59,
-	0x3b, 0x89, 0xfd, 0xf6, 0xf1, 0x5a, 0x3b, 0xc0, 0x83, 0xb4, 0xe7, 0xe8, 0x1d, 0xb2, 0x84, 0xb2,
-	0x8f, 0x09, 0xa6, 0x47, 0x8e, 0xe1, 0x38, 0x49, 0xec, 0x3b, 0xc7, 0x6b, 0xad, 0x4b, 0x28, 0xfb,
-	0xed, 0xe3, 0x35, 0x1a, 0xc5, 0x7d, 0xba, 0xd6, 0x66, 0x3c, 0x40, 0xa3, 0xd0, 0xb2, 0xc7, 0x2c,
-	0xc6, 0x9d, 0x58, 0x59, 0x3c, 0x42, 0x21, 0x68, 0x0f, 0x45, 0xc6, 0xb9, 0xd2, 0xe3, 0xbc, 0x17,
-	0x61, 0x9b, 0xc6, 0x61, 0x9b, 0x32, 0xc6, 0x25, 0x95, 0x21, 0x67, 0xf9, 0xee, 0xe5, 0x6c, 0x57,
-	0x4b, 0x07, 0x69, 0xb7, 0x8d, 0x47, 0xb1, 0x1c, 0x98, 0x4d, 0xfb, 0x01, 0x5c, 0xdc, 0x65, 0x7e,
-	0x94, 0x8a, 0x90, 0xb3, 0xfd, 0x88, 0x4b, 0x17, 0x5f, 0xa6, 0x28, 0x24, 0xa9, 0x43, 0x29, 0x0c,
-	0x9a, 0xd6, 0xaa, 0x75, 0x7b, 0xd6, 0x2d, 0x85, 0x01, 0x21, 0x30, 0x2b, 0x22, 0x2e, 0x9b, 0x25,
-	0x8d, 0xe8, 0xb5, 0x7d, 0x0f, 0xfe, 0xff, 0x17, 0x5d, 0x11, 0x73, 0x26, 0x70, 0x22, 0xf9, 0x05,
-	0x90, 0x0d, 0x7d, 0x86, 0x7d, 0x49, 0x25, 0xe6, 0x6e, 0x2e, 0x66, 0x4c, 0xed, 0xe8, 0xd1, 0x39,
-	0xc3, 0x25, 0x2b, 0x00, 0x07, 0x11, 0xf7, 0x0f, 0xbd, 0x84, 0x67, 0x56, 0x6a, 0x8f, 0xce, 0xb9,
-	0x15, 0x8d, 0xb9, 0x9c, 0xcb, 0x8d, 0x3a, 0xd4, 0x5e, 0xa6, 0x98, 0x0c, 0xbc, 0x6e, 0x18, 0x49,
-	0x4c, 0xec, 0xfb, 0x50, 0xdb, 0xd0, 0x9b, 0x99, 0xd9, 0xab, 0x63, 0x06, 0x94, 0xf1, 0xda, 0x88,
-	0xba, 0x7d, 0x0b, 0xaa, 0xfb, 0xfb, 0x9f, 0x16, 0xe1, 0x36, 0x61, 0x1e, 0x99, 0xcf, 0x03, 0x0c,
-	0x32, 0x6a, 0x2e, 0xda, 0xdf, 0x5a, 0x70, 0x61, 0x8f, 0xf7, 0x7a, 0x21, 0xeb, 0xed, 0xe1, 0x31,
-	0x46, 0xb9, 0xfd, 0x1d, 0x28, 0x47, 0x4a, 0xd6, 0xfc, 0x7a, 0x67, 0xcd, 0x99, 0x5c, 0x55, 0x67,
-	0x82, 0xae, 0x63, 0x04, 0xa3, 0x6f, 0xdf, 0x82, 0xb2, 0x96, 0xc9, 0x02, 0xcc, 0xee, 0x3e, 0x7e,
-	0xf8, 0xa4, 0x71, 0x8e, 0x54, 0xa0, 0xbc, 0xb5, 0xbd, 0xf1, 0x7c, 0xa7, 0x61, 0xa9, 0xe5, 0x33,
-	0x77, 0x7d, 0x73, 0xbb, 0x51, 0xb2, 0xbf, 0x99, 0x81, 0x2b, 0x4f, 0x55, 0xc5, 0xd6, 0x93, 0x84,
-	0x0e, 0x1e, 0xf2, 0xe4, 0x70, 0xb3, 0xcf, 0x43, 0x1f, 0x8b, 0x43, 0xdc, 0x82, 0xc5, 0x38, 0x49,
-	0x19, 0x7a, 0xb2, 0x9f, 0xa0, 0xe8, 0xf3, 0x28, 0xaf, 0x5e, 0x5d, 0xc3, 0xcf, 0x72, 0x54, 0x11,
-	0x3f, 0x4f, 0x85, 0x0c, 0xbb, 0x21, 0x06, 0x1e, 0xc6, 0xdc, 0xef, 0x67, 0x75, 0xaa, 0x17, 0xf0,
-	0xb6, 0x42, 0x15, 0xb1, 0x1b, 0x32, 0x1a, 0x85, 0xaf, 0x0a, 0xe2, 0x8c, 0x21, 0x16, 0xb0, 0x21,
-	0xba, 0x70, 0x5e, 0x37, 0x93, 0x47, 0x55, 0x6c, 0x9e, 0x6a, 0x5e, 0xd1, 0x9c, 0x5d, 0x9d, 0xb9,
-	0x5d, 0xed, 0xdc, 0x9c, 0x96, 0x99, 0xe1, 0x59, 0x1e, 0xf3, 0x00, 0xdd, 0xc5, 0x78, 0x4c, 0x16,
-	0xe4, 0x05, 0xcc, 0x87, 0x2c, 0x08, 0x7d, 0x14, 0xcd, 0xb2, 0xb6, 0xb4, 0xfe, 0xf7, 0x96, 0x4e,
-	0x67, 0xc5, 0xd9, 0x35, 0x36, 0xb6, 0x99, 0x4c, 0x06, 0x6e, 0x6e, 0xb1, 0xf5, 0x00, 0x6a, 0xa3,
-	0x1b, 0xa4, 0x01, 0x33, 0x87, 0x38, 0xd0, 0xf9, 0xaa, 0xb8, 0x6a, 0x49, 0x2e, 0x42, 0xf9, 0x98,
-	0x46, 0x29, 0x66, 0xa9, 0x31, 0xc2, 0x83, 0xd2, 0xfb, 0x96, 0xfd, 0xba, 0x04, 0xf5, 0xf1, 0xe0,
-	0x8b, 0x76, 0xb7, 0x86, 0xed, 0xae, 0xb0, 0x61, 0xf3, 0xba, 0x7a, 0x4d, 0x96, 0x60, 0x2e, 0xa6,
-	0x09, 0x32, 0x99, 0xe5, 0x31, 0x93, 0x26, 0x55, 0x64, 0xf6, 0xac, 0x15, 0x29, 0x4f, 0xac, 0xc8,
-	0x12, 0xcc, 0x7d, 0x81, 0x61, 0xaf, 0x2f, 0x9b, 0x73, 0xc6, 0x93, 0x91, 0xf4, 0xbd, 0x40, 0x21,
-	0x3d, 0xbf, 0x1f, 0x46, 0x41, 0x73, 0x5e, 0xef, 0x55, 0x14, 0xb2, 0xa9, 0x00, 0x65, 0x5f, 0x6f,
-	0x07, 0x28, 0x7c, 0x64, 0x01, 0x65, 0xb2, 0xb9, 0x60, 0xec, 0x2b, 0x78, 0xab, 0x40, 0xed, 0xcf,
-	0x80, 0x6c, 0xa9, 0x47, 0xed, 0x29, 0x62, 0x92, 0xe7, 0x5a, 0x90, 0x1d, 0xa8, 0x24, 0xb9, 0xd0,
-	0xb4, 0x74, 0xd5, 0xee, 0x4c, 0xab, 0xda, 0x29, 0x75, 0x77, 0xa8, 0x6b, 0xff, 0x54, 0x86, 0xf3,
-	0xa7, 0x08, 0xa4, 0x0d, 0x17, 0xa2, 0x50, 0x48, 0x64, 0x21, 0xeb, 0x79, 0x34, 0x08, 0x12, 0x14,
-	0xb9, 0xa3, 0x8a, 0x4b, 0x8a, 0xad, 0xf5, 0x7c, 0x87, 0x6c, 0x40, 0x25, 0x08, 0x13, 0xf4, 0xd5,
-	0x63, 0xa8, 0x0b, 0x51, 0xef, 0xdc, 0x18, 0xc6, 0x83, 0xb2, 0xef, 0xe4, 0x0f, 0xae, 0xa3, 0x1c,
-	0x6d, 0xe5, 0x5c, 0x77, 0xa8, 0x46, 0x3e, 0x86, 0x86, 0xcf, 0x19, 0x33, 0x92, 0x27, 0xd4, 0xdb,
-	0xa5, 0xab, 0x57, 0x1f, 0x6d, 0xed, 0x31, 0x53, 0x9b, 0x05, 0xdd, 0xbc, 0x74, 0x8b, 0xfe, 0x38,
-	0x40, 0x2e, 0xc1, 0x7c, 0x8c, 0x98, 0x78, 0x61, 0xa0, 0xcb, 0x5c, 0x71, 0xe7, 0x94, 0xb8, 0x1b,
-	0xa8, 0x36, 0x44, 0x96, 0xe8, 0x92, 0x56, 0x5c, 0xb5, 0x24, 0x4f, 0xa0, 0x62, 0xa8, 0xac, 0xcb,
-	0x75, 0x29, 0xab, 0x9d, 0xce, 0x99, 0x33, 0xaa, 0x0f, 0xb5, 0xcb, 0xba, 0xdc, 0x5d, 0x88, 0xb3,
-	0x15, 0xf9, 0x10, 0xaa, 0xda, 0xa0, 0x3a, 0x48, 0x2a, 0x74, 0x07, 0x54, 0x3b, 0xcb, 0xa7, 0x4c,
-	0xc6, 0x9d, 0x58, 0x99, 0xdc, 0xd7, 0x2c, 0x17, 0x94, 0x8a, 0x59, 0x93, 0x6b, 0x50, 0x8b, 0xa8,
-	0x90, 0x5e, 0x1a, 0x07, 0x54, 0x62, 0x90, 0xf5, 0x47, 0x55, 0x61, 0xcf, 0x0d, 0xd4, 0xfa, 0xc3,
-	0x82, 0x85, 0xdc, 0x35, 0xf9, 0x00, 0x16, 0x8e, 0x50, 0xd2, 0x80, 0x4a, 0xaa, 0xef, 0x47, 0xb5,
-	0xb3, 0x3a, 0xcd, 0xdb, 0x47, 0x28, 0xe9, 0x16, 0x95, 0xd4, 0x2d, 0x34, 0xc8, 0x15, 0xa8, 0xe8,
-	0x87, 0xc1, 0xe7, 0x91, 0x68, 0x96, 0x74, 0xa1, 0x87, 0x00, 0x59, 0x81, 0x6a, 0x97, 0xa6, 0x91,
-	0xf4, 0x7c, 0x9e, 0x16, 0x97, 0x0a, 0x34, 0xb4, 0xa9, 0x10, 0x72, 0x07, 0x1a, 0x39, 0xdb, 0x3b,
-	0xc6, 0x44, 0xcd, 0xa9, 0x2c, 0xe5, 0x8b, 0x39, 0xfe, 0x89, 0x81, 0xc9, 0x75, 0xf8, 0x1f, 0xed,
-	0x21, 0x93, 0x05, 0xcf, 0x54, 0xa1, 0xa6, 0xc1, 0x9c, 0x74, 0x0d, 0x6a, 0x3a, 0x7b, 0x11, 0x95,
-	0xc8, 0xfc, 0x41, 0x76, 0xb9, 0x74, 0x46, 0xf7, 0x0c, 0xd4, 0xf9, 0x71, 0x1e, 0xca, 0xba, 0x12,
-	0xe4, 0x6b, 0x0b, 0xea, 0x3b, 0x28, 0x47, 0x86, 0x1e, 0xb9, 0x3b, 0xad, 0x76, 0xa7, 0x27, 0x63,
-	0xeb, 0xfa, 0x34, 0xee, 0xc8, 0xe4, 0xb2, 0xaf, 0xbd, 0xfe, 0xf5, 0xf7, 0xef, 0x4b, 0x97, 0xc9,
-	0x5b, 0xed, 0xb1, 0xef, 0x83, 0xfe, 0x70, 0xb4, 0x75, 0xb3, 0x92, 0x2f, 0x61, 0x41, 0x45, 0xa1,
-	0x66, 0x1f, 0xb9, 0x31, 0xd5, 0xff, 0xc8, 0xf0, 0xfc, 0x0f, 0x3c, 0xeb, 0x49, 0x4b, 0xbe, 0x82,
-	0xc5, 0x7d, 0x94, 0xa3, 0x23, 0x90, 0xdc, 0xfb, 0x07, 0x83, 0xb2, 0xb5, 0xe4, 0x98, 0x8f, 0x8b,
-	0x93, 0x7f, 0x5c, 0x9c, 0x6d, 0xf5, 0x71, 0xb1, 0xaf, 0x6b, 0xd7, 0x57, 0xed, 0xcb, 0x93, 0x5c,
-	0x47, 0xc6, 0x10, 0xf9, 0xce, 0x82, 0x4b, 0x3b, 0x28, 0x27, 0x0d, 0x07, 0x32, 0xc5, 0x70, 0xeb,
-	0xbd, 0x7f, 0x33, 0x62, 0xec, 0x9b, 0x3a, 0x9c, 0x55, 0xb2, 0x3c, 0x29, 0x9c, 0x2e, 0x4f, 0x0e,
-	0x7d, 0xe3, 0x35, 0x81, 0xca, 0x5e, 0x28, 0xa4, 0xba, 0x19, 0x62, 0x6a, 0x08, 0x77, 0xcf, 0x7c,
-	0xbb, 0xc5, 0x9b, 0x4b, 0x10, 0x6b, 0x37, 0xaf, 0x60, 0x5e, 0x25, 0x01, 0x31, 0x21, 0xf6, 0x1b,
-	0x5e, 0xbe, 0x3c, 0xe3, 0x67, 0x7f, 0xad, 0xed, 0x55, 0xed, 0xbc, 0x45, 0x9a, 0xd3, 0x9c, 0x93,
-	0x1f, 0x2c, 0x68, 0xec, 0xa0, 0x1c, 0xfb, 0x21, 0x92, 0x77, 0xa6, 0x79, 0x98, 0xf4, 0x09, 0x6d,
-	0xdd, 0x3f, 0x23, 0x3b, 0x8b, 0xe9, 0x6d, 0x1d, 0xd3, 0x0a, 0xb9, 0x3a, 0x29, 0xa6, 0x30, 0x57,
-	0xd9, 0xa8, 0xfd, 0x7c, 0xb2, 0x6c, 0xfd, 0x72, 0xb2, 0x6c, 0xfd, 0x76, 0xb2, 0x6c, 0x1d, 0xcc,
-	0xe9, 0x0a, 0xbc, 0xfb, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x03, 0xf6, 0x1f, 0xf4, 0xba, 0x0b,
-	0x00, 0x00,
+func (m *GossipScore) Reset()         { *m = GossipScore{} }
+func (m *GossipScore) String() string { return proto.CompactTextString(m) }
+func (*GossipScore) ProtoMessage()    {}
+func (*GossipScore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{13}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// DebugClient is the client API for Debug service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type DebugClient interface {
-	GetBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (*SSZResponse, error)
-	GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*SSZResponse, error)
-	SetLoggingLevel(ctx context.Context, in *LoggingLevelRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	GetProtoArrayForkChoice(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ProtoArrayForkChoiceResponse, error)
-	ListPeers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*DebugPeerResponses, error)
-	GetPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*DebugPeerResponse, error)
-	GetInclusionSlot(ctx context.Context, in *InclusionSlotRequest, opts ...grpc.CallOption) (*InclusionSlotResponse, error)
+func (m *GossipScore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type debugClient struct {
-	cc *grpc.ClientConn
+func (m *GossipScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GossipScore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewDebugClient(cc *grpc.ClientConn) DebugClient {
-	return &debugClient{cc}
+func (m *GossipScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GossipScore.Merge(m, src)
 }
-
-func (c *debugClient) GetBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (*SSZResponse, error) {
-	out := new(SSZResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBeaconState", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GossipScore) XXX_Size() int {
+	return m.Size()
+}
+func (m *GossipScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_GossipScore.DiscardUnknown(m)
 }
 
-func (c *debugClient) GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*SSZResponse, error) {
-	out := new(SSZResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GossipScore proto.InternalMessageInfo
+
+func (m *GossipScore) GetScore() float64 {
+	if m != nil {
+		return m.Score
 	}
-	return out, nil
+	return 0
 }
 
-func (c *debugClient) SetLoggingLevel(ctx context.Context, in *LoggingLevelRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/SetLoggingLevel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GossipScore) GetTopicScores() map[string]*TopicScoreSnapshot {
+	if m != nil {
+		return m.TopicScores
 	}
-	return out, nil
+	return nil
 }
 
-func (c *debugClient) GetProtoArrayForkChoice(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ProtoArrayForkChoiceResponse, error) {
-	out := new(ProtoArrayForkChoiceResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetProtoArrayForkChoice", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GossipScore) GetAppSpecificScore() float64 {
+	if m != nil {
+		return m.AppSpecificScore
 	}
-	return out, nil
+	return 0
 }
 
-func (c *debugClient) ListPeers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*DebugPeerResponses, error) {
-	out := new(DebugPeerResponses)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListPeers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GossipScore) GetIpColocationFactor() float64 {
+	if m != nil {
+		return m.IpColocationFactor
 	}
-	return out, nil
+	return 0
 }
 
-func (c *debugClient) GetPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*DebugPeerResponse, error) {
-	out := new(DebugPeerResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetPeer", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GossipScore) GetBehaviourPenalty() float64 {
+	if m != nil {
+		return m.BehaviourPenalty
 	}
-	return out, nil
+	return 0
 }
 
-func (c *debugClient) GetInclusionSlot(ctx context.Context, in *InclusionSlotRequest, opts ...grpc.CallOption) (*InclusionSlotResponse, error) {
-	out := new(InclusionSlotResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetInclusionSlot", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type TopicScoreSnapshot struct {
+	TimeInMesh               uint64   `protobuf:"varint,1,opt,name=time_in_mesh,json=timeInMesh,proto3" json:"time_in_mesh,omitempty"`
+	FirstMessageDeliveries   float64  `protobuf:"fixed64,2,opt,name=first_message_deliveries,json=firstMessageDeliveries,proto3" json:"first_message_deliveries,omitempty"`
+	MeshMessageDeliveries    float64  `protobuf:"fixed64,3,opt,name=mesh_message_deliveries,json=meshMessageDeliveries,proto3" json:"mesh_message_deliveries,omitempty"`
+	InvalidMessageDeliveries float64  `protobuf:"fixed64,4,opt,name=invalid_message_deliveries,json=invalidMessageDeliveries,proto3" json:"invalid_message_deliveries,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
 }
 
-// DebugServer is the server API for Debug service.
-type DebugServer interface {
-	GetBeaconState(context.Context, *BeaconStateRequest) (*SSZResponse, error)
-	GetBlock(context.Context, *BlockRequest) (*SSZResponse, error)
-	SetLoggingLevel(context.Context, *LoggingLevelRequest) (*types.Empty, error)
-	GetProtoArrayForkChoice(context.Context, *types.Empty) (*ProtoArrayForkChoiceResponse, error)
-	ListPeers(context.Context, *types.Empty) (*DebugPeerResponses, error)
-	GetPeer(context.Context, *v1alpha1.PeerRequest) (*DebugPeerResponse, error)
-	GetInclusionSlot(context.Context, *InclusionSlotRequest) (*InclusionSlotResponse, error)
-}
-
-// UnimplementedDebugServer can be embedded to have forward compatible implementations.
-type UnimplementedDebugServer struct {
-}
-
-func (*UnimplementedDebugServer) GetBeaconState(ctx context.Context, req *BeaconStateRequest) (*SSZResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBeaconState not implemented")
-}
-func (*UnimplementedDebugServer) GetBlock(ctx context.Context, req *BlockRequest) (*SSZResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBlock not implemented")
+func (m *TopicScoreSnapshot) Reset()         { *m = TopicScoreSnapshot{} }
+func (m *TopicScoreSnapshot) String() string { return proto.CompactTextString(m) }
+func (*TopicScoreSnapshot) ProtoMessage()    {}
+func (*TopicScoreSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{14}
 }
-func (*UnimplementedDebugServer) SetLoggingLevel(ctx context.Context, req *LoggingLevelRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetLoggingLevel not implemented")
+func (m *TopicScoreSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedDebugServer) GetProtoArrayForkChoice(ctx context.Context, req *types.Empty) (*ProtoArrayForkChoiceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoArrayForkChoice not implemented")
+func (m *TopicScoreSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicScoreSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedDebugServer) ListPeers(ctx context.Context, req *types.Empty) (*DebugPeerResponses, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPeers not implemented")
+func (m *TopicScoreSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicScoreSnapshot.Merge(m, src)
 }
-func (*UnimplementedDebugServer) GetPeer(ctx context.Context, req *v1alpha1.PeerRequest) (*DebugPeerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPeer not implemented")
+func (m *TopicScoreSnapshot) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedDebugServer) GetInclusionSlot(ctx context.Context, req *InclusionSlotRequest) (*InclusionSlotResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetInclusionSlot not implemented")
+func (m *TopicScoreSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicScoreSnapshot.DiscardUnknown(m)
 }
 
-func RegisterDebugServer(s *grpc.Server, srv DebugServer) {
-	s.RegisterService(&_Debug_serviceDesc, srv)
-}
+var xxx_messageInfo_TopicScoreSnapshot proto.InternalMessageInfo
 
-func _Debug_GetBeaconState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BeaconStateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DebugServer).GetBeaconState(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBeaconState",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).GetBeaconState(ctx, req.(*BeaconStateRequest))
+func (m *TopicScoreSnapshot) GetTimeInMesh() uint64 {
+	if m != nil {
+		return m.TimeInMesh
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Debug_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DebugServer).GetBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).GetBlock(ctx, req.(*BlockRequest))
+func (m *TopicScoreSnapshot) GetFirstMessageDeliveries() float64 {
+	if m != nil {
+		return m.FirstMessageDeliveries
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Debug_SetLoggingLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoggingLevelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DebugServer).SetLoggingLevel(ctx, in)
+func (m *TopicScoreSnapshot) GetMeshMessageDeliveries() float64 {
+	if m != nil {
+		return m.MeshMessageDeliveries
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/SetLoggingLevel",
+	return 0
+}
+
+func (m *TopicScoreSnapshot) GetInvalidMessageDeliveries() float64 {
+	if m != nil {
+		return m.InvalidMessageDeliveries
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).SetLoggingLevel(ctx, req.(*LoggingLevelRequest))
+	return 0
+}
+
+type ChainReorg struct {
+	OldHeadSlot          uint64   `protobuf:"varint,1,opt,name=old_head_slot,json=oldHeadSlot,proto3" json:"old_head_slot,omitempty"`
+	OldHeadRoot          []byte   `protobuf:"bytes,2,opt,name=old_head_root,json=oldHeadRoot,proto3" json:"old_head_root,omitempty"`
+	NewHeadSlot          uint64   `protobuf:"varint,3,opt,name=new_head_slot,json=newHeadSlot,proto3" json:"new_head_slot,omitempty"`
+	NewHeadRoot          []byte   `protobuf:"bytes,4,opt,name=new_head_root,json=newHeadRoot,proto3" json:"new_head_root,omitempty"`
+	CommonAncestorSlot   uint64   `protobuf:"varint,5,opt,name=common_ancestor_slot,json=commonAncestorSlot,proto3" json:"common_ancestor_slot,omitempty"`
+	CommonAncestorRoot   []byte   `protobuf:"bytes,6,opt,name=common_ancestor_root,json=commonAncestorRoot,proto3" json:"common_ancestor_root,omitempty"`
+	Depth                uint64   `protobuf:"varint,7,opt,name=depth,proto3" json:"depth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChainReorg) Reset()         { *m = ChainReorg{} }
+func (m *ChainReorg) String() string { return proto.CompactTextString(m) }
+func (*ChainReorg) ProtoMessage()    {}
+func (*ChainReorg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{15}
+}
+func (m *ChainReorg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainReorg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainReorg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ChainReorg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainReorg.Merge(m, src)
+}
+func (m *ChainReorg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainReorg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainReorg.DiscardUnknown(m)
 }
 
-func _Debug_GetProtoArrayForkChoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_ChainReorg proto.InternalMessageInfo
+
+func (m *ChainReorg) GetOldHeadSlot() uint64 {
+	if m != nil {
+		return m.OldHeadSlot
 	}
-	if interceptor == nil {
-		return srv.(DebugServer).GetProtoArrayForkChoice(ctx, in)
+	return 0
+}
+
+func (m *ChainReorg) GetOldHeadRoot() []byte {
+	if m != nil {
+		return m.OldHeadRoot
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetProtoArrayForkChoice",
+	return nil
+}
+
+func (m *ChainReorg) GetNewHeadSlot() uint64 {
+	if m != nil {
+		return m.NewHeadSlot
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).GetProtoArrayForkChoice(ctx, req.(*types.Empty))
+	return 0
+}
+
+func (m *ChainReorg) GetNewHeadRoot() []byte {
+	if m != nil {
+		return m.NewHeadRoot
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Debug_ListPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ChainReorg) GetCommonAncestorSlot() uint64 {
+	if m != nil {
+		return m.CommonAncestorSlot
 	}
-	if interceptor == nil {
-		return srv.(DebugServer).ListPeers(ctx, in)
+	return 0
+}
+
+func (m *ChainReorg) GetCommonAncestorRoot() []byte {
+	if m != nil {
+		return m.CommonAncestorRoot
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListPeers",
+	return nil
+}
+
+func (m *ChainReorg) GetDepth() uint64 {
+	if m != nil {
+		return m.Depth
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).ListPeers(ctx, req.(*types.Empty))
+	return 0
+}
+
+type StateTransitionRequest struct {
+	// Types that are valid to be assigned to PreState:
+	//	*StateTransitionRequest_PreStateSsz
+	//	*StateTransitionRequest_StateId
+	PreState             isStateTransitionRequest_PreState `protobuf_oneof:"pre_state"`
+	SignedBlockSsz       []byte                            `protobuf:"bytes,3,opt,name=signed_block_ssz,json=signedBlockSsz,proto3" json:"signed_block_ssz,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *StateTransitionRequest) Reset()         { *m = StateTransitionRequest{} }
+func (m *StateTransitionRequest) String() string { return proto.CompactTextString(m) }
+func (*StateTransitionRequest) ProtoMessage()    {}
+func (*StateTransitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{16}
+}
+func (m *StateTransitionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateTransitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateTransitionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *StateTransitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateTransitionRequest.Merge(m, src)
+}
+func (m *StateTransitionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateTransitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateTransitionRequest.DiscardUnknown(m)
 }
 
-func _Debug_GetPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha1.PeerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_StateTransitionRequest proto.InternalMessageInfo
+
+type isStateTransitionRequest_PreState interface {
+	isStateTransitionRequest_PreState()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type StateTransitionRequest_PreStateSsz struct {
+	PreStateSsz []byte `protobuf:"bytes,1,opt,name=pre_state_ssz,json=preStateSsz,proto3,oneof" json:"pre_state_ssz,omitempty"`
+}
+type StateTransitionRequest_StateId struct {
+	StateId string `protobuf:"bytes,2,opt,name=state_id,json=stateId,proto3,oneof" json:"state_id,omitempty"`
+}
+
+func (*StateTransitionRequest_PreStateSsz) isStateTransitionRequest_PreState() {}
+func (*StateTransitionRequest_StateId) isStateTransitionRequest_PreState()     {}
+
+func (m *StateTransitionRequest) GetPreState() isStateTransitionRequest_PreState {
+	if m != nil {
+		return m.PreState
 	}
-	if interceptor == nil {
-		return srv.(DebugServer).GetPeer(ctx, in)
+	return nil
+}
+
+func (m *StateTransitionRequest) GetPreStateSsz() []byte {
+	if x, ok := m.GetPreState().(*StateTransitionRequest_PreStateSsz); ok {
+		return x.PreStateSsz
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetPeer",
+	return nil
+}
+
+func (m *StateTransitionRequest) GetStateId() string {
+	if x, ok := m.GetPreState().(*StateTransitionRequest_StateId); ok {
+		return x.StateId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).GetPeer(ctx, req.(*v1alpha1.PeerRequest))
+	return ""
+}
+
+func (m *StateTransitionRequest) GetSignedBlockSsz() []byte {
+	if m != nil {
+		return m.SignedBlockSsz
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Debug_GetInclusionSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InclusionSlotRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*StateTransitionRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*StateTransitionRequest_PreStateSsz)(nil),
+		(*StateTransitionRequest_StateId)(nil),
 	}
-	if interceptor == nil {
-		return srv.(DebugServer).GetInclusionSlot(ctx, in)
+}
+
+type StateTransitionResponse struct {
+	PreStateRoot         []byte                 `protobuf:"bytes,1,opt,name=pre_state_root,json=preStateRoot,proto3" json:"pre_state_root,omitempty"`
+	PostStateRoot        []byte                 `protobuf:"bytes,2,opt,name=post_state_root,json=postStateRoot,proto3" json:"post_state_root,omitempty"`
+	Steps                []*StateTransitionStep `protobuf:"bytes,3,rep,name=steps,proto3" json:"steps,omitempty"`
+	Error                *StateTransitionError  `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *StateTransitionResponse) Reset()         { *m = StateTransitionResponse{} }
+func (m *StateTransitionResponse) String() string { return proto.CompactTextString(m) }
+func (*StateTransitionResponse) ProtoMessage()    {}
+func (*StateTransitionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{17}
+}
+func (m *StateTransitionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateTransitionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateTransitionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetInclusionSlot",
+}
+func (m *StateTransitionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateTransitionResponse.Merge(m, src)
+}
+func (m *StateTransitionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateTransitionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateTransitionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateTransitionResponse proto.InternalMessageInfo
+
+func (m *StateTransitionResponse) GetPreStateRoot() []byte {
+	if m != nil {
+		return m.PreStateRoot
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).GetInclusionSlot(ctx, req.(*InclusionSlotRequest))
+	return nil
+}
+
+func (m *StateTransitionResponse) GetPostStateRoot() []byte {
+	if m != nil {
+		return m.PostStateRoot
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Debug_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.beacon.rpc.v1.Debug",
-	HandlerType: (*DebugServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetBeaconState",
-			Handler:    _Debug_GetBeaconState_Handler,
-		},
-		{
-			MethodName: "GetBlock",
-			Handler:    _Debug_GetBlock_Handler,
-		},
-		{
-			MethodName: "SetLoggingLevel",
-			Handler:    _Debug_SetLoggingLevel_Handler,
-		},
-		{
-			MethodName: "GetProtoArrayForkChoice",
-			Handler:    _Debug_GetProtoArrayForkChoice_Handler,
-		},
-		{
-			MethodName: "ListPeers",
-			Handler:    _Debug_ListPeers_Handler,
-		},
-		{
-			MethodName: "GetPeer",
-			Handler:    _Debug_GetPeer_Handler,
-		},
-		{
-			MethodName: "GetInclusionSlot",
-			Handler:    _Debug_GetInclusionSlot_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/beacon/rpc/v1/debug.proto",
+func (m *StateTransitionResponse) GetSteps() []*StateTransitionStep {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
 }
 
-func (m *InclusionSlotRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StateTransitionResponse) GetError() *StateTransitionError {
+	if m != nil {
+		return m.Error
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *InclusionSlotRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type StateTransitionStep struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Operation            bool     `protobuf:"varint,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	OperationIndex       uint64   `protobuf:"varint,3,opt,name=operation_index,json=operationIndex,proto3" json:"operation_index,omitempty"`
+	DurationNanoseconds  uint64   `protobuf:"varint,4,opt,name=duration_nanoseconds,json=durationNanoseconds,proto3" json:"duration_nanoseconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *InclusionSlotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Slot != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Id != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *StateTransitionStep) Reset()         { *m = StateTransitionStep{} }
+func (m *StateTransitionStep) String() string { return proto.CompactTextString(m) }
+func (*StateTransitionStep) ProtoMessage()    {}
+func (*StateTransitionStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{18}
+}
+func (m *StateTransitionStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateTransitionStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateTransitionStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *StateTransitionStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateTransitionStep.Merge(m, src)
+}
+func (m *StateTransitionStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateTransitionStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateTransitionStep.DiscardUnknown(m)
 }
 
-func (m *InclusionSlotResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_StateTransitionStep proto.InternalMessageInfo
+
+func (m *StateTransitionStep) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *InclusionSlotResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StateTransitionStep) GetOperation() bool {
+	if m != nil {
+		return m.Operation
+	}
+	return false
 }
 
-func (m *InclusionSlotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Slot != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
-		i--
-		dAtA[i] = 0x10
+func (m *StateTransitionStep) GetOperationIndex() uint64 {
+	if m != nil {
+		return m.OperationIndex
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *BeaconStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StateTransitionStep) GetDurationNanoseconds() uint64 {
+	if m != nil {
+		return m.DurationNanoseconds
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *BeaconStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type StateTransitionError struct {
+	Step                 *StateTransitionStep `protobuf:"bytes,1,opt,name=step,proto3" json:"step,omitempty"`
+	Message              string               `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *BeaconStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.QueryFilter != nil {
-		{
-			size := m.QueryFilter.Size()
-			i -= size
-			if _, err := m.QueryFilter.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *StateTransitionError) Reset()         { *m = StateTransitionError{} }
+func (m *StateTransitionError) String() string { return proto.CompactTextString(m) }
+func (*StateTransitionError) ProtoMessage()    {}
+func (*StateTransitionError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{19}
+}
+func (m *StateTransitionError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateTransitionError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateTransitionError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *StateTransitionError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateTransitionError.Merge(m, src)
+}
+func (m *StateTransitionError) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateTransitionError) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateTransitionError.DiscardUnknown(m)
 }
 
-func (m *BeaconStateRequest_Slot) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_StateTransitionError proto.InternalMessageInfo
+
+func (m *StateTransitionError) GetStep() *StateTransitionStep {
+	if m != nil {
+		return m.Step
+	}
+	return nil
 }
 
-func (m *BeaconStateRequest_Slot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
+func (m *StateTransitionError) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
 }
-func (m *BeaconStateRequest_BlockRoot) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+type AttestationInclusionsRequest struct {
+	StartEpoch           uint64   `protobuf:"varint,1,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	EndEpoch             uint64   `protobuf:"varint,2,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
+	Indices              []uint64 `protobuf:"varint,3,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	PublicKeys           [][]byte `protobuf:"bytes,4,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BeaconStateRequest_BlockRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.BlockRoot != nil {
-		i -= len(m.BlockRoot)
-		copy(dAtA[i:], m.BlockRoot)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
-		i--
-		dAtA[i] = 0x12
-	}
-	return len(dAtA) - i, nil
+func (m *AttestationInclusionsRequest) Reset()         { *m = AttestationInclusionsRequest{} }
+func (m *AttestationInclusionsRequest) String() string { return proto.CompactTextString(m) }
+func (*AttestationInclusionsRequest) ProtoMessage()    {}
+func (*AttestationInclusionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{20}
 }
-func (m *BlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AttestationInclusionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationInclusionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationInclusionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *AttestationInclusionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationInclusionsRequest.Merge(m, src)
+}
+func (m *AttestationInclusionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationInclusionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationInclusionsRequest.DiscardUnknown(m)
 }
 
-func (m *BlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_AttestationInclusionsRequest proto.InternalMessageInfo
+
+func (m *AttestationInclusionsRequest) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
 }
 
-func (m *BlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *AttestationInclusionsRequest) GetEndEpoch() uint64 {
+	if m != nil {
+		return m.EndEpoch
 	}
-	if len(m.BlockRoot) > 0 {
-		i -= len(m.BlockRoot)
-		copy(dAtA[i:], m.BlockRoot)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *AttestationInclusionsRequest) GetIndices() []uint64 {
+	if m != nil {
+		return m.Indices
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *SSZResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AttestationInclusionsRequest) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SSZResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type AttestationInclusionsResponse struct {
+	Inclusions           []*AttestationInclusion `protobuf:"bytes,1,rep,name=inclusions,proto3" json:"inclusions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
-func (m *SSZResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Encoded) > 0 {
-		i -= len(m.Encoded)
-		copy(dAtA[i:], m.Encoded)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.Encoded)))
-		i--
-		dAtA[i] = 0xa
+func (m *AttestationInclusionsResponse) Reset()         { *m = AttestationInclusionsResponse{} }
+func (m *AttestationInclusionsResponse) String() string { return proto.CompactTextString(m) }
+func (*AttestationInclusionsResponse) ProtoMessage()    {}
+func (*AttestationInclusionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{21}
+}
+func (m *AttestationInclusionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationInclusionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationInclusionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *AttestationInclusionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationInclusionsResponse.Merge(m, src)
+}
+func (m *AttestationInclusionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationInclusionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationInclusionsResponse.DiscardUnknown(m)
 }
 
-func (m *LoggingLevelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AttestationInclusionsResponse proto.InternalMessageInfo
+
+func (m *AttestationInclusionsResponse) GetInclusions() []*AttestationInclusion {
+	if m != nil {
+		return m.Inclusions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *LoggingLevelRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type AttestationInclusion struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ValidatorIndex       uint64   `protobuf:"varint,2,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	PublicKey            []byte   `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Active               bool     `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	Included             bool     `protobuf:"varint,5,opt,name=included,proto3" json:"included,omitempty"`
+	AttestationSlot      uint64   `protobuf:"varint,6,opt,name=attestation_slot,json=attestationSlot,proto3" json:"attestation_slot,omitempty"`
+	InclusionSlot        uint64   `protobuf:"varint,7,opt,name=inclusion_slot,json=inclusionSlot,proto3" json:"inclusion_slot,omitempty"`
+	InclusionDelay       uint64   `protobuf:"varint,8,opt,name=inclusion_delay,json=inclusionDelay,proto3" json:"inclusion_delay,omitempty"`
+	CorrectSource        bool     `protobuf:"varint,9,opt,name=correct_source,json=correctSource,proto3" json:"correct_source,omitempty"`
+	CorrectTarget        bool     `protobuf:"varint,10,opt,name=correct_target,json=correctTarget,proto3" json:"correct_target,omitempty"`
+	CorrectHead          bool     `protobuf:"varint,11,opt,name=correct_head,json=correctHead,proto3" json:"correct_head,omitempty"`
+	ProposerIndex        uint64   `protobuf:"varint,12,opt,name=proposer_index,json=proposerIndex,proto3" json:"proposer_index,omitempty"`
+	BlockRoot            []byte   `protobuf:"bytes,13,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	AggregateIndex       uint64   `protobuf:"varint,14,opt,name=aggregate_index,json=aggregateIndex,proto3" json:"aggregate_index,omitempty"`
+	AggregationBits      []byte   `protobuf:"bytes,15,opt,name=aggregation_bits,json=aggregationBits,proto3" json:"aggregation_bits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LoggingLevelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Level != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Level))
-		i--
-		dAtA[i] = 0x8
+func (m *AttestationInclusion) Reset()         { *m = AttestationInclusion{} }
+func (m *AttestationInclusion) String() string { return proto.CompactTextString(m) }
+func (*AttestationInclusion) ProtoMessage()    {}
+func (*AttestationInclusion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{22}
+}
+func (m *AttestationInclusion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationInclusion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationInclusion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *AttestationInclusion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationInclusion.Merge(m, src)
+}
+func (m *AttestationInclusion) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationInclusion) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationInclusion.DiscardUnknown(m)
 }
 
-func (m *ProtoArrayForkChoiceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AttestationInclusion proto.InternalMessageInfo
+
+func (m *AttestationInclusion) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ProtoArrayForkChoiceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AttestationInclusion) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
 }
 
-func (m *ProtoArrayForkChoiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Indices) > 0 {
-		for k := range m.Indices {
-			v := m.Indices[k]
-			baseI := i
-			i = encodeVarintDebug(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintDebug(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintDebug(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.ProtoArrayNodes) > 0 {
-		for iNdEx := len(m.ProtoArrayNodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ProtoArrayNodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDebug(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.FinalizedEpoch != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.FinalizedEpoch))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.JustifiedEpoch != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.JustifiedEpoch))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.PruneThreshold != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.PruneThreshold))
-		i--
-		dAtA[i] = 0x8
+func (m *AttestationInclusion) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ProtoArrayNode) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AttestationInclusion) GetActive() bool {
+	if m != nil {
+		return m.Active
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *ProtoArrayNode) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AttestationInclusion) GetIncluded() bool {
+	if m != nil {
+		return m.Included
+	}
+	return false
 }
 
-func (m *ProtoArrayNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.BestDescendant != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.BestDescendant))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.BestChild != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.BestChild))
-		i--
-		dAtA[i] = 0x38
+func (m *AttestationInclusion) GetAttestationSlot() uint64 {
+	if m != nil {
+		return m.AttestationSlot
 	}
-	if m.Weight != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Weight))
-		i--
-		dAtA[i] = 0x30
+	return 0
+}
+
+func (m *AttestationInclusion) GetInclusionSlot() uint64 {
+	if m != nil {
+		return m.InclusionSlot
 	}
-	if m.FinalizedEpoch != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.FinalizedEpoch))
-		i--
-		dAtA[i] = 0x28
+	return 0
+}
+
+func (m *AttestationInclusion) GetInclusionDelay() uint64 {
+	if m != nil {
+		return m.InclusionDelay
 	}
-	if m.JustifiedEpoch != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.JustifiedEpoch))
-		i--
-		dAtA[i] = 0x20
+	return 0
+}
+
+func (m *AttestationInclusion) GetCorrectSource() bool {
+	if m != nil {
+		return m.CorrectSource
 	}
-	if m.Parent != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Parent))
-		i--
-		dAtA[i] = 0x18
+	return false
+}
+
+func (m *AttestationInclusion) GetCorrectTarget() bool {
+	if m != nil {
+		return m.CorrectTarget
 	}
-	if len(m.Root) > 0 {
-		i -= len(m.Root)
-		copy(dAtA[i:], m.Root)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.Root)))
-		i--
-		dAtA[i] = 0x12
+	return false
+}
+
+func (m *AttestationInclusion) GetCorrectHead() bool {
+	if m != nil {
+		return m.CorrectHead
 	}
-	if m.Slot != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
-		i--
-		dAtA[i] = 0x8
+	return false
+}
+
+func (m *AttestationInclusion) GetProposerIndex() uint64 {
+	if m != nil {
+		return m.ProposerIndex
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DebugPeerResponses) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AttestationInclusion) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DebugPeerResponses) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AttestationInclusion) GetAggregateIndex() uint64 {
+	if m != nil {
+		return m.AggregateIndex
+	}
+	return 0
 }
 
-func (m *DebugPeerResponses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *AttestationInclusion) GetAggregationBits() []byte {
+	if m != nil {
+		return m.AggregationBits
 	}
-	if len(m.Responses) > 0 {
-		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Responses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDebug(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *DebugPeerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.LoggingLevelRequest_Level", LoggingLevelRequest_Level_name, LoggingLevelRequest_Level_value)
+	proto.RegisterType((*AddTrustedPeerRequest)(nil), "ethereum.beacon.rpc.v1.AddTrustedPeerRequest")
+	proto.RegisterType((*AttestationSubnetsResponse)(nil), "ethereum.beacon.rpc.v1.AttestationSubnetsResponse")
+	proto.RegisterType((*ValidatorSubnetAssignment)(nil), "ethereum.beacon.rpc.v1.ValidatorSubnetAssignment")
+	proto.RegisterType((*InclusionSlotRequest)(nil), "ethereum.beacon.rpc.v1.InclusionSlotRequest")
+	proto.RegisterType((*InclusionSlotResponse)(nil), "ethereum.beacon.rpc.v1.InclusionSlotResponse")
+	proto.RegisterType((*BeaconStateRequest)(nil), "ethereum.beacon.rpc.v1.BeaconStateRequest")
+	proto.RegisterType((*BlockRequest)(nil), "ethereum.beacon.rpc.v1.BlockRequest")
+	proto.RegisterType((*SSZResponse)(nil), "ethereum.beacon.rpc.v1.SSZResponse")
+	proto.RegisterType((*LoggingLevelRequest)(nil), "ethereum.beacon.rpc.v1.LoggingLevelRequest")
+	proto.RegisterType((*ProtoArrayForkChoiceResponse)(nil), "ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse")
+	proto.RegisterMapType((map[string]uint64)(nil), "ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse.IndicesEntry")
+	proto.RegisterType((*ProtoArrayNode)(nil), "ethereum.beacon.rpc.v1.ProtoArrayNode")
+	proto.RegisterType((*DebugPeerResponses)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponses")
+	proto.RegisterType((*DebugPeerResponse)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponse")
+	proto.RegisterType((*DebugPeerResponse_PeerInfo)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponse.PeerInfo")
+	proto.RegisterType((*GossipScore)(nil), "ethereum.beacon.rpc.v1.GossipScore")
+	proto.RegisterMapType((map[string]*TopicScoreSnapshot)(nil), "ethereum.beacon.rpc.v1.GossipScore.TopicScoresEntry")
+	proto.RegisterType((*TopicScoreSnapshot)(nil), "ethereum.beacon.rpc.v1.TopicScoreSnapshot")
+	proto.RegisterType((*ChainReorg)(nil), "ethereum.beacon.rpc.v1.ChainReorg")
+	proto.RegisterType((*StateTransitionRequest)(nil), "ethereum.beacon.rpc.v1.StateTransitionRequest")
+	proto.RegisterType((*StateTransitionResponse)(nil), "ethereum.beacon.rpc.v1.StateTransitionResponse")
+	proto.RegisterType((*StateTransitionStep)(nil), "ethereum.beacon.rpc.v1.StateTransitionStep")
+	proto.RegisterType((*StateTransitionError)(nil), "ethereum.beacon.rpc.v1.StateTransitionError")
+	proto.RegisterType((*AttestationInclusionsRequest)(nil), "ethereum.beacon.rpc.v1.AttestationInclusionsRequest")
+	proto.RegisterType((*AttestationInclusionsResponse)(nil), "ethereum.beacon.rpc.v1.AttestationInclusionsResponse")
+	proto.RegisterType((*AttestationInclusion)(nil), "ethereum.beacon.rpc.v1.AttestationInclusion")
+}
+
+func init() { proto.RegisterFile("proto/beacon/rpc/v1/debug.proto", fileDescriptor_851e5cb2de3d61dd) }
+
+var fileDescriptor_851e5cb2de3d61dd = []byte{
+	// 2527 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x38, 0x5b, 0x6f, 0x1b, 0xc7,
+	0xd5, 0x5e, 0xdd, 0x79, 0x48, 0x51, 0xf2, 0x58, 0x91, 0x19, 0xfa, 0x26, 0xaf, 0x1d, 0xc7, 0xb1,
+	0x63, 0x32, 0x66, 0xf2, 0x7d, 0x08, 0x8c, 0x00, 0xa9, 0x6e, 0x96, 0x85, 0x3a, 0x4e, 0xba, 0x74,
+	0xd2, 0xa2, 0x41, 0xb1, 0x18, 0xed, 0x1e, 0x91, 0x1b, 0x2f, 0x67, 0xd6, 0x33, 0x43, 0xc5, 0x72,
+	0x5a, 0x14, 0x48, 0xaf, 0x6f, 0x05, 0x5a, 0x20, 0xfd, 0x01, 0xed, 0x73, 0x51, 0xf4, 0x57, 0xf4,
+	0xb1, 0x40, 0xd1, 0xf7, 0x22, 0x28, 0xfa, 0xd8, 0xb7, 0xbe, 0xf4, 0xa9, 0x98, 0xcb, 0x2e, 0x29,
+	0x89, 0x9b, 0xc8, 0x6d, 0xdf, 0x66, 0xce, 0xfd, 0x9c, 0x99, 0x39, 0x97, 0x81, 0x2b, 0x99, 0xe0,
+	0x8a, 0xb7, 0xf7, 0x90, 0x46, 0x9c, 0xb5, 0x45, 0x16, 0xb5, 0x0f, 0xee, 0xb6, 0x63, 0xdc, 0x1b,
+	0xf6, 0x5a, 0x06, 0x43, 0x56, 0x51, 0xf5, 0x51, 0xe0, 0x70, 0xd0, 0xb2, 0x34, 0x2d, 0x91, 0x45,
+	0xad, 0x83, 0xbb, 0xcd, 0xf3, 0xa8, 0xfa, 0xed, 0x83, 0xbb, 0x34, 0xcd, 0xfa, 0xf4, 0x6e, 0x9b,
+	0xf1, 0x18, 0x2d, 0x43, 0xd3, 0x3f, 0x22, 0x31, 0xeb, 0x64, 0x5a, 0xe2, 0x00, 0xa5, 0xa4, 0x3d,
+	0x94, 0x8e, 0xe6, 0x62, 0x8f, 0xf3, 0x5e, 0x8a, 0x6d, 0x9a, 0x25, 0x6d, 0xca, 0x18, 0x57, 0x54,
+	0x25, 0x9c, 0xe5, 0xd8, 0x0b, 0x0e, 0x6b, 0x76, 0x7b, 0xc3, 0xfd, 0x36, 0x0e, 0x32, 0x75, 0x68,
+	0x91, 0xfe, 0x6d, 0x78, 0x69, 0x3d, 0x8e, 0x1f, 0x8b, 0xa1, 0x54, 0x18, 0x7f, 0x80, 0x28, 0x02,
+	0x7c, 0x3a, 0x44, 0xa9, 0x08, 0x81, 0x19, 0x1a, 0xc7, 0xa2, 0xe1, 0xad, 0x79, 0x37, 0x2b, 0x81,
+	0x59, 0xfb, 0x7f, 0xf1, 0xa0, 0xb9, 0xae, 0x14, 0x4a, 0xab, 0xa0, 0x3b, 0xdc, 0x63, 0xa8, 0x64,
+	0x80, 0x32, 0xe3, 0x4c, 0x22, 0xb9, 0x03, 0x84, 0xc6, 0x07, 0x28, 0x54, 0x22, 0x31, 0x0e, 0xa5,
+	0xc5, 0x36, 0xbc, 0xb5, 0xe9, 0x9b, 0x33, 0xc1, 0xd9, 0x11, 0xc6, 0xb1, 0x91, 0x16, 0x9c, 0x1b,
+	0xa0, 0xa2, 0x31, 0x55, 0x34, 0x94, 0xf8, 0x34, 0x64, 0xc3, 0xc1, 0x1e, 0x8a, 0xc6, 0xd4, 0x9a,
+	0xa7, 0xe9, 0x73, 0x54, 0x17, 0x9f, 0x3e, 0x32, 0x08, 0xf2, 0x1d, 0xa8, 0x0b, 0xca, 0x62, 0x3e,
+	0x28, 0x44, 0x4f, 0xaf, 0x4d, 0xdf, 0xac, 0x76, 0xee, 0xb6, 0x26, 0xc7, 0xb4, 0xf5, 0x11, 0x4d,
+	0x93, 0x98, 0x2a, 0x2e, 0xac, 0xc6, 0x75, 0x29, 0x93, 0x1e, 0x1b, 0x20, 0x53, 0xc1, 0xa2, 0x15,
+	0xe4, 0x2c, 0xf1, 0x7f, 0x08, 0x2f, 0x97, 0xd2, 0x92, 0x4b, 0x00, 0xd9, 0x70, 0x2f, 0x4d, 0xa2,
+	0xf0, 0x09, 0x1e, 0x9a, 0x70, 0xd4, 0x82, 0x8a, 0x85, 0x7c, 0x13, 0x0f, 0x49, 0x03, 0xe6, 0x73,
+	0x73, 0xa6, 0x8c, 0xa7, 0xf9, 0x96, 0xbc, 0x06, 0xcb, 0xf8, 0x2c, 0x4b, 0x84, 0x89, 0x55, 0x88,
+	0x19, 0x8f, 0xfa, 0x8d, 0x69, 0xe3, 0xdc, 0xd2, 0x08, 0xbe, 0xad, 0xc1, 0xfe, 0x3d, 0x58, 0xd9,
+	0x65, 0x51, 0x3a, 0x94, 0x3a, 0xaa, 0x29, 0x57, 0xf9, 0x21, 0xd4, 0x61, 0x2a, 0x89, 0x8d, 0xce,
+	0x99, 0x60, 0x2a, 0x89, 0xf5, 0xa1, 0xc8, 0x94, 0x2b, 0x17, 0x23, 0xb3, 0xd6, 0x27, 0x78, 0x8c,
+	0xd7, 0x1d, 0xc7, 0x24, 0xe2, 0x8f, 0x81, 0x6c, 0x98, 0x18, 0x75, 0x15, 0x55, 0x98, 0xab, 0x59,
+	0x71, 0x94, 0x46, 0xd1, 0x83, 0x33, 0x96, 0x96, 0x5c, 0x01, 0xd8, 0x4b, 0x79, 0xf4, 0x24, 0x14,
+	0xdc, 0x49, 0xa9, 0x3d, 0x38, 0x13, 0x54, 0x0c, 0x2c, 0xe0, 0x5c, 0x6d, 0xd4, 0xa1, 0xf6, 0x74,
+	0x88, 0xe2, 0x30, 0xdc, 0x4f, 0x52, 0x85, 0xc2, 0xbf, 0x03, 0xb5, 0x0d, 0x83, 0x74, 0x62, 0x2f,
+	0x1d, 0x11, 0xe0, 0x22, 0x57, 0xb0, 0xfb, 0xaf, 0x42, 0xb5, 0xdb, 0xfd, 0x6e, 0x61, 0x6e, 0x03,
+	0xe6, 0x91, 0x45, 0x3c, 0xc6, 0xd8, 0x91, 0xe6, 0x5b, 0xff, 0x67, 0x1e, 0x9c, 0x7b, 0xc8, 0x7b,
+	0xbd, 0x84, 0xf5, 0x1e, 0xe2, 0x01, 0xa6, 0xb9, 0xfc, 0x1d, 0x98, 0x4d, 0xf5, 0xde, 0xd0, 0xd7,
+	0xcb, 0xef, 0xc1, 0x04, 0xde, 0x96, 0xdd, 0x58, 0x7e, 0xff, 0x55, 0x98, 0x35, 0x7b, 0xb2, 0x00,
+	0x33, 0xbb, 0x8f, 0xee, 0xbf, 0xbf, 0x7c, 0x86, 0x54, 0x60, 0x76, 0x6b, 0x7b, 0xe3, 0xc3, 0x9d,
+	0x65, 0x4f, 0x2f, 0x1f, 0x07, 0xeb, 0x9b, 0xdb, 0xcb, 0x53, 0xfe, 0x4f, 0xa7, 0xe1, 0xe2, 0x07,
+	0xfa, 0xdd, 0xac, 0x0b, 0x41, 0x0f, 0xef, 0x73, 0xf1, 0x64, 0xb3, 0xcf, 0x93, 0x08, 0x0b, 0x27,
+	0x5e, 0x85, 0xa5, 0x4c, 0x0c, 0x19, 0x86, 0xaa, 0x2f, 0x50, 0xf6, 0x79, 0x9a, 0x9f, 0x5e, 0xdd,
+	0x80, 0x1f, 0xe7, 0x50, 0x4d, 0xf8, 0xc9, 0x50, 0xaa, 0x64, 0x3f, 0xc1, 0xd8, 0xdd, 0x0d, 0x7b,
+	0x4e, 0xf5, 0x02, 0x6c, 0xae, 0x86, 0x26, 0xdc, 0x4f, 0x18, 0x4d, 0x93, 0xe7, 0x18, 0x1f, 0xb9,
+	0x44, 0xf5, 0x02, 0x6c, 0x09, 0x03, 0x38, 0x6b, 0x9e, 0x74, 0x48, 0xb5, 0x6d, 0xa1, 0x4e, 0x21,
+	0xb2, 0x31, 0x63, 0x5e, 0xc8, 0x8d, 0xb2, 0xc8, 0x8c, 0x7c, 0x79, 0xc4, 0x63, 0x0c, 0x96, 0xb2,
+	0x23, 0x7b, 0x49, 0x3e, 0x86, 0xf9, 0x84, 0xc5, 0x49, 0x84, 0xb2, 0x31, 0x6b, 0x24, 0xad, 0x7f,
+	0xbd, 0xa4, 0x93, 0x51, 0x69, 0xed, 0x5a, 0x19, 0xdb, 0x4c, 0x89, 0xc3, 0x20, 0x97, 0xd8, 0xbc,
+	0x07, 0xb5, 0x71, 0x04, 0x59, 0x86, 0xe9, 0xfc, 0x85, 0x55, 0x02, 0xbd, 0x24, 0x2b, 0x30, 0x7b,
+	0x40, 0xd3, 0x21, 0xba, 0xd0, 0xd8, 0xcd, 0xbd, 0xa9, 0xb7, 0x3d, 0xff, 0xf3, 0x29, 0xa8, 0x1f,
+	0x35, 0xbe, 0xb8, 0xee, 0xde, 0xe8, 0xba, 0x6b, 0xd8, 0xe8, 0xf2, 0x06, 0x66, 0x4d, 0x56, 0x61,
+	0x2e, 0xa3, 0x02, 0x99, 0x72, 0x71, 0x74, 0xbb, 0x49, 0x27, 0x32, 0x73, 0xda, 0x13, 0x99, 0x9d,
+	0x78, 0x22, 0xab, 0x30, 0xf7, 0x29, 0x26, 0xbd, 0xbe, 0x6a, 0xcc, 0x59, 0x4d, 0x76, 0x67, 0xde,
+	0x05, 0x4a, 0x15, 0x46, 0xfd, 0x24, 0x8d, 0x1b, 0xf3, 0x06, 0x57, 0xd1, 0x90, 0x4d, 0x0d, 0xd0,
+	0xf2, 0x0d, 0x3a, 0x46, 0x19, 0x21, 0x8b, 0x29, 0x53, 0x8d, 0x05, 0x2b, 0x5f, 0x83, 0xb7, 0x0a,
+	0xa8, 0xff, 0x3d, 0x20, 0x5b, 0xba, 0xb4, 0xd8, 0xb4, 0x6d, 0x63, 0x2d, 0xc9, 0x0e, 0x54, 0x44,
+	0xbe, 0x31, 0xc9, 0xb7, 0xda, 0x79, 0xad, 0xec, 0xd4, 0x4e, 0xb0, 0x07, 0x23, 0x5e, 0xff, 0x0f,
+	0x73, 0x70, 0xf6, 0x04, 0x01, 0x69, 0xc3, 0xb9, 0x34, 0x91, 0x0a, 0x59, 0xc2, 0x7a, 0xa1, 0xae,
+	0x0a, 0x28, 0x73, 0x45, 0x95, 0x80, 0x14, 0xa8, 0xf5, 0x1c, 0x43, 0x36, 0xa0, 0x12, 0x27, 0x02,
+	0x23, 0x9d, 0xed, 0xcc, 0x41, 0xd4, 0x3b, 0xd7, 0x47, 0xf6, 0xa0, 0xea, 0xb7, 0xf2, 0xb2, 0xd7,
+	0xd2, 0x8a, 0xb6, 0x72, 0xda, 0x60, 0xc4, 0x46, 0xbe, 0x05, 0xcb, 0x11, 0x67, 0xcc, 0xee, 0x42,
+	0x5d, 0x7e, 0xd0, 0x9c, 0x5e, 0xbd, 0x73, 0xa3, 0x44, 0xd4, 0x66, 0x41, 0x6e, 0x33, 0xdd, 0x52,
+	0x74, 0x14, 0x40, 0xce, 0xc3, 0x7c, 0x86, 0x28, 0xc2, 0x24, 0x36, 0xc7, 0x5c, 0x09, 0xe6, 0xf4,
+	0x76, 0x37, 0xd6, 0xd7, 0x10, 0x99, 0x30, 0x47, 0x5a, 0x09, 0xf4, 0x92, 0xbc, 0x0f, 0x15, 0x4b,
+	0xca, 0xf6, 0xb9, 0x39, 0xca, 0x6a, 0xa7, 0x73, 0xea, 0x88, 0x1a, 0xa7, 0x76, 0xd9, 0x3e, 0x0f,
+	0x16, 0x32, 0xb7, 0x22, 0xef, 0x42, 0xd5, 0x08, 0xd4, 0x8e, 0x0c, 0xa5, 0xb9, 0x01, 0xd5, 0xce,
+	0xe5, 0x13, 0x22, 0xb3, 0x4e, 0xa6, 0x45, 0x76, 0x0d, 0x55, 0x00, 0x9a, 0xc5, 0xae, 0xc9, 0x55,
+	0xa8, 0xa5, 0x54, 0xaa, 0x70, 0x98, 0xc5, 0x54, 0x61, 0xec, 0xee, 0x47, 0x55, 0xc3, 0x3e, 0xb4,
+	0x20, 0x9d, 0x4e, 0x95, 0xad, 0xea, 0x8d, 0xca, 0x9a, 0x77, 0x73, 0x21, 0xc8, 0xb7, 0xfa, 0x5a,
+	0xee, 0x51, 0xc6, 0x30, 0x6e, 0x80, 0x41, 0xb8, 0x1d, 0xb9, 0x0f, 0xb5, 0x1e, 0x97, 0x32, 0xc9,
+	0x42, 0x19, 0x71, 0x81, 0x8d, 0xaa, 0x31, 0xeb, 0x5a, 0x99, 0xa7, 0x3b, 0x86, 0xb6, 0xab, 0x49,
+	0x83, 0x6a, 0x6f, 0xb4, 0x69, 0xfe, 0xcb, 0x83, 0x85, 0xdc, 0x69, 0xf2, 0x0e, 0x2c, 0xe4, 0x95,
+	0xdc, 0xbc, 0xcc, 0x6a, 0x67, 0xad, 0xcc, 0xcf, 0xf7, 0x50, 0xd1, 0x2d, 0xaa, 0x68, 0x50, 0x70,
+	0x90, 0x8b, 0x50, 0x31, 0x29, 0x29, 0xe2, 0xa9, 0x2d, 0xaf, 0x95, 0x60, 0x04, 0x20, 0x57, 0xa0,
+	0xba, 0x4f, 0x87, 0xa9, 0x0a, 0x23, 0x3e, 0x2c, 0x9e, 0x33, 0x18, 0xd0, 0xa6, 0x86, 0xe8, 0x0a,
+	0x9c, 0x53, 0x87, 0x07, 0x28, 0x74, 0x85, 0x74, 0x87, 0xbd, 0x94, 0xc3, 0x3f, 0xb2, 0x60, 0x72,
+	0x0d, 0x16, 0x69, 0x0f, 0x99, 0x2a, 0xe8, 0xec, 0xf9, 0xd7, 0x0c, 0x30, 0x27, 0xba, 0x0a, 0x35,
+	0x73, 0x6e, 0x29, 0x55, 0xc8, 0xa2, 0x43, 0xf7, 0xac, 0xcd, 0x59, 0x3e, 0xb4, 0x20, 0xff, 0x9f,
+	0x53, 0x50, 0x1d, 0x8b, 0x8c, 0x4e, 0x61, 0x36, 0x9a, 0xda, 0x79, 0x2f, 0xb0, 0x1b, 0xf2, 0x6d,
+	0xa8, 0x29, 0x9e, 0x25, 0x91, 0x8d, 0xb4, 0x75, 0xad, 0xda, 0x79, 0xeb, 0x14, 0xa1, 0x6e, 0x3d,
+	0xd6, 0x7c, 0x66, 0xe9, 0xf2, 0x69, 0x55, 0x8d, 0x20, 0xe4, 0x75, 0x20, 0x34, 0xcb, 0x42, 0x99,
+	0x61, 0x94, 0xec, 0xe7, 0xf2, 0x4d, 0x64, 0xbc, 0x60, 0x99, 0x66, 0x59, 0xd7, 0x21, 0xac, 0x71,
+	0x6f, 0xc0, 0x4a, 0x92, 0x85, 0x11, 0x4f, 0x79, 0x64, 0x9b, 0x94, 0x7d, 0x1a, 0x29, 0x2e, 0x4c,
+	0x8c, 0xbc, 0x80, 0x24, 0xd9, 0x66, 0x81, 0xba, 0x6f, 0x30, 0xe4, 0x36, 0x9c, 0xdd, 0xc3, 0x3e,
+	0x3d, 0x48, 0xf8, 0x50, 0x84, 0x19, 0x32, 0x9a, 0xaa, 0x43, 0x13, 0x2a, 0x2f, 0x58, 0x2e, 0x10,
+	0x1f, 0x58, 0x78, 0xf3, 0x13, 0x58, 0x3e, 0x6e, 0xed, 0x84, 0x24, 0xff, 0x8d, 0xf1, 0x24, 0x5f,
+	0xed, 0xdc, 0x2a, 0x0b, 0xc2, 0x48, 0x54, 0x97, 0xd1, 0x4c, 0xf6, 0xb9, 0x1a, 0x2f, 0x08, 0x7f,
+	0xf7, 0x80, 0x9c, 0xa4, 0x20, 0x6b, 0x50, 0x53, 0xc9, 0x00, 0xc3, 0x84, 0x85, 0x03, 0x94, 0x7d,
+	0x57, 0x1c, 0x40, 0xc3, 0x76, 0xd9, 0x7b, 0x28, 0xfb, 0xe4, 0x6d, 0x68, 0xec, 0x27, 0x42, 0xaa,
+	0xd0, 0xf5, 0xd4, 0x61, 0x8c, 0x69, 0x72, 0x80, 0x22, 0x31, 0xc7, 0xa2, 0x1d, 0x5b, 0x35, 0xf8,
+	0xf7, 0x2c, 0x7a, 0xab, 0xc0, 0x92, 0xff, 0x87, 0xf3, 0x5a, 0xe6, 0x24, 0x46, 0x1b, 0xf0, 0x97,
+	0x34, 0xfa, 0x24, 0xdf, 0x3b, 0xd0, 0x4c, 0xd8, 0x81, 0xee, 0x37, 0x27, 0xb1, 0xda, 0xd8, 0x37,
+	0x1c, 0xc5, 0x09, 0x6e, 0xff, 0x8b, 0x29, 0x80, 0xcd, 0x3e, 0x4d, 0x58, 0x80, 0x5c, 0xf4, 0x88,
+	0x0f, 0x8b, 0x3c, 0x8d, 0xc3, 0x3e, 0xd2, 0x38, 0x1c, 0x2b, 0x7f, 0x55, 0x9e, 0xc6, 0x0f, 0x90,
+	0xc6, 0xba, 0x21, 0x3c, 0x42, 0x33, 0x56, 0x0e, 0x73, 0x9a, 0x80, 0x5b, 0x1a, 0x86, 0x9f, 0x8e,
+	0xc9, 0xb1, 0xaf, 0xa9, 0xca, 0xf0, 0xd3, 0x71, 0x39, 0x05, 0x8d, 0x91, 0x33, 0x63, 0xe5, 0x38,
+	0x1a, 0x23, 0xe7, 0x0d, 0x58, 0x89, 0xf8, 0x60, 0xc0, 0x59, 0x48, 0x59, 0x84, 0x52, 0x71, 0x61,
+	0xc5, 0xd9, 0x0a, 0x49, 0x2c, 0x6e, 0xdd, 0xa1, 0xba, 0xe9, 0x64, 0x0e, 0x23, 0x7c, 0xce, 0x08,
+	0x3f, 0xc6, 0x61, 0x74, 0xac, 0xc0, 0x6c, 0x8c, 0x99, 0xea, 0xbb, 0xd2, 0x69, 0x37, 0xfe, 0x2f,
+	0x3d, 0x58, 0x35, 0xa9, 0xfd, 0xb1, 0xa0, 0x4c, 0x26, 0xa6, 0x84, 0xb8, 0x46, 0xf1, 0x3a, 0x2c,
+	0x66, 0x02, 0x6d, 0xdd, 0x08, 0xa5, 0x7c, 0x6e, 0x1b, 0xcc, 0x07, 0x67, 0x82, 0x6a, 0x26, 0xd0,
+	0xf0, 0x74, 0xe5, 0x73, 0x72, 0x01, 0x16, 0x2c, 0x45, 0x12, 0x9b, 0x08, 0x55, 0x1e, 0x9c, 0x09,
+	0xe6, 0x0d, 0x64, 0x37, 0x26, 0x37, 0x61, 0x59, 0x4f, 0x04, 0x18, 0x87, 0xb6, 0xa5, 0xd5, 0x52,
+	0xa6, 0x8d, 0x85, 0x75, 0x0b, 0x37, 0x9d, 0x6f, 0x57, 0x3e, 0xdf, 0xa8, 0x42, 0xa5, 0x50, 0xe6,
+	0xff, 0xc3, 0x83, 0xf3, 0x27, 0x8c, 0x72, 0x95, 0xf4, 0x3a, 0xd4, 0x47, 0x56, 0x8d, 0xb5, 0xc8,
+	0xb5, 0xdc, 0x28, 0xe3, 0xec, 0x0d, 0x58, 0xca, 0xb8, 0x54, 0xe3, 0x64, 0xf6, 0xf8, 0x16, 0x35,
+	0x78, 0x44, 0xb7, 0x0e, 0xb3, 0x52, 0x61, 0x96, 0x0f, 0x45, 0xb7, 0xcb, 0x9e, 0xd1, 0x31, 0x6b,
+	0xba, 0x0a, 0xb3, 0xc0, 0x72, 0x92, 0x0d, 0x98, 0x45, 0x21, 0xdc, 0xfb, 0xaf, 0x76, 0x5e, 0x3f,
+	0xa5, 0x88, 0x6d, 0xcd, 0x13, 0x58, 0x56, 0xff, 0x37, 0x1e, 0x9c, 0x9b, 0xa0, 0x42, 0x77, 0x62,
+	0x8c, 0x0e, 0x30, 0x1f, 0x27, 0xf5, 0x5a, 0x67, 0x77, 0x9e, 0xa1, 0x9d, 0x83, 0x8c, 0x53, 0x0b,
+	0xc1, 0x08, 0xa0, 0xdb, 0xa0, 0x62, 0x13, 0x26, 0x2c, 0xc6, 0x67, 0x79, 0xe3, 0x5b, 0x80, 0x77,
+	0x35, 0x94, 0xdc, 0x85, 0x95, 0x78, 0xe8, 0xe8, 0x18, 0x65, 0x5c, 0x62, 0xc4, 0x59, 0x2c, 0x5d,
+	0xf7, 0x76, 0x2e, 0xc7, 0x3d, 0x1a, 0xa1, 0xfc, 0xa7, 0xb0, 0x32, 0xc9, 0x09, 0xf2, 0x2e, 0xcc,
+	0xe8, 0x50, 0xb8, 0x4a, 0xf5, 0x42, 0x31, 0x34, 0x8c, 0xba, 0xea, 0xba, 0x37, 0x6d, 0xaf, 0x50,
+	0x90, 0x6f, 0xfd, 0x5f, 0x7b, 0x70, 0x71, 0x6c, 0x76, 0x2e, 0x46, 0x36, 0x99, 0x5f, 0xd2, 0x2b,
+	0x50, 0x95, 0x8a, 0x0a, 0xe5, 0x5a, 0x4a, 0x97, 0xa9, 0x0c, 0xc8, 0xb6, 0x93, 0x17, 0xa0, 0x82,
+	0xec, 0xe8, 0xb0, 0xb0, 0x80, 0xcc, 0xf5, 0x9a, 0x8d, 0x51, 0xa7, 0x3e, 0x6d, 0xc7, 0x50, 0xb7,
+	0xd5, 0x72, 0x47, 0xf3, 0xab, 0x9d, 0x08, 0x6a, 0x01, 0x14, 0x03, 0xac, 0xf4, 0x07, 0x70, 0xa9,
+	0xc4, 0x30, 0x77, 0x51, 0x1f, 0x02, 0x24, 0x05, 0xd4, 0xb5, 0x94, 0xa5, 0x97, 0x63, 0x92, 0xa8,
+	0x60, 0x8c, 0xdf, 0xff, 0xdd, 0x0c, 0xac, 0x4c, 0x22, 0xd2, 0xcf, 0x7a, 0xdc, 0x75, 0xbb, 0xd1,
+	0xd7, 0xe0, 0x20, 0x9f, 0xcd, 0xdd, 0x35, 0x70, 0x83, 0x52, 0x01, 0xb6, 0xd7, 0xe0, 0xe8, 0x9c,
+	0x3e, 0x7d, 0x7c, 0x4e, 0x5f, 0x85, 0x39, 0x1a, 0xa9, 0xe4, 0x00, 0xcd, 0xbd, 0x58, 0x08, 0xdc,
+	0x8e, 0x34, 0x61, 0xc1, 0x18, 0xa7, 0xe7, 0xce, 0x59, 0x83, 0x29, 0xf6, 0xba, 0x7f, 0xa0, 0x23,
+	0x4b, 0x6d, 0x22, 0xb3, 0x35, 0x7f, 0x69, 0x0c, 0x6e, 0xb2, 0xd8, 0x2b, 0x50, 0x2f, 0x7c, 0xb4,
+	0x84, 0x36, 0x39, 0x2d, 0x26, 0xe3, 0xb3, 0xb9, 0xf6, 0x66, 0x44, 0x16, 0x63, 0x4a, 0x0f, 0xf3,
+	0xde, 0xbe, 0x00, 0x6f, 0x69, 0xa8, 0x96, 0x17, 0x71, 0x21, 0x30, 0x52, 0xa1, 0xe4, 0x43, 0x11,
+	0xa1, 0xeb, 0xe2, 0x16, 0x1d, 0xb4, 0x6b, 0x80, 0xe3, 0x64, 0x8a, 0x8a, 0x1e, 0xaa, 0x06, 0x1c,
+	0x21, 0x7b, 0x6c, 0x80, 0xba, 0x71, 0xc9, 0xc9, 0x74, 0xf6, 0x36, 0xad, 0xdd, 0x42, 0x50, 0x75,
+	0x30, 0x9d, 0xbc, 0xb5, 0xa4, 0x4c, 0xf0, 0x8c, 0x4b, 0xcc, 0xc3, 0x5c, 0xb3, 0x0e, 0xe4, 0xd0,
+	0x22, 0xca, 0x63, 0x33, 0xfd, 0xe2, 0xb1, 0x99, 0x5e, 0xfb, 0x47, 0x7b, 0x3d, 0x81, 0x3d, 0x93,
+	0x47, 0x8d, 0x98, 0xba, 0xf5, 0xaf, 0x00, 0x5b, 0x39, 0x3a, 0xb4, 0x0e, 0xa2, 0x43, 0xb1, 0x97,
+	0x28, 0xd9, 0x58, 0x32, 0xd2, 0x96, 0xc6, 0xe0, 0x1b, 0x89, 0x92, 0x9d, 0x1f, 0x2d, 0xc1, 0xac,
+	0x69, 0xab, 0xc9, 0x8f, 0x3d, 0xa8, 0xef, 0xa0, 0x1a, 0xfb, 0xc1, 0x20, 0xa5, 0xed, 0xc2, 0xc9,
+	0x6f, 0x8e, 0x66, 0x69, 0x2b, 0x3b, 0xf6, 0x0d, 0xe1, 0x5f, 0xfd, 0xfc, 0xcf, 0x7f, 0xfb, 0xd5,
+	0xd4, 0x05, 0xf2, 0x72, 0xfb, 0xc8, 0x8f, 0x9c, 0xf9, 0xc3, 0x6b, 0x9b, 0x24, 0x4c, 0x9e, 0xc1,
+	0x82, 0xb6, 0x42, 0x3b, 0x4d, 0xae, 0x97, 0xea, 0x1f, 0xfb, 0x09, 0xf9, 0x1f, 0x68, 0x36, 0x21,
+	0x26, 0x9f, 0xc1, 0x52, 0x17, 0xd5, 0xf8, 0x7f, 0x06, 0xb9, 0xfd, 0x02, 0xbf, 0x1e, 0xcd, 0xd5,
+	0x96, 0xfd, 0x0b, 0x6c, 0xe5, 0x7f, 0x81, 0xad, 0x6d, 0xfd, 0x17, 0xe8, 0x5f, 0x33, 0xaa, 0x2f,
+	0xf9, 0x17, 0x26, 0xa9, 0x4e, 0xad, 0x20, 0xf2, 0x0b, 0x0f, 0xce, 0xef, 0xa0, 0x9a, 0x34, 0xe9,
+	0x93, 0x12, 0xc1, 0xcd, 0xb7, 0xfe, 0x93, 0xff, 0x02, 0xff, 0x86, 0x31, 0x67, 0x8d, 0x5c, 0x9e,
+	0x64, 0xce, 0x3e, 0x17, 0x4f, 0x22, 0xab, 0x55, 0x40, 0xe5, 0x61, 0x22, 0x95, 0x1e, 0x36, 0x64,
+	0xa9, 0x09, 0xb7, 0x4e, 0x3d, 0xaa, 0xc9, 0xaf, 0x3e, 0x82, 0xcc, 0xa8, 0x79, 0x0e, 0xf3, 0x3a,
+	0x08, 0x88, 0x82, 0xf8, 0x5f, 0x31, 0xc6, 0xe6, 0x11, 0x3f, 0xfd, 0xe8, 0xed, 0xaf, 0x19, 0xe5,
+	0x4d, 0xd2, 0x28, 0x53, 0x4e, 0x7e, 0xe2, 0x41, 0xfd, 0xe8, 0x6f, 0x2d, 0xb9, 0x53, 0x9a, 0x87,
+	0x27, 0xfd, 0xea, 0x96, 0x5e, 0x80, 0xd7, 0x8d, 0xee, 0x1b, 0xfe, 0xd5, 0x52, 0xc7, 0xdb, 0x6e,
+	0x7c, 0xbc, 0xe7, 0xdd, 0xd2, 0xef, 0xf0, 0x6c, 0x80, 0x03, 0x7e, 0x80, 0xe3, 0xa6, 0x9c, 0x26,
+	0x1c, 0x65, 0xfa, 0xdf, 0x34, 0xfa, 0xef, 0xdc, 0xba, 0xfd, 0xb5, 0xfa, 0xdb, 0x9f, 0xb9, 0xb1,
+	0xfd, 0x07, 0x84, 0xc3, 0xfc, 0x06, 0x65, 0xff, 0xb5, 0xee, 0x9b, 0x46, 0xb7, 0xef, 0x5f, 0x2a,
+	0xd7, 0xbd, 0x47, 0x99, 0xf6, 0xfb, 0xe7, 0x1e, 0xbc, 0xb4, 0x83, 0xea, 0xe4, 0x17, 0x78, 0xe9,
+	0xe5, 0xeb, 0x9c, 0xa2, 0x4c, 0x1e, 0xfb, 0x46, 0xcf, 0x1f, 0x23, 0x99, 0xf8, 0x18, 0xf3, 0xcf,
+	0xe5, 0x2f, 0x3c, 0x58, 0xde, 0x41, 0x75, 0xe4, 0xe7, 0x97, 0x94, 0x16, 0xe5, 0x49, 0x9f, 0xcb,
+	0xcd, 0x3b, 0xa7, 0xa4, 0x76, 0x66, 0xbd, 0x62, 0xcc, 0xba, 0x42, 0x26, 0x86, 0xa9, 0xa8, 0x5e,
+	0xe4, 0xfb, 0x70, 0xb6, 0xab, 0x04, 0xd2, 0xc1, 0x68, 0x48, 0x29, 0x0f, 0x8f, 0x5f, 0x66, 0xc2,
+	0x88, 0xd9, 0x7f, 0xcd, 0xe8, 0xbd, 0x46, 0x26, 0x5e, 0x4d, 0x61, 0xe4, 0xb7, 0xa5, 0xd1, 0xf8,
+	0x86, 0x47, 0x7e, 0xeb, 0xc1, 0xea, 0xf6, 0x33, 0x8c, 0x86, 0x0a, 0x8f, 0x35, 0x69, 0xa4, 0x75,
+	0xca, 0x6e, 0x2e, 0x0f, 0x4f, 0xfb, 0xd4, 0xf4, 0x2e, 0x40, 0xce, 0x50, 0x7f, 0x62, 0xd6, 0x52,
+	0x05, 0xbd, 0xbe, 0x48, 0xbf, 0xf7, 0xe0, 0x65, 0x9d, 0xb9, 0x26, 0xf6, 0x5d, 0xe4, 0xad, 0x17,
+	0xe9, 0xad, 0xf2, 0xfe, 0xb1, 0xf9, 0x7f, 0x2f, 0xc8, 0x75, 0x9a, 0x5c, 0x3b, 0x6a, 0xdb, 0x36,
+	0x6a, 0x7f, 0xfc, 0xf2, 0xb2, 0xf7, 0xa7, 0x2f, 0x2f, 0x7b, 0x7f, 0xfd, 0xf2, 0xb2, 0xb7, 0x37,
+	0x67, 0x0e, 0xf2, 0xcd, 0x7f, 0x0f, 0x00, 0xd6, 0x28, 0xfc, 0x3d, 0xf0, 0x1a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DebugClient is the client API for Debug service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DebugClient interface {
+	GetBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (*SSZResponse, error)
+	GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*SSZResponse, error)
+	SetLoggingLevel(ctx context.Context, in *LoggingLevelRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	GetProtoArrayForkChoice(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ProtoArrayForkChoiceResponse, error)
+	ListPeers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*DebugPeerResponses, error)
+	GetPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*DebugPeerResponse, error)
+	AddTrustedPeer(ctx context.Context, in *AddTrustedPeerRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	RemoveTrustedPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	BanPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	GetAttestationSubnets(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*AttestationSubnetsResponse, error)
+	GetInclusionSlot(ctx context.Context, in *InclusionSlotRequest, opts ...grpc.CallOption) (*InclusionSlotResponse, error)
+	StreamChainReorgs(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (Debug_StreamChainReorgsClient, error)
+	ExecuteStateTransition(ctx context.Context, in *StateTransitionRequest, opts ...grpc.CallOption) (*StateTransitionResponse, error)
+	ListAttestationInclusions(ctx context.Context, in *AttestationInclusionsRequest, opts ...grpc.CallOption) (*AttestationInclusionsResponse, error)
+}
+
+type debugClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDebugClient(cc *grpc.ClientConn) DebugClient {
+	return &debugClient{cc}
+}
+
+func (c *debugClient) GetBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (*SSZResponse, error) {
+	out := new(SSZResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBeaconState", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *DebugPeerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *debugClient) GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*SSZResponse, error) {
+	out := new(SSZResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *DebugPeerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.LastUpdated != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.LastUpdated))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.PeerStatus != nil {
-		{
-			size, err := m.PeerStatus.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDebug(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+func (c *debugClient) SetLoggingLevel(ctx context.Context, in *LoggingLevelRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/SetLoggingLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.PeerInfo != nil {
-		{
-			size, err := m.PeerInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDebug(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+	return out, nil
+}
+
+func (c *debugClient) GetProtoArrayForkChoice(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ProtoArrayForkChoiceResponse, error) {
+	out := new(ProtoArrayForkChoiceResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetProtoArrayForkChoice", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Enr) > 0 {
-		i -= len(m.Enr)
-		copy(dAtA[i:], m.Enr)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.Enr)))
-		i--
-		dAtA[i] = 0x2a
+	return out, nil
+}
+
+func (c *debugClient) ListPeers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*DebugPeerResponses, error) {
+	out := new(DebugPeerResponses)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PeerId) > 0 {
-		i -= len(m.PeerId)
-		copy(dAtA[i:], m.PeerId)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.PeerId)))
-		i--
-		dAtA[i] = 0x22
+	return out, nil
+}
+
+func (c *debugClient) GetPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*DebugPeerResponse, error) {
+	out := new(DebugPeerResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.ConnectionState != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.ConnectionState))
-		i--
-		dAtA[i] = 0x18
+	return out, nil
+}
+
+func (c *debugClient) AddTrustedPeer(ctx context.Context, in *AddTrustedPeerRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/AddTrustedPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Direction != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Direction))
-		i--
-		dAtA[i] = 0x10
+	return out, nil
+}
+
+func (c *debugClient) RemoveTrustedPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/RemoveTrustedPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ListeningAddresses) > 0 {
-		for iNdEx := len(m.ListeningAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ListeningAddresses[iNdEx])
-			copy(dAtA[i:], m.ListeningAddresses[iNdEx])
-			i = encodeVarintDebug(dAtA, i, uint64(len(m.ListeningAddresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	return out, nil
+}
+
+func (c *debugClient) BanPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/BanPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *DebugPeerResponse_PeerInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *debugClient) GetAttestationSubnets(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*AttestationSubnetsResponse, error) {
+	out := new(AttestationSubnetsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetAttestationSubnets", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *DebugPeerResponse_PeerInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *debugClient) GetInclusionSlot(ctx context.Context, in *InclusionSlotRequest, opts ...grpc.CallOption) (*InclusionSlotResponse, error) {
+	out := new(InclusionSlotResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetInclusionSlot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *DebugPeerResponse_PeerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *debugClient) StreamChainReorgs(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (Debug_StreamChainReorgsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Debug_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.Debug/StreamChainReorgs", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.PeerLatency != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.PeerLatency))
-		i--
-		dAtA[i] = 0x30
+	x := &debugStreamChainReorgsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if len(m.AgentVersion) > 0 {
-		i -= len(m.AgentVersion)
-		copy(dAtA[i:], m.AgentVersion)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.AgentVersion)))
-		i--
-		dAtA[i] = 0x2a
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	if len(m.ProtocolVersion) > 0 {
-		i -= len(m.ProtocolVersion)
-		copy(dAtA[i:], m.ProtocolVersion)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.ProtocolVersion)))
-		i--
-		dAtA[i] = 0x22
+	return x, nil
+}
+
+type Debug_StreamChainReorgsClient interface {
+	Recv() (*ChainReorg, error)
+	grpc.ClientStream
+}
+
+type debugStreamChainReorgsClient struct {
+	grpc.ClientStream
+}
+
+func (x *debugStreamChainReorgsClient) Recv() (*ChainReorg, error) {
+	m := new(ChainReorg)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	if m.FaultCount != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.FaultCount))
-		i--
-		dAtA[i] = 0x18
+	return m, nil
+}
+
+func (c *debugClient) ExecuteStateTransition(ctx context.Context, in *StateTransitionRequest, opts ...grpc.CallOption) (*StateTransitionResponse, error) {
+	out := new(StateTransitionResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ExecuteStateTransition", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Protocols) > 0 {
-		for iNdEx := len(m.Protocols) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Protocols[iNdEx])
-			copy(dAtA[i:], m.Protocols[iNdEx])
-			i = encodeVarintDebug(dAtA, i, uint64(len(m.Protocols[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDebug(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintDebug(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDebug(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *debugClient) ListAttestationInclusions(ctx context.Context, in *AttestationInclusionsRequest, opts ...grpc.CallOption) (*AttestationInclusionsResponse, error) {
+	out := new(AttestationInclusionsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListAttestationInclusions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *InclusionSlotRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+// DebugServer is the server API for Debug service.
+type DebugServer interface {
+	GetBeaconState(context.Context, *BeaconStateRequest) (*SSZResponse, error)
+	GetBlock(context.Context, *BlockRequest) (*SSZResponse, error)
+	SetLoggingLevel(context.Context, *LoggingLevelRequest) (*types.Empty, error)
+	GetProtoArrayForkChoice(context.Context, *types.Empty) (*ProtoArrayForkChoiceResponse, error)
+	ListPeers(context.Context, *types.Empty) (*DebugPeerResponses, error)
+	GetPeer(context.Context, *v1alpha1.PeerRequest) (*DebugPeerResponse, error)
+	AddTrustedPeer(context.Context, *AddTrustedPeerRequest) (*types.Empty, error)
+	RemoveTrustedPeer(context.Context, *v1alpha1.PeerRequest) (*types.Empty, error)
+	BanPeer(context.Context, *v1alpha1.PeerRequest) (*types.Empty, error)
+	GetAttestationSubnets(context.Context, *types.Empty) (*AttestationSubnetsResponse, error)
+	GetInclusionSlot(context.Context, *InclusionSlotRequest) (*InclusionSlotResponse, error)
+	StreamChainReorgs(*types.Empty, Debug_StreamChainReorgsServer) error
+	ExecuteStateTransition(context.Context, *StateTransitionRequest) (*StateTransitionResponse, error)
+	ListAttestationInclusions(context.Context, *AttestationInclusionsRequest) (*AttestationInclusionsResponse, error)
+}
+
+// UnimplementedDebugServer can be embedded to have forward compatible implementations.
+type UnimplementedDebugServer struct {
+}
+
+func (*UnimplementedDebugServer) GetBeaconState(ctx context.Context, req *BeaconStateRequest) (*SSZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBeaconState not implemented")
+}
+func (*UnimplementedDebugServer) GetBlock(ctx context.Context, req *BlockRequest) (*SSZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlock not implemented")
+}
+func (*UnimplementedDebugServer) SetLoggingLevel(ctx context.Context, req *LoggingLevelRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLoggingLevel not implemented")
+}
+func (*UnimplementedDebugServer) GetProtoArrayForkChoice(ctx context.Context, req *types.Empty) (*ProtoArrayForkChoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoArrayForkChoice not implemented")
+}
+func (*UnimplementedDebugServer) ListPeers(ctx context.Context, req *types.Empty) (*DebugPeerResponses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPeers not implemented")
+}
+func (*UnimplementedDebugServer) GetPeer(ctx context.Context, req *v1alpha1.PeerRequest) (*DebugPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeer not implemented")
+}
+func (*UnimplementedDebugServer) AddTrustedPeer(ctx context.Context, req *AddTrustedPeerRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTrustedPeer not implemented")
+}
+func (*UnimplementedDebugServer) RemoveTrustedPeer(ctx context.Context, req *v1alpha1.PeerRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTrustedPeer not implemented")
+}
+func (*UnimplementedDebugServer) BanPeer(ctx context.Context, req *v1alpha1.PeerRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanPeer not implemented")
+}
+func (*UnimplementedDebugServer) GetAttestationSubnets(ctx context.Context, req *types.Empty) (*AttestationSubnetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttestationSubnets not implemented")
+}
+func (*UnimplementedDebugServer) GetInclusionSlot(ctx context.Context, req *InclusionSlotRequest) (*InclusionSlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInclusionSlot not implemented")
+}
+func (*UnimplementedDebugServer) StreamChainReorgs(req *types.Empty, srv Debug_StreamChainReorgsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamChainReorgs not implemented")
+}
+func (*UnimplementedDebugServer) ExecuteStateTransition(ctx context.Context, req *StateTransitionRequest) (*StateTransitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteStateTransition not implemented")
+}
+func (*UnimplementedDebugServer) ListAttestationInclusions(ctx context.Context, req *AttestationInclusionsRequest) (*AttestationInclusionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttestationInclusions not implemented")
+}
+
+func RegisterDebugServer(s *grpc.Server, srv DebugServer) {
+	s.RegisterService(&_Debug_serviceDesc, srv)
+}
+
+func _Debug_GetBeaconState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeaconStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovDebug(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(DebugServer).GetBeaconState(ctx, in)
 	}
-	if m.Slot != 0 {
-		n += 1 + sovDebug(uint64(m.Slot))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBeaconState",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetBeaconState(ctx, req.(*BeaconStateRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *InclusionSlotResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Debug_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Slot != 0 {
-		n += 1 + sovDebug(uint64(m.Slot))
+	if interceptor == nil {
+		return srv.(DebugServer).GetBlock(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBlock",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetBlock(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BeaconStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Debug_SetLoggingLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoggingLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.QueryFilter != nil {
-		n += m.QueryFilter.Size()
+	if interceptor == nil {
+		return srv.(DebugServer).SetLoggingLevel(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/SetLoggingLevel",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).SetLoggingLevel(ctx, req.(*LoggingLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BeaconStateRequest_Slot) Size() (n int) {
-	if m == nil {
-		return 0
+func _Debug_GetProtoArrayForkChoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	n += 1 + sovDebug(uint64(m.Slot))
-	return n
-}
-func (m *BeaconStateRequest_BlockRoot) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(DebugServer).GetProtoArrayForkChoice(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.BlockRoot != nil {
-		l = len(m.BlockRoot)
-		n += 1 + l + sovDebug(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetProtoArrayForkChoice",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetProtoArrayForkChoice(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (m *BlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func _Debug_ListPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.BlockRoot)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
+	if interceptor == nil {
+		return srv.(DebugServer).ListPeers(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListPeers",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListPeers(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SSZResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Debug_GetPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.PeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Encoded)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
+	if interceptor == nil {
+		return srv.(DebugServer).GetPeer(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetPeer",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetPeer(ctx, req.(*v1alpha1.PeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LoggingLevelRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Debug_AddTrustedPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTrustedPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Level != 0 {
-		n += 1 + sovDebug(uint64(m.Level))
+	if interceptor == nil {
+		return srv.(DebugServer).AddTrustedPeer(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/AddTrustedPeer",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).AddTrustedPeer(ctx, req.(*AddTrustedPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ProtoArrayForkChoiceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PruneThreshold != 0 {
-		n += 1 + sovDebug(uint64(m.PruneThreshold))
+func _Debug_RemoveTrustedPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.PeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.JustifiedEpoch != 0 {
-		n += 1 + sovDebug(uint64(m.JustifiedEpoch))
+	if interceptor == nil {
+		return srv.(DebugServer).RemoveTrustedPeer(ctx, in)
 	}
-	if m.FinalizedEpoch != 0 {
-		n += 1 + sovDebug(uint64(m.FinalizedEpoch))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/RemoveTrustedPeer",
 	}
-	if len(m.ProtoArrayNodes) > 0 {
-		for _, e := range m.ProtoArrayNodes {
-			l = e.Size()
-			n += 1 + l + sovDebug(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).RemoveTrustedPeer(ctx, req.(*v1alpha1.PeerRequest))
 	}
-	if len(m.Indices) > 0 {
-		for k, v := range m.Indices {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovDebug(uint64(len(k))) + 1 + sovDebug(uint64(v))
-			n += mapEntrySize + 1 + sovDebug(uint64(mapEntrySize))
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_BanPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.PeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(DebugServer).BanPeer(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/BanPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).BanPeer(ctx, req.(*v1alpha1.PeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ProtoArrayNode) Size() (n int) {
-	if m == nil {
-		return 0
+func _Debug_GetAttestationSubnets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Slot != 0 {
-		n += 1 + sovDebug(uint64(m.Slot))
+	if interceptor == nil {
+		return srv.(DebugServer).GetAttestationSubnets(ctx, in)
 	}
-	l = len(m.Root)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetAttestationSubnets",
 	}
-	if m.Parent != 0 {
-		n += 1 + sovDebug(uint64(m.Parent))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetAttestationSubnets(ctx, req.(*types.Empty))
 	}
-	if m.JustifiedEpoch != 0 {
-		n += 1 + sovDebug(uint64(m.JustifiedEpoch))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetInclusionSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InclusionSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.FinalizedEpoch != 0 {
-		n += 1 + sovDebug(uint64(m.FinalizedEpoch))
+	if interceptor == nil {
+		return srv.(DebugServer).GetInclusionSlot(ctx, in)
 	}
-	if m.Weight != 0 {
-		n += 1 + sovDebug(uint64(m.Weight))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetInclusionSlot",
 	}
-	if m.BestChild != 0 {
-		n += 1 + sovDebug(uint64(m.BestChild))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetInclusionSlot(ctx, req.(*InclusionSlotRequest))
 	}
-	if m.BestDescendant != 0 {
-		n += 1 + sovDebug(uint64(m.BestDescendant))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_StreamChainReorgs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(types.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return srv.(DebugServer).StreamChainReorgs(m, &debugStreamChainReorgsServer{stream})
+}
+
+type Debug_StreamChainReorgsServer interface {
+	Send(*ChainReorg) error
+	grpc.ServerStream
+}
+
+type debugStreamChainReorgsServer struct {
+	grpc.ServerStream
+}
+
+func (x *debugStreamChainReorgsServer) Send(m *ChainReorg) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Debug_ExecuteStateTransition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(DebugServer).ExecuteStateTransition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ExecuteStateTransition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ExecuteStateTransition(ctx, req.(*StateTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DebugPeerResponses) Size() (n int) {
-	if m == nil {
-		return 0
+func _Debug_ListAttestationInclusions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestationInclusionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).ListAttestationInclusions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListAttestationInclusions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListAttestationInclusions(ctx, req.(*AttestationInclusionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Debug_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.Debug",
+	HandlerType: (*DebugServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBeaconState",
+			Handler:    _Debug_GetBeaconState_Handler,
+		},
+		{
+			MethodName: "GetBlock",
+			Handler:    _Debug_GetBlock_Handler,
+		},
+		{
+			MethodName: "SetLoggingLevel",
+			Handler:    _Debug_SetLoggingLevel_Handler,
+		},
+		{
+			MethodName: "GetProtoArrayForkChoice",
+			Handler:    _Debug_GetProtoArrayForkChoice_Handler,
+		},
+		{
+			MethodName: "ListPeers",
+			Handler:    _Debug_ListPeers_Handler,
+		},
+		{
+			MethodName: "GetPeer",
+			Handler:    _Debug_GetPeer_Handler,
+		},
+		{
+			MethodName: "AddTrustedPeer",
+			Handler:    _Debug_AddTrustedPeer_Handler,
+		},
+		{
+			MethodName: "RemoveTrustedPeer",
+			Handler:    _Debug_RemoveTrustedPeer_Handler,
+		},
+		{
+			MethodName: "BanPeer",
+			Handler:    _Debug_BanPeer_Handler,
+		},
+		{
+			MethodName: "GetAttestationSubnets",
+			Handler:    _Debug_GetAttestationSubnets_Handler,
+		},
+		{
+			MethodName: "GetInclusionSlot",
+			Handler:    _Debug_GetInclusionSlot_Handler,
+		},
+		{
+			MethodName: "ExecuteStateTransition",
+			Handler:    _Debug_ExecuteStateTransition_Handler,
+		},
+		{
+			MethodName: "ListAttestationInclusions",
+			Handler:    _Debug_ListAttestationInclusions_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamChainReorgs",
+			Handler:       _Debug_StreamChainReorgs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/beacon/rpc/v1/debug.proto",
+}
+
+func (m *AddTrustedPeerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AddTrustedPeerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddTrustedPeerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Responses) > 0 {
-		for _, e := range m.Responses {
-			l = e.Size()
-			n += 1 + l + sovDebug(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DebugPeerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AttestationSubnetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationSubnetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationSubnetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ListeningAddresses) > 0 {
-		for _, s := range m.ListeningAddresses {
-			l = len(s)
-			n += 1 + l + sovDebug(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RandomSubnets) > 0 {
+		for iNdEx := len(m.RandomSubnets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RandomSubnets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MetadataSeqNumber != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.MetadataSeqNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AdvertisedSubnets) > 0 {
+		dAtA2 := make([]byte, len(m.AdvertisedSubnets)*10)
+		var j1 int
+		for _, num := range m.AdvertisedSubnets {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintDebug(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSubnetAssignment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSubnetAssignment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSubnetAssignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExpirationEpoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.ExpirationEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Subnets) > 0 {
+		dAtA4 := make([]byte, len(m.Subnets)*10)
+		var j3 int
+		for _, num := range m.Subnets {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintDebug(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InclusionSlotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InclusionSlotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InclusionSlotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Slot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InclusionSlotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InclusionSlotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InclusionSlotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Slot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x10
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BeaconStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeaconStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.QueryFilter != nil {
+		{
+			size := m.QueryFilter.Size()
+			i -= size
+			if _, err := m.QueryFilter.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BeaconStateRequest_Slot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconStateRequest_Slot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+func (m *BeaconStateRequest_BlockRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconStateRequest_BlockRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BlockRoot != nil {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SSZResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SSZResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SSZResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Encoded) > 0 {
+		i -= len(m.Encoded)
+		copy(dAtA[i:], m.Encoded)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Encoded)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LoggingLevelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LoggingLevelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LoggingLevelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Level != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProtoArrayForkChoiceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProtoArrayForkChoiceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProtoArrayForkChoiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Indices) > 0 {
+		for k := range m.Indices {
+			v := m.Indices[k]
+			baseI := i
+			i = encodeVarintDebug(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintDebug(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintDebug(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ProtoArrayNodes) > 0 {
+		for iNdEx := len(m.ProtoArrayNodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtoArrayNodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.FinalizedEpoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.FinalizedEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.JustifiedEpoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.JustifiedEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PruneThreshold != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.PruneThreshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProtoArrayNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProtoArrayNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProtoArrayNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BestDescendant != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.BestDescendant))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BestChild != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.BestChild))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Weight != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FinalizedEpoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.FinalizedEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.JustifiedEpoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.JustifiedEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Parent != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Parent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DebugPeerResponses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DebugPeerResponses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DebugPeerResponses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Responses) > 0 {
+		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Responses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DebugPeerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DebugPeerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DebugPeerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.GossipScore != nil {
+		{
+			size, err := m.GossipScore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Banned {
+		i--
+		if m.Banned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Trusted {
+		i--
+		if m.Trusted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.LastUpdated != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.LastUpdated))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PeerStatus != nil {
+		{
+			size, err := m.PeerStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PeerInfo != nil {
+		{
+			size, err := m.PeerInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Enr) > 0 {
+		i -= len(m.Enr)
+		copy(dAtA[i:], m.Enr)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Enr)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PeerId) > 0 {
+		i -= len(m.PeerId)
+		copy(dAtA[i:], m.PeerId)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.PeerId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ConnectionState != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.ConnectionState))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Direction != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ListeningAddresses) > 0 {
+		for iNdEx := len(m.ListeningAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ListeningAddresses[iNdEx])
+			copy(dAtA[i:], m.ListeningAddresses[iNdEx])
+			i = encodeVarintDebug(dAtA, i, uint64(len(m.ListeningAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DebugPeerResponse_PeerInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DebugPeerResponse_PeerInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DebugPeerResponse_PeerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PeerLatency != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.PeerLatency))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.AgentVersion) > 0 {
+		i -= len(m.AgentVersion)
+		copy(dAtA[i:], m.AgentVersion)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.AgentVersion)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ProtocolVersion) > 0 {
+		i -= len(m.ProtocolVersion)
+		copy(dAtA[i:], m.ProtocolVersion)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.ProtocolVersion)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.FaultCount != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.FaultCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Protocols) > 0 {
+		for iNdEx := len(m.Protocols) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Protocols[iNdEx])
+			copy(dAtA[i:], m.Protocols[iNdEx])
+			i = encodeVarintDebug(dAtA, i, uint64(len(m.Protocols[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GossipScore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GossipScore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GossipScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BehaviourPenalty != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.BehaviourPenalty))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.IpColocationFactor != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.IpColocationFactor))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.AppSpecificScore != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.AppSpecificScore))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if len(m.TopicScores) > 0 {
+		for k := range m.TopicScores {
+			v := m.TopicScores[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintDebug(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintDebug(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintDebug(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Score != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Score))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicScoreSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicScoreSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicScoreSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.InvalidMessageDeliveries != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.InvalidMessageDeliveries))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.MeshMessageDeliveries != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MeshMessageDeliveries))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.FirstMessageDeliveries != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FirstMessageDeliveries))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.TimeInMesh != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.TimeInMesh))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainReorg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainReorg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainReorg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Depth != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.CommonAncestorRoot) > 0 {
+		i -= len(m.CommonAncestorRoot)
+		copy(dAtA[i:], m.CommonAncestorRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.CommonAncestorRoot)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CommonAncestorSlot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.CommonAncestorSlot))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.NewHeadRoot) > 0 {
+		i -= len(m.NewHeadRoot)
+		copy(dAtA[i:], m.NewHeadRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.NewHeadRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NewHeadSlot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.NewHeadSlot))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OldHeadRoot) > 0 {
+		i -= len(m.OldHeadRoot)
+		copy(dAtA[i:], m.OldHeadRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.OldHeadRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OldHeadSlot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.OldHeadSlot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateTransitionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateTransitionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateTransitionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SignedBlockSsz) > 0 {
+		i -= len(m.SignedBlockSsz)
+		copy(dAtA[i:], m.SignedBlockSsz)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.SignedBlockSsz)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PreState != nil {
+		{
+			size := m.PreState.Size()
+			i -= size
+			if _, err := m.PreState.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateTransitionRequest_PreStateSsz) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateTransitionRequest_PreStateSsz) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PreStateSsz != nil {
+		i -= len(m.PreStateSsz)
+		copy(dAtA[i:], m.PreStateSsz)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.PreStateSsz)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StateTransitionRequest_StateId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateTransitionRequest_StateId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.StateId)
+	copy(dAtA[i:], m.StateId)
+	i = encodeVarintDebug(dAtA, i, uint64(len(m.StateId)))
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+func (m *StateTransitionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateTransitionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateTransitionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PostStateRoot) > 0 {
+		i -= len(m.PostStateRoot)
+		copy(dAtA[i:], m.PostStateRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.PostStateRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PreStateRoot) > 0 {
+		i -= len(m.PreStateRoot)
+		copy(dAtA[i:], m.PreStateRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.PreStateRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateTransitionStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateTransitionStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateTransitionStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DurationNanoseconds != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.DurationNanoseconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.OperationIndex != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.OperationIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Operation {
+		i--
+		if m.Operation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateTransitionError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateTransitionError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateTransitionError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Step != nil {
+		{
+			size, err := m.Step.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationInclusionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationInclusionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationInclusionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintDebug(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Indices) > 0 {
+		dAtA13 := make([]byte, len(m.Indices)*10)
+		var j12 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintDebug(dAtA, i, uint64(j12))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndEpoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.EndEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationInclusionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationInclusionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationInclusionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Inclusions) > 0 {
+		for iNdEx := len(m.Inclusions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inclusions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationInclusion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationInclusion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationInclusion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.AggregationBits) > 0 {
+		i -= len(m.AggregationBits)
+		copy(dAtA[i:], m.AggregationBits)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.AggregationBits)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.AggregateIndex != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.AggregateIndex))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.ProposerIndex != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.ProposerIndex))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.CorrectHead {
+		i--
+		if m.CorrectHead {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.CorrectTarget {
+		i--
+		if m.CorrectTarget {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CorrectSource {
+		i--
+		if m.CorrectSource {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.InclusionDelay != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.InclusionDelay))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.InclusionSlot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.InclusionSlot))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AttestationSlot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.AttestationSlot))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Included {
+		i--
+		if m.Included {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDebug(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDebug(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AddTrustedPeerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttestationSubnetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AdvertisedSubnets) > 0 {
+		l = 0
+		for _, e := range m.AdvertisedSubnets {
+			l += sovDebug(uint64(e))
+		}
+		n += 1 + sovDebug(uint64(l)) + l
+	}
+	if m.MetadataSeqNumber != 0 {
+		n += 1 + sovDebug(uint64(m.MetadataSeqNumber))
+	}
+	if len(m.RandomSubnets) > 0 {
+		for _, e := range m.RandomSubnets {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorSubnetAssignment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if len(m.Subnets) > 0 {
+		l = 0
+		for _, e := range m.Subnets {
+			l += sovDebug(uint64(e))
+		}
+		n += 1 + sovDebug(uint64(l)) + l
+	}
+	if m.ExpirationEpoch != 0 {
+		n += 1 + sovDebug(uint64(m.ExpirationEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InclusionSlotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDebug(uint64(m.Id))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovDebug(uint64(m.Slot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InclusionSlotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovDebug(uint64(m.Slot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BeaconStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryFilter != nil {
+		n += m.QueryFilter.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BeaconStateRequest_Slot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovDebug(uint64(m.Slot))
+	return n
+}
+func (m *BeaconStateRequest_BlockRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRoot != nil {
+		l = len(m.BlockRoot)
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	return n
+}
+func (m *BlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SSZResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Encoded)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LoggingLevelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Level != 0 {
+		n += 1 + sovDebug(uint64(m.Level))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProtoArrayForkChoiceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PruneThreshold != 0 {
+		n += 1 + sovDebug(uint64(m.PruneThreshold))
+	}
+	if m.JustifiedEpoch != 0 {
+		n += 1 + sovDebug(uint64(m.JustifiedEpoch))
+	}
+	if m.FinalizedEpoch != 0 {
+		n += 1 + sovDebug(uint64(m.FinalizedEpoch))
+	}
+	if len(m.ProtoArrayNodes) > 0 {
+		for _, e := range m.ProtoArrayNodes {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if len(m.Indices) > 0 {
+		for k, v := range m.Indices {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovDebug(uint64(len(k))) + 1 + sovDebug(uint64(v))
+			n += mapEntrySize + 1 + sovDebug(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProtoArrayNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovDebug(uint64(m.Slot))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.Parent != 0 {
+		n += 1 + sovDebug(uint64(m.Parent))
+	}
+	if m.JustifiedEpoch != 0 {
+		n += 1 + sovDebug(uint64(m.JustifiedEpoch))
+	}
+	if m.FinalizedEpoch != 0 {
+		n += 1 + sovDebug(uint64(m.FinalizedEpoch))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovDebug(uint64(m.Weight))
+	}
+	if m.BestChild != 0 {
+		n += 1 + sovDebug(uint64(m.BestChild))
+	}
+	if m.BestDescendant != 0 {
+		n += 1 + sovDebug(uint64(m.BestDescendant))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DebugPeerResponses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DebugPeerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ListeningAddresses) > 0 {
+		for _, s := range m.ListeningAddresses {
+			l = len(s)
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.Direction != 0 {
+		n += 1 + sovDebug(uint64(m.Direction))
+	}
+	if m.ConnectionState != 0 {
+		n += 1 + sovDebug(uint64(m.ConnectionState))
+	}
+	l = len(m.PeerId)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	l = len(m.Enr)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.PeerInfo != nil {
+		l = m.PeerInfo.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.PeerStatus != nil {
+		l = m.PeerStatus.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.LastUpdated != 0 {
+		n += 1 + sovDebug(uint64(m.LastUpdated))
+	}
+	if m.Trusted {
+		n += 2
+	}
+	if m.Banned {
+		n += 2
+	}
+	if m.GossipScore != nil {
+		l = m.GossipScore.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DebugPeerResponse_PeerInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if len(m.Protocols) > 0 {
+		for _, s := range m.Protocols {
+			l = len(s)
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.FaultCount != 0 {
+		n += 1 + sovDebug(uint64(m.FaultCount))
+	}
+	l = len(m.ProtocolVersion)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	l = len(m.AgentVersion)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.PeerLatency != 0 {
+		n += 1 + sovDebug(uint64(m.PeerLatency))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GossipScore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Score != 0 {
+		n += 9
+	}
+	if len(m.TopicScores) > 0 {
+		for k, v := range m.TopicScores {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovDebug(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovDebug(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovDebug(uint64(mapEntrySize))
+		}
+	}
+	if m.AppSpecificScore != 0 {
+		n += 9
+	}
+	if m.IpColocationFactor != 0 {
+		n += 9
+	}
+	if m.BehaviourPenalty != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TopicScoreSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TimeInMesh != 0 {
+		n += 1 + sovDebug(uint64(m.TimeInMesh))
+	}
+	if m.FirstMessageDeliveries != 0 {
+		n += 9
+	}
+	if m.MeshMessageDeliveries != 0 {
+		n += 9
+	}
+	if m.InvalidMessageDeliveries != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChainReorg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OldHeadSlot != 0 {
+		n += 1 + sovDebug(uint64(m.OldHeadSlot))
+	}
+	l = len(m.OldHeadRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.NewHeadSlot != 0 {
+		n += 1 + sovDebug(uint64(m.NewHeadSlot))
+	}
+	l = len(m.NewHeadRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.CommonAncestorSlot != 0 {
+		n += 1 + sovDebug(uint64(m.CommonAncestorSlot))
+	}
+	l = len(m.CommonAncestorRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovDebug(uint64(m.Depth))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StateTransitionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreState != nil {
+		n += m.PreState.Size()
+	}
+	l = len(m.SignedBlockSsz)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StateTransitionRequest_PreStateSsz) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreStateSsz != nil {
+		l = len(m.PreStateSsz)
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	return n
+}
+func (m *StateTransitionRequest_StateId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StateId)
+	n += 1 + l + sovDebug(uint64(l))
+	return n
+}
+func (m *StateTransitionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PreStateRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	l = len(m.PostStateRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StateTransitionStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.Operation {
+		n += 2
+	}
+	if m.OperationIndex != 0 {
+		n += 1 + sovDebug(uint64(m.OperationIndex))
+	}
+	if m.DurationNanoseconds != 0 {
+		n += 1 + sovDebug(uint64(m.DurationNanoseconds))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StateTransitionError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Step != nil {
+		l = m.Step.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttestationInclusionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartEpoch != 0 {
+		n += 1 + sovDebug(uint64(m.StartEpoch))
+	}
+	if m.EndEpoch != 0 {
+		n += 1 + sovDebug(uint64(m.EndEpoch))
+	}
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovDebug(uint64(e))
+		}
+		n += 1 + sovDebug(uint64(l)) + l
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttestationInclusionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Inclusions) > 0 {
+		for _, e := range m.Inclusions {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttestationInclusion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovDebug(uint64(m.Epoch))
+	}
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovDebug(uint64(m.ValidatorIndex))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.Active {
+		n += 2
+	}
+	if m.Included {
+		n += 2
+	}
+	if m.AttestationSlot != 0 {
+		n += 1 + sovDebug(uint64(m.AttestationSlot))
+	}
+	if m.InclusionSlot != 0 {
+		n += 1 + sovDebug(uint64(m.InclusionSlot))
+	}
+	if m.InclusionDelay != 0 {
+		n += 1 + sovDebug(uint64(m.InclusionDelay))
+	}
+	if m.CorrectSource {
+		n += 2
+	}
+	if m.CorrectTarget {
+		n += 2
+	}
+	if m.CorrectHead {
+		n += 2
+	}
+	if m.ProposerIndex != 0 {
+		n += 1 + sovDebug(uint64(m.ProposerIndex))
+	}
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.AggregateIndex != 0 {
+		n += 1 + sovDebug(uint64(m.AggregateIndex))
+	}
+	l = len(m.AggregationBits)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovDebug(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDebug(x uint64) (n int) {
+	return sovDebug(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AddTrustedPeerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddTrustedPeerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddTrustedPeerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationSubnetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationSubnetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationSubnetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDebug
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AdvertisedSubnets = append(m.AdvertisedSubnets, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDebug
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthDebug
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthDebug
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AdvertisedSubnets) == 0 {
+					m.AdvertisedSubnets = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDebug
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AdvertisedSubnets = append(m.AdvertisedSubnets, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdvertisedSubnets", wireType)
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataSeqNumber", wireType)
+			}
+			m.MetadataSeqNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MetadataSeqNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandomSubnets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RandomSubnets = append(m.RandomSubnets, &ValidatorSubnetAssignment{})
+			if err := m.RandomSubnets[len(m.RandomSubnets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSubnetAssignment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSubnetAssignment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSubnetAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDebug
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Subnets = append(m.Subnets, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDebug
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthDebug
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthDebug
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Subnets) == 0 {
+					m.Subnets = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDebug
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Subnets = append(m.Subnets, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subnets", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationEpoch", wireType)
+			}
+			m.ExpirationEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InclusionSlotRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InclusionSlotRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InclusionSlotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InclusionSlotResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InclusionSlotResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InclusionSlotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeaconStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeaconStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeaconStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QueryFilter = &BeaconStateRequest_Slot{v}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.QueryFilter = &BeaconStateRequest_BlockRoot{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SSZResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SSZResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SSZResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoded", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encoded = append(m.Encoded[:0], dAtA[iNdEx:postIndex]...)
+			if m.Encoded == nil {
+				m.Encoded = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LoggingLevelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LoggingLevelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LoggingLevelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= LoggingLevelRequest_Level(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtoArrayForkChoiceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtoArrayForkChoiceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtoArrayForkChoiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneThreshold", wireType)
+			}
+			m.PruneThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PruneThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedEpoch", wireType)
+			}
+			m.JustifiedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JustifiedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+			}
+			m.FinalizedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtoArrayNodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtoArrayNodes = append(m.ProtoArrayNodes, &ProtoArrayNode{})
+			if err := m.ProtoArrayNodes[len(m.ProtoArrayNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Indices == nil {
+				m.Indices = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDebug
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDebug
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthDebug
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthDebug
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDebug
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDebug(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthDebug
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Indices[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtoArrayNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtoArrayNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtoArrayNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			m.Parent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedEpoch", wireType)
+			}
+			m.JustifiedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JustifiedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+			}
+			m.FinalizedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestChild", wireType)
+			}
+			m.BestChild = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BestChild |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestDescendant", wireType)
+			}
+			m.BestDescendant = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BestDescendant |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DebugPeerResponses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DebugPeerResponses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DebugPeerResponses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, &DebugPeerResponse{})
+			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DebugPeerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DebugPeerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DebugPeerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListeningAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ListeningAddresses = append(m.ListeningAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= v1alpha1.PeerDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
+			}
+			m.ConnectionState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConnectionState |= v1alpha1.ConnectionState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Enr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PeerInfo == nil {
+				m.PeerInfo = &DebugPeerResponse_PeerInfo{}
+			}
+			if err := m.PeerInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PeerStatus == nil {
+				m.PeerStatus = &v1.Status{}
+			}
+			if err := m.PeerStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+			}
+			m.LastUpdated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trusted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Trusted = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Banned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Banned = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GossipScore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GossipScore == nil {
+				m.GossipScore = &GossipScore{}
+			}
+			if err := m.GossipScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DebugPeerResponse_PeerInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &v1.MetaData{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocols", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Protocols = append(m.Protocols, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FaultCount", wireType)
+			}
+			m.FaultCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FaultCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerLatency", wireType)
+			}
+			m.PeerLatency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerLatency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.Direction != 0 {
-		n += 1 + sovDebug(uint64(m.Direction))
-	}
-	if m.ConnectionState != 0 {
-		n += 1 + sovDebug(uint64(m.ConnectionState))
-	}
-	l = len(m.PeerId)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	l = len(m.Enr)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	if m.PeerInfo != nil {
-		l = m.PeerInfo.Size()
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	if m.PeerStatus != nil {
-		l = m.PeerStatus.Size()
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	if m.LastUpdated != 0 {
-		n += 1 + sovDebug(uint64(m.LastUpdated))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func (m *DebugPeerResponse_PeerInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	if len(m.Protocols) > 0 {
-		for _, s := range m.Protocols {
-			l = len(s)
-			n += 1 + l + sovDebug(uint64(l))
-		}
-	}
-	if m.FaultCount != 0 {
-		n += 1 + sovDebug(uint64(m.FaultCount))
-	}
-	l = len(m.ProtocolVersion)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	l = len(m.AgentVersion)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	if m.PeerLatency != 0 {
-		n += 1 + sovDebug(uint64(m.PeerLatency))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovDebug(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDebug(x uint64) (n int) {
-	return sovDebug(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *InclusionSlotRequest) Unmarshal(dAtA []byte) error {
+func (m *GossipScore) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2106,17 +6875,28 @@ func (m *InclusionSlotRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InclusionSlotRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GossipScore: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InclusionSlotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GossipScore: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
 			}
-			m.Id = 0
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Score = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicScores", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2126,30 +6906,154 @@ func (m *InclusionSlotRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
 			}
-			m.Slot = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TopicScores == nil {
+				m.TopicScores = make(map[string]*TopicScoreSnapshot)
+			}
+			var mapkey string
+			var mapvalue *TopicScoreSnapshot
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDebug
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDebug
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthDebug
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthDebug
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDebug
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthDebug
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthDebug
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &TopicScoreSnapshot{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDebug(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthDebug
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
+			m.TopicScores[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppSpecificScore", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.AppSpecificScore = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpColocationFactor", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.IpColocationFactor = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BehaviourPenalty", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.BehaviourPenalty = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDebug(dAtA[iNdEx:])
@@ -2175,7 +7079,7 @@ func (m *InclusionSlotRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InclusionSlotResponse) Unmarshal(dAtA []byte) error {
+func (m *TopicScoreSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2198,17 +7102,17 @@ func (m *InclusionSlotResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InclusionSlotResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicScoreSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InclusionSlotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicScoreSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInMesh", wireType)
 			}
-			m.Slot = 0
+			m.TimeInMesh = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2218,11 +7122,44 @@ func (m *InclusionSlotResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
+				m.TimeInMesh |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstMessageDeliveries", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FirstMessageDeliveries = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MeshMessageDeliveries", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MeshMessageDeliveries = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidMessageDeliveries", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.InvalidMessageDeliveries = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDebug(dAtA[iNdEx:])
@@ -2248,7 +7185,7 @@ func (m *InclusionSlotResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BeaconStateRequest) Unmarshal(dAtA []byte) error {
+func (m *ChainReorg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2271,17 +7208,17 @@ func (m *BeaconStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BeaconStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChainReorg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BeaconStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChainReorg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldHeadSlot", wireType)
 			}
-			var v uint64
+			m.OldHeadSlot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2291,15 +7228,14 @@ func (m *BeaconStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint64(b&0x7F) << shift
+				m.OldHeadSlot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.QueryFilter = &BeaconStateRequest_Slot{v}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldHeadRoot", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2326,67 +7262,86 @@ func (m *BeaconStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.QueryFilter = &BeaconStateRequest_BlockRoot{v}
+			m.OldHeadRoot = append(m.OldHeadRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.OldHeadRoot == nil {
+				m.OldHeadRoot = []byte{}
+			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewHeadSlot", wireType)
 			}
-			if skippy < 0 {
+			m.NewHeadSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewHeadSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewHeadRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthDebug
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
 				return ErrInvalidLengthDebug
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BlockRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDebug
+			m.NewHeadRoot = append(m.NewHeadRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewHeadRoot == nil {
+				m.NewHeadRoot = []byte{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonAncestorSlot", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.CommonAncestorSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommonAncestorSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BlockRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonAncestorRoot", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2413,11 +7368,30 @@ func (m *BlockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockRoot == nil {
-				m.BlockRoot = []byte{}
+			m.CommonAncestorRoot = append(m.CommonAncestorRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.CommonAncestorRoot == nil {
+				m.CommonAncestorRoot = []byte{}
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDebug(dAtA[iNdEx:])
@@ -2443,7 +7417,7 @@ func (m *BlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SSZResponse) Unmarshal(dAtA []byte) error {
+func (m *StateTransitionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2466,15 +7440,15 @@ func (m *SSZResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SSZResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StateTransitionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SSZResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StateTransitionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Encoded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreStateSsz", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2501,70 +7475,47 @@ func (m *SSZResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Encoded = append(m.Encoded[:0], dAtA[iNdEx:postIndex]...)
-			if m.Encoded == nil {
-				m.Encoded = []byte{}
-			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.PreState = &StateTransitionRequest_PreStateSsz{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateId", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthDebug
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDebug
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LoggingLevelRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDebug
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LoggingLevelRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LoggingLevelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			m.PreState = &StateTransitionRequest_StateId{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlockSsz", wireType)
 			}
-			m.Level = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2574,11 +7525,26 @@ func (m *LoggingLevelRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Level |= LoggingLevelRequest_Level(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignedBlockSsz = append(m.SignedBlockSsz[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignedBlockSsz == nil {
+				m.SignedBlockSsz = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDebug(dAtA[iNdEx:])
@@ -2604,7 +7570,7 @@ func (m *LoggingLevelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProtoArrayForkChoiceResponse) Unmarshal(dAtA []byte) error {
+func (m *StateTransitionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2627,17 +7593,17 @@ func (m *ProtoArrayForkChoiceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProtoArrayForkChoiceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StateTransitionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProtoArrayForkChoiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StateTransitionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PruneThreshold", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreStateRoot", wireType)
 			}
-			m.PruneThreshold = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2647,16 +7613,31 @@ func (m *ProtoArrayForkChoiceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PruneThreshold |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreStateRoot = append(m.PreStateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.PreStateRoot == nil {
+				m.PreStateRoot = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedEpoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostStateRoot", wireType)
 			}
-			m.JustifiedEpoch = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2666,33 +7647,29 @@ func (m *ProtoArrayForkChoiceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.JustifiedEpoch |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
 			}
-			m.FinalizedEpoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FinalizedEpoch |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostStateRoot = append(m.PostStateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.PostStateRoot == nil {
+				m.PostStateRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtoArrayNodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2719,14 +7696,14 @@ func (m *ProtoArrayForkChoiceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProtoArrayNodes = append(m.ProtoArrayNodes, &ProtoArrayNode{})
-			if err := m.ProtoArrayNodes[len(m.ProtoArrayNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Steps = append(m.Steps, &StateTransitionStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2753,89 +7730,12 @@ func (m *ProtoArrayForkChoiceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Indices == nil {
-				m.Indices = make(map[string]uint64)
+			if m.Error == nil {
+				m.Error = &StateTransitionError{}
 			}
-			var mapkey string
-			var mapvalue uint64
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowDebug
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowDebug
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthDebug
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthDebug
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowDebug
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipDebug(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthDebug
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Indices[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2862,7 +7762,7 @@ func (m *ProtoArrayForkChoiceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProtoArrayNode) Unmarshal(dAtA []byte) error {
+func (m *StateTransitionStep) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2885,108 +7785,17 @@ func (m *ProtoArrayNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProtoArrayNode: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProtoArrayNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StateTransitionStep: wiretype end group for non-group")
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
-			}
-			m.Slot = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthDebug
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
-			if m.Root == nil {
-				m.Root = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
-			}
-			m.Parent = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Parent |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedEpoch", wireType)
-			}
-			m.JustifiedEpoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.JustifiedEpoch |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateTransitionStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.FinalizedEpoch = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2996,16 +7805,29 @@ func (m *ProtoArrayNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FinalizedEpoch |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
-			m.Weight = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -3015,16 +7837,17 @@ func (m *ProtoArrayNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Weight |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			m.Operation = bool(v != 0)
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BestChild", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationIndex", wireType)
 			}
-			m.BestChild = 0
+			m.OperationIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -3034,16 +7857,16 @@ func (m *ProtoArrayNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BestChild |= uint64(b&0x7F) << shift
+				m.OperationIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BestDescendant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationNanoseconds", wireType)
 			}
-			m.BestDescendant = 0
+			m.DurationNanoseconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -3053,7 +7876,7 @@ func (m *ProtoArrayNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BestDescendant |= uint64(b&0x7F) << shift
+				m.DurationNanoseconds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3083,7 +7906,7 @@ func (m *ProtoArrayNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DebugPeerResponses) Unmarshal(dAtA []byte) error {
+func (m *StateTransitionError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3106,15 +7929,15 @@ func (m *DebugPeerResponses) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DebugPeerResponses: wiretype end group for non-group")
+			return fmt.Errorf("proto: StateTransitionError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DebugPeerResponses: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StateTransitionError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3141,11 +7964,45 @@ func (m *DebugPeerResponses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Responses = append(m.Responses, &DebugPeerResponse{})
-			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Step == nil {
+				m.Step = &StateTransitionStep{}
+			}
+			if err := m.Step.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDebug(dAtA[iNdEx:])
@@ -3171,7 +8028,7 @@ func (m *DebugPeerResponses) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DebugPeerResponse) Unmarshal(dAtA []byte) error {
+func (m *AttestationInclusionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3194,17 +8051,17 @@ func (m *DebugPeerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DebugPeerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AttestationInclusionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DebugPeerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AttestationInclusionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListeningAddresses", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
 			}
-			var stringLen uint64
+			m.StartEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -3214,29 +8071,16 @@ func (m *DebugPeerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ListeningAddresses = append(m.ListeningAddresses, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
 			}
-			m.Direction = 0
+			m.EndEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -3246,67 +8090,92 @@ func (m *DebugPeerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Direction |= v1alpha1.PeerDirection(b&0x7F) << shift
+				m.EndEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
-			}
-			m.ConnectionState = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDebug
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDebug
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDebug
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ConnectionState |= v1alpha1.ConnectionState(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthDebug
 				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDebug
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthDebug
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDebug
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PeerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -3316,63 +8185,81 @@ func (m *DebugPeerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Enr = string(dAtA[iNdEx:postIndex])
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthDebug
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthDebug
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PeerInfo == nil {
-				m.PeerInfo = &DebugPeerResponse_PeerInfo{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationInclusionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
 			}
-			if err := m.PeerInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationInclusionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationInclusionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inclusions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3399,32 +8286,11 @@ func (m *DebugPeerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PeerStatus == nil {
-				m.PeerStatus = &v1.Status{}
-			}
-			if err := m.PeerStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Inclusions = append(m.Inclusions, &AttestationInclusion{})
+			if err := m.Inclusions[len(m.Inclusions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
-			}
-			m.LastUpdated = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastUpdated |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDebug(dAtA[iNdEx:])
@@ -3450,7 +8316,7 @@ func (m *DebugPeerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DebugPeerResponse_PeerInfo) Unmarshal(dAtA []byte) error {
+func (m *AttestationInclusion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3473,17 +8339,55 @@ func (m *DebugPeerResponse_PeerInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PeerInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: AttestationInclusion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PeerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AttestationInclusion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -3493,33 +8397,31 @@ func (m *DebugPeerResponse_PeerInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &v1.MetaData{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Protocols", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -3529,29 +8431,56 @@ func (m *DebugPeerResponse_PeerInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDebug
+			m.Active = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Included", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDebug
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Included = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationSlot", wireType)
 			}
-			m.Protocols = append(m.Protocols, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
+			m.AttestationSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FaultCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionSlot", wireType)
 			}
-			m.FaultCount = 0
+			m.InclusionSlot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -3561,16 +8490,16 @@ func (m *DebugPeerResponse_PeerInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FaultCount |= uint64(b&0x7F) << shift
+				m.InclusionSlot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionDelay", wireType)
 			}
-			var stringLen uint64
+			m.InclusionDelay = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -3580,29 +8509,95 @@ func (m *DebugPeerResponse_PeerInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.InclusionDelay |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDebug
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectSource", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDebug
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.CorrectSource = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectTarget", wireType)
 			}
-			m.ProtocolVersion = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CorrectTarget = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectHead", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CorrectHead = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerIndex", wireType)
+			}
+			m.ProposerIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposerIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AgentVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -3612,29 +8607,31 @@ func (m *DebugPeerResponse_PeerInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AgentVersion = string(dAtA[iNdEx:postIndex])
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
 			iNdEx = postIndex
-		case 6:
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerLatency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateIndex", wireType)
 			}
-			m.PeerLatency = 0
+			m.AggregateIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -3644,11 +8641,45 @@ func (m *DebugPeerResponse_PeerInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PeerLatency |= uint64(b&0x7F) << shift
+				m.AggregateIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregationBits", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregationBits = append(m.AggregationBits[:0], dAtA[iNdEx:postIndex]...)
+			if m.AggregationBits == nil {
+				m.AggregationBits = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDebug(dAtA[iNdEx:])
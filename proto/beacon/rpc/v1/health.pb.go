@@ -76,30 +76,126 @@ func (m *LogsResponse) GetLogs() []string {
 	return nil
 }
 
+type BackfillStatus struct {
+	OriginSlot           uint64   `protobuf:"varint,1,opt,name=origin_slot,json=originSlot,proto3" json:"origin_slot,omitempty"`
+	OriginRoot           []byte   `protobuf:"bytes,2,opt,name=origin_root,json=originRoot,proto3" json:"origin_root,omitempty"`
+	LowestSlot           uint64   `protobuf:"varint,3,opt,name=lowest_slot,json=lowestSlot,proto3" json:"lowest_slot,omitempty"`
+	LowestRoot           []byte   `protobuf:"bytes,4,opt,name=lowest_root,json=lowestRoot,proto3" json:"lowest_root,omitempty"`
+	Complete             bool     `protobuf:"varint,5,opt,name=complete,proto3" json:"complete,omitempty"`
+	Backfilling          bool     `protobuf:"varint,6,opt,name=backfilling,proto3" json:"backfilling,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackfillStatus) Reset()         { *m = BackfillStatus{} }
+func (m *BackfillStatus) String() string { return proto.CompactTextString(m) }
+func (*BackfillStatus) ProtoMessage()    {}
+func (*BackfillStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e4b7e98e3e10444, []int{1}
+}
+func (m *BackfillStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackfillStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackfillStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BackfillStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackfillStatus.Merge(m, src)
+}
+func (m *BackfillStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackfillStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackfillStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackfillStatus proto.InternalMessageInfo
+
+func (m *BackfillStatus) GetOriginSlot() uint64 {
+	if m != nil {
+		return m.OriginSlot
+	}
+	return 0
+}
+
+func (m *BackfillStatus) GetOriginRoot() []byte {
+	if m != nil {
+		return m.OriginRoot
+	}
+	return nil
+}
+
+func (m *BackfillStatus) GetLowestSlot() uint64 {
+	if m != nil {
+		return m.LowestSlot
+	}
+	return 0
+}
+
+func (m *BackfillStatus) GetLowestRoot() []byte {
+	if m != nil {
+		return m.LowestRoot
+	}
+	return nil
+}
+
+func (m *BackfillStatus) GetComplete() bool {
+	if m != nil {
+		return m.Complete
+	}
+	return false
+}
+
+func (m *BackfillStatus) GetBackfilling() bool {
+	if m != nil {
+		return m.Backfilling
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*LogsResponse)(nil), "ethereum.beacon.rpc.v1.LogsResponse")
+	proto.RegisterType((*BackfillStatus)(nil), "ethereum.beacon.rpc.v1.BackfillStatus")
 }
 
 func init() { proto.RegisterFile("proto/beacon/rpc/v1/health.proto", fileDescriptor_2e4b7e98e3e10444) }
 
 var fileDescriptor_2e4b7e98e3e10444 = []byte{
-	// 249 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x28, 0x28, 0xca, 0x2f,
-	0xc9, 0xd7, 0x4f, 0x4a, 0x4d, 0x4c, 0xce, 0xcf, 0xd3, 0x2f, 0x2a, 0x48, 0xd6, 0x2f, 0x33, 0xd4,
-	0xcf, 0x48, 0x4d, 0xcc, 0x29, 0xc9, 0xd0, 0x03, 0x4b, 0x09, 0x89, 0xa5, 0x96, 0x64, 0xa4, 0x16,
-	0xa5, 0x96, 0xe6, 0xea, 0x41, 0x14, 0xe9, 0x15, 0x15, 0x24, 0xeb, 0x95, 0x19, 0x4a, 0xc9, 0xa4,
-	0xe7, 0xe7, 0xa7, 0xe7, 0xa4, 0xea, 0x27, 0x16, 0x64, 0xea, 0x27, 0xe6, 0xe5, 0xe5, 0x97, 0x24,
-	0x96, 0x64, 0xe6, 0xe7, 0x15, 0x43, 0x74, 0x49, 0x49, 0x43, 0x65, 0xc1, 0xbc, 0xa4, 0xd2, 0x34,
-	0xfd, 0xd4, 0xdc, 0x82, 0x92, 0x4a, 0x88, 0xa4, 0x92, 0x12, 0x17, 0x8f, 0x4f, 0x7e, 0x7a, 0x71,
-	0x50, 0x6a, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa, 0x90, 0x10, 0x17, 0x4b, 0x4e, 0x7e, 0x7a, 0xb1,
-	0x04, 0xa3, 0x02, 0xb3, 0x06, 0x67, 0x10, 0x98, 0x6d, 0xd4, 0xc6, 0xc8, 0xc5, 0xe6, 0x01, 0x76,
-	0x87, 0x50, 0x0d, 0x97, 0x40, 0x70, 0x49, 0x51, 0x6a, 0x62, 0xae, 0x13, 0xd8, 0x01, 0x20, 0xad,
-	0x42, 0x62, 0x7a, 0x10, 0x0b, 0xf4, 0x60, 0x16, 0xe8, 0xb9, 0x82, 0x2c, 0x90, 0x52, 0xd1, 0xc3,
-	0xee, 0x5c, 0x3d, 0x64, 0x0b, 0x95, 0x34, 0x9a, 0x2e, 0x3f, 0x99, 0xcc, 0xa4, 0x24, 0xa4, 0xa0,
-	0x9f, 0x5a, 0x92, 0xa1, 0x5f, 0x66, 0x98, 0x98, 0x53, 0x90, 0x91, 0x08, 0xf3, 0xb7, 0x3e, 0xc8,
-	0x7e, 0xfd, 0x62, 0xb0, 0x8d, 0x06, 0x8c, 0x4e, 0x3c, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24,
-	0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0x63, 0x12, 0x1b, 0xd8, 0x3e, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xb7, 0x40, 0x67, 0x57, 0x38, 0x01, 0x00, 0x00,
+	// 381 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x41, 0x8e, 0xd3, 0x30,
+	0x14, 0x86, 0xe5, 0xb6, 0x54, 0xc5, 0xad, 0x10, 0x78, 0x51, 0x45, 0x01, 0xda, 0x28, 0x02, 0x94,
+	0x95, 0x4d, 0xe1, 0x06, 0x95, 0x10, 0x2c, 0x58, 0xa5, 0x07, 0x40, 0x4e, 0xe4, 0x26, 0x11, 0x4e,
+	0x9e, 0x15, 0xbf, 0x16, 0x81, 0x58, 0xf5, 0x0a, 0x5c, 0x8a, 0xe5, 0x68, 0xe6, 0x02, 0xa3, 0x6a,
+	0x0e, 0x32, 0xaa, 0xdd, 0x8c, 0x3a, 0xa3, 0x76, 0x67, 0xbf, 0xff, 0xf7, 0xff, 0x3f, 0x7d, 0xa6,
+	0x91, 0x69, 0x01, 0x41, 0x64, 0x4a, 0xe6, 0xd0, 0x88, 0xd6, 0xe4, 0x62, 0xbb, 0x10, 0xa5, 0x92,
+	0x1a, 0x4b, 0xee, 0x24, 0x36, 0x55, 0x58, 0xaa, 0x56, 0x6d, 0x6a, 0xee, 0x4d, 0xbc, 0x35, 0x39,
+	0xdf, 0x2e, 0xc2, 0x37, 0x05, 0x40, 0xa1, 0x95, 0x90, 0xa6, 0x12, 0xb2, 0x69, 0x00, 0x25, 0x56,
+	0xd0, 0x58, 0xff, 0x2a, 0x7c, 0x7d, 0x54, 0xdd, 0x2d, 0xdb, 0xac, 0x85, 0xaa, 0x0d, 0xfe, 0xf6,
+	0x62, 0x1c, 0xd3, 0xc9, 0x77, 0x28, 0x6c, 0xaa, 0xac, 0x81, 0xc6, 0x2a, 0xc6, 0xe8, 0x40, 0x43,
+	0x61, 0x03, 0x12, 0xf5, 0x93, 0xe7, 0xa9, 0x3b, 0xc7, 0xd7, 0x84, 0xbe, 0x58, 0xca, 0xfc, 0xe7,
+	0xba, 0xd2, 0x7a, 0x85, 0x12, 0x37, 0x96, 0xcd, 0xe9, 0x18, 0xda, 0xaa, 0xa8, 0x9a, 0x1f, 0x56,
+	0x03, 0x06, 0x24, 0x22, 0xc9, 0x20, 0xa5, 0x7e, 0xb4, 0xd2, 0x80, 0x27, 0x86, 0x16, 0x00, 0x83,
+	0x5e, 0x44, 0x92, 0x49, 0x67, 0x48, 0xc1, 0x1b, 0x34, 0xfc, 0x52, 0x16, 0x7d, 0x42, 0xdf, 0x27,
+	0xf8, 0x51, 0x97, 0x70, 0x34, 0xb8, 0x84, 0x81, 0x4f, 0xf0, 0x23, 0x97, 0x10, 0xd2, 0x51, 0x0e,
+	0xb5, 0xd1, 0x0a, 0x55, 0xf0, 0x2c, 0x22, 0xc9, 0x28, 0x7d, 0xb8, 0xb3, 0x88, 0x8e, 0xb3, 0xe3,
+	0xc6, 0x55, 0x53, 0x04, 0x43, 0x27, 0x9f, 0x8e, 0x3e, 0xed, 0x7a, 0x74, 0xf8, 0xcd, 0xc1, 0x65,
+	0x7f, 0xe9, 0xcb, 0x15, 0xb6, 0x4a, 0xd6, 0x4b, 0x47, 0xf5, 0xc0, 0x83, 0x4d, 0xb9, 0xa7, 0xc6,
+	0x3b, 0x6a, 0xfc, 0xcb, 0x81, 0x5a, 0xf8, 0x8e, 0x9f, 0xff, 0x03, 0x7e, 0x4a, 0x31, 0x4e, 0x76,
+	0x37, 0x77, 0xff, 0x7a, 0x31, 0x8b, 0x84, 0xc2, 0x52, 0x6c, 0x17, 0x52, 0x9b, 0x52, 0x76, 0x9f,
+	0x29, 0x0e, 0x50, 0x85, 0x75, 0x8d, 0x1f, 0x09, 0xfb, 0x43, 0x5f, 0x7d, 0x55, 0xf8, 0x84, 0xef,
+	0xa5, 0xfa, 0x0f, 0x97, 0xea, 0x1f, 0xbf, 0x8f, 0xdf, 0xbb, 0x05, 0xe6, 0xec, 0xed, 0xd9, 0x05,
+	0x3a, 0x0e, 0xcb, 0xc9, 0xff, 0xfd, 0x8c, 0x5c, 0xed, 0x67, 0xe4, 0x76, 0x3f, 0x23, 0xd9, 0xd0,
+	0x95, 0x7d, 0xbe, 0x1f, 0x00, 0x29, 0x53, 0x90, 0x63, 0x89, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -115,6 +211,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type HealthClient interface {
 	StreamBeaconLogs(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (Health_StreamBeaconLogsClient, error)
+	GetBackfillStatus(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*BackfillStatus, error)
 }
 
 type healthClient struct {
@@ -157,9 +254,19 @@ func (x *healthStreamBeaconLogsClient) Recv() (*LogsResponse, error) {
 	return m, nil
 }
 
+func (c *healthClient) GetBackfillStatus(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*BackfillStatus, error) {
+	out := new(BackfillStatus)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Health/GetBackfillStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HealthServer is the server API for Health service.
 type HealthServer interface {
 	StreamBeaconLogs(*types.Empty, Health_StreamBeaconLogsServer) error
+	GetBackfillStatus(context.Context, *types.Empty) (*BackfillStatus, error)
 }
 
 // UnimplementedHealthServer can be embedded to have forward compatible implementations.
@@ -169,6 +276,9 @@ type UnimplementedHealthServer struct {
 func (*UnimplementedHealthServer) StreamBeaconLogs(req *types.Empty, srv Health_StreamBeaconLogsServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamBeaconLogs not implemented")
 }
+func (*UnimplementedHealthServer) GetBackfillStatus(ctx context.Context, req *types.Empty) (*BackfillStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBackfillStatus not implemented")
+}
 
 func RegisterHealthServer(s *grpc.Server, srv HealthServer) {
 	s.RegisterService(&_Health_serviceDesc, srv)
@@ -195,10 +305,33 @@ func (x *healthStreamBeaconLogsServer) Send(m *LogsResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Health_GetBackfillStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServer).GetBackfillStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Health/GetBackfillStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServer).GetBackfillStatus(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Health_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethereum.beacon.rpc.v1.Health",
 	HandlerType: (*HealthServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBackfillStatus",
+			Handler:    _Health_GetBackfillStatus_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "StreamBeaconLogs",
@@ -245,6 +378,77 @@ func (m *LogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BackfillStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackfillStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackfillStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Backfilling {
+		i--
+		if m.Backfilling {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Complete {
+		i--
+		if m.Complete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.LowestRoot) > 0 {
+		i -= len(m.LowestRoot)
+		copy(dAtA[i:], m.LowestRoot)
+		i = encodeVarintHealth(dAtA, i, uint64(len(m.LowestRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LowestSlot != 0 {
+		i = encodeVarintHealth(dAtA, i, uint64(m.LowestSlot))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OriginRoot) > 0 {
+		i -= len(m.OriginRoot)
+		copy(dAtA[i:], m.OriginRoot)
+		i = encodeVarintHealth(dAtA, i, uint64(len(m.OriginRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OriginSlot != 0 {
+		i = encodeVarintHealth(dAtA, i, uint64(m.OriginSlot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintHealth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovHealth(v)
 	base := offset
@@ -274,6 +478,38 @@ func (m *LogsResponse) Size() (n int) {
 	return n
 }
 
+func (m *BackfillStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OriginSlot != 0 {
+		n += 1 + sovHealth(uint64(m.OriginSlot))
+	}
+	l = len(m.OriginRoot)
+	if l > 0 {
+		n += 1 + l + sovHealth(uint64(l))
+	}
+	if m.LowestSlot != 0 {
+		n += 1 + sovHealth(uint64(m.LowestSlot))
+	}
+	l = len(m.LowestRoot)
+	if l > 0 {
+		n += 1 + l + sovHealth(uint64(l))
+	}
+	if m.Complete {
+		n += 2
+	}
+	if m.Backfilling {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovHealth(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -366,6 +602,206 @@ func (m *LogsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BackfillStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHealth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackfillStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackfillStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginSlot", wireType)
+			}
+			m.OriginSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHealth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginRoot = append(m.OriginRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.OriginRoot == nil {
+				m.OriginRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowestSlot", wireType)
+			}
+			m.LowestSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowestSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowestRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHealth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LowestRoot = append(m.LowestRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.LowestRoot == nil {
+				m.LowestRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Complete = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backfilling", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Backfilling = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHealth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipHealth(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -50,3 +50,107 @@ func V1ToV1Alpha1Block(alphaBlk *ethpb.SignedBeaconBlock) (*ethpb_alpha.SignedBe
 	}
 	return v1alpha1Block, nil
 }
+
+// V1Alpha1ToV1Attestation converts a v1alpha1 Attestation proto to a v1 proto.
+func V1Alpha1ToV1Attestation(v1alpha1Att *ethpb_alpha.Attestation) (*ethpb.Attestation, error) {
+	marshaledAtt, err := v1alpha1Att.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal attestation")
+	}
+	v1Att := &ethpb.Attestation{}
+	if err := proto.Unmarshal(marshaledAtt, v1Att); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal attestation")
+	}
+	return v1Att, nil
+}
+
+// V1ToV1Alpha1Attestation converts a v1 Attestation proto to a v1alpha1 proto.
+func V1ToV1Alpha1Attestation(v1Att *ethpb.Attestation) (*ethpb_alpha.Attestation, error) {
+	marshaledAtt, err := v1Att.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal attestation")
+	}
+	v1alpha1Att := &ethpb_alpha.Attestation{}
+	if err := proto.Unmarshal(marshaledAtt, v1alpha1Att); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal attestation")
+	}
+	return v1alpha1Att, nil
+}
+
+// V1Alpha1ToV1AttesterSlashing converts a v1alpha1 AttesterSlashing proto to a v1 proto.
+func V1Alpha1ToV1AttesterSlashing(v1alpha1Slashing *ethpb_alpha.AttesterSlashing) (*ethpb.AttesterSlashing, error) {
+	marshaledSlashing, err := v1alpha1Slashing.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal attester slashing")
+	}
+	v1Slashing := &ethpb.AttesterSlashing{}
+	if err := proto.Unmarshal(marshaledSlashing, v1Slashing); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal attester slashing")
+	}
+	return v1Slashing, nil
+}
+
+// V1ToV1Alpha1AttesterSlashing converts a v1 AttesterSlashing proto to a v1alpha1 proto.
+func V1ToV1Alpha1AttesterSlashing(v1Slashing *ethpb.AttesterSlashing) (*ethpb_alpha.AttesterSlashing, error) {
+	marshaledSlashing, err := v1Slashing.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal attester slashing")
+	}
+	v1alpha1Slashing := &ethpb_alpha.AttesterSlashing{}
+	if err := proto.Unmarshal(marshaledSlashing, v1alpha1Slashing); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal attester slashing")
+	}
+	return v1alpha1Slashing, nil
+}
+
+// V1Alpha1ToV1ProposerSlashing converts a v1alpha1 ProposerSlashing proto to a v1 proto.
+func V1Alpha1ToV1ProposerSlashing(v1alpha1Slashing *ethpb_alpha.ProposerSlashing) (*ethpb.ProposerSlashing, error) {
+	marshaledSlashing, err := v1alpha1Slashing.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal proposer slashing")
+	}
+	v1Slashing := &ethpb.ProposerSlashing{}
+	if err := proto.Unmarshal(marshaledSlashing, v1Slashing); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal proposer slashing")
+	}
+	return v1Slashing, nil
+}
+
+// V1ToV1Alpha1ProposerSlashing converts a v1 ProposerSlashing proto to a v1alpha1 proto.
+func V1ToV1Alpha1ProposerSlashing(v1Slashing *ethpb.ProposerSlashing) (*ethpb_alpha.ProposerSlashing, error) {
+	marshaledSlashing, err := v1Slashing.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal proposer slashing")
+	}
+	v1alpha1Slashing := &ethpb_alpha.ProposerSlashing{}
+	if err := proto.Unmarshal(marshaledSlashing, v1alpha1Slashing); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal proposer slashing")
+	}
+	return v1alpha1Slashing, nil
+}
+
+// V1Alpha1ToV1Exit converts a v1alpha1 SignedVoluntaryExit proto to a v1 proto.
+func V1Alpha1ToV1Exit(v1alpha1Exit *ethpb_alpha.SignedVoluntaryExit) (*ethpb.SignedVoluntaryExit, error) {
+	marshaledExit, err := v1alpha1Exit.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal voluntary exit")
+	}
+	v1Exit := &ethpb.SignedVoluntaryExit{}
+	if err := proto.Unmarshal(marshaledExit, v1Exit); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal voluntary exit")
+	}
+	return v1Exit, nil
+}
+
+// V1ToV1Alpha1Exit converts a v1 SignedVoluntaryExit proto to a v1alpha1 proto.
+func V1ToV1Alpha1Exit(v1Exit *ethpb.SignedVoluntaryExit) (*ethpb_alpha.SignedVoluntaryExit, error) {
+	marshaledExit, err := v1Exit.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal voluntary exit")
+	}
+	v1alpha1Exit := &ethpb_alpha.SignedVoluntaryExit{}
+	if err := proto.Unmarshal(marshaledExit, v1alpha1Exit); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal voluntary exit")
+	}
+	return v1alpha1Exit, nil
+}
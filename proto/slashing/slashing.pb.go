@@ -14,6 +14,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	v1alpha1 "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	github_com_prysmaticlabs_go_bitfield "github.com/prysmaticlabs/go-bitfield"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -30,6 +31,325 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type SlashingStatus int32
+
+const (
+	SlashingStatus_ALL      SlashingStatus = 0
+	SlashingStatus_PENDING  SlashingStatus = 1
+	SlashingStatus_INCLUDED SlashingStatus = 2
+)
+
+var SlashingStatus_name = map[int32]string{
+	0: "ALL",
+	1: "PENDING",
+	2: "INCLUDED",
+}
+
+var SlashingStatus_value = map[string]int32{
+	"ALL":      0,
+	"PENDING":  1,
+	"INCLUDED": 2,
+}
+
+func (x SlashingStatus) String() string {
+	return proto.EnumName(SlashingStatus_name, int32(x))
+}
+
+func (SlashingStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{0}
+}
+
+type SlashingsRequest struct {
+	ValidatorIndices     []uint64       `protobuf:"varint,1,rep,packed,name=validator_indices,json=validatorIndices,proto3" json:"validator_indices,omitempty"`
+	StartEpoch           uint64         `protobuf:"varint,2,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	EndEpoch             uint64         `protobuf:"varint,3,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
+	Status               SlashingStatus `protobuf:"varint,4,opt,name=status,proto3,enum=ethereum.slashing.SlashingStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SlashingsRequest) Reset()         { *m = SlashingsRequest{} }
+func (m *SlashingsRequest) String() string { return proto.CompactTextString(m) }
+func (*SlashingsRequest) ProtoMessage()    {}
+func (*SlashingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{0}
+}
+func (m *SlashingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashingsRequest.Merge(m, src)
+}
+func (m *SlashingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashingsRequest proto.InternalMessageInfo
+
+func (m *SlashingsRequest) GetValidatorIndices() []uint64 {
+	if m != nil {
+		return m.ValidatorIndices
+	}
+	return nil
+}
+
+func (m *SlashingsRequest) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
+}
+
+func (m *SlashingsRequest) GetEndEpoch() uint64 {
+	if m != nil {
+		return m.EndEpoch
+	}
+	return 0
+}
+
+func (m *SlashingsRequest) GetStatus() SlashingStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SlashingStatus_ALL
+}
+
+type AttesterSlashingsResponse struct {
+	Slashings            []*AttesterSlashingRecord `protobuf:"bytes,1,rep,name=slashings,proto3" json:"slashings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *AttesterSlashingsResponse) Reset()         { *m = AttesterSlashingsResponse{} }
+func (m *AttesterSlashingsResponse) String() string { return proto.CompactTextString(m) }
+func (*AttesterSlashingsResponse) ProtoMessage()    {}
+func (*AttesterSlashingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{1}
+}
+func (m *AttesterSlashingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttesterSlashingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttesterSlashingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttesterSlashingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttesterSlashingsResponse.Merge(m, src)
+}
+func (m *AttesterSlashingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttesterSlashingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttesterSlashingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttesterSlashingsResponse proto.InternalMessageInfo
+
+func (m *AttesterSlashingsResponse) GetSlashings() []*AttesterSlashingRecord {
+	if m != nil {
+		return m.Slashings
+	}
+	return nil
+}
+
+type AttesterSlashingRecord struct {
+	Slashing             *v1alpha1.AttesterSlashing `protobuf:"bytes,1,opt,name=slashing,proto3" json:"slashing,omitempty"`
+	SlashedIndices       []uint64                   `protobuf:"varint,2,rep,packed,name=slashed_indices,json=slashedIndices,proto3" json:"slashed_indices,omitempty"`
+	Status               SlashingStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=ethereum.slashing.SlashingStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *AttesterSlashingRecord) Reset()         { *m = AttesterSlashingRecord{} }
+func (m *AttesterSlashingRecord) String() string { return proto.CompactTextString(m) }
+func (*AttesterSlashingRecord) ProtoMessage()    {}
+func (*AttesterSlashingRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{2}
+}
+func (m *AttesterSlashingRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttesterSlashingRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttesterSlashingRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttesterSlashingRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttesterSlashingRecord.Merge(m, src)
+}
+func (m *AttesterSlashingRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttesterSlashingRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttesterSlashingRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttesterSlashingRecord proto.InternalMessageInfo
+
+func (m *AttesterSlashingRecord) GetSlashing() *v1alpha1.AttesterSlashing {
+	if m != nil {
+		return m.Slashing
+	}
+	return nil
+}
+
+func (m *AttesterSlashingRecord) GetSlashedIndices() []uint64 {
+	if m != nil {
+		return m.SlashedIndices
+	}
+	return nil
+}
+
+func (m *AttesterSlashingRecord) GetStatus() SlashingStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SlashingStatus_ALL
+}
+
+type ProposerSlashingsResponse struct {
+	Slashings            []*ProposerSlashingRecord `protobuf:"bytes,1,rep,name=slashings,proto3" json:"slashings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ProposerSlashingsResponse) Reset()         { *m = ProposerSlashingsResponse{} }
+func (m *ProposerSlashingsResponse) String() string { return proto.CompactTextString(m) }
+func (*ProposerSlashingsResponse) ProtoMessage()    {}
+func (*ProposerSlashingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{3}
+}
+func (m *ProposerSlashingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposerSlashingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposerSlashingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposerSlashingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerSlashingsResponse.Merge(m, src)
+}
+func (m *ProposerSlashingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposerSlashingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerSlashingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposerSlashingsResponse proto.InternalMessageInfo
+
+func (m *ProposerSlashingsResponse) GetSlashings() []*ProposerSlashingRecord {
+	if m != nil {
+		return m.Slashings
+	}
+	return nil
+}
+
+type ProposerSlashingRecord struct {
+	Slashing             *v1alpha1.ProposerSlashing `protobuf:"bytes,1,opt,name=slashing,proto3" json:"slashing,omitempty"`
+	ProposerIndex        uint64                     `protobuf:"varint,2,opt,name=proposer_index,json=proposerIndex,proto3" json:"proposer_index,omitempty"`
+	Status               SlashingStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=ethereum.slashing.SlashingStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *ProposerSlashingRecord) Reset()         { *m = ProposerSlashingRecord{} }
+func (m *ProposerSlashingRecord) String() string { return proto.CompactTextString(m) }
+func (*ProposerSlashingRecord) ProtoMessage()    {}
+func (*ProposerSlashingRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{4}
+}
+func (m *ProposerSlashingRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposerSlashingRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposerSlashingRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposerSlashingRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerSlashingRecord.Merge(m, src)
+}
+func (m *ProposerSlashingRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposerSlashingRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerSlashingRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposerSlashingRecord proto.InternalMessageInfo
+
+func (m *ProposerSlashingRecord) GetSlashing() *v1alpha1.ProposerSlashing {
+	if m != nil {
+		return m.Slashing
+	}
+	return nil
+}
+
+func (m *ProposerSlashingRecord) GetProposerIndex() uint64 {
+	if m != nil {
+		return m.ProposerIndex
+	}
+	return 0
+}
+
+func (m *ProposerSlashingRecord) GetStatus() SlashingStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SlashingStatus_ALL
+}
+
 type HighestAttestationRequest struct {
 	ValidatorIds         []uint64 `protobuf:"varint,1,rep,packed,name=validator_ids,json=validatorIds,proto3" json:"validator_ids,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -41,7 +361,7 @@ func (m *HighestAttestationRequest) Reset()         { *m = HighestAttestationReq
 func (m *HighestAttestationRequest) String() string { return proto.CompactTextString(m) }
 func (*HighestAttestationRequest) ProtoMessage()    {}
 func (*HighestAttestationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_da7e95107d0081b4, []int{0}
+	return fileDescriptor_da7e95107d0081b4, []int{5}
 }
 func (m *HighestAttestationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -88,7 +408,7 @@ func (m *HighestAttestationResponse) Reset()         { *m = HighestAttestationRe
 func (m *HighestAttestationResponse) String() string { return proto.CompactTextString(m) }
 func (*HighestAttestationResponse) ProtoMessage()    {}
 func (*HighestAttestationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_da7e95107d0081b4, []int{1}
+	return fileDescriptor_da7e95107d0081b4, []int{6}
 }
 func (m *HighestAttestationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -137,7 +457,7 @@ func (m *HighestAttestation) Reset()         { *m = HighestAttestation{} }
 func (m *HighestAttestation) String() string { return proto.CompactTextString(m) }
 func (*HighestAttestation) ProtoMessage()    {}
 func (*HighestAttestation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_da7e95107d0081b4, []int{2}
+	return fileDescriptor_da7e95107d0081b4, []int{7}
 }
 func (m *HighestAttestation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -198,7 +518,7 @@ func (m *ProposerSlashingResponse) Reset()         { *m = ProposerSlashingRespon
 func (m *ProposerSlashingResponse) String() string { return proto.CompactTextString(m) }
 func (*ProposerSlashingResponse) ProtoMessage()    {}
 func (*ProposerSlashingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_da7e95107d0081b4, []int{3}
+	return fileDescriptor_da7e95107d0081b4, []int{8}
 }
 func (m *ProposerSlashingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -245,7 +565,7 @@ func (m *Slashable) Reset()         { *m = Slashable{} }
 func (m *Slashable) String() string { return proto.CompactTextString(m) }
 func (*Slashable) ProtoMessage()    {}
 func (*Slashable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_da7e95107d0081b4, []int{4}
+	return fileDescriptor_da7e95107d0081b4, []int{9}
 }
 func (m *Slashable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -292,7 +612,7 @@ func (m *AttesterSlashingResponse) Reset()         { *m = AttesterSlashingRespon
 func (m *AttesterSlashingResponse) String() string { return proto.CompactTextString(m) }
 func (*AttesterSlashingResponse) ProtoMessage()    {}
 func (*AttesterSlashingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_da7e95107d0081b4, []int{5}
+	return fileDescriptor_da7e95107d0081b4, []int{10}
 }
 func (m *AttesterSlashingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -340,7 +660,7 @@ func (m *ProposalHistory) Reset()         { *m = ProposalHistory{} }
 func (m *ProposalHistory) String() string { return proto.CompactTextString(m) }
 func (*ProposalHistory) ProtoMessage()    {}
 func (*ProposalHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_da7e95107d0081b4, []int{6}
+	return fileDescriptor_da7e95107d0081b4, []int{11}
 }
 func (m *ProposalHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -395,7 +715,7 @@ func (m *AttestationHistory) Reset()         { *m = AttestationHistory{} }
 func (m *AttestationHistory) String() string { return proto.CompactTextString(m) }
 func (*AttestationHistory) ProtoMessage()    {}
 func (*AttestationHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_da7e95107d0081b4, []int{7}
+	return fileDescriptor_da7e95107d0081b4, []int{12}
 }
 func (m *AttestationHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -439,6 +759,12 @@ func (m *AttestationHistory) GetLatestEpochWritten() uint64 {
 }
 
 func init() {
+	proto.RegisterEnum("ethereum.slashing.SlashingStatus", SlashingStatus_name, SlashingStatus_value)
+	proto.RegisterType((*SlashingsRequest)(nil), "ethereum.slashing.SlashingsRequest")
+	proto.RegisterType((*AttesterSlashingsResponse)(nil), "ethereum.slashing.AttesterSlashingsResponse")
+	proto.RegisterType((*AttesterSlashingRecord)(nil), "ethereum.slashing.AttesterSlashingRecord")
+	proto.RegisterType((*ProposerSlashingsResponse)(nil), "ethereum.slashing.ProposerSlashingsResponse")
+	proto.RegisterType((*ProposerSlashingRecord)(nil), "ethereum.slashing.ProposerSlashingRecord")
 	proto.RegisterType((*HighestAttestationRequest)(nil), "ethereum.slashing.HighestAttestationRequest")
 	proto.RegisterType((*HighestAttestationResponse)(nil), "ethereum.slashing.HighestAttestationResponse")
 	proto.RegisterType((*HighestAttestation)(nil), "ethereum.slashing.HighestAttestation")
@@ -453,49 +779,68 @@ func init() {
 func init() { proto.RegisterFile("proto/slashing/slashing.proto", fileDescriptor_da7e95107d0081b4) }
 
 var fileDescriptor_da7e95107d0081b4 = []byte{
-	// 661 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdd, 0x4e, 0xd4, 0x40,
-	0x14, 0x4e, 0x01, 0x7f, 0x18, 0x56, 0x5d, 0x06, 0x62, 0xd6, 0x0d, 0x2e, 0x58, 0x63, 0x84, 0x08,
-	0x5d, 0xc0, 0x1b, 0xf5, 0x4a, 0x36, 0x31, 0x61, 0x6f, 0xd4, 0x2c, 0x18, 0x2f, 0x9b, 0x69, 0x7b,
-	0x68, 0x27, 0x94, 0x4e, 0x9d, 0x39, 0x8b, 0xee, 0x7b, 0x78, 0xe7, 0x43, 0xf8, 0x1a, 0x5e, 0xfa,
-	0x04, 0xc6, 0xf0, 0x00, 0x3e, 0x80, 0x57, 0xa6, 0x33, 0xed, 0x6e, 0xa1, 0x5d, 0xb3, 0xdc, 0x4d,
-	0xcf, 0xdf, 0x77, 0xce, 0x77, 0xbe, 0x99, 0x92, 0x87, 0xa9, 0x14, 0x28, 0xba, 0x2a, 0x66, 0x2a,
-	0xe2, 0x49, 0x38, 0x3e, 0x38, 0xda, 0x4e, 0x97, 0x01, 0x23, 0x90, 0x30, 0x3c, 0x73, 0x0a, 0x47,
-	0x7b, 0x1d, 0x30, 0xea, 0x9e, 0xef, 0xb1, 0x38, 0x8d, 0xd8, 0x5e, 0xd7, 0x03, 0xe6, 0x8b, 0xc4,
-	0xf5, 0x62, 0xe1, 0x9f, 0x9a, 0x9c, 0xf6, 0x4e, 0xc8, 0x31, 0x1a, 0x7a, 0x8e, 0x2f, 0xce, 0xba,
-	0xa1, 0x08, 0x45, 0x57, 0x9b, 0xbd, 0xe1, 0x89, 0xfe, 0x32, 0x78, 0xd9, 0xc9, 0x84, 0xdb, 0xaf,
-	0xc9, 0x83, 0x43, 0x1e, 0x46, 0xa0, 0xf0, 0x00, 0x11, 0x14, 0x32, 0xe4, 0x22, 0x19, 0xc0, 0xa7,
-	0x21, 0x28, 0xa4, 0x8f, 0xc9, 0x9d, 0x73, 0x16, 0xf3, 0x80, 0xa1, 0x90, 0x2e, 0x0f, 0x54, 0xcb,
-	0xda, 0x98, 0xdf, 0x5c, 0x18, 0x34, 0xc6, 0xc6, 0x7e, 0xa0, 0xec, 0x90, 0xb4, 0xeb, 0x2a, 0xa8,
-	0x54, 0x24, 0x0a, 0x68, 0x9f, 0x34, 0xd8, 0xc4, 0x6c, 0x2a, 0x2c, 0xed, 0x3f, 0x71, 0x2a, 0x93,
-	0x39, 0x35, 0x45, 0x2e, 0xa5, 0xda, 0xdf, 0x2c, 0x42, 0xab, 0x41, 0xf4, 0x11, 0x69, 0x94, 0x9b,
-	0x6c, 0x59, 0x1b, 0xd6, 0xe6, 0xc2, 0x60, 0xa9, 0xd4, 0x23, 0xdd, 0x25, 0xab, 0x91, 0x49, 0x74,
-	0x95, 0x18, 0x4a, 0x1f, 0x5c, 0x48, 0x85, 0x1f, 0xb5, 0xe6, 0x74, 0x28, 0xcd, 0x7d, 0x47, 0xda,
-	0xf5, 0x26, 0xf3, 0x94, 0x33, 0x90, 0xc9, 0x10, 0x30, 0xcf, 0x98, 0xbf, 0x94, 0x71, 0xac, 0x5d,
-	0x3a, 0xc3, 0x4e, 0x49, 0xeb, 0xbd, 0x14, 0xa9, 0x50, 0x20, 0x8f, 0xf2, 0x91, 0xc6, 0x24, 0x1c,
-	0x93, 0xe5, 0x34, 0xf7, 0xb9, 0xc5, 0xbc, 0x39, 0x13, 0x4f, 0x27, 0x4c, 0x00, 0x46, 0x4e, 0xb1,
-	0x59, 0xa7, 0x52, 0xab, 0x99, 0x5e, 0xb1, 0xd8, 0x5b, 0x64, 0x51, 0x9f, 0x99, 0x17, 0x03, 0x5d,
-	0x23, 0x8b, 0xaa, 0xf8, 0xd0, 0x14, 0xdc, 0x1e, 0x4c, 0x0c, 0x59, 0x73, 0x86, 0xb2, 0xfa, 0xe6,
-	0x58, 0xee, 0x9b, 0xb5, 0xb9, 0x4a, 0xad, 0x26, 0xbb, 0x62, 0xb1, 0xbf, 0x5a, 0xe4, 0x9e, 0x99,
-	0x81, 0xc5, 0x87, 0x5c, 0xa1, 0x90, 0x23, 0xfa, 0x8e, 0x10, 0xcd, 0xa2, 0xeb, 0x71, 0x54, 0xba,
-	0xc9, 0x46, 0x6f, 0xf7, 0xef, 0xaf, 0xf5, 0xed, 0x92, 0x64, 0x53, 0x39, 0x52, 0x67, 0x0c, 0xb9,
-	0x1f, 0x33, 0x4f, 0x75, 0x43, 0xb1, 0xe3, 0x71, 0x3c, 0xe1, 0x10, 0x07, 0x4e, 0x8f, 0x63, 0xcc,
-	0x15, 0x0e, 0x16, 0x75, 0x8d, 0x1e, 0x47, 0x95, 0x6d, 0x29, 0x66, 0x19, 0xb0, 0xd9, 0x8e, 0xfb,
-	0x59, 0x72, 0x44, 0x48, 0x8a, 0xbd, 0x1a, 0x9f, 0x5e, 0xcf, 0x47, 0xe3, 0xb1, 0xff, 0x58, 0x84,
-	0x96, 0xc4, 0x53, 0x74, 0xe6, 0x93, 0x66, 0xbe, 0x66, 0x14, 0xb9, 0x44, 0x72, 0x0a, 0x5e, 0xd6,
-	0x28, 0xb5, 0x5a, 0xc0, 0x31, 0x4a, 0x38, 0x16, 0xb9, 0x86, 0x12, 0x94, 0xa3, 0xc1, 0x5d, 0xbc,
-	0x64, 0xbc, 0x7e, 0xb7, 0xed, 0x03, 0xb2, 0x52, 0x53, 0x98, 0x36, 0xc9, 0xfc, 0x29, 0x8c, 0x72,
-	0xa1, 0x67, 0x47, 0xba, 0x4a, 0x6e, 0x9c, 0xb3, 0x78, 0x08, 0x79, 0x2d, 0xf3, 0xf1, 0x6a, 0xee,
-	0x85, 0xb5, 0xff, 0x7d, 0x81, 0xdc, 0xd2, 0x4b, 0x01, 0x49, 0x53, 0x72, 0xbf, 0xaf, 0xc6, 0x92,
-	0x29, 0xdf, 0xa1, 0xad, 0x29, 0x8b, 0xee, 0x27, 0x01, 0x7c, 0x81, 0xa0, 0x14, 0xda, 0x7e, 0x36,
-	0x95, 0x90, 0x1a, 0x6d, 0x09, 0xd2, 0x2c, 0x21, 0xf6, 0xb2, 0x67, 0x8a, 0x3a, 0x53, 0xb0, 0x8e,
-	0x78, 0x98, 0x40, 0xd0, 0xd3, 0x2f, 0x9a, 0x8e, 0x3c, 0x04, 0x16, 0x80, 0xac, 0x05, 0x9c, 0x7a,
-	0xd3, 0x38, 0xe9, 0xd4, 0x8f, 0xf8, 0x56, 0x7c, 0x48, 0x03, 0x86, 0x70, 0x9d, 0x51, 0xd7, 0x6a,
-	0x90, 0x27, 0x37, 0xce, 0x23, 0xad, 0xab, 0xb3, 0x8d, 0x41, 0x36, 0xa7, 0x80, 0x54, 0xa7, 0xfb,
-	0x3f, 0x86, 0x24, 0x2b, 0xd5, 0x17, 0x4f, 0xd1, 0xed, 0xd9, 0x9e, 0x4f, 0xf3, 0x8a, 0xb7, 0x77,
-	0x66, 0x8c, 0x36, 0x14, 0xf6, 0x1a, 0x3f, 0x2e, 0x3a, 0xd6, 0xcf, 0x8b, 0x8e, 0xf5, 0xfb, 0xa2,
-	0x63, 0x79, 0x37, 0xf5, 0x6f, 0xe2, 0xf9, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe6, 0x5a, 0x08,
-	0xcf, 0xaa, 0x06, 0x00, 0x00,
+	// 969 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0xbb, 0x21, 0x7f, 0xde, 0x6e, 0xb7, 0xce, 0xb4, 0x8a, 0xb6, 0x4b, 0x48, 0x52, 0x57,
+	0x55, 0x37, 0x6d, 0xb2, 0x4e, 0x03, 0x07, 0xca, 0x89, 0x6c, 0x13, 0x35, 0x2b, 0x45, 0xa1, 0x72,
+	0x52, 0x71, 0x5c, 0x8d, 0xed, 0xa9, 0x3d, 0xaa, 0xe3, 0x31, 0x9e, 0xd9, 0x40, 0xae, 0x7c, 0x05,
+	0x38, 0x20, 0xf1, 0x45, 0xb8, 0x21, 0x6e, 0x1c, 0x91, 0xb8, 0x23, 0x14, 0xc1, 0x95, 0x0f, 0xc0,
+	0x09, 0x79, 0x3c, 0xf6, 0x7a, 0xd7, 0xde, 0x6a, 0xd3, 0xde, 0xc6, 0xef, 0xff, 0xfc, 0xe6, 0xf7,
+	0x9e, 0x1f, 0x7c, 0x1c, 0xc5, 0x4c, 0x30, 0x93, 0x07, 0x98, 0xfb, 0x34, 0xf4, 0xf2, 0x43, 0x4f,
+	0xca, 0xd1, 0x2a, 0x11, 0x3e, 0x89, 0xc9, 0xe8, 0xa2, 0x97, 0x29, 0x3a, 0x9b, 0x44, 0xf8, 0xe6,
+	0xe5, 0x53, 0x1c, 0x44, 0x3e, 0x7e, 0x6a, 0xda, 0x04, 0x3b, 0x2c, 0x1c, 0xda, 0x01, 0x73, 0xde,
+	0xa4, 0x3e, 0x9d, 0x5d, 0x8f, 0x0a, 0x7f, 0x64, 0xf7, 0x1c, 0x76, 0x61, 0x7a, 0xcc, 0x63, 0xa6,
+	0x14, 0xdb, 0xa3, 0xd7, 0xf2, 0x2b, 0xcd, 0x97, 0x9c, 0x94, 0xf9, 0xba, 0xc7, 0x98, 0x17, 0x10,
+	0x13, 0x47, 0xd4, 0xc4, 0x61, 0xc8, 0x04, 0x16, 0x94, 0x85, 0x3c, 0xd5, 0x1a, 0x3f, 0x6b, 0xa0,
+	0x9f, 0xa9, 0xd4, 0xdc, 0x22, 0x5f, 0x8f, 0x08, 0x17, 0xe8, 0x09, 0xac, 0x5e, 0xe2, 0x80, 0xba,
+	0x58, 0xb0, 0x78, 0x48, 0x43, 0x97, 0x3a, 0x84, 0xb7, 0xb5, 0xad, 0x7a, 0x77, 0xc1, 0xd2, 0x73,
+	0xc5, 0x20, 0x95, 0xa3, 0x4d, 0x68, 0x70, 0x81, 0x63, 0x31, 0x24, 0x11, 0x73, 0xfc, 0x76, 0x6d,
+	0x4b, 0xeb, 0x2e, 0x58, 0x20, 0x45, 0x47, 0x89, 0x04, 0x7d, 0x04, 0x2b, 0x24, 0x74, 0x95, 0xba,
+	0x2e, 0xd5, 0xcb, 0x24, 0x74, 0x53, 0xe5, 0x33, 0x58, 0xe4, 0x02, 0x8b, 0x11, 0x6f, 0x2f, 0x6c,
+	0x69, 0xdd, 0xd6, 0xfe, 0xfd, 0x5e, 0x09, 0x91, 0x5e, 0x56, 0xdf, 0x99, 0x34, 0xb4, 0x94, 0x83,
+	0xe1, 0xc2, 0xbd, 0x03, 0x21, 0x08, 0x17, 0x24, 0x2e, 0xdc, 0x80, 0x47, 0x2c, 0xe4, 0x04, 0xbd,
+	0x80, 0x95, 0xcc, 0x3f, 0x2d, 0xbd, 0xb1, 0xbf, 0x5d, 0x11, 0x7a, 0x3a, 0x80, 0x45, 0x1c, 0x16,
+	0xbb, 0xd6, 0xd8, 0xd7, 0xf8, 0x55, 0x83, 0xb5, 0x6a, 0x2b, 0xf4, 0x1c, 0x96, 0x33, 0xbb, 0xb6,
+	0xb6, 0xa5, 0x75, 0x1b, 0xfb, 0x8f, 0xc6, 0x29, 0x88, 0xf0, 0x7b, 0xd9, 0x2b, 0x96, 0xd3, 0xe4,
+	0x8e, 0xe8, 0x11, 0xdc, 0x96, 0x67, 0xe2, 0xe6, 0x48, 0xd7, 0x24, 0xd2, 0x2d, 0x25, 0xce, 0x70,
+	0x1e, 0x23, 0x55, 0x7f, 0x07, 0xa4, 0x5e, 0xc6, 0x2c, 0x62, 0xfc, 0x3d, 0x90, 0x9a, 0x0e, 0x50,
+	0x46, 0xea, 0x17, 0x0d, 0xd6, 0xaa, 0xad, 0x6e, 0x80, 0x54, 0x29, 0xc0, 0x18, 0xa9, 0x87, 0xd0,
+	0x8a, 0x94, 0x36, 0x81, 0x8a, 0x7c, 0xab, 0xb8, 0x76, 0x2b, 0x93, 0x0e, 0x12, 0xe1, 0xfb, 0xe0,
+	0xf4, 0x05, 0xdc, 0x3b, 0xa6, 0x9e, 0x4f, 0xb8, 0x48, 0x1f, 0x4c, 0x76, 0x4a, 0xd6, 0x14, 0x0f,
+	0xe0, 0x56, 0xa1, 0x29, 0xdc, 0xac, 0x21, 0x9a, 0xe3, 0x86, 0x70, 0xb9, 0xe1, 0x41, 0xa7, 0x2a,
+	0x82, 0x82, 0x7a, 0x00, 0x4d, 0x3c, 0x16, 0x67, 0x68, 0x3f, 0xac, 0x28, 0xb0, 0x22, 0xc8, 0x84,
+	0xab, 0xf1, 0x93, 0x06, 0xa8, 0x6c, 0x84, 0xee, 0x43, 0xb3, 0x58, 0xa4, 0x04, 0x7b, 0xc1, 0x6a,
+	0x14, 0x6a, 0x44, 0x7b, 0x70, 0xd7, 0x4f, 0x1d, 0x87, 0x9c, 0x8d, 0x62, 0x87, 0x4c, 0x34, 0x2e,
+	0x52, 0xba, 0x33, 0xa9, 0x4a, 0x7b, 0xb4, 0xe0, 0x21, 0x70, 0xec, 0x11, 0x31, 0xd1, 0xcb, 0x99,
+	0xc7, 0xb9, 0x54, 0x49, 0x0f, 0x23, 0x82, 0x76, 0x99, 0x09, 0x0a, 0x84, 0x73, 0x58, 0xcd, 0x9f,
+	0xb1, 0x40, 0x8a, 0xfa, 0x4d, 0x48, 0xa1, 0x47, 0x53, 0x12, 0x63, 0x1b, 0x56, 0xe4, 0x19, 0xdb,
+	0x01, 0x41, 0xeb, 0x8a, 0xd2, 0xc9, 0x87, 0x84, 0x60, 0xd9, 0x1a, 0x0b, 0x92, 0xe2, 0xca, 0x0d,
+	0x3d, 0x2e, 0x0e, 0x2b, 0xdd, 0xbc, 0xc5, 0x95, 0x62, 0xe9, 0x78, 0x4a, 0x62, 0xfc, 0xa0, 0xc1,
+	0xed, 0xf4, 0x0e, 0x38, 0x38, 0xa6, 0x5c, 0xb0, 0xf8, 0x0a, 0x7d, 0x09, 0x20, 0x51, 0x1c, 0xda,
+	0x54, 0x70, 0x59, 0x64, 0xb3, 0xbf, 0xf7, 0xdf, 0x9f, 0x9b, 0x3b, 0x85, 0xe9, 0x1e, 0xc5, 0x57,
+	0xfc, 0x02, 0x0b, 0xea, 0x04, 0xd8, 0xe6, 0xa6, 0xc7, 0x76, 0x6d, 0x2a, 0x5e, 0x53, 0x12, 0xb8,
+	0xbd, 0x3e, 0x15, 0x01, 0xe5, 0xc2, 0x5a, 0x91, 0x31, 0xfa, 0x54, 0xf0, 0xe4, 0x95, 0x02, 0x9c,
+	0x24, 0x4e, 0x5f, 0x67, 0xf8, 0x4d, 0x4c, 0x85, 0x20, 0x61, 0xf6, 0xae, 0xa9, 0x4e, 0x3e, 0xcf,
+	0x57, 0xa9, 0xc6, 0xf8, 0x57, 0x03, 0x54, 0x20, 0x4f, 0x56, 0x99, 0x03, 0xba, 0x7a, 0x66, 0xc1,
+	0x14, 0x45, 0x14, 0x04, 0xcf, 0x66, 0x4e, 0xd0, 0x62, 0x80, 0x5e, 0xca, 0x84, 0x73, 0xa6, 0x38,
+	0x14, 0x8a, 0xf8, 0xca, 0x6a, 0x89, 0x09, 0xe1, 0xcd, 0xab, 0xed, 0x1c, 0xc0, 0x9d, 0x8a, 0xc0,
+	0x48, 0x87, 0xfa, 0x1b, 0x72, 0xa5, 0x88, 0x9e, 0x1c, 0xd1, 0x5d, 0xf8, 0xf0, 0x12, 0x07, 0x23,
+	0xa2, 0x62, 0xa5, 0x1f, 0x9f, 0xd7, 0x3e, 0xd3, 0x1e, 0x7f, 0x0a, 0xad, 0xc9, 0xce, 0x47, 0x4b,
+	0x50, 0x3f, 0x38, 0x39, 0xd1, 0x3f, 0x40, 0x0d, 0x58, 0x7a, 0x79, 0x74, 0x7a, 0x38, 0x38, 0x7d,
+	0xa1, 0x6b, 0xa8, 0x09, 0xcb, 0x83, 0xd3, 0xe7, 0x27, 0xaf, 0x0e, 0x8f, 0x0e, 0xf5, 0xda, 0xfe,
+	0x3f, 0x8b, 0xb0, 0x24, 0xdd, 0x48, 0x8c, 0x22, 0x58, 0x1b, 0xf0, 0x9c, 0x68, 0xc5, 0xce, 0xdb,
+	0x9e, 0x41, 0x0f, 0x39, 0x94, 0x88, 0x5b, 0x30, 0xed, 0x3c, 0x99, 0xeb, 0x47, 0xa4, 0x18, 0xc9,
+	0x40, 0x2f, 0x64, 0xec, 0x27, 0x7b, 0x00, 0xea, 0xcd, 0xc8, 0x75, 0x46, 0xbd, 0x90, 0xb8, 0x7d,
+	0xb9, 0x32, 0x48, 0xcb, 0x63, 0x82, 0x5d, 0x12, 0x57, 0x26, 0x9c, 0xd9, 0x9f, 0x14, 0x36, 0xaa,
+	0xaf, 0x78, 0xca, 0x5e, 0x45, 0x2e, 0x16, 0xe4, 0x26, 0x57, 0x5d, 0x9f, 0x35, 0x7c, 0x65, 0x9f,
+	0xda, 0xd0, 0x9e, 0xbe, 0x5b, 0x9e, 0xa4, 0x3b, 0x23, 0x49, 0xf9, 0x76, 0x6f, 0xcf, 0x11, 0xc3,
+	0x9d, 0xf2, 0x9c, 0xe4, 0x68, 0x67, 0xbe, 0xa1, 0x9b, 0xce, 0xfe, 0xce, 0xee, 0x9c, 0xd6, 0x0a,
+	0xc2, 0x1f, 0x35, 0x58, 0x2d, 0xad, 0x26, 0xe8, 0xc1, 0x5b, 0x7e, 0x44, 0xd9, 0xea, 0xd5, 0xd9,
+	0x99, 0x83, 0x1b, 0xf9, 0xbf, 0xdb, 0xd8, 0xfb, 0xee, 0x8f, 0xbf, 0xbf, 0xaf, 0x3d, 0x46, 0x5d,
+	0x73, 0x62, 0x69, 0xe4, 0x29, 0x5b, 0xf3, 0x65, 0x93, 0x9b, 0xd9, 0x48, 0x92, 0xa5, 0x95, 0x76,
+	0x81, 0x77, 0x2f, 0x6d, 0xe6, 0x5a, 0x31, 0x7f, 0x69, 0xd9, 0x28, 0xef, 0x37, 0x7f, 0xbb, 0xde,
+	0xd0, 0x7e, 0xbf, 0xde, 0xd0, 0xfe, 0xba, 0xde, 0xd0, 0xec, 0x45, 0xb9, 0x9f, 0x7e, 0xf2, 0xff,
+	0x00, 0xb9, 0xb4, 0x5f, 0xa6, 0x41, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -515,6 +860,8 @@ type SlasherClient interface {
 	IsSlashableAttestationNoUpdate(ctx context.Context, in *v1alpha1.IndexedAttestation, opts ...grpc.CallOption) (*Slashable, error)
 	IsSlashableBlockNoUpdate(ctx context.Context, in *v1alpha1.BeaconBlockHeader, opts ...grpc.CallOption) (*Slashable, error)
 	HighestAttestations(ctx context.Context, in *HighestAttestationRequest, opts ...grpc.CallOption) (*HighestAttestationResponse, error)
+	AttesterSlashings(ctx context.Context, in *SlashingsRequest, opts ...grpc.CallOption) (*AttesterSlashingsResponse, error)
+	ProposerSlashings(ctx context.Context, in *SlashingsRequest, opts ...grpc.CallOption) (*ProposerSlashingsResponse, error)
 }
 
 type slasherClient struct {
@@ -570,6 +917,24 @@ func (c *slasherClient) HighestAttestations(ctx context.Context, in *HighestAtte
 	return out, nil
 }
 
+func (c *slasherClient) AttesterSlashings(ctx context.Context, in *SlashingsRequest, opts ...grpc.CallOption) (*AttesterSlashingsResponse, error) {
+	out := new(AttesterSlashingsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.slashing.Slasher/AttesterSlashings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slasherClient) ProposerSlashings(ctx context.Context, in *SlashingsRequest, opts ...grpc.CallOption) (*ProposerSlashingsResponse, error) {
+	out := new(ProposerSlashingsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.slashing.Slasher/ProposerSlashings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SlasherServer is the server API for Slasher service.
 type SlasherServer interface {
 	IsSlashableAttestation(context.Context, *v1alpha1.IndexedAttestation) (*AttesterSlashingResponse, error)
@@ -577,6 +942,8 @@ type SlasherServer interface {
 	IsSlashableAttestationNoUpdate(context.Context, *v1alpha1.IndexedAttestation) (*Slashable, error)
 	IsSlashableBlockNoUpdate(context.Context, *v1alpha1.BeaconBlockHeader) (*Slashable, error)
 	HighestAttestations(context.Context, *HighestAttestationRequest) (*HighestAttestationResponse, error)
+	AttesterSlashings(context.Context, *SlashingsRequest) (*AttesterSlashingsResponse, error)
+	ProposerSlashings(context.Context, *SlashingsRequest) (*ProposerSlashingsResponse, error)
 }
 
 // UnimplementedSlasherServer can be embedded to have forward compatible implementations.
@@ -598,6 +965,12 @@ func (*UnimplementedSlasherServer) IsSlashableBlockNoUpdate(ctx context.Context,
 func (*UnimplementedSlasherServer) HighestAttestations(ctx context.Context, req *HighestAttestationRequest) (*HighestAttestationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HighestAttestations not implemented")
 }
+func (*UnimplementedSlasherServer) AttesterSlashings(ctx context.Context, req *SlashingsRequest) (*AttesterSlashingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttesterSlashings not implemented")
+}
+func (*UnimplementedSlasherServer) ProposerSlashings(ctx context.Context, req *SlashingsRequest) (*ProposerSlashingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposerSlashings not implemented")
+}
 
 func RegisterSlasherServer(s *grpc.Server, srv SlasherServer) {
 	s.RegisterService(&_Slasher_serviceDesc, srv)
@@ -693,6 +1066,42 @@ func _Slasher_HighestAttestations_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Slasher_AttesterSlashings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlashingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherServer).AttesterSlashings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.slashing.Slasher/AttesterSlashings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherServer).AttesterSlashings(ctx, req.(*SlashingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Slasher_ProposerSlashings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlashingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherServer).ProposerSlashings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.slashing.Slasher/ProposerSlashings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherServer).ProposerSlashings(ctx, req.(*SlashingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Slasher_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethereum.slashing.Slasher",
 	HandlerType: (*SlasherServer)(nil),
@@ -717,12 +1126,20 @@ var _Slasher_serviceDesc = grpc.ServiceDesc{
 			MethodName: "HighestAttestations",
 			Handler:    _Slasher_HighestAttestations_Handler,
 		},
+		{
+			MethodName: "AttesterSlashings",
+			Handler:    _Slasher_AttesterSlashings_Handler,
+		},
+		{
+			MethodName: "ProposerSlashings",
+			Handler:    _Slasher_ProposerSlashings_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/slashing/slashing.proto",
 }
 
-func (m *HighestAttestationRequest) Marshal() (dAtA []byte, err error) {
+func (m *SlashingsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -732,12 +1149,12 @@ func (m *HighestAttestationRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HighestAttestationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SlashingsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HighestAttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SlashingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -746,10 +1163,25 @@ func (m *HighestAttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ValidatorIds) > 0 {
-		dAtA2 := make([]byte, len(m.ValidatorIds)*10)
+	if m.Status != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EndEpoch != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.EndEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorIndices) > 0 {
+		dAtA2 := make([]byte, len(m.ValidatorIndices)*10)
 		var j1 int
-		for _, num := range m.ValidatorIds {
+		for _, num := range m.ValidatorIndices {
 			for num >= 1<<7 {
 				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
@@ -767,7 +1199,7 @@ func (m *HighestAttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *HighestAttestationResponse) Marshal() (dAtA []byte, err error) {
+func (m *AttesterSlashingsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -777,12 +1209,12 @@ func (m *HighestAttestationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HighestAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AttesterSlashingsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HighestAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AttesterSlashingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -791,10 +1223,10 @@ func (m *HighestAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Attestations) > 0 {
-		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Slashings) > 0 {
+		for iNdEx := len(m.Slashings) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Slashings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -808,7 +1240,7 @@ func (m *HighestAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *HighestAttestation) Marshal() (dAtA []byte, err error) {
+func (m *AttesterSlashingRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -818,12 +1250,250 @@ func (m *HighestAttestation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HighestAttestation) MarshalTo(dAtA []byte) (int, error) {
+func (m *AttesterSlashingRecord) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HighestAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AttesterSlashingRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SlashedIndices) > 0 {
+		dAtA4 := make([]byte, len(m.SlashedIndices)*10)
+		var j3 int
+		for _, num := range m.SlashedIndices {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintSlashing(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slashing != nil {
+		{
+			size, err := m.Slashing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSlashing(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposerSlashingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposerSlashingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposerSlashingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Slashings) > 0 {
+		for iNdEx := len(m.Slashings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposerSlashingRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposerSlashingRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposerSlashingRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProposerIndex != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.ProposerIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Slashing != nil {
+		{
+			size, err := m.Slashing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSlashing(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HighestAttestationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HighestAttestationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HighestAttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ValidatorIds) > 0 {
+		dAtA8 := make([]byte, len(m.ValidatorIds)*10)
+		var j7 int
+		for _, num := range m.ValidatorIds {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintSlashing(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HighestAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HighestAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HighestAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HighestAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HighestAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HighestAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1066,33 +1736,42 @@ func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
-func (m *HighestAttestationRequest) Size() (n int) {
+func (m *SlashingsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ValidatorIds) > 0 {
+	if len(m.ValidatorIndices) > 0 {
 		l = 0
-		for _, e := range m.ValidatorIds {
+		for _, e := range m.ValidatorIndices {
 			l += sovSlashing(uint64(e))
 		}
 		n += 1 + sovSlashing(uint64(l)) + l
 	}
+	if m.StartEpoch != 0 {
+		n += 1 + sovSlashing(uint64(m.StartEpoch))
+	}
+	if m.EndEpoch != 0 {
+		n += 1 + sovSlashing(uint64(m.EndEpoch))
+	}
+	if m.Status != 0 {
+		n += 1 + sovSlashing(uint64(m.Status))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *HighestAttestationResponse) Size() (n int) {
+func (m *AttesterSlashingsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Attestations) > 0 {
-		for _, e := range m.Attestations {
+	if len(m.Slashings) > 0 {
+		for _, e := range m.Slashings {
 			l = e.Size()
 			n += 1 + l + sovSlashing(uint64(l))
 		}
@@ -1103,20 +1782,25 @@ func (m *HighestAttestationResponse) Size() (n int) {
 	return n
 }
 
-func (m *HighestAttestation) Size() (n int) {
+func (m *AttesterSlashingRecord) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ValidatorId != 0 {
-		n += 1 + sovSlashing(uint64(m.ValidatorId))
+	if m.Slashing != nil {
+		l = m.Slashing.Size()
+		n += 1 + l + sovSlashing(uint64(l))
 	}
-	if m.HighestSourceEpoch != 0 {
-		n += 1 + sovSlashing(uint64(m.HighestSourceEpoch))
+	if len(m.SlashedIndices) > 0 {
+		l = 0
+		for _, e := range m.SlashedIndices {
+			l += sovSlashing(uint64(e))
+		}
+		n += 1 + sovSlashing(uint64(l)) + l
 	}
-	if m.HighestTargetEpoch != 0 {
-		n += 1 + sovSlashing(uint64(m.HighestTargetEpoch))
+	if m.Status != 0 {
+		n += 1 + sovSlashing(uint64(m.Status))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -1124,14 +1808,14 @@ func (m *HighestAttestation) Size() (n int) {
 	return n
 }
 
-func (m *ProposerSlashingResponse) Size() (n int) {
+func (m *ProposerSlashingsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ProposerSlashing) > 0 {
-		for _, e := range m.ProposerSlashing {
+	if len(m.Slashings) > 0 {
+		for _, e := range m.Slashings {
 			l = e.Size()
 			n += 1 + l + sovSlashing(uint64(l))
 		}
@@ -1142,14 +1826,21 @@ func (m *ProposerSlashingResponse) Size() (n int) {
 	return n
 }
 
-func (m *Slashable) Size() (n int) {
+func (m *ProposerSlashingRecord) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Slashable {
-		n += 2
+	if m.Slashing != nil {
+		l = m.Slashing.Size()
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.ProposerIndex != 0 {
+		n += 1 + sovSlashing(uint64(m.ProposerIndex))
+	}
+	if m.Status != 0 {
+		n += 1 + sovSlashing(uint64(m.Status))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -1157,17 +1848,18 @@ func (m *Slashable) Size() (n int) {
 	return n
 }
 
-func (m *AttesterSlashingResponse) Size() (n int) {
+func (m *HighestAttestationRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.AttesterSlashing) > 0 {
-		for _, e := range m.AttesterSlashing {
-			l = e.Size()
-			n += 1 + l + sovSlashing(uint64(l))
+	if len(m.ValidatorIds) > 0 {
+		l = 0
+		for _, e := range m.ValidatorIds {
+			l += sovSlashing(uint64(e))
 		}
+		n += 1 + sovSlashing(uint64(l)) + l
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -1175,18 +1867,17 @@ func (m *AttesterSlashingResponse) Size() (n int) {
 	return n
 }
 
-func (m *ProposalHistory) Size() (n int) {
+func (m *HighestAttestationResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.EpochBits)
-	if l > 0 {
-		n += 1 + l + sovSlashing(uint64(l))
-	}
-	if m.LatestEpochWritten != 0 {
-		n += 1 + sovSlashing(uint64(m.LatestEpochWritten))
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -1194,20 +1885,111 @@ func (m *ProposalHistory) Size() (n int) {
 	return n
 }
 
-func (m *AttestationHistory) Size() (n int) {
+func (m *HighestAttestation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.TargetToSource) > 0 {
-		for k, v := range m.TargetToSource {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sovSlashing(uint64(k)) + 1 + sovSlashing(uint64(v))
-			n += mapEntrySize + 1 + sovSlashing(uint64(mapEntrySize))
-		}
-	}
+	if m.ValidatorId != 0 {
+		n += 1 + sovSlashing(uint64(m.ValidatorId))
+	}
+	if m.HighestSourceEpoch != 0 {
+		n += 1 + sovSlashing(uint64(m.HighestSourceEpoch))
+	}
+	if m.HighestTargetEpoch != 0 {
+		n += 1 + sovSlashing(uint64(m.HighestTargetEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProposerSlashingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProposerSlashing) > 0 {
+		for _, e := range m.ProposerSlashing {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Slashable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slashable {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttesterSlashingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AttesterSlashing) > 0 {
+		for _, e := range m.AttesterSlashing {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProposalHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EpochBits)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.LatestEpochWritten != 0 {
+		n += 1 + sovSlashing(uint64(m.LatestEpochWritten))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttestationHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TargetToSource) > 0 {
+		for k, v := range m.TargetToSource {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovSlashing(uint64(k)) + 1 + sovSlashing(uint64(v))
+			n += mapEntrySize + 1 + sovSlashing(uint64(mapEntrySize))
+		}
+	}
 	if m.LatestEpochWritten != 0 {
 		n += 1 + sovSlashing(uint64(m.LatestEpochWritten))
 	}
@@ -1223,6 +2005,682 @@ func sovSlashing(x uint64) (n int) {
 func sozSlashing(x uint64) (n int) {
 	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *SlashingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSlashing
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ValidatorIndices = append(m.ValidatorIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSlashing
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSlashing
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSlashing
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ValidatorIndices) == 0 {
+					m.ValidatorIndices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSlashing
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ValidatorIndices = append(m.ValidatorIndices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndices", wireType)
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
+			}
+			m.EndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SlashingStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttesterSlashingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttesterSlashingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttesterSlashingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashings = append(m.Slashings, &AttesterSlashingRecord{})
+			if err := m.Slashings[len(m.Slashings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttesterSlashingRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttesterSlashingRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttesterSlashingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Slashing == nil {
+				m.Slashing = &v1alpha1.AttesterSlashing{}
+			}
+			if err := m.Slashing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSlashing
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SlashedIndices = append(m.SlashedIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSlashing
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSlashing
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSlashing
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SlashedIndices) == 0 {
+					m.SlashedIndices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSlashing
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SlashedIndices = append(m.SlashedIndices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedIndices", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SlashingStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposerSlashingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposerSlashingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposerSlashingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashings = append(m.Slashings, &ProposerSlashingRecord{})
+			if err := m.Slashings[len(m.Slashings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposerSlashingRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposerSlashingRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposerSlashingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Slashing == nil {
+				m.Slashing = &v1alpha1.ProposerSlashing{}
+			}
+			if err := m.Slashing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerIndex", wireType)
+			}
+			m.ProposerIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposerIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SlashingStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *HighestAttestationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
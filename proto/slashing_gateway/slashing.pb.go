@@ -0,0 +1,1516 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.13.0
+// source: proto/slashing/slashing.proto
+
+package ethereum_slashing
+
+import (
+	context "context"
+	reflect "reflect"
+	sync "sync"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/golang/protobuf/proto"
+	v1alpha1 "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type SlashingStatus int32
+
+const (
+	SlashingStatus_ALL      SlashingStatus = 0
+	SlashingStatus_PENDING  SlashingStatus = 1
+	SlashingStatus_INCLUDED SlashingStatus = 2
+)
+
+// Enum value maps for SlashingStatus.
+var (
+	SlashingStatus_name = map[int32]string{
+		0: "ALL",
+		1: "PENDING",
+		2: "INCLUDED",
+	}
+	SlashingStatus_value = map[string]int32{
+		"ALL":      0,
+		"PENDING":  1,
+		"INCLUDED": 2,
+	}
+)
+
+func (x SlashingStatus) Enum() *SlashingStatus {
+	p := new(SlashingStatus)
+	*p = x
+	return p
+}
+
+func (x SlashingStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SlashingStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_slashing_slashing_proto_enumTypes[0].Descriptor()
+}
+
+func (SlashingStatus) Type() protoreflect.EnumType {
+	return &file_proto_slashing_slashing_proto_enumTypes[0]
+}
+
+func (x SlashingStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SlashingStatus.Descriptor instead.
+func (SlashingStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_slashing_slashing_proto_rawDescGZIP(), []int{0}
+}
+
+type SlashingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidatorIndices []uint64       `protobuf:"varint,1,rep,packed,name=validator_indices,json=validatorIndices,proto3" json:"validator_indices,omitempty"`
+	StartEpoch       uint64         `protobuf:"varint,2,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	EndEpoch         uint64         `protobuf:"varint,3,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
+	Status           SlashingStatus `protobuf:"varint,4,opt,name=status,proto3,enum=ethereum.slashing.SlashingStatus" json:"status,omitempty"`
+}
+
+func (x *SlashingsRequest) Reset() {
+	*x = SlashingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_slashing_slashing_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlashingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlashingsRequest) ProtoMessage() {}
+
+func (x *SlashingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_slashing_slashing_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlashingsRequest.ProtoReflect.Descriptor instead.
+func (*SlashingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_slashing_slashing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SlashingsRequest) GetValidatorIndices() []uint64 {
+	if x != nil {
+		return x.ValidatorIndices
+	}
+	return nil
+}
+
+func (x *SlashingsRequest) GetStartEpoch() uint64 {
+	if x != nil {
+		return x.StartEpoch
+	}
+	return 0
+}
+
+func (x *SlashingsRequest) GetEndEpoch() uint64 {
+	if x != nil {
+		return x.EndEpoch
+	}
+	return 0
+}
+
+func (x *SlashingsRequest) GetStatus() SlashingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SlashingStatus_ALL
+}
+
+type AttesterSlashingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slashings []*AttesterSlashingRecord `protobuf:"bytes,1,rep,name=slashings,proto3" json:"slashings,omitempty"`
+}
+
+func (x *AttesterSlashingsResponse) Reset() {
+	*x = AttesterSlashingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_slashing_slashing_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttesterSlashingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttesterSlashingsResponse) ProtoMessage() {}
+
+func (x *AttesterSlashingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_slashing_slashing_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttesterSlashingsResponse.ProtoReflect.Descriptor instead.
+func (*AttesterSlashingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_slashing_slashing_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AttesterSlashingsResponse) GetSlashings() []*AttesterSlashingRecord {
+	if x != nil {
+		return x.Slashings
+	}
+	return nil
+}
+
+type AttesterSlashingRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slashing       *v1alpha1.AttesterSlashing `protobuf:"bytes,1,opt,name=slashing,proto3" json:"slashing,omitempty"`
+	SlashedIndices []uint64                   `protobuf:"varint,2,rep,packed,name=slashed_indices,json=slashedIndices,proto3" json:"slashed_indices,omitempty"`
+	Status         SlashingStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=ethereum.slashing.SlashingStatus" json:"status,omitempty"`
+}
+
+func (x *AttesterSlashingRecord) Reset() {
+	*x = AttesterSlashingRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_slashing_slashing_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttesterSlashingRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttesterSlashingRecord) ProtoMessage() {}
+
+func (x *AttesterSlashingRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_slashing_slashing_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttesterSlashingRecord.ProtoReflect.Descriptor instead.
+func (*AttesterSlashingRecord) Descriptor() ([]byte, []int) {
+	return file_proto_slashing_slashing_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AttesterSlashingRecord) GetSlashing() *v1alpha1.AttesterSlashing {
+	if x != nil {
+		return x.Slashing
+	}
+	return nil
+}
+
+func (x *AttesterSlashingRecord) GetSlashedIndices() []uint64 {
+	if x != nil {
+		return x.SlashedIndices
+	}
+	return nil
+}
+
+func (x *AttesterSlashingRecord) GetStatus() SlashingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SlashingStatus_ALL
+}
+
+type ProposerSlashingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slashings []*ProposerSlashingRecord `protobuf:"bytes,1,rep,name=slashings,proto3" json:"slashings,omitempty"`
+}
+
+func (x *ProposerSlashingsResponse) Reset() {
+	*x = ProposerSlashingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_slashing_slashing_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposerSlashingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposerSlashingsResponse) ProtoMessage() {}
+
+func (x *ProposerSlashingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_slashing_slashing_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposerSlashingsResponse.ProtoReflect.Descriptor instead.
+func (*ProposerSlashingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_slashing_slashing_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProposerSlashingsResponse) GetSlashings() []*ProposerSlashingRecord {
+	if x != nil {
+		return x.Slashings
+	}
+	return nil
+}
+
+type ProposerSlashingRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slashing      *v1alpha1.ProposerSlashing `protobuf:"bytes,1,opt,name=slashing,proto3" json:"slashing,omitempty"`
+	ProposerIndex uint64                     `protobuf:"varint,2,opt,name=proposer_index,json=proposerIndex,proto3" json:"proposer_index,omitempty"`
+	Status        SlashingStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=ethereum.slashing.SlashingStatus" json:"status,omitempty"`
+}
+
+func (x *ProposerSlashingRecord) Reset() {
+	*x = ProposerSlashingRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_slashing_slashing_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposerSlashingRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposerSlashingRecord) ProtoMessage() {}
+
+func (x *ProposerSlashingRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_slashing_slashing_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposerSlashingRecord.ProtoReflect.Descriptor instead.
+func (*ProposerSlashingRecord) Descriptor() ([]byte, []int) {
+	return file_proto_slashing_slashing_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProposerSlashingRecord) GetSlashing() *v1alpha1.ProposerSlashing {
+	if x != nil {
+		return x.Slashing
+	}
+	return nil
+}
+
+func (x *ProposerSlashingRecord) GetProposerIndex() uint64 {
+	if x != nil {
+		return x.ProposerIndex
+	}
+	return 0
+}
+
+func (x *ProposerSlashingRecord) GetStatus() SlashingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SlashingStatus_ALL
+}
+
+type HighestAttestationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidatorIds []uint64 `protobuf:"varint,1,rep,packed,name=validator_ids,json=validatorIds,proto3" json:"validator_ids,omitempty"`
+}
+
+func (x *HighestAttestationRequest) Reset() {
+	*x = HighestAttestationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_slashing_slashing_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HighestAttestationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighestAttestationRequest) ProtoMessage() {}
+
+func (x *HighestAttestationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_slashing_slashing_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighestAttestationRequest.ProtoReflect.Descriptor instead.
+func (*HighestAttestationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_slashing_slashing_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *HighestAttestationRequest) GetValidatorIds() []uint64 {
+	if x != nil {
+		return x.ValidatorIds
+	}
+	return nil
+}
+
+type HighestAttestationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attestations []*HighestAttestation `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations,omitempty"`
+}
+
+func (x *HighestAttestationResponse) Reset() {
+	*x = HighestAttestationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_slashing_slashing_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HighestAttestationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighestAttestationResponse) ProtoMessage() {}
+
+func (x *HighestAttestationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_slashing_slashing_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighestAttestationResponse.ProtoReflect.Descriptor instead.
+func (*HighestAttestationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_slashing_slashing_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *HighestAttestationResponse) GetAttestations() []*HighestAttestation {
+	if x != nil {
+		return x.Attestations
+	}
+	return nil
+}
+
+type HighestAttestation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidatorId        uint64 `protobuf:"varint,1,opt,name=validator_id,json=validatorId,proto3" json:"validator_id,omitempty"`
+	HighestSourceEpoch uint64 `protobuf:"varint,2,opt,name=highest_source_epoch,json=highestSourceEpoch,proto3" json:"highest_source_epoch,omitempty"`
+	HighestTargetEpoch uint64 `protobuf:"varint,3,opt,name=highest_target_epoch,json=highestTargetEpoch,proto3" json:"highest_target_epoch,omitempty"`
+}
+
+func (x *HighestAttestation) Reset() {
+	*x = HighestAttestation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_slashing_slashing_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HighestAttestation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighestAttestation) ProtoMessage() {}
+
+func (x *HighestAttestation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_slashing_slashing_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighestAttestation.ProtoReflect.Descriptor instead.
+func (*HighestAttestation) Descriptor() ([]byte, []int) {
+	return file_proto_slashing_slashing_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *HighestAttestation) GetValidatorId() uint64 {
+	if x != nil {
+		return x.ValidatorId
+	}
+	return 0
+}
+
+func (x *HighestAttestation) GetHighestSourceEpoch() uint64 {
+	if x != nil {
+		return x.HighestSourceEpoch
+	}
+	return 0
+}
+
+func (x *HighestAttestation) GetHighestTargetEpoch() uint64 {
+	if x != nil {
+		return x.HighestTargetEpoch
+	}
+	return 0
+}
+
+type ProposerSlashingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProposerSlashing []*v1alpha1.ProposerSlashing `protobuf:"bytes,1,rep,name=proposer_slashing,json=proposerSlashing,proto3" json:"proposer_slashing,omitempty"`
+}
+
+func (x *ProposerSlashingResponse) Reset() {
+	*x = ProposerSlashingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_slashing_slashing_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposerSlashingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposerSlashingResponse) ProtoMessage() {}
+
+func (x *ProposerSlashingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_slashing_slashing_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposerSlashingResponse.ProtoReflect.Descriptor instead.
+func (*ProposerSlashingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_slashing_slashing_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ProposerSlashingResponse) GetProposerSlashing() []*v1alpha1.ProposerSlashing {
+	if x != nil {
+		return x.ProposerSlashing
+	}
+	return nil
+}
+
+type Slashable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slashable bool `protobuf:"varint,1,opt,name=slashable,proto3" json:"slashable,omitempty"`
+}
+
+func (x *Slashable) Reset() {
+	*x = Slashable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_slashing_slashing_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Slashable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Slashable) ProtoMessage() {}
+
+func (x *Slashable) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_slashing_slashing_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Slashable.ProtoReflect.Descriptor instead.
+func (*Slashable) Descriptor() ([]byte, []int) {
+	return file_proto_slashing_slashing_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Slashable) GetSlashable() bool {
+	if x != nil {
+		return x.Slashable
+	}
+	return false
+}
+
+type AttesterSlashingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AttesterSlashing []*v1alpha1.AttesterSlashing `protobuf:"bytes,1,rep,name=attester_slashing,json=attesterSlashing,proto3" json:"attester_slashing,omitempty"`
+}
+
+func (x *AttesterSlashingResponse) Reset() {
+	*x = AttesterSlashingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_slashing_slashing_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttesterSlashingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttesterSlashingResponse) ProtoMessage() {}
+
+func (x *AttesterSlashingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_slashing_slashing_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttesterSlashingResponse.ProtoReflect.Descriptor instead.
+func (*AttesterSlashingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_slashing_slashing_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AttesterSlashingResponse) GetAttesterSlashing() []*v1alpha1.AttesterSlashing {
+	if x != nil {
+		return x.AttesterSlashing
+	}
+	return nil
+}
+
+type ProposalHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EpochBits          []byte `protobuf:"bytes,1,opt,name=epoch_bits,json=epochBits,proto3" json:"epoch_bits,omitempty"`
+	LatestEpochWritten uint64 `protobuf:"varint,2,opt,name=latest_epoch_written,json=latestEpochWritten,proto3" json:"latest_epoch_written,omitempty"`
+}
+
+func (x *ProposalHistory) Reset() {
+	*x = ProposalHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_slashing_slashing_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposalHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalHistory) ProtoMessage() {}
+
+func (x *ProposalHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_slashing_slashing_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposalHistory.ProtoReflect.Descriptor instead.
+func (*ProposalHistory) Descriptor() ([]byte, []int) {
+	return file_proto_slashing_slashing_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ProposalHistory) GetEpochBits() []byte {
+	if x != nil {
+		return x.EpochBits
+	}
+	return nil
+}
+
+func (x *ProposalHistory) GetLatestEpochWritten() uint64 {
+	if x != nil {
+		return x.LatestEpochWritten
+	}
+	return 0
+}
+
+type AttestationHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetToSource     map[uint64]uint64 `protobuf:"bytes,1,rep,name=target_to_source,json=targetToSource,proto3" json:"target_to_source,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	LatestEpochWritten uint64            `protobuf:"varint,2,opt,name=latest_epoch_written,json=latestEpochWritten,proto3" json:"latest_epoch_written,omitempty"`
+}
+
+func (x *AttestationHistory) Reset() {
+	*x = AttestationHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_slashing_slashing_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttestationHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttestationHistory) ProtoMessage() {}
+
+func (x *AttestationHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_slashing_slashing_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttestationHistory.ProtoReflect.Descriptor instead.
+func (*AttestationHistory) Descriptor() ([]byte, []int) {
+	return file_proto_slashing_slashing_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AttestationHistory) GetTargetToSource() map[uint64]uint64 {
+	if x != nil {
+		return x.TargetToSource
+	}
+	return nil
+}
+
+func (x *AttestationHistory) GetLatestEpochWritten() uint64 {
+	if x != nil {
+		return x.LatestEpochWritten
+	}
+	return 0
+}
+
+var File_proto_slashing_slashing_proto protoreflect.FileDescriptor
+
+var file_proto_slashing_slashing_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
+	0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x11, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69,
+	0x6e, 0x67, 0x1a, 0x1f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x67, 0x6f, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x67, 0x6f,
+	0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xb8, 0x01, 0x0a, 0x10, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04,
+	0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x69, 0x63,
+	0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x21, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x73, 0x6c, 0x61, 0x73,
+	0x68, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x64, 0x0a, 0x19, 0x41,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x09, 0x73, 0x6c, 0x61, 0x73,
+	0x68, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e,
+	0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x09, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
+	0x73, 0x22, 0xc1, 0x01, 0x0a, 0x16, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53, 0x6c,
+	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x43, 0x0a, 0x08,
+	0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e,
+	0x67, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x64,
+	0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x6c, 0x61, 0x73,
+	0x68, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x65, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x64, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
+	0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x47, 0x0a, 0x09, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x52, 0x09, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x16,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x43, 0x0a, 0x08, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69,
+	0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72,
+	0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e,
+	0x67, 0x52, 0x08, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x21, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x73, 0x6c,
+	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x40, 0x0a,
+	0x19, 0x48, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x73, 0x22,
+	0x67, 0x0a, 0x1a, 0x48, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a,
+	0x0c, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x48, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x41,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x12, 0x48, 0x69, 0x67,
+	0x68, 0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x21, 0x0a, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x12, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x30, 0x0a, 0x14, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x5f,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x12, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x70, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x54, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x53, 0x6c,
+	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72,
+	0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x22, 0x29, 0x0a, 0x09, 0x53, 0x6c, 0x61, 0x73,
+	0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x61,
+	0x62, 0x6c, 0x65, 0x22, 0x70, 0x0a, 0x18, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x54, 0x0a, 0x11, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x61, 0x73,
+	0x68, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68,
+	0x69, 0x6e, 0x67, 0x52, 0x10, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53, 0x6c, 0x61,
+	0x73, 0x68, 0x69, 0x6e, 0x67, 0x22, 0x94, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x4f, 0x0a, 0x0a, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x5f, 0x62, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x30, 0xfa,
+	0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72,
+	0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x67, 0x6f, 0x2d, 0x62,
+	0x69, 0x74, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x42, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x52,
+	0x09, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x69, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x22, 0xee, 0x01, 0x0a,
+	0x12, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x63, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x6f,
+	0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e,
+	0x67, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x6f, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x54, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x1a, 0x41, 0x0a, 0x13, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x54, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x2a, 0x34, 0x0a,
+	0x0e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x43, 0x4c, 0x55, 0x44, 0x45,
+	0x44, 0x10, 0x02, 0x32, 0xe5, 0x06, 0x0a, 0x07, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x72, 0x12,
+	0x70, 0x0a, 0x16, 0x49, 0x73, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x2b, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65,
+	0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x6f, 0x0a, 0x10, 0x49, 0x73, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x61, 0x62, 0x6c, 0x65,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2e, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x1a, 0x2b, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x69, 0x0a, 0x1e, 0x49, 0x73, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x61, 0x62, 0x6c,
+	0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x29, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x64, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
+	0x1c, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x69, 0x6e, 0x67, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x62, 0x0a,
+	0x18, 0x49, 0x73, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x4e, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x28, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x1a, 0x1c, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x72, 0x0a, 0x13, 0x48, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72,
+	0x65, 0x75, 0x6d, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x48, 0x69, 0x67,
+	0x68, 0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
+	0x6d, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x48, 0x69, 0x67, 0x68, 0x65,
+	0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x98, 0x01, 0x0a, 0x11, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x23, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e,
+	0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2c, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x73, 0x6c, 0x61, 0x73,
+	0x68, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53, 0x6c, 0x61,
+	0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x72, 0x2f, 0x73, 0x6c,
+	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x12, 0x98, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x53, 0x6c, 0x61,
+	0x73, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x23, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
+	0x6d, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2a, 0x12, 0x28, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x72, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e,
+	0x67, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_slashing_slashing_proto_rawDescOnce sync.Once
+	file_proto_slashing_slashing_proto_rawDescData = file_proto_slashing_slashing_proto_rawDesc
+)
+
+func file_proto_slashing_slashing_proto_rawDescGZIP() []byte {
+	file_proto_slashing_slashing_proto_rawDescOnce.Do(func() {
+		file_proto_slashing_slashing_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_slashing_slashing_proto_rawDescData)
+	})
+	return file_proto_slashing_slashing_proto_rawDescData
+}
+
+var file_proto_slashing_slashing_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_slashing_slashing_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_proto_slashing_slashing_proto_goTypes = []interface{}{
+	(SlashingStatus)(0),                      // 0: ethereum.slashing.SlashingStatus
+	(*SlashingsRequest)(nil),                 // 1: ethereum.slashing.SlashingsRequest
+	(*AttesterSlashingsResponse)(nil),        // 2: ethereum.slashing.AttesterSlashingsResponse
+	(*AttesterSlashingRecord)(nil),           // 3: ethereum.slashing.AttesterSlashingRecord
+	(*ProposerSlashingsResponse)(nil),        // 4: ethereum.slashing.ProposerSlashingsResponse
+	(*ProposerSlashingRecord)(nil),           // 5: ethereum.slashing.ProposerSlashingRecord
+	(*HighestAttestationRequest)(nil),        // 6: ethereum.slashing.HighestAttestationRequest
+	(*HighestAttestationResponse)(nil),       // 7: ethereum.slashing.HighestAttestationResponse
+	(*HighestAttestation)(nil),               // 8: ethereum.slashing.HighestAttestation
+	(*ProposerSlashingResponse)(nil),         // 9: ethereum.slashing.ProposerSlashingResponse
+	(*Slashable)(nil),                        // 10: ethereum.slashing.Slashable
+	(*AttesterSlashingResponse)(nil),         // 11: ethereum.slashing.AttesterSlashingResponse
+	(*ProposalHistory)(nil),                  // 12: ethereum.slashing.ProposalHistory
+	(*AttestationHistory)(nil),               // 13: ethereum.slashing.AttestationHistory
+	nil,                                      // 14: ethereum.slashing.AttestationHistory.TargetToSourceEntry
+	(*v1alpha1.AttesterSlashing)(nil),        // 15: ethereum.eth.v1alpha1.AttesterSlashing
+	(*v1alpha1.ProposerSlashing)(nil),        // 16: ethereum.eth.v1alpha1.ProposerSlashing
+	(*v1alpha1.IndexedAttestation)(nil),      // 17: ethereum.eth.v1alpha1.IndexedAttestation
+	(*v1alpha1.SignedBeaconBlockHeader)(nil), // 18: ethereum.eth.v1alpha1.SignedBeaconBlockHeader
+	(*v1alpha1.BeaconBlockHeader)(nil),       // 19: ethereum.eth.v1alpha1.BeaconBlockHeader
+}
+var file_proto_slashing_slashing_proto_depIdxs = []int32{
+	0,  // 0: ethereum.slashing.SlashingsRequest.status:type_name -> ethereum.slashing.SlashingStatus
+	3,  // 1: ethereum.slashing.AttesterSlashingsResponse.slashings:type_name -> ethereum.slashing.AttesterSlashingRecord
+	15, // 2: ethereum.slashing.AttesterSlashingRecord.slashing:type_name -> ethereum.eth.v1alpha1.AttesterSlashing
+	0,  // 3: ethereum.slashing.AttesterSlashingRecord.status:type_name -> ethereum.slashing.SlashingStatus
+	5,  // 4: ethereum.slashing.ProposerSlashingsResponse.slashings:type_name -> ethereum.slashing.ProposerSlashingRecord
+	16, // 5: ethereum.slashing.ProposerSlashingRecord.slashing:type_name -> ethereum.eth.v1alpha1.ProposerSlashing
+	0,  // 6: ethereum.slashing.ProposerSlashingRecord.status:type_name -> ethereum.slashing.SlashingStatus
+	8,  // 7: ethereum.slashing.HighestAttestationResponse.attestations:type_name -> ethereum.slashing.HighestAttestation
+	16, // 8: ethereum.slashing.ProposerSlashingResponse.proposer_slashing:type_name -> ethereum.eth.v1alpha1.ProposerSlashing
+	15, // 9: ethereum.slashing.AttesterSlashingResponse.attester_slashing:type_name -> ethereum.eth.v1alpha1.AttesterSlashing
+	14, // 10: ethereum.slashing.AttestationHistory.target_to_source:type_name -> ethereum.slashing.AttestationHistory.TargetToSourceEntry
+	17, // 11: ethereum.slashing.Slasher.IsSlashableAttestation:input_type -> ethereum.eth.v1alpha1.IndexedAttestation
+	18, // 12: ethereum.slashing.Slasher.IsSlashableBlock:input_type -> ethereum.eth.v1alpha1.SignedBeaconBlockHeader
+	17, // 13: ethereum.slashing.Slasher.IsSlashableAttestationNoUpdate:input_type -> ethereum.eth.v1alpha1.IndexedAttestation
+	19, // 14: ethereum.slashing.Slasher.IsSlashableBlockNoUpdate:input_type -> ethereum.eth.v1alpha1.BeaconBlockHeader
+	6,  // 15: ethereum.slashing.Slasher.HighestAttestations:input_type -> ethereum.slashing.HighestAttestationRequest
+	1,  // 16: ethereum.slashing.Slasher.AttesterSlashings:input_type -> ethereum.slashing.SlashingsRequest
+	1,  // 17: ethereum.slashing.Slasher.ProposerSlashings:input_type -> ethereum.slashing.SlashingsRequest
+	11, // 18: ethereum.slashing.Slasher.IsSlashableAttestation:output_type -> ethereum.slashing.AttesterSlashingResponse
+	9,  // 19: ethereum.slashing.Slasher.IsSlashableBlock:output_type -> ethereum.slashing.ProposerSlashingResponse
+	10, // 20: ethereum.slashing.Slasher.IsSlashableAttestationNoUpdate:output_type -> ethereum.slashing.Slashable
+	10, // 21: ethereum.slashing.Slasher.IsSlashableBlockNoUpdate:output_type -> ethereum.slashing.Slashable
+	7,  // 22: ethereum.slashing.Slasher.HighestAttestations:output_type -> ethereum.slashing.HighestAttestationResponse
+	2,  // 23: ethereum.slashing.Slasher.AttesterSlashings:output_type -> ethereum.slashing.AttesterSlashingsResponse
+	4,  // 24: ethereum.slashing.Slasher.ProposerSlashings:output_type -> ethereum.slashing.ProposerSlashingsResponse
+	18, // [18:25] is the sub-list for method output_type
+	11, // [11:18] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_proto_slashing_slashing_proto_init() }
+func file_proto_slashing_slashing_proto_init() {
+	if File_proto_slashing_slashing_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_slashing_slashing_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlashingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_slashing_slashing_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttesterSlashingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_slashing_slashing_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttesterSlashingRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_slashing_slashing_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposerSlashingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_slashing_slashing_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposerSlashingRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_slashing_slashing_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HighestAttestationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_slashing_slashing_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HighestAttestationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_slashing_slashing_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HighestAttestation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_slashing_slashing_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposerSlashingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_slashing_slashing_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Slashable); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_slashing_slashing_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttesterSlashingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_slashing_slashing_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposalHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_slashing_slashing_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttestationHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_slashing_slashing_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_slashing_slashing_proto_goTypes,
+		DependencyIndexes: file_proto_slashing_slashing_proto_depIdxs,
+		EnumInfos:         file_proto_slashing_slashing_proto_enumTypes,
+		MessageInfos:      file_proto_slashing_slashing_proto_msgTypes,
+	}.Build()
+	File_proto_slashing_slashing_proto = out.File
+	file_proto_slashing_slashing_proto_rawDesc = nil
+	file_proto_slashing_slashing_proto_goTypes = nil
+	file_proto_slashing_slashing_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// SlasherClient is the client API for Slasher service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SlasherClient interface {
+	IsSlashableAttestation(ctx context.Context, in *v1alpha1.IndexedAttestation, opts ...grpc.CallOption) (*AttesterSlashingResponse, error)
+	IsSlashableBlock(ctx context.Context, in *v1alpha1.SignedBeaconBlockHeader, opts ...grpc.CallOption) (*ProposerSlashingResponse, error)
+	IsSlashableAttestationNoUpdate(ctx context.Context, in *v1alpha1.IndexedAttestation, opts ...grpc.CallOption) (*Slashable, error)
+	IsSlashableBlockNoUpdate(ctx context.Context, in *v1alpha1.BeaconBlockHeader, opts ...grpc.CallOption) (*Slashable, error)
+	HighestAttestations(ctx context.Context, in *HighestAttestationRequest, opts ...grpc.CallOption) (*HighestAttestationResponse, error)
+	AttesterSlashings(ctx context.Context, in *SlashingsRequest, opts ...grpc.CallOption) (*AttesterSlashingsResponse, error)
+	ProposerSlashings(ctx context.Context, in *SlashingsRequest, opts ...grpc.CallOption) (*ProposerSlashingsResponse, error)
+}
+
+type slasherClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSlasherClient(cc grpc.ClientConnInterface) SlasherClient {
+	return &slasherClient{cc}
+}
+
+func (c *slasherClient) IsSlashableAttestation(ctx context.Context, in *v1alpha1.IndexedAttestation, opts ...grpc.CallOption) (*AttesterSlashingResponse, error) {
+	out := new(AttesterSlashingResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.slashing.Slasher/IsSlashableAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slasherClient) IsSlashableBlock(ctx context.Context, in *v1alpha1.SignedBeaconBlockHeader, opts ...grpc.CallOption) (*ProposerSlashingResponse, error) {
+	out := new(ProposerSlashingResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.slashing.Slasher/IsSlashableBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slasherClient) IsSlashableAttestationNoUpdate(ctx context.Context, in *v1alpha1.IndexedAttestation, opts ...grpc.CallOption) (*Slashable, error) {
+	out := new(Slashable)
+	err := c.cc.Invoke(ctx, "/ethereum.slashing.Slasher/IsSlashableAttestationNoUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slasherClient) IsSlashableBlockNoUpdate(ctx context.Context, in *v1alpha1.BeaconBlockHeader, opts ...grpc.CallOption) (*Slashable, error) {
+	out := new(Slashable)
+	err := c.cc.Invoke(ctx, "/ethereum.slashing.Slasher/IsSlashableBlockNoUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slasherClient) HighestAttestations(ctx context.Context, in *HighestAttestationRequest, opts ...grpc.CallOption) (*HighestAttestationResponse, error) {
+	out := new(HighestAttestationResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.slashing.Slasher/HighestAttestations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slasherClient) AttesterSlashings(ctx context.Context, in *SlashingsRequest, opts ...grpc.CallOption) (*AttesterSlashingsResponse, error) {
+	out := new(AttesterSlashingsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.slashing.Slasher/AttesterSlashings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slasherClient) ProposerSlashings(ctx context.Context, in *SlashingsRequest, opts ...grpc.CallOption) (*ProposerSlashingsResponse, error) {
+	out := new(ProposerSlashingsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.slashing.Slasher/ProposerSlashings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SlasherServer is the server API for Slasher service.
+type SlasherServer interface {
+	IsSlashableAttestation(context.Context, *v1alpha1.IndexedAttestation) (*AttesterSlashingResponse, error)
+	IsSlashableBlock(context.Context, *v1alpha1.SignedBeaconBlockHeader) (*ProposerSlashingResponse, error)
+	IsSlashableAttestationNoUpdate(context.Context, *v1alpha1.IndexedAttestation) (*Slashable, error)
+	IsSlashableBlockNoUpdate(context.Context, *v1alpha1.BeaconBlockHeader) (*Slashable, error)
+	HighestAttestations(context.Context, *HighestAttestationRequest) (*HighestAttestationResponse, error)
+	AttesterSlashings(context.Context, *SlashingsRequest) (*AttesterSlashingsResponse, error)
+	ProposerSlashings(context.Context, *SlashingsRequest) (*ProposerSlashingsResponse, error)
+}
+
+// UnimplementedSlasherServer can be embedded to have forward compatible implementations.
+type UnimplementedSlasherServer struct {
+}
+
+func (*UnimplementedSlasherServer) IsSlashableAttestation(context.Context, *v1alpha1.IndexedAttestation) (*AttesterSlashingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsSlashableAttestation not implemented")
+}
+func (*UnimplementedSlasherServer) IsSlashableBlock(context.Context, *v1alpha1.SignedBeaconBlockHeader) (*ProposerSlashingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsSlashableBlock not implemented")
+}
+func (*UnimplementedSlasherServer) IsSlashableAttestationNoUpdate(context.Context, *v1alpha1.IndexedAttestation) (*Slashable, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsSlashableAttestationNoUpdate not implemented")
+}
+func (*UnimplementedSlasherServer) IsSlashableBlockNoUpdate(context.Context, *v1alpha1.BeaconBlockHeader) (*Slashable, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsSlashableBlockNoUpdate not implemented")
+}
+func (*UnimplementedSlasherServer) HighestAttestations(context.Context, *HighestAttestationRequest) (*HighestAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HighestAttestations not implemented")
+}
+func (*UnimplementedSlasherServer) AttesterSlashings(context.Context, *SlashingsRequest) (*AttesterSlashingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttesterSlashings not implemented")
+}
+func (*UnimplementedSlasherServer) ProposerSlashings(context.Context, *SlashingsRequest) (*ProposerSlashingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposerSlashings not implemented")
+}
+
+func RegisterSlasherServer(s *grpc.Server, srv SlasherServer) {
+	s.RegisterService(&_Slasher_serviceDesc, srv)
+}
+
+func _Slasher_IsSlashableAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.IndexedAttestation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherServer).IsSlashableAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.slashing.Slasher/IsSlashableAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherServer).IsSlashableAttestation(ctx, req.(*v1alpha1.IndexedAttestation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Slasher_IsSlashableBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.SignedBeaconBlockHeader)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherServer).IsSlashableBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.slashing.Slasher/IsSlashableBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherServer).IsSlashableBlock(ctx, req.(*v1alpha1.SignedBeaconBlockHeader))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Slasher_IsSlashableAttestationNoUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.IndexedAttestation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherServer).IsSlashableAttestationNoUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.slashing.Slasher/IsSlashableAttestationNoUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherServer).IsSlashableAttestationNoUpdate(ctx, req.(*v1alpha1.IndexedAttestation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Slasher_IsSlashableBlockNoUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.BeaconBlockHeader)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherServer).IsSlashableBlockNoUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.slashing.Slasher/IsSlashableBlockNoUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherServer).IsSlashableBlockNoUpdate(ctx, req.(*v1alpha1.BeaconBlockHeader))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Slasher_HighestAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HighestAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherServer).HighestAttestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.slashing.Slasher/HighestAttestations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherServer).HighestAttestations(ctx, req.(*HighestAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Slasher_AttesterSlashings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlashingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherServer).AttesterSlashings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.slashing.Slasher/AttesterSlashings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherServer).AttesterSlashings(ctx, req.(*SlashingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Slasher_ProposerSlashings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlashingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherServer).ProposerSlashings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.slashing.Slasher/ProposerSlashings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherServer).ProposerSlashings(ctx, req.(*SlashingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Slasher_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.slashing.Slasher",
+	HandlerType: (*SlasherServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IsSlashableAttestation",
+			Handler:    _Slasher_IsSlashableAttestation_Handler,
+		},
+		{
+			MethodName: "IsSlashableBlock",
+			Handler:    _Slasher_IsSlashableBlock_Handler,
+		},
+		{
+			MethodName: "IsSlashableAttestationNoUpdate",
+			Handler:    _Slasher_IsSlashableAttestationNoUpdate_Handler,
+		},
+		{
+			MethodName: "IsSlashableBlockNoUpdate",
+			Handler:    _Slasher_IsSlashableBlockNoUpdate_Handler,
+		},
+		{
+			MethodName: "HighestAttestations",
+			Handler:    _Slasher_HighestAttestations_Handler,
+		},
+		{
+			MethodName: "AttesterSlashings",
+			Handler:    _Slasher_AttesterSlashings_Handler,
+		},
+		{
+			MethodName: "ProposerSlashings",
+			Handler:    _Slasher_ProposerSlashings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/slashing/slashing.proto",
+}
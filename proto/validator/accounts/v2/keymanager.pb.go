@@ -112,6 +112,7 @@ type SignRequest struct {
 	PublicKey       []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
 	SigningRoot     []byte `protobuf:"bytes,2,opt,name=signing_root,json=signingRoot,proto3" json:"signing_root,omitempty"`
 	SignatureDomain []byte `protobuf:"bytes,3,opt,name=signature_domain,json=signatureDomain,proto3" json:"signature_domain,omitempty"`
+	SigningEpoch    uint64 `protobuf:"varint,4,opt,name=signing_epoch,json=signingEpoch,proto3" json:"signing_epoch,omitempty"`
 	// Types that are valid to be assigned to Object:
 	//	*SignRequest_Block
 	//	*SignRequest_AttestationData
@@ -218,6 +219,13 @@ func (m *SignRequest) GetSignatureDomain() []byte {
 	return nil
 }
 
+func (m *SignRequest) GetSigningEpoch() uint64 {
+	if m != nil {
+		return m.SigningEpoch
+	}
+	return 0
+}
+
 func (m *SignRequest) GetBlock() *v1alpha1.BeaconBlock {
 	if x, ok := m.GetObject().(*SignRequest_Block); ok {
 		return x.Block
@@ -339,49 +347,50 @@ func init() {
 }
 
 var fileDescriptor_795e98bd0a473d79 = []byte{
-	// 671 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdd, 0x6a, 0xdb, 0x4c,
-	0x10, 0xb5, 0x6c, 0xc7, 0xdf, 0x97, 0xb5, 0xdb, 0x98, 0x25, 0x18, 0xe1, 0xba, 0x8e, 0x2b, 0x42,
-	0x71, 0x69, 0x90, 0x88, 0x53, 0x7a, 0x11, 0x7a, 0x13, 0xc7, 0x2a, 0x0e, 0x09, 0x6e, 0x90, 0x49,
-	0x7a, 0x29, 0xd6, 0xf2, 0x44, 0x56, 0x22, 0xef, 0xaa, 0xd2, 0xca, 0xc4, 0xd0, 0xab, 0xf6, 0x05,
-	0x0a, 0x7d, 0x88, 0x3e, 0x42, 0x5f, 0xa1, 0x97, 0x85, 0xbe, 0x40, 0x09, 0x7d, 0x8d, 0x42, 0xd9,
-	0x95, 0xfc, 0x13, 0x70, 0xfa, 0x73, 0xe7, 0x39, 0x73, 0xce, 0xcc, 0xf8, 0xcc, 0x8e, 0xd0, 0x4e,
-	0x10, 0x32, 0xce, 0x8c, 0x09, 0xf1, 0xbd, 0x21, 0xe1, 0x2c, 0x34, 0x88, 0xe3, 0xb0, 0x98, 0xf2,
-	0xc8, 0x98, 0xb4, 0x8c, 0x2b, 0x98, 0x8e, 0x09, 0x25, 0x2e, 0x84, 0xba, 0xa4, 0xe1, 0x3a, 0xf0,
-	0x11, 0x84, 0x10, 0x8f, 0xf5, 0xb9, 0x40, 0x9f, 0x09, 0xf4, 0x49, 0xab, 0x2a, 0xf2, 0xc6, 0x64,
-	0x97, 0xf8, 0xc1, 0x88, 0xec, 0x1a, 0x84, 0x73, 0x88, 0x38, 0xe1, 0x1e, 0xa3, 0x89, 0xbe, 0xba,
-	0x75, 0x2b, 0x3f, 0x00, 0xe2, 0x30, 0x6a, 0x0f, 0x7c, 0xe6, 0x5c, 0xa5, 0x84, 0x9a, 0xcb, 0x98,
-	0xeb, 0x83, 0x41, 0x02, 0xcf, 0x20, 0x94, 0xb2, 0x44, 0x1d, 0xa5, 0xd9, 0x07, 0x69, 0x56, 0x46,
-	0x83, 0xf8, 0xc2, 0x80, 0x71, 0xc0, 0xa7, 0x49, 0x52, 0xeb, 0xa1, 0xca, 0x89, 0x17, 0xf1, 0xd3,
-	0x78, 0xe0, 0x7b, 0xce, 0x31, 0x4c, 0x23, 0x0b, 0xa2, 0x80, 0xd1, 0x08, 0xf0, 0x33, 0x54, 0x49,
-	0xc7, 0xf5, 0xa8, 0x6b, 0x07, 0x92, 0x60, 0x5f, 0xc1, 0x34, 0x52, 0xb3, 0x8d, 0x5c, 0xb3, 0x64,
-	0x6d, 0x2e, 0xb2, 0x0b, 0xb5, 0xf6, 0x33, 0x87, 0x8a, 0x7d, 0xcf, 0xa5, 0x16, 0xbc, 0x89, 0x21,
-	0xe2, 0xf8, 0x21, 0x42, 0x0b, 0xa9, 0xaa, 0x34, 0x94, 0x66, 0xc9, 0x5a, 0x0f, 0x66, 0x7c, 0xfc,
-	0x08, 0x95, 0x22, 0xcf, 0xa5, 0xa2, 0x43, 0xc8, 0x18, 0x57, 0xb3, 0x92, 0x50, 0x4c, 0x31, 0x8b,
-	0x31, 0x8e, 0x9f, 0xa0, 0xb2, 0x08, 0x09, 0x8f, 0x43, 0xb0, 0x87, 0x6c, 0x4c, 0x3c, 0xaa, 0xe6,
-	0x24, 0x6d, 0x63, 0x8e, 0x77, 0x24, 0x8c, 0xf7, 0xd1, 0x9a, 0xb4, 0x45, 0x85, 0x86, 0xd2, 0x2c,
-	0xb6, 0x34, 0x7d, 0x6e, 0x3c, 0xf0, 0x91, 0x3e, 0x73, 0x50, 0x6f, 0x4b, 0x07, 0xdb, 0x82, 0xd9,
-	0xcd, 0x58, 0x89, 0x04, 0xf7, 0x51, 0x79, 0xc9, 0x79, 0x7b, 0x48, 0x38, 0x51, 0x2f, 0x64, 0x99,
-	0xc7, 0x77, 0x94, 0x39, 0x58, 0xd0, 0x3b, 0x84, 0x93, 0x6e, 0xc6, 0xda, 0x20, 0xb7, 0x21, 0xfc,
-	0x16, 0x6d, 0x11, 0xd7, 0x0d, 0xc1, 0x25, 0x1c, 0xec, 0xe5, 0xf2, 0x84, 0x0e, 0xed, 0x20, 0x64,
-	0xec, 0x42, 0x75, 0x65, 0x8f, 0xbd, 0xbb, 0x7a, 0xcc, 0xd4, 0x4b, 0xcd, 0x0e, 0xe8, 0xf0, 0x54,
-	0x48, 0xbb, 0x19, 0xab, 0x46, 0x7e, 0x93, 0xc7, 0xfb, 0x28, 0x0f, 0xd7, 0x1e, 0x57, 0x47, 0xb2,
-	0xc5, 0xf6, 0x1d, 0x2d, 0xce, 0x99, 0x1f, 0x53, 0x4e, 0xc2, 0xa9, 0x79, 0xed, 0xf1, 0x6e, 0xc6,
-	0x92, 0x1a, 0xbc, 0x89, 0xf2, 0x91, 0xcf, 0xb8, 0xea, 0x35, 0x94, 0x66, 0x5e, 0xa0, 0x22, 0xc2,
-	0x15, 0xb4, 0x06, 0x01, 0x73, 0x46, 0xea, 0x65, 0x0a, 0x27, 0x61, 0xfb, 0x7f, 0x54, 0x60, 0x83,
-	0x4b, 0x70, 0xb8, 0xf6, 0x59, 0x41, 0xa5, 0x64, 0xff, 0xe9, 0x33, 0xaa, 0xa1, 0xf5, 0xf9, 0x9a,
-	0x66, 0xfb, 0x9f, 0x03, 0xf8, 0x18, 0x15, 0xc4, 0xd4, 0x71, 0x24, 0x37, 0x7f, 0x7f, 0xd9, 0x87,
-	0x95, 0xb7, 0xa2, 0x2f, 0xd7, 0xd6, 0xfb, 0x52, 0x6a, 0xa5, 0x25, 0xb4, 0x17, 0xa8, 0x90, 0x20,
-	0xb8, 0x88, 0xfe, 0x3b, 0xeb, 0x1d, 0xf7, 0x5e, 0xbd, 0xee, 0x95, 0x33, 0xf8, 0x1e, 0x5a, 0xef,
-	0x9f, 0x1d, 0x1e, 0x9a, 0x66, 0xc7, 0xec, 0x94, 0x15, 0x8c, 0x50, 0xa1, 0x63, 0xf6, 0x8e, 0xcc,
-	0x4e, 0x39, 0x2b, 0x7e, 0xbf, 0x3c, 0x38, 0x3a, 0x31, 0x3b, 0xe5, 0x5c, 0xeb, 0x53, 0x16, 0x95,
-	0x2c, 0x18, 0x33, 0x0e, 0xa2, 0x07, 0x84, 0xf8, 0x83, 0x82, 0x54, 0x71, 0x1b, 0xe7, 0x2b, 0xde,
-	0x39, 0xae, 0xe8, 0xc9, 0x55, 0xe9, 0xb3, 0xab, 0xd2, 0x4d, 0x71, 0x55, 0xd5, 0xe7, 0x7f, 0xfa,
-	0x03, 0xab, 0xaf, 0x4d, 0xdb, 0x7e, 0xf7, 0xed, 0xc7, 0xc7, 0x6c, 0x1d, 0xd7, 0x6e, 0x7d, 0x4a,
-	0x42, 0x39, 0xcf, 0x1c, 0xc2, 0xef, 0x15, 0x94, 0x17, 0xd3, 0xe1, 0xa7, 0x7f, 0xe7, 0x93, 0xbc,
-	0xc1, 0xea, 0xce, 0xbf, 0x98, 0xaa, 0x35, 0xe4, 0x24, 0x55, 0x4d, 0x5d, 0x35, 0x89, 0xd8, 0x5c,
-	0xbb, 0xf4, 0xe5, 0xa6, 0xae, 0x7c, 0xbd, 0xa9, 0x2b, 0xdf, 0x6f, 0xea, 0xca, 0xa0, 0x20, 0x1d,
-	0xd8, 0xfb, 0x15, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x70, 0x82, 0xe4, 0x14, 0x05, 0x00, 0x00,
+	// 684 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xda, 0x4c,
+	0x14, 0xc5, 0x84, 0xf0, 0x7d, 0xb9, 0x90, 0x06, 0x8d, 0x22, 0x64, 0x51, 0x4a, 0xa8, 0x1b, 0x55,
+	0x54, 0x8d, 0x6c, 0x85, 0x54, 0x5d, 0x44, 0xdd, 0x84, 0xe0, 0x8a, 0x28, 0x11, 0x8d, 0x8c, 0x92,
+	0x2e, 0xd1, 0x60, 0x26, 0xc6, 0x89, 0x99, 0x71, 0xed, 0x31, 0x0a, 0x52, 0x57, 0xed, 0x0b, 0x54,
+	0xea, 0x1b, 0x74, 0xd3, 0x47, 0xe8, 0x2b, 0x74, 0x59, 0xa9, 0x2f, 0x50, 0x45, 0x7d, 0x90, 0x6a,
+	0xc6, 0x36, 0x10, 0x89, 0xf4, 0x67, 0xc7, 0x9c, 0x7b, 0xcf, 0x3d, 0x97, 0x33, 0x73, 0x0c, 0x3b,
+	0x7e, 0xc0, 0x38, 0x33, 0x26, 0xd8, 0x73, 0x87, 0x98, 0xb3, 0xc0, 0xc0, 0xb6, 0xcd, 0x22, 0xca,
+	0x43, 0x63, 0xd2, 0x34, 0xae, 0xc8, 0x74, 0x8c, 0x29, 0x76, 0x48, 0xa0, 0xcb, 0x36, 0x54, 0x23,
+	0x7c, 0x44, 0x02, 0x12, 0x8d, 0xf5, 0x19, 0x41, 0x4f, 0x09, 0xfa, 0xa4, 0x59, 0x11, 0x75, 0x63,
+	0xb2, 0x8b, 0x3d, 0x7f, 0x84, 0x77, 0x0d, 0xcc, 0x39, 0x09, 0x39, 0xe6, 0x2e, 0xa3, 0x31, 0xbf,
+	0xb2, 0x75, 0xab, 0x3e, 0x20, 0xd8, 0x66, 0xb4, 0x3f, 0xf0, 0x98, 0x7d, 0x95, 0x34, 0x54, 0x1d,
+	0xc6, 0x1c, 0x8f, 0x18, 0xd8, 0x77, 0x0d, 0x4c, 0x29, 0x8b, 0xd9, 0x61, 0x52, 0xbd, 0x9f, 0x54,
+	0xe5, 0x69, 0x10, 0x5d, 0x18, 0x64, 0xec, 0xf3, 0x69, 0x5c, 0xd4, 0xba, 0x50, 0x3e, 0x71, 0x43,
+	0x7e, 0x1a, 0x0d, 0x3c, 0xd7, 0x3e, 0x26, 0xd3, 0xd0, 0x22, 0xa1, 0xcf, 0x68, 0x48, 0xd0, 0x33,
+	0x28, 0x27, 0xeb, 0xba, 0xd4, 0xe9, 0xfb, 0xb2, 0xa1, 0x7f, 0x45, 0xa6, 0xa1, 0x9a, 0xad, 0xaf,
+	0x34, 0x8a, 0xd6, 0xe6, 0xbc, 0x3a, 0x67, 0x6b, 0x9f, 0x72, 0x50, 0xe8, 0xb9, 0x0e, 0xb5, 0xc8,
+	0x9b, 0x88, 0x84, 0x1c, 0x3d, 0x00, 0x98, 0x53, 0x55, 0xa5, 0xae, 0x34, 0x8a, 0xd6, 0x9a, 0x9f,
+	0xf6, 0xa3, 0x87, 0x50, 0x0c, 0x5d, 0x87, 0x0a, 0x85, 0x80, 0x31, 0xae, 0x66, 0x65, 0x43, 0x21,
+	0xc1, 0x2c, 0xc6, 0x38, 0x7a, 0x02, 0x25, 0x71, 0xc4, 0x3c, 0x0a, 0x48, 0x7f, 0xc8, 0xc6, 0xd8,
+	0xa5, 0xea, 0x8a, 0x6c, 0xdb, 0x98, 0xe1, 0x6d, 0x09, 0xa3, 0x47, 0xb0, 0x9e, 0x4e, 0x23, 0x3e,
+	0xb3, 0x47, 0x6a, 0xae, 0xae, 0x34, 0x72, 0x56, 0x2a, 0x61, 0x0a, 0x0c, 0xed, 0xc3, 0xaa, 0xf4,
+	0x4e, 0x25, 0x75, 0xa5, 0x51, 0x68, 0x6a, 0xfa, 0xec, 0x76, 0x08, 0x1f, 0xe9, 0xa9, 0xcd, 0x7a,
+	0x4b, 0xda, 0xdc, 0x12, 0x9d, 0x9d, 0x8c, 0x15, 0x53, 0x50, 0x0f, 0x4a, 0x0b, 0xd7, 0xd3, 0x1f,
+	0x62, 0x8e, 0xd5, 0x0b, 0x39, 0xe6, 0xf1, 0x1d, 0x63, 0x0e, 0xe6, 0xed, 0x6d, 0xcc, 0x71, 0x27,
+	0x63, 0x6d, 0xe0, 0xdb, 0x10, 0x7a, 0x0b, 0x5b, 0xd8, 0x71, 0x02, 0xe2, 0x60, 0x4e, 0xfa, 0x8b,
+	0xe3, 0x31, 0x1d, 0xf6, 0xfd, 0x80, 0xb1, 0x0b, 0xd5, 0x91, 0x1a, 0x7b, 0x77, 0x69, 0xa4, 0xec,
+	0x05, 0xb1, 0x03, 0x3a, 0x3c, 0x15, 0xd4, 0x4e, 0xc6, 0xaa, 0xe2, 0xdf, 0xd4, 0xd1, 0x3e, 0xe4,
+	0xc8, 0xb5, 0xcb, 0xd5, 0x91, 0x94, 0xd8, 0xbe, 0x43, 0xe2, 0x9c, 0x79, 0x11, 0xe5, 0x38, 0x98,
+	0x9a, 0xd7, 0x2e, 0xef, 0x64, 0x2c, 0xc9, 0x41, 0x9b, 0x90, 0x0b, 0x3d, 0xc6, 0x55, 0x57, 0xd8,
+	0x2c, 0x50, 0x71, 0x42, 0x65, 0x58, 0x8d, 0xdd, 0xbf, 0x4c, 0xe0, 0xf8, 0xd8, 0xfa, 0x1f, 0xf2,
+	0x6c, 0x70, 0x49, 0x6c, 0xae, 0x7d, 0x51, 0xa0, 0x18, 0x3f, 0x92, 0xe4, 0xad, 0x55, 0x61, 0x6d,
+	0x76, 0x97, 0xe9, 0x23, 0x99, 0x01, 0xe8, 0x18, 0xf2, 0x62, 0xeb, 0x28, 0x94, 0xcf, 0xe3, 0xde,
+	0xa2, 0x0f, 0x4b, 0x03, 0xa5, 0x2f, 0xce, 0xd6, 0x7b, 0x92, 0x6a, 0x25, 0x23, 0xb4, 0x17, 0x90,
+	0x8f, 0x11, 0x54, 0x80, 0xff, 0xce, 0xba, 0xc7, 0xdd, 0x57, 0xaf, 0xbb, 0xa5, 0x0c, 0x5a, 0x87,
+	0xb5, 0xde, 0xd9, 0xe1, 0xa1, 0x69, 0xb6, 0xcd, 0x76, 0x49, 0x41, 0x00, 0xf9, 0xb6, 0xd9, 0x3d,
+	0x32, 0xdb, 0xa5, 0xac, 0xf8, 0xfd, 0xf2, 0xe0, 0xe8, 0xc4, 0x6c, 0x97, 0x56, 0x9a, 0x9f, 0xb3,
+	0x50, 0xb4, 0xc8, 0x98, 0x71, 0x22, 0x34, 0x48, 0x80, 0x3e, 0x28, 0xa0, 0x8a, 0x00, 0x9d, 0x2f,
+	0x09, 0x03, 0x2a, 0xeb, 0x71, 0xf4, 0xf4, 0x34, 0x7a, 0xba, 0x29, 0xa2, 0x57, 0x79, 0xfe, 0xa7,
+	0x3f, 0xb0, 0x3c, 0x92, 0xda, 0xf6, 0xbb, 0xef, 0x3f, 0x3f, 0x66, 0x6b, 0xa8, 0x7a, 0xeb, 0x7b,
+	0x13, 0xc8, 0x7d, 0x66, 0x10, 0x7a, 0xaf, 0x40, 0x4e, 0x6c, 0x87, 0x9e, 0xfe, 0x9d, 0x4f, 0x32,
+	0xa8, 0x95, 0x9d, 0x7f, 0x31, 0x55, 0xab, 0xcb, 0x4d, 0x2a, 0x9a, 0xba, 0x6c, 0x13, 0x71, 0x73,
+	0xad, 0xe2, 0xd7, 0x9b, 0x9a, 0xf2, 0xed, 0xa6, 0xa6, 0xfc, 0xb8, 0xa9, 0x29, 0x83, 0xbc, 0x74,
+	0x60, 0xef, 0xd7, 0x00, 0xc0, 0xa2, 0xcd, 0xee, 0x39, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -569,6 +578,11 @@ func (m *SignRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			}
 		}
 	}
+	if m.SigningEpoch != 0 {
+		i = encodeVarintKeymanager(dAtA, i, uint64(m.SigningEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.SignatureDomain) > 0 {
 		i -= len(m.SignatureDomain)
 		copy(dAtA[i:], m.SignatureDomain)
@@ -799,6 +813,9 @@ func (m *SignRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovKeymanager(uint64(l))
 	}
+	if m.SigningEpoch != 0 {
+		n += 1 + sovKeymanager(uint64(m.SigningEpoch))
+	}
 	if m.Object != nil {
 		n += m.Object.Size()
 	}
@@ -1116,6 +1133,25 @@ func (m *SignRequest) Unmarshal(dAtA []byte) error {
 				m.SignatureDomain = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningEpoch", wireType)
+			}
+			m.SigningEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeymanager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigningEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 101:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
@@ -5,6 +5,7 @@ package ethereum_validator_accounts_v2
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -588,26 +589,27 @@ func (m *Account) GetDerivationPath() string {
 	return ""
 }
 
-type AccountRequest struct {
+type PerformanceHistoryRequest struct {
 	PublicKeys           [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
-	Indices              []uint64 `protobuf:"varint,2,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	StartEpoch           uint64   `protobuf:"varint,2,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	EndEpoch             uint64   `protobuf:"varint,3,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AccountRequest) Reset()         { *m = AccountRequest{} }
-func (m *AccountRequest) String() string { return proto.CompactTextString(m) }
-func (*AccountRequest) ProtoMessage()    {}
-func (*AccountRequest) Descriptor() ([]byte, []int) {
+func (m *PerformanceHistoryRequest) Reset()         { *m = PerformanceHistoryRequest{} }
+func (m *PerformanceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*PerformanceHistoryRequest) ProtoMessage()    {}
+func (*PerformanceHistoryRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8a5153635bfe042e, []int{8}
 }
-func (m *AccountRequest) XXX_Unmarshal(b []byte) error {
+func (m *PerformanceHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PerformanceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AccountRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PerformanceHistoryRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -617,52 +619,58 @@ func (m *AccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *AccountRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AccountRequest.Merge(m, src)
+func (m *PerformanceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PerformanceHistoryRequest.Merge(m, src)
 }
-func (m *AccountRequest) XXX_Size() int {
+func (m *PerformanceHistoryRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AccountRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AccountRequest.DiscardUnknown(m)
+func (m *PerformanceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PerformanceHistoryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AccountRequest proto.InternalMessageInfo
+var xxx_messageInfo_PerformanceHistoryRequest proto.InternalMessageInfo
 
-func (m *AccountRequest) GetPublicKeys() [][]byte {
+func (m *PerformanceHistoryRequest) GetPublicKeys() [][]byte {
 	if m != nil {
 		return m.PublicKeys
 	}
 	return nil
 }
 
-func (m *AccountRequest) GetIndices() []uint64 {
+func (m *PerformanceHistoryRequest) GetStartEpoch() uint64 {
 	if m != nil {
-		return m.Indices
+		return m.StartEpoch
 	}
-	return nil
+	return 0
 }
 
-type AuthRequest struct {
-	Password             string   `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
-	PasswordConfirmation string   `protobuf:"bytes,2,opt,name=password_confirmation,json=passwordConfirmation,proto3" json:"password_confirmation,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *PerformanceHistoryRequest) GetEndEpoch() uint64 {
+	if m != nil {
+		return m.EndEpoch
+	}
+	return 0
 }
 
-func (m *AuthRequest) Reset()         { *m = AuthRequest{} }
-func (m *AuthRequest) String() string { return proto.CompactTextString(m) }
-func (*AuthRequest) ProtoMessage()    {}
-func (*AuthRequest) Descriptor() ([]byte, []int) {
+type PerformanceHistoryResponse struct {
+	Histories            []*ValidatorPerformanceHistory `protobuf:"bytes,1,rep,name=histories,proto3" json:"histories,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *PerformanceHistoryResponse) Reset()         { *m = PerformanceHistoryResponse{} }
+func (m *PerformanceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*PerformanceHistoryResponse) ProtoMessage()    {}
+func (*PerformanceHistoryResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8a5153635bfe042e, []int{9}
 }
-func (m *AuthRequest) XXX_Unmarshal(b []byte) error {
+func (m *PerformanceHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AuthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PerformanceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AuthRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PerformanceHistoryResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -672,52 +680,46 @@ func (m *AuthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *AuthRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthRequest.Merge(m, src)
+func (m *PerformanceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PerformanceHistoryResponse.Merge(m, src)
 }
-func (m *AuthRequest) XXX_Size() int {
+func (m *PerformanceHistoryResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *AuthRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthRequest.DiscardUnknown(m)
+func (m *PerformanceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PerformanceHistoryResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AuthRequest proto.InternalMessageInfo
-
-func (m *AuthRequest) GetPassword() string {
-	if m != nil {
-		return m.Password
-	}
-	return ""
-}
+var xxx_messageInfo_PerformanceHistoryResponse proto.InternalMessageInfo
 
-func (m *AuthRequest) GetPasswordConfirmation() string {
+func (m *PerformanceHistoryResponse) GetHistories() []*ValidatorPerformanceHistory {
 	if m != nil {
-		return m.PasswordConfirmation
+		return m.Histories
 	}
-	return ""
+	return nil
 }
 
-type AuthResponse struct {
-	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	TokenExpiration      uint64   `protobuf:"varint,2,opt,name=token_expiration,json=tokenExpiration,proto3" json:"token_expiration,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ValidatorPerformanceHistory struct {
+	PublicKey            []byte              `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Epochs               []*EpochPerformance `protobuf:"bytes,2,rep,name=epochs,proto3" json:"epochs,omitempty"`
+	Summary              *PerformanceSummary `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *AuthResponse) Reset()         { *m = AuthResponse{} }
-func (m *AuthResponse) String() string { return proto.CompactTextString(m) }
-func (*AuthResponse) ProtoMessage()    {}
-func (*AuthResponse) Descriptor() ([]byte, []int) {
+func (m *ValidatorPerformanceHistory) Reset()         { *m = ValidatorPerformanceHistory{} }
+func (m *ValidatorPerformanceHistory) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformanceHistory) ProtoMessage()    {}
+func (*ValidatorPerformanceHistory) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8a5153635bfe042e, []int{10}
 }
-func (m *AuthResponse) XXX_Unmarshal(b []byte) error {
+func (m *ValidatorPerformanceHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AuthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorPerformanceHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AuthResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorPerformanceHistory.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -727,55 +729,70 @@ func (m *AuthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *AuthResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthResponse.Merge(m, src)
+func (m *ValidatorPerformanceHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformanceHistory.Merge(m, src)
 }
-func (m *AuthResponse) XXX_Size() int {
+func (m *ValidatorPerformanceHistory) XXX_Size() int {
 	return m.Size()
 }
-func (m *AuthResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthResponse.DiscardUnknown(m)
+func (m *ValidatorPerformanceHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformanceHistory.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AuthResponse proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorPerformanceHistory proto.InternalMessageInfo
 
-func (m *AuthResponse) GetToken() string {
+func (m *ValidatorPerformanceHistory) GetPublicKey() []byte {
 	if m != nil {
-		return m.Token
+		return m.PublicKey
 	}
-	return ""
+	return nil
 }
 
-func (m *AuthResponse) GetTokenExpiration() uint64 {
+func (m *ValidatorPerformanceHistory) GetEpochs() []*EpochPerformance {
 	if m != nil {
-		return m.TokenExpiration
+		return m.Epochs
 	}
-	return 0
+	return nil
 }
 
-type NodeConnectionResponse struct {
-	BeaconNodeEndpoint     string   `protobuf:"bytes,1,opt,name=beacon_node_endpoint,json=beaconNodeEndpoint,proto3" json:"beacon_node_endpoint,omitempty"`
-	Connected              bool     `protobuf:"varint,2,opt,name=connected,proto3" json:"connected,omitempty"`
-	Syncing                bool     `protobuf:"varint,3,opt,name=syncing,proto3" json:"syncing,omitempty"`
-	GenesisTime            uint64   `protobuf:"varint,4,opt,name=genesis_time,json=genesisTime,proto3" json:"genesis_time,omitempty"`
-	DepositContractAddress []byte   `protobuf:"bytes,5,opt,name=deposit_contract_address,json=depositContractAddress,proto3" json:"deposit_contract_address,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_unrecognized       []byte   `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
+func (m *ValidatorPerformanceHistory) GetSummary() *PerformanceSummary {
+	if m != nil {
+		return m.Summary
+	}
+	return nil
 }
 
-func (m *NodeConnectionResponse) Reset()         { *m = NodeConnectionResponse{} }
-func (m *NodeConnectionResponse) String() string { return proto.CompactTextString(m) }
-func (*NodeConnectionResponse) ProtoMessage()    {}
-func (*NodeConnectionResponse) Descriptor() ([]byte, []int) {
+type EpochPerformance struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	AttestationIncluded  bool     `protobuf:"varint,2,opt,name=attestation_included,json=attestationIncluded,proto3" json:"attestation_included,omitempty"`
+	AttestationLate      bool     `protobuf:"varint,3,opt,name=attestation_late,json=attestationLate,proto3" json:"attestation_late,omitempty"`
+	InclusionSlot        uint64   `protobuf:"varint,4,opt,name=inclusion_slot,json=inclusionSlot,proto3" json:"inclusion_slot,omitempty"`
+	InclusionDistance    uint64   `protobuf:"varint,5,opt,name=inclusion_distance,json=inclusionDistance,proto3" json:"inclusion_distance,omitempty"`
+	CorrectlyVotedSource bool     `protobuf:"varint,6,opt,name=correctly_voted_source,json=correctlyVotedSource,proto3" json:"correctly_voted_source,omitempty"`
+	CorrectlyVotedTarget bool     `protobuf:"varint,7,opt,name=correctly_voted_target,json=correctlyVotedTarget,proto3" json:"correctly_voted_target,omitempty"`
+	CorrectlyVotedHead   bool     `protobuf:"varint,8,opt,name=correctly_voted_head,json=correctlyVotedHead,proto3" json:"correctly_voted_head,omitempty"`
+	ProposalsMade        uint64   `protobuf:"varint,9,opt,name=proposals_made,json=proposalsMade,proto3" json:"proposals_made,omitempty"`
+	ProposalsMissed      uint64   `protobuf:"varint,10,opt,name=proposals_missed,json=proposalsMissed,proto3" json:"proposals_missed,omitempty"`
+	BalanceBefore        uint64   `protobuf:"varint,11,opt,name=balance_before,json=balanceBefore,proto3" json:"balance_before,omitempty"`
+	BalanceAfter         uint64   `protobuf:"varint,12,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	BalanceChange        int64    `protobuf:"varint,13,opt,name=balance_change,json=balanceChange,proto3" json:"balance_change,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EpochPerformance) Reset()         { *m = EpochPerformance{} }
+func (m *EpochPerformance) String() string { return proto.CompactTextString(m) }
+func (*EpochPerformance) ProtoMessage()    {}
+func (*EpochPerformance) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8a5153635bfe042e, []int{11}
 }
-func (m *NodeConnectionResponse) XXX_Unmarshal(b []byte) error {
+func (m *EpochPerformance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NodeConnectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EpochPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_NodeConnectionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EpochPerformance.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -785,184 +802,137 @@ func (m *NodeConnectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *NodeConnectionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NodeConnectionResponse.Merge(m, src)
+func (m *EpochPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochPerformance.Merge(m, src)
 }
-func (m *NodeConnectionResponse) XXX_Size() int {
+func (m *EpochPerformance) XXX_Size() int {
 	return m.Size()
 }
-func (m *NodeConnectionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_NodeConnectionResponse.DiscardUnknown(m)
+func (m *EpochPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochPerformance.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NodeConnectionResponse proto.InternalMessageInfo
+var xxx_messageInfo_EpochPerformance proto.InternalMessageInfo
 
-func (m *NodeConnectionResponse) GetBeaconNodeEndpoint() string {
+func (m *EpochPerformance) GetEpoch() uint64 {
 	if m != nil {
-		return m.BeaconNodeEndpoint
+		return m.Epoch
 	}
-	return ""
+	return 0
 }
 
-func (m *NodeConnectionResponse) GetConnected() bool {
+func (m *EpochPerformance) GetAttestationIncluded() bool {
 	if m != nil {
-		return m.Connected
+		return m.AttestationIncluded
 	}
 	return false
 }
 
-func (m *NodeConnectionResponse) GetSyncing() bool {
+func (m *EpochPerformance) GetAttestationLate() bool {
 	if m != nil {
-		return m.Syncing
+		return m.AttestationLate
 	}
 	return false
 }
 
-func (m *NodeConnectionResponse) GetGenesisTime() uint64 {
+func (m *EpochPerformance) GetInclusionSlot() uint64 {
 	if m != nil {
-		return m.GenesisTime
+		return m.InclusionSlot
 	}
 	return 0
 }
 
-func (m *NodeConnectionResponse) GetDepositContractAddress() []byte {
+func (m *EpochPerformance) GetInclusionDistance() uint64 {
 	if m != nil {
-		return m.DepositContractAddress
+		return m.InclusionDistance
 	}
-	return nil
-}
-
-type LogsEndpointResponse struct {
-	ValidatorLogsEndpoint string   `protobuf:"bytes,1,opt,name=validator_logs_endpoint,json=validatorLogsEndpoint,proto3" json:"validator_logs_endpoint,omitempty"`
-	BeaconLogsEndpoint    string   `protobuf:"bytes,2,opt,name=beacon_logs_endpoint,json=beaconLogsEndpoint,proto3" json:"beacon_logs_endpoint,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
-	XXX_unrecognized      []byte   `json:"-"`
-	XXX_sizecache         int32    `json:"-"`
+	return 0
 }
 
-func (m *LogsEndpointResponse) Reset()         { *m = LogsEndpointResponse{} }
-func (m *LogsEndpointResponse) String() string { return proto.CompactTextString(m) }
-func (*LogsEndpointResponse) ProtoMessage()    {}
-func (*LogsEndpointResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a5153635bfe042e, []int{12}
-}
-func (m *LogsEndpointResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *LogsEndpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_LogsEndpointResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *EpochPerformance) GetCorrectlyVotedSource() bool {
+	if m != nil {
+		return m.CorrectlyVotedSource
 	}
-}
-func (m *LogsEndpointResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LogsEndpointResponse.Merge(m, src)
-}
-func (m *LogsEndpointResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *LogsEndpointResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LogsEndpointResponse.DiscardUnknown(m)
+	return false
 }
 
-var xxx_messageInfo_LogsEndpointResponse proto.InternalMessageInfo
-
-func (m *LogsEndpointResponse) GetValidatorLogsEndpoint() string {
+func (m *EpochPerformance) GetCorrectlyVotedTarget() bool {
 	if m != nil {
-		return m.ValidatorLogsEndpoint
+		return m.CorrectlyVotedTarget
 	}
-	return ""
+	return false
 }
 
-func (m *LogsEndpointResponse) GetBeaconLogsEndpoint() string {
+func (m *EpochPerformance) GetCorrectlyVotedHead() bool {
 	if m != nil {
-		return m.BeaconLogsEndpoint
+		return m.CorrectlyVotedHead
 	}
-	return ""
+	return false
 }
 
-type VersionResponse struct {
-	Beacon               string   `protobuf:"bytes,1,opt,name=beacon,proto3" json:"beacon,omitempty"`
-	Validator            string   `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *EpochPerformance) GetProposalsMade() uint64 {
+	if m != nil {
+		return m.ProposalsMade
+	}
+	return 0
 }
 
-func (m *VersionResponse) Reset()         { *m = VersionResponse{} }
-func (m *VersionResponse) String() string { return proto.CompactTextString(m) }
-func (*VersionResponse) ProtoMessage()    {}
-func (*VersionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a5153635bfe042e, []int{13}
-}
-func (m *VersionResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *VersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_VersionResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *EpochPerformance) GetProposalsMissed() uint64 {
+	if m != nil {
+		return m.ProposalsMissed
 	}
+	return 0
 }
-func (m *VersionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VersionResponse.Merge(m, src)
-}
-func (m *VersionResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *VersionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_VersionResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_VersionResponse proto.InternalMessageInfo
 
-func (m *VersionResponse) GetBeacon() string {
+func (m *EpochPerformance) GetBalanceBefore() uint64 {
 	if m != nil {
-		return m.Beacon
+		return m.BalanceBefore
 	}
-	return ""
+	return 0
 }
 
-func (m *VersionResponse) GetValidator() string {
+func (m *EpochPerformance) GetBalanceAfter() uint64 {
 	if m != nil {
-		return m.Validator
+		return m.BalanceAfter
 	}
-	return ""
+	return 0
 }
 
-type ChangePasswordRequest struct {
-	CurrentPassword      string   `protobuf:"bytes,1,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
-	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	PasswordConfirmation string   `protobuf:"bytes,3,opt,name=password_confirmation,json=passwordConfirmation,proto3" json:"password_confirmation,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *EpochPerformance) GetBalanceChange() int64 {
+	if m != nil {
+		return m.BalanceChange
+	}
+	return 0
 }
 
-func (m *ChangePasswordRequest) Reset()         { *m = ChangePasswordRequest{} }
-func (m *ChangePasswordRequest) String() string { return proto.CompactTextString(m) }
-func (*ChangePasswordRequest) ProtoMessage()    {}
-func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a5153635bfe042e, []int{14}
+type PerformanceSummary struct {
+	IncludedAttestations     uint64   `protobuf:"varint,1,opt,name=included_attestations,json=includedAttestations,proto3" json:"included_attestations,omitempty"`
+	MissedAttestations       uint64   `protobuf:"varint,2,opt,name=missed_attestations,json=missedAttestations,proto3" json:"missed_attestations,omitempty"`
+	LateAttestations         uint64   `protobuf:"varint,3,opt,name=late_attestations,json=lateAttestations,proto3" json:"late_attestations,omitempty"`
+	AverageInclusionDistance float64  `protobuf:"fixed64,4,opt,name=average_inclusion_distance,json=averageInclusionDistance,proto3" json:"average_inclusion_distance,omitempty"`
+	CorrectlyVotedSource     uint64   `protobuf:"varint,5,opt,name=correctly_voted_source,json=correctlyVotedSource,proto3" json:"correctly_voted_source,omitempty"`
+	CorrectlyVotedTarget     uint64   `protobuf:"varint,6,opt,name=correctly_voted_target,json=correctlyVotedTarget,proto3" json:"correctly_voted_target,omitempty"`
+	CorrectlyVotedHead       uint64   `protobuf:"varint,7,opt,name=correctly_voted_head,json=correctlyVotedHead,proto3" json:"correctly_voted_head,omitempty"`
+	ProposalsMade            uint64   `protobuf:"varint,8,opt,name=proposals_made,json=proposalsMade,proto3" json:"proposals_made,omitempty"`
+	ProposalsMissed          uint64   `protobuf:"varint,9,opt,name=proposals_missed,json=proposalsMissed,proto3" json:"proposals_missed,omitempty"`
+	BalanceChange            int64    `protobuf:"varint,10,opt,name=balance_change,json=balanceChange,proto3" json:"balance_change,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
+}
+
+func (m *PerformanceSummary) Reset()         { *m = PerformanceSummary{} }
+func (m *PerformanceSummary) String() string { return proto.CompactTextString(m) }
+func (*PerformanceSummary) ProtoMessage()    {}
+func (*PerformanceSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{12}
 }
-func (m *ChangePasswordRequest) XXX_Unmarshal(b []byte) error {
+func (m *PerformanceSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ChangePasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PerformanceSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ChangePasswordRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PerformanceSummary.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -972,106 +942,108 @@ func (m *ChangePasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *ChangePasswordRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChangePasswordRequest.Merge(m, src)
+func (m *PerformanceSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PerformanceSummary.Merge(m, src)
 }
-func (m *ChangePasswordRequest) XXX_Size() int {
+func (m *PerformanceSummary) XXX_Size() int {
 	return m.Size()
 }
-func (m *ChangePasswordRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChangePasswordRequest.DiscardUnknown(m)
+func (m *PerformanceSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_PerformanceSummary.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChangePasswordRequest proto.InternalMessageInfo
+var xxx_messageInfo_PerformanceSummary proto.InternalMessageInfo
 
-func (m *ChangePasswordRequest) GetCurrentPassword() string {
+func (m *PerformanceSummary) GetIncludedAttestations() uint64 {
 	if m != nil {
-		return m.CurrentPassword
+		return m.IncludedAttestations
 	}
-	return ""
+	return 0
 }
 
-func (m *ChangePasswordRequest) GetPassword() string {
+func (m *PerformanceSummary) GetMissedAttestations() uint64 {
 	if m != nil {
-		return m.Password
+		return m.MissedAttestations
 	}
-	return ""
+	return 0
 }
 
-func (m *ChangePasswordRequest) GetPasswordConfirmation() string {
+func (m *PerformanceSummary) GetLateAttestations() uint64 {
 	if m != nil {
-		return m.PasswordConfirmation
+		return m.LateAttestations
 	}
-	return ""
+	return 0
 }
 
-type HasWalletResponse struct {
-	WalletExists         bool     `protobuf:"varint,1,opt,name=wallet_exists,json=walletExists,proto3" json:"wallet_exists,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *PerformanceSummary) GetAverageInclusionDistance() float64 {
+	if m != nil {
+		return m.AverageInclusionDistance
+	}
+	return 0
 }
 
-func (m *HasWalletResponse) Reset()         { *m = HasWalletResponse{} }
-func (m *HasWalletResponse) String() string { return proto.CompactTextString(m) }
-func (*HasWalletResponse) ProtoMessage()    {}
-func (*HasWalletResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a5153635bfe042e, []int{15}
-}
-func (m *HasWalletResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *HasWalletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_HasWalletResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *PerformanceSummary) GetCorrectlyVotedSource() uint64 {
+	if m != nil {
+		return m.CorrectlyVotedSource
 	}
+	return 0
 }
-func (m *HasWalletResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HasWalletResponse.Merge(m, src)
+
+func (m *PerformanceSummary) GetCorrectlyVotedTarget() uint64 {
+	if m != nil {
+		return m.CorrectlyVotedTarget
+	}
+	return 0
 }
-func (m *HasWalletResponse) XXX_Size() int {
-	return m.Size()
+
+func (m *PerformanceSummary) GetCorrectlyVotedHead() uint64 {
+	if m != nil {
+		return m.CorrectlyVotedHead
+	}
+	return 0
 }
-func (m *HasWalletResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_HasWalletResponse.DiscardUnknown(m)
+
+func (m *PerformanceSummary) GetProposalsMade() uint64 {
+	if m != nil {
+		return m.ProposalsMade
+	}
+	return 0
 }
 
-var xxx_messageInfo_HasWalletResponse proto.InternalMessageInfo
+func (m *PerformanceSummary) GetProposalsMissed() uint64 {
+	if m != nil {
+		return m.ProposalsMissed
+	}
+	return 0
+}
 
-func (m *HasWalletResponse) GetWalletExists() bool {
+func (m *PerformanceSummary) GetBalanceChange() int64 {
 	if m != nil {
-		return m.WalletExists
+		return m.BalanceChange
 	}
-	return false
+	return 0
 }
 
-type ImportKeystoresRequest struct {
-	KeystoresImported    []string `protobuf:"bytes,1,rep,name=keystores_imported,json=keystoresImported,proto3" json:"keystores_imported,omitempty"`
-	KeystoresPassword    string   `protobuf:"bytes,2,opt,name=keystores_password,json=keystoresPassword,proto3" json:"keystores_password,omitempty"`
+type AccountRequest struct {
+	PublicKeys           [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	Indices              []uint64 `protobuf:"varint,2,rep,packed,name=indices,proto3" json:"indices,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ImportKeystoresRequest) Reset()         { *m = ImportKeystoresRequest{} }
-func (m *ImportKeystoresRequest) String() string { return proto.CompactTextString(m) }
-func (*ImportKeystoresRequest) ProtoMessage()    {}
-func (*ImportKeystoresRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a5153635bfe042e, []int{16}
+func (m *AccountRequest) Reset()         { *m = AccountRequest{} }
+func (m *AccountRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountRequest) ProtoMessage()    {}
+func (*AccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{13}
 }
-func (m *ImportKeystoresRequest) XXX_Unmarshal(b []byte) error {
+func (m *AccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ImportKeystoresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ImportKeystoresRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AccountRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1081,51 +1053,52 @@ func (m *ImportKeystoresRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *ImportKeystoresRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ImportKeystoresRequest.Merge(m, src)
+func (m *AccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountRequest.Merge(m, src)
 }
-func (m *ImportKeystoresRequest) XXX_Size() int {
+func (m *AccountRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ImportKeystoresRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ImportKeystoresRequest.DiscardUnknown(m)
+func (m *AccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ImportKeystoresRequest proto.InternalMessageInfo
+var xxx_messageInfo_AccountRequest proto.InternalMessageInfo
 
-func (m *ImportKeystoresRequest) GetKeystoresImported() []string {
+func (m *AccountRequest) GetPublicKeys() [][]byte {
 	if m != nil {
-		return m.KeystoresImported
+		return m.PublicKeys
 	}
 	return nil
 }
 
-func (m *ImportKeystoresRequest) GetKeystoresPassword() string {
+func (m *AccountRequest) GetIndices() []uint64 {
 	if m != nil {
-		return m.KeystoresPassword
+		return m.Indices
 	}
-	return ""
+	return nil
 }
 
-type ImportKeystoresResponse struct {
-	ImportedPublicKeys   [][]byte `protobuf:"bytes,1,rep,name=imported_public_keys,json=importedPublicKeys,proto3" json:"imported_public_keys,omitempty"`
+type AuthRequest struct {
+	Password             string   `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	PasswordConfirmation string   `protobuf:"bytes,2,opt,name=password_confirmation,json=passwordConfirmation,proto3" json:"password_confirmation,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ImportKeystoresResponse) Reset()         { *m = ImportKeystoresResponse{} }
-func (m *ImportKeystoresResponse) String() string { return proto.CompactTextString(m) }
-func (*ImportKeystoresResponse) ProtoMessage()    {}
-func (*ImportKeystoresResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a5153635bfe042e, []int{17}
+func (m *AuthRequest) Reset()         { *m = AuthRequest{} }
+func (m *AuthRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthRequest) ProtoMessage()    {}
+func (*AuthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{14}
 }
-func (m *ImportKeystoresResponse) XXX_Unmarshal(b []byte) error {
+func (m *AuthRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ImportKeystoresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AuthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ImportKeystoresResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AuthRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1135,45 +1108,52 @@ func (m *ImportKeystoresResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *ImportKeystoresResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ImportKeystoresResponse.Merge(m, src)
+func (m *AuthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthRequest.Merge(m, src)
 }
-func (m *ImportKeystoresResponse) XXX_Size() int {
+func (m *AuthRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ImportKeystoresResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ImportKeystoresResponse.DiscardUnknown(m)
+func (m *AuthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ImportKeystoresResponse proto.InternalMessageInfo
+var xxx_messageInfo_AuthRequest proto.InternalMessageInfo
 
-func (m *ImportKeystoresResponse) GetImportedPublicKeys() [][]byte {
+func (m *AuthRequest) GetPassword() string {
 	if m != nil {
-		return m.ImportedPublicKeys
+		return m.Password
 	}
-	return nil
+	return ""
 }
 
-type HasUsedWebResponse struct {
-	HasSignedUp          bool     `protobuf:"varint,1,opt,name=has_signed_up,json=hasSignedUp,proto3" json:"has_signed_up,omitempty"`
-	HasWallet            bool     `protobuf:"varint,2,opt,name=has_wallet,json=hasWallet,proto3" json:"has_wallet,omitempty"`
+func (m *AuthRequest) GetPasswordConfirmation() string {
+	if m != nil {
+		return m.PasswordConfirmation
+	}
+	return ""
+}
+
+type AuthResponse struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	TokenExpiration      uint64   `protobuf:"varint,2,opt,name=token_expiration,json=tokenExpiration,proto3" json:"token_expiration,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *HasUsedWebResponse) Reset()         { *m = HasUsedWebResponse{} }
-func (m *HasUsedWebResponse) String() string { return proto.CompactTextString(m) }
-func (*HasUsedWebResponse) ProtoMessage()    {}
-func (*HasUsedWebResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a5153635bfe042e, []int{18}
+func (m *AuthResponse) Reset()         { *m = AuthResponse{} }
+func (m *AuthResponse) String() string { return proto.CompactTextString(m) }
+func (*AuthResponse) ProtoMessage()    {}
+func (*AuthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{15}
 }
-func (m *HasUsedWebResponse) XXX_Unmarshal(b []byte) error {
+func (m *AuthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *HasUsedWebResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AuthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_HasUsedWebResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AuthResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1183,51 +1163,55 @@ func (m *HasUsedWebResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *HasUsedWebResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HasUsedWebResponse.Merge(m, src)
+func (m *AuthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthResponse.Merge(m, src)
 }
-func (m *HasUsedWebResponse) XXX_Size() int {
+func (m *AuthResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *HasUsedWebResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_HasUsedWebResponse.DiscardUnknown(m)
+func (m *AuthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HasUsedWebResponse proto.InternalMessageInfo
+var xxx_messageInfo_AuthResponse proto.InternalMessageInfo
 
-func (m *HasUsedWebResponse) GetHasSignedUp() bool {
+func (m *AuthResponse) GetToken() string {
 	if m != nil {
-		return m.HasSignedUp
+		return m.Token
 	}
-	return false
+	return ""
 }
 
-func (m *HasUsedWebResponse) GetHasWallet() bool {
+func (m *AuthResponse) GetTokenExpiration() uint64 {
 	if m != nil {
-		return m.HasWallet
+		return m.TokenExpiration
 	}
-	return false
+	return 0
 }
 
-type LogsResponse struct {
-	Logs                 []string `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type NodeConnectionResponse struct {
+	BeaconNodeEndpoint     string   `protobuf:"bytes,1,opt,name=beacon_node_endpoint,json=beaconNodeEndpoint,proto3" json:"beacon_node_endpoint,omitempty"`
+	Connected              bool     `protobuf:"varint,2,opt,name=connected,proto3" json:"connected,omitempty"`
+	Syncing                bool     `protobuf:"varint,3,opt,name=syncing,proto3" json:"syncing,omitempty"`
+	GenesisTime            uint64   `protobuf:"varint,4,opt,name=genesis_time,json=genesisTime,proto3" json:"genesis_time,omitempty"`
+	DepositContractAddress []byte   `protobuf:"bytes,5,opt,name=deposit_contract_address,json=depositContractAddress,proto3" json:"deposit_contract_address,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
 }
 
-func (m *LogsResponse) Reset()         { *m = LogsResponse{} }
-func (m *LogsResponse) String() string { return proto.CompactTextString(m) }
-func (*LogsResponse) ProtoMessage()    {}
-func (*LogsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a5153635bfe042e, []int{19}
+func (m *NodeConnectionResponse) Reset()         { *m = NodeConnectionResponse{} }
+func (m *NodeConnectionResponse) String() string { return proto.CompactTextString(m) }
+func (*NodeConnectionResponse) ProtoMessage()    {}
+func (*NodeConnectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{16}
 }
-func (m *LogsResponse) XXX_Unmarshal(b []byte) error {
+func (m *NodeConnectionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NodeConnectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LogsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_NodeConnectionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1237,49 +1221,73 @@ func (m *LogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *LogsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LogsResponse.Merge(m, src)
+func (m *NodeConnectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeConnectionResponse.Merge(m, src)
 }
-func (m *LogsResponse) XXX_Size() int {
+func (m *NodeConnectionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *LogsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LogsResponse.DiscardUnknown(m)
+func (m *NodeConnectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeConnectionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LogsResponse proto.InternalMessageInfo
+var xxx_messageInfo_NodeConnectionResponse proto.InternalMessageInfo
 
-func (m *LogsResponse) GetLogs() []string {
+func (m *NodeConnectionResponse) GetBeaconNodeEndpoint() string {
 	if m != nil {
-		return m.Logs
+		return m.BeaconNodeEndpoint
 	}
-	return nil
-}
-
-type BeaconStatusResponse struct {
-	BeaconNodeEndpoint     string              `protobuf:"bytes,1,opt,name=beacon_node_endpoint,json=beaconNodeEndpoint,proto3" json:"beacon_node_endpoint,omitempty"`
-	Connected              bool                `protobuf:"varint,2,opt,name=connected,proto3" json:"connected,omitempty"`
-	Syncing                bool                `protobuf:"varint,3,opt,name=syncing,proto3" json:"syncing,omitempty"`
-	GenesisTime            uint64              `protobuf:"varint,4,opt,name=genesis_time,json=genesisTime,proto3" json:"genesis_time,omitempty"`
-	DepositContractAddress []byte              `protobuf:"bytes,5,opt,name=deposit_contract_address,json=depositContractAddress,proto3" json:"deposit_contract_address,omitempty"`
-	ChainHead              *v1alpha1.ChainHead `protobuf:"bytes,6,opt,name=chain_head,json=chainHead,proto3" json:"chain_head,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}            `json:"-"`
-	XXX_unrecognized       []byte              `json:"-"`
-	XXX_sizecache          int32               `json:"-"`
+	return ""
 }
 
-func (m *BeaconStatusResponse) Reset()         { *m = BeaconStatusResponse{} }
-func (m *BeaconStatusResponse) String() string { return proto.CompactTextString(m) }
-func (*BeaconStatusResponse) ProtoMessage()    {}
-func (*BeaconStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a5153635bfe042e, []int{20}
+func (m *NodeConnectionResponse) GetConnected() bool {
+	if m != nil {
+		return m.Connected
+	}
+	return false
 }
-func (m *BeaconStatusResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *NodeConnectionResponse) GetSyncing() bool {
+	if m != nil {
+		return m.Syncing
+	}
+	return false
+}
+
+func (m *NodeConnectionResponse) GetGenesisTime() uint64 {
+	if m != nil {
+		return m.GenesisTime
+	}
+	return 0
+}
+
+func (m *NodeConnectionResponse) GetDepositContractAddress() []byte {
+	if m != nil {
+		return m.DepositContractAddress
+	}
+	return nil
+}
+
+type LogsEndpointResponse struct {
+	ValidatorLogsEndpoint string   `protobuf:"bytes,1,opt,name=validator_logs_endpoint,json=validatorLogsEndpoint,proto3" json:"validator_logs_endpoint,omitempty"`
+	BeaconLogsEndpoint    string   `protobuf:"bytes,2,opt,name=beacon_logs_endpoint,json=beaconLogsEndpoint,proto3" json:"beacon_logs_endpoint,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *LogsEndpointResponse) Reset()         { *m = LogsEndpointResponse{} }
+func (m *LogsEndpointResponse) String() string { return proto.CompactTextString(m) }
+func (*LogsEndpointResponse) ProtoMessage()    {}
+func (*LogsEndpointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{17}
+}
+func (m *LogsEndpointResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BeaconStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *LogsEndpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BeaconStatusResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_LogsEndpointResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1289,1805 +1297,1758 @@ func (m *BeaconStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *BeaconStatusResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BeaconStatusResponse.Merge(m, src)
+func (m *LogsEndpointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogsEndpointResponse.Merge(m, src)
 }
-func (m *BeaconStatusResponse) XXX_Size() int {
+func (m *LogsEndpointResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *BeaconStatusResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BeaconStatusResponse.DiscardUnknown(m)
+func (m *LogsEndpointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogsEndpointResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BeaconStatusResponse proto.InternalMessageInfo
+var xxx_messageInfo_LogsEndpointResponse proto.InternalMessageInfo
 
-func (m *BeaconStatusResponse) GetBeaconNodeEndpoint() string {
+func (m *LogsEndpointResponse) GetValidatorLogsEndpoint() string {
 	if m != nil {
-		return m.BeaconNodeEndpoint
+		return m.ValidatorLogsEndpoint
 	}
 	return ""
 }
 
-func (m *BeaconStatusResponse) GetConnected() bool {
+func (m *LogsEndpointResponse) GetBeaconLogsEndpoint() string {
 	if m != nil {
-		return m.Connected
+		return m.BeaconLogsEndpoint
 	}
-	return false
+	return ""
 }
 
-func (m *BeaconStatusResponse) GetSyncing() bool {
-	if m != nil {
-		return m.Syncing
-	}
-	return false
+type VersionResponse struct {
+	Beacon               string   `protobuf:"bytes,1,opt,name=beacon,proto3" json:"beacon,omitempty"`
+	Validator            string   `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BeaconStatusResponse) GetGenesisTime() uint64 {
-	if m != nil {
-		return m.GenesisTime
+func (m *VersionResponse) Reset()         { *m = VersionResponse{} }
+func (m *VersionResponse) String() string { return proto.CompactTextString(m) }
+func (*VersionResponse) ProtoMessage()    {}
+func (*VersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{18}
+}
+func (m *VersionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VersionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *VersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionResponse.Merge(m, src)
+}
+func (m *VersionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionResponse.DiscardUnknown(m)
 }
 
-func (m *BeaconStatusResponse) GetDepositContractAddress() []byte {
+var xxx_messageInfo_VersionResponse proto.InternalMessageInfo
+
+func (m *VersionResponse) GetBeacon() string {
 	if m != nil {
-		return m.DepositContractAddress
+		return m.Beacon
 	}
-	return nil
+	return ""
 }
 
-func (m *BeaconStatusResponse) GetChainHead() *v1alpha1.ChainHead {
+func (m *VersionResponse) GetValidator() string {
 	if m != nil {
-		return m.ChainHead
+		return m.Validator
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterEnum("ethereum.validator.accounts.v2.KeymanagerKind", KeymanagerKind_name, KeymanagerKind_value)
-	proto.RegisterType((*CreateWalletRequest)(nil), "ethereum.validator.accounts.v2.CreateWalletRequest")
-	proto.RegisterType((*CreateWalletResponse)(nil), "ethereum.validator.accounts.v2.CreateWalletResponse")
-	proto.RegisterType((*EditWalletConfigRequest)(nil), "ethereum.validator.accounts.v2.EditWalletConfigRequest")
-	proto.RegisterType((*GenerateMnemonicResponse)(nil), "ethereum.validator.accounts.v2.GenerateMnemonicResponse")
-	proto.RegisterType((*WalletResponse)(nil), "ethereum.validator.accounts.v2.WalletResponse")
-	proto.RegisterType((*ListAccountsRequest)(nil), "ethereum.validator.accounts.v2.ListAccountsRequest")
-	proto.RegisterType((*ListAccountsResponse)(nil), "ethereum.validator.accounts.v2.ListAccountsResponse")
-	proto.RegisterType((*Account)(nil), "ethereum.validator.accounts.v2.Account")
-	proto.RegisterType((*AccountRequest)(nil), "ethereum.validator.accounts.v2.AccountRequest")
-	proto.RegisterType((*AuthRequest)(nil), "ethereum.validator.accounts.v2.AuthRequest")
-	proto.RegisterType((*AuthResponse)(nil), "ethereum.validator.accounts.v2.AuthResponse")
-	proto.RegisterType((*NodeConnectionResponse)(nil), "ethereum.validator.accounts.v2.NodeConnectionResponse")
-	proto.RegisterType((*LogsEndpointResponse)(nil), "ethereum.validator.accounts.v2.LogsEndpointResponse")
-	proto.RegisterType((*VersionResponse)(nil), "ethereum.validator.accounts.v2.VersionResponse")
-	proto.RegisterType((*ChangePasswordRequest)(nil), "ethereum.validator.accounts.v2.ChangePasswordRequest")
-	proto.RegisterType((*HasWalletResponse)(nil), "ethereum.validator.accounts.v2.HasWalletResponse")
-	proto.RegisterType((*ImportKeystoresRequest)(nil), "ethereum.validator.accounts.v2.ImportKeystoresRequest")
-	proto.RegisterType((*ImportKeystoresResponse)(nil), "ethereum.validator.accounts.v2.ImportKeystoresResponse")
-	proto.RegisterType((*HasUsedWebResponse)(nil), "ethereum.validator.accounts.v2.HasUsedWebResponse")
-	proto.RegisterType((*LogsResponse)(nil), "ethereum.validator.accounts.v2.LogsResponse")
-	proto.RegisterType((*BeaconStatusResponse)(nil), "ethereum.validator.accounts.v2.BeaconStatusResponse")
+type ChangePasswordRequest struct {
+	CurrentPassword      string   `protobuf:"bytes,1,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	PasswordConfirmation string   `protobuf:"bytes,3,opt,name=password_confirmation,json=passwordConfirmation,proto3" json:"password_confirmation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() {
-	proto.RegisterFile("proto/validator/accounts/v2/web_api.proto", fileDescriptor_8a5153635bfe042e)
+func (m *ChangePasswordRequest) Reset()         { *m = ChangePasswordRequest{} }
+func (m *ChangePasswordRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangePasswordRequest) ProtoMessage()    {}
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{19}
 }
-
-var fileDescriptor_8a5153635bfe042e = []byte{
-	// 2058 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcd, 0x6f, 0x1c, 0x49,
-	0x15, 0xa7, 0x6d, 0x67, 0x32, 0x7e, 0x33, 0xfe, 0x48, 0xd9, 0xb1, 0x27, 0x8e, 0x63, 0x3b, 0x95,
-	0x0f, 0x3b, 0x4e, 0x76, 0x26, 0x99, 0xb0, 0x49, 0x94, 0x0b, 0x8a, 0xc7, 0x83, 0x13, 0x39, 0x1f,
-	0xa6, 0xe3, 0x4d, 0xc4, 0x65, 0x5b, 0xe5, 0xee, 0x4a, 0x4f, 0xcb, 0x33, 0xdd, 0xbd, 0xdd, 0x35,
-	0x13, 0x3b, 0x5c, 0x60, 0x59, 0x09, 0x81, 0xc4, 0x01, 0xf6, 0x80, 0x90, 0x10, 0x12, 0x9c, 0x39,
-	0x80, 0x84, 0xb4, 0xff, 0x02, 0xdc, 0x90, 0xf8, 0x03, 0x40, 0x11, 0x17, 0xe0, 0x9f, 0x40, 0x55,
-	0x5d, 0xd5, 0x1f, 0x93, 0xe9, 0x6d, 0x5b, 0x88, 0x0b, 0xb7, 0xae, 0xf7, 0xf9, 0xab, 0x57, 0xaf,
-	0x5e, 0xbd, 0xd7, 0x70, 0xc3, 0x0f, 0x3c, 0xe6, 0x35, 0x06, 0xa4, 0xeb, 0x58, 0x84, 0x79, 0x41,
-	0x83, 0x98, 0xa6, 0xd7, 0x77, 0x59, 0xd8, 0x18, 0x34, 0x1b, 0x6f, 0xe9, 0x81, 0x41, 0x7c, 0xa7,
-	0x2e, 0x64, 0xd0, 0x0a, 0x65, 0x1d, 0x1a, 0xd0, 0x7e, 0xaf, 0x1e, 0x4b, 0xd7, 0x95, 0x74, 0x7d,
-	0xd0, 0x5c, 0x5a, 0x8b, 0x4c, 0x1d, 0x50, 0x62, 0x7a, 0x6e, 0x23, 0xf0, 0xcd, 0xc6, 0xe0, 0x4e,
-	0xa3, 0x43, 0x49, 0x97, 0x75, 0x22, 0x0b, 0x4b, 0xab, 0x94, 0x75, 0x1a, 0x83, 0x3b, 0xa4, 0xeb,
-	0x77, 0xc8, 0x1d, 0x29, 0x68, 0x98, 0x1d, 0xe2, 0xb8, 0x52, 0x60, 0x31, 0x23, 0xe0, 0x7a, 0x16,
-	0x95, 0x8c, 0xe5, 0x0c, 0x23, 0xf1, 0x2f, 0xb9, 0xb6, 0xe7, 0xd9, 0x5d, 0xda, 0x20, 0xbe, 0xd3,
-	0x20, 0xae, 0xeb, 0x31, 0xc2, 0x1c, 0xcf, 0x0d, 0x25, 0xf7, 0xa2, 0xe4, 0x8a, 0xd5, 0x41, 0xff,
-	0x4d, 0x83, 0xf6, 0x7c, 0x76, 0x2c, 0x99, 0x1f, 0xd9, 0x0e, 0xeb, 0xf4, 0x0f, 0xea, 0xa6, 0xd7,
-	0x6b, 0xd8, 0x9e, 0xed, 0x25, 0x52, 0x7c, 0x15, 0xed, 0x88, 0x7f, 0x45, 0xe2, 0xf8, 0xdf, 0x63,
-	0x30, 0xd7, 0x0a, 0x28, 0x61, 0xf4, 0x35, 0xe9, 0x76, 0x29, 0xd3, 0xe9, 0x67, 0x7d, 0x1a, 0x32,
-	0xf4, 0x1c, 0xe0, 0x90, 0x1e, 0xf7, 0x88, 0x4b, 0x6c, 0x1a, 0xd4, 0xb4, 0x35, 0x6d, 0x63, 0xba,
-	0x59, 0xaf, 0x7f, 0x7d, 0xc0, 0xea, 0xbb, 0xb1, 0xc6, 0xae, 0xe3, 0x5a, 0x7a, 0xca, 0x02, 0x5a,
-	0x87, 0x99, 0xb7, 0xc2, 0x81, 0xe1, 0x93, 0x30, 0x7c, 0xeb, 0x05, 0x56, 0x6d, 0x6c, 0x4d, 0xdb,
-	0x98, 0xd4, 0xa7, 0x23, 0xf2, 0x9e, 0xa4, 0xa2, 0x25, 0x28, 0xf7, 0x5c, 0xda, 0xf3, 0x5c, 0xc7,
-	0xac, 0x8d, 0x0b, 0x89, 0x78, 0x8d, 0x2e, 0x43, 0xd5, 0xed, 0xf7, 0x0c, 0xe5, 0xb2, 0x36, 0xb1,
-	0xa6, 0x6d, 0x4c, 0xe8, 0x15, 0xb7, 0xdf, 0x7b, 0x24, 0x49, 0x68, 0x15, 0x2a, 0x01, 0xed, 0x79,
-	0x8c, 0x1a, 0xc4, 0xb2, 0x82, 0xda, 0x19, 0x61, 0x01, 0x22, 0xd2, 0x23, 0xcb, 0x0a, 0xd0, 0x75,
-	0x98, 0x91, 0x02, 0x66, 0xc0, 0xc1, 0xb0, 0x4e, 0xad, 0x24, 0x84, 0xa6, 0x22, 0x72, 0x2b, 0x60,
-	0x7b, 0x84, 0x75, 0x52, 0x72, 0x87, 0xf4, 0x38, 0x92, 0x3b, 0x9b, 0x96, 0xdb, 0xa5, 0xc7, 0x42,
-	0xee, 0x26, 0x20, 0x65, 0x8f, 0x24, 0x26, 0xcb, 0x42, 0x54, 0x5a, 0x68, 0x11, 0x69, 0x14, 0x7f,
-	0x0a, 0xf3, 0xd9, 0x60, 0x87, 0xbe, 0xe7, 0x86, 0x14, 0x7d, 0x1b, 0x4a, 0x51, 0x18, 0x44, 0xa4,
-	0x2b, 0xc5, 0x91, 0xce, 0xea, 0xeb, 0x52, 0x1b, 0x7f, 0xa5, 0xc1, 0x62, 0xdb, 0x72, 0x58, 0xc4,
-	0x6e, 0x79, 0xee, 0x1b, 0xc7, 0x56, 0x27, 0x3a, 0x14, 0x19, 0xed, 0x24, 0x91, 0x19, 0x3b, 0x61,
-	0x64, 0xc6, 0x4f, 0x1e, 0x99, 0x89, 0xd1, 0x91, 0xb9, 0x07, 0xb5, 0x1d, 0xea, 0xd2, 0x80, 0x30,
-	0xfa, 0x4c, 0x1e, 0x77, 0x1c, 0x9d, 0x74, 0x4a, 0x68, 0xd9, 0x94, 0xc0, 0x3f, 0xd1, 0x60, 0x7a,
-	0x28, 0x98, 0xab, 0x50, 0x89, 0x53, 0x8d, 0x75, 0xd4, 0x46, 0x55, 0x9a, 0xb1, 0x0e, 0x7a, 0x0d,
-	0x33, 0x49, 0x66, 0x1a, 0x87, 0x8e, 0x1b, 0xe5, 0xe2, 0xe9, 0x13, 0x7c, 0xfa, 0x30, 0xb3, 0xc6,
-	0x3f, 0xd7, 0x60, 0xee, 0xa9, 0x13, 0x32, 0x95, 0x8d, 0x2a, 0xf4, 0x1f, 0xc1, 0x9c, 0x4d, 0x99,
-	0x61, 0x51, 0xdf, 0x0b, 0x1d, 0x66, 0xb0, 0x23, 0xc3, 0x22, 0x8c, 0x08, 0x64, 0x65, 0x7d, 0xd6,
-	0xa6, 0x6c, 0x3b, 0xe2, 0xec, 0x1f, 0x6d, 0x13, 0x46, 0xd0, 0x45, 0x98, 0xf4, 0x89, 0x4d, 0x8d,
-	0xd0, 0x79, 0x47, 0x05, 0xb2, 0x33, 0x7a, 0x99, 0x13, 0x5e, 0x3a, 0xef, 0x28, 0xba, 0x04, 0x20,
-	0x98, 0xcc, 0x3b, 0xa4, 0xae, 0x0c, 0xbc, 0x10, 0xdf, 0xe7, 0x04, 0x34, 0x0b, 0xe3, 0xa4, 0xdb,
-	0x15, 0x51, 0x2e, 0xeb, 0xfc, 0x13, 0xff, 0x56, 0x83, 0xf9, 0x2c, 0x28, 0x19, 0xa7, 0x16, 0x94,
-	0xe3, 0x9b, 0xa4, 0xad, 0x8d, 0x6f, 0x54, 0x9a, 0xeb, 0x45, 0xfb, 0x97, 0x36, 0xf4, 0x58, 0x91,
-	0x27, 0x83, 0x4b, 0x8f, 0x78, 0xa8, 0x63, 0x4c, 0x32, 0x69, 0x38, 0x79, 0x2f, 0xc6, 0x75, 0x09,
-	0x80, 0x79, 0x8c, 0x74, 0xa3, 0x4d, 0x8d, 0x8b, 0x4d, 0x4d, 0x0a, 0x0a, 0xdf, 0x15, 0xfe, 0x83,
-	0x06, 0x67, 0xa5, 0x71, 0xd4, 0x84, 0xf3, 0xd2, 0xbb, 0xe3, 0xda, 0x86, 0xdf, 0x3f, 0xe8, 0x3a,
-	0x26, 0x4f, 0x35, 0x11, 0xaf, 0xaa, 0x3e, 0x97, 0x30, 0xf7, 0x04, 0x6f, 0x97, 0x1e, 0xf3, 0xca,
-	0x20, 0x21, 0x19, 0x2e, 0xe9, 0x51, 0x89, 0xa1, 0x22, 0x69, 0xcf, 0x49, 0x8f, 0x72, 0xa4, 0xc3,
-	0x07, 0x30, 0x2e, 0x0c, 0x4e, 0x59, 0x99, 0xe8, 0xaf, 0x73, 0xb9, 0xc0, 0x19, 0x88, 0x92, 0x9b,
-	0xce, 0xd9, 0xe9, 0x84, 0x2c, 0x52, 0x76, 0x17, 0xa6, 0x55, 0x3c, 0x92, 0x2b, 0x96, 0xc0, 0x8d,
-	0x82, 0x5a, 0xd5, 0xc1, 0x57, 0x28, 0x43, 0x54, 0x83, 0xb3, 0x8e, 0x6b, 0x39, 0x26, 0x0d, 0x6b,
-	0x63, 0x6b, 0xe3, 0x1b, 0x13, 0xba, 0x5a, 0xe2, 0x4f, 0xa1, 0xf2, 0xa8, 0xcf, 0x3a, 0xca, 0xd2,
-	0x12, 0x94, 0xe3, 0x3a, 0x29, 0x53, 0x5e, 0xad, 0xd1, 0x5d, 0x38, 0xaf, 0xbe, 0x0d, 0x93, 0x5f,
-	0xf1, 0xa0, 0x27, 0x40, 0xc9, 0x4d, 0xcf, 0x2b, 0x66, 0x2b, 0xc5, 0xc3, 0x2f, 0xa0, 0x1a, 0xd9,
-	0x97, 0x87, 0x3f, 0x0f, 0x67, 0xa2, 0xd3, 0x8a, 0xac, 0x47, 0x0b, 0x74, 0x03, 0x66, 0xc5, 0x87,
-	0x41, 0x8f, 0x7c, 0x27, 0x48, 0xac, 0x4e, 0xe8, 0x33, 0x82, 0xde, 0x8e, 0xc9, 0xf8, 0x6f, 0x1a,
-	0x2c, 0x3c, 0xf7, 0x2c, 0xda, 0xf2, 0x5c, 0x97, 0x9a, 0x9c, 0x14, 0xdb, 0xbe, 0x0d, 0xf3, 0xf2,
-	0x29, 0xe4, 0x0f, 0x9e, 0x41, 0x5d, 0xcb, 0xf7, 0x1c, 0x97, 0x49, 0x57, 0x28, 0xe2, 0x71, 0xdd,
-	0xb6, 0xe4, 0xa0, 0x65, 0x98, 0x34, 0x23, 0x3b, 0x34, 0xba, 0x8b, 0x65, 0x3d, 0x21, 0xf0, 0xa8,
-	0x85, 0xc7, 0xae, 0xe9, 0xb8, 0xb6, 0x38, 0xb1, 0xb2, 0xae, 0x96, 0xfc, 0xd8, 0x6d, 0xea, 0xd2,
-	0xd0, 0x09, 0x0d, 0xe6, 0xf4, 0xa8, 0x7a, 0x10, 0x24, 0x6d, 0xdf, 0xe9, 0x51, 0xf4, 0x00, 0x6a,
-	0xea, 0xd8, 0x4d, 0xcf, 0x65, 0x01, 0x31, 0x99, 0x28, 0x80, 0x34, 0x0c, 0xc5, 0xeb, 0x50, 0xd5,
-	0x17, 0x24, 0xbf, 0x25, 0xd9, 0x8f, 0x22, 0x2e, 0xfe, 0x3e, 0xbf, 0x38, 0x9e, 0x1d, 0x2a, 0x94,
-	0xf1, 0xfe, 0xee, 0xc1, 0x62, 0x7c, 0x3d, 0x8c, 0xae, 0x67, 0x87, 0xc3, 0x5b, 0x3c, 0x1f, 0xb3,
-	0xd3, 0xfa, 0xa9, 0xb8, 0x64, 0x95, 0xc6, 0xd2, 0x71, 0x49, 0x6b, 0xe0, 0x1d, 0x98, 0x79, 0x45,
-	0x83, 0x30, 0x1d, 0xdc, 0x05, 0x28, 0x45, 0x82, 0xd2, 0x97, 0x5c, 0xf1, 0x10, 0xc6, 0x5e, 0xa5,
-	0xc5, 0x84, 0x80, 0xbf, 0xd4, 0xe0, 0x7c, 0xab, 0x43, 0x5c, 0x9b, 0xaa, 0x87, 0x56, 0x65, 0xda,
-	0x0d, 0x98, 0x35, 0xfb, 0x41, 0x40, 0xdd, 0xd4, 0xcb, 0x1c, 0x59, 0x9e, 0x91, 0xf4, 0xf4, 0xd3,
-	0x3c, 0xf4, 0x78, 0x9f, 0x20, 0x29, 0xc7, 0xbf, 0x26, 0x29, 0x1f, 0xc0, 0xb9, 0xc7, 0x24, 0x1c,
-	0x2a, 0xdf, 0x57, 0x60, 0x4a, 0x96, 0x6f, 0x7a, 0xe4, 0x84, 0xa2, 0x36, 0xf1, 0x33, 0xaf, 0x46,
-	0xc4, 0xb6, 0xa0, 0xe1, 0x01, 0x2c, 0x3c, 0xe9, 0xf9, 0x5e, 0xc0, 0xf8, 0xb5, 0x62, 0x5e, 0x40,
-	0x53, 0xb5, 0x16, 0x1d, 0x2a, 0x9a, 0xe1, 0x08, 0x19, 0x6a, 0x89, 0xab, 0x38, 0xa9, 0x9f, 0x8b,
-	0x39, 0x4f, 0x24, 0x23, 0x2b, 0x3e, 0xb4, 0xbb, 0x44, 0x5c, 0x85, 0x00, 0xef, 0xc2, 0xe2, 0x07,
-	0x7e, 0x93, 0xac, 0x57, 0xee, 0x8c, 0x0f, 0xab, 0x00, 0x52, 0xbc, 0xb8, 0x66, 0x85, 0xf8, 0x35,
-	0xa0, 0xc7, 0x24, 0xfc, 0x24, 0xa4, 0xd6, 0x6b, 0x7a, 0x10, 0xdb, 0xc1, 0x30, 0xd5, 0x21, 0xa1,
-	0x11, 0x3a, 0xb6, 0x4b, 0x2d, 0xa3, 0xef, 0xcb, 0xfd, 0x57, 0x3a, 0x24, 0x7c, 0x29, 0x68, 0x9f,
-	0xf8, 0xbc, 0x9a, 0x72, 0x19, 0xd9, 0x33, 0xc8, 0x0b, 0xd3, 0x51, 0xa1, 0xc4, 0x18, 0xaa, 0x3c,
-	0x8d, 0x62, 0x93, 0x08, 0x26, 0x78, 0xc6, 0xc9, 0x28, 0x88, 0x6f, 0xfc, 0xeb, 0x31, 0x98, 0xdf,
-	0x12, 0xa9, 0xf3, 0x92, 0x11, 0xd6, 0x0f, 0xff, 0xcf, 0x6e, 0x2f, 0xfa, 0x16, 0x80, 0x68, 0xc4,
-	0x8d, 0x0e, 0x25, 0x96, 0x68, 0xf1, 0x2a, 0xcd, 0xb5, 0xe4, 0x7d, 0xa3, 0xac, 0x53, 0x57, 0xed,
-	0x77, 0xbd, 0xc5, 0x05, 0x1f, 0x53, 0x62, 0xe9, 0x93, 0xa6, 0xfa, 0xdc, 0xbc, 0x0f, 0xd3, 0xd9,
-	0xe7, 0x1e, 0x55, 0xe0, 0xec, 0x76, 0x5b, 0x7f, 0xf2, 0xaa, 0xbd, 0x3d, 0xfb, 0x0d, 0x54, 0x85,
-	0xf2, 0x93, 0x67, 0x7b, 0x2f, 0xf4, 0xfd, 0xf6, 0xf6, 0xac, 0x86, 0x00, 0x4a, 0x7a, 0xfb, 0xd9,
-	0x8b, 0xfd, 0xf6, 0xec, 0x58, 0xf3, 0x9f, 0x13, 0x50, 0x8a, 0x0e, 0x02, 0xfd, 0x46, 0x83, 0x6a,
-	0xba, 0xe1, 0x43, 0x77, 0x8b, 0x5e, 0xd8, 0x11, 0xbd, 0xf8, 0xd2, 0x37, 0x4f, 0xa7, 0x14, 0x9d,
-	0x23, 0xbe, 0xfe, 0xf9, 0x5f, 0xff, 0xf1, 0xe5, 0xd8, 0x1a, 0xbe, 0xc8, 0x07, 0x9f, 0x64, 0x1c,
-	0x8a, 0x72, 0xa6, 0x61, 0x0a, 0x95, 0x87, 0xda, 0x26, 0x62, 0x50, 0x4d, 0xb7, 0x8b, 0x68, 0xa1,
-	0x1e, 0x8d, 0x17, 0x75, 0x35, 0x38, 0xd4, 0xdb, 0x7c, 0xbc, 0x58, 0x3a, 0x65, 0x4f, 0x8a, 0x97,
-	0x85, 0xff, 0x05, 0x34, 0x3f, 0xca, 0x3f, 0xfa, 0xa9, 0x06, 0xb3, 0xc3, 0x0d, 0x5f, 0xae, 0xeb,
-	0x07, 0x45, 0xae, 0xf3, 0x5a, 0x47, 0xbc, 0x2e, 0x40, 0x5c, 0x46, 0xab, 0x59, 0x10, 0xaa, 0x7d,
-	0x6c, 0xd8, 0x52, 0x11, 0xfd, 0x51, 0x83, 0x99, 0xa1, 0x9b, 0x8d, 0xee, 0x15, 0xb9, 0x1d, 0x5d,
-	0x82, 0x96, 0xee, 0x9f, 0x5a, 0x4f, 0xa2, 0xbd, 0x2d, 0xd0, 0x6e, 0xe2, 0x6b, 0x23, 0x8f, 0x2c,
-	0xae, 0x46, 0x8d, 0xa8, 0x96, 0x3c, 0xd4, 0x36, 0x9b, 0xbf, 0x1f, 0x83, 0x72, 0x3c, 0xfb, 0xfc,
-	0x52, 0x83, 0x6a, 0xba, 0xd3, 0x2b, 0xce, 0xb6, 0x11, 0xcd, 0x6a, 0x71, 0xb6, 0x8d, 0x6a, 0x26,
-	0xf1, 0x8a, 0x80, 0x5e, 0x43, 0x0b, 0x59, 0xe8, 0x71, 0x9f, 0xf8, 0x23, 0x0d, 0xa6, 0xb3, 0x0f,
-	0x10, 0xfa, 0xb8, 0x30, 0xad, 0x47, 0x3d, 0x58, 0x4b, 0x39, 0x49, 0x92, 0x97, 0xef, 0xaa, 0xa6,
-	0x37, 0xa8, 0xe5, 0x88, 0x90, 0xfd, 0xa0, 0x0c, 0xa5, 0xa8, 0xf0, 0xa1, 0x2f, 0x34, 0x98, 0xd9,
-	0xa1, 0x2c, 0x5d, 0x06, 0x73, 0x73, 0xb0, 0x30, 0x2c, 0xa3, 0x8a, 0x29, 0xbe, 0x22, 0x40, 0x5d,
-	0x42, 0x43, 0xa0, 0xe4, 0x2f, 0x85, 0x30, 0x72, 0xf9, 0x95, 0x06, 0x17, 0x76, 0x28, 0x7b, 0xa5,
-	0xd8, 0x7b, 0x24, 0x60, 0x8e, 0xe9, 0xf8, 0xe2, 0x91, 0x44, 0xf7, 0x73, 0x8a, 0x56, 0xae, 0x86,
-	0x0a, 0xd4, 0xc7, 0x39, 0x8a, 0x79, 0x5a, 0x12, 0xf2, 0xa6, 0x80, 0x7c, 0x15, 0xe1, 0x91, 0x90,
-	0xfd, 0x0c, 0xb6, 0xdf, 0x69, 0xb0, 0x98, 0xc1, 0x41, 0x83, 0x37, 0x5e, 0xd0, 0x23, 0xae, 0x49,
-	0x51, 0xb3, 0xd0, 0x7d, 0x22, 0xac, 0x20, 0xdf, 0x3d, 0x95, 0x8e, 0x04, 0xbc, 0x21, 0x00, 0x63,
-	0xb4, 0x36, 0x1a, 0x70, 0x0a, 0xd2, 0x8f, 0x35, 0x98, 0x4a, 0xc3, 0x0d, 0xd1, 0xad, 0x1c, 0x87,
-	0x3c, 0xc7, 0x13, 0x31, 0x05, 0xef, 0x72, 0x11, 0xbc, 0x30, 0xaf, 0xe0, 0x48, 0x30, 0x83, 0xc4,
-	0xf3, 0xaf, 0x34, 0x98, 0x4f, 0x63, 0xd9, 0x22, 0x5d, 0x8e, 0x31, 0x73, 0x69, 0xf3, 0x21, 0x29,
-	0x69, 0x85, 0x6c, 0xa3, 0x08, 0x99, 0x52, 0xc0, 0xd7, 0x04, 0xc0, 0x55, 0x74, 0x69, 0x24, 0xc0,
-	0x03, 0x85, 0x62, 0x00, 0xe7, 0xd2, 0xe8, 0xbe, 0xd3, 0xa7, 0x7d, 0x9a, 0x7b, 0x37, 0xae, 0x15,
-	0x79, 0x17, 0xea, 0x18, 0x0b, 0xd7, 0xcb, 0x68, 0x69, 0xa4, 0xeb, 0xcf, 0x84, 0x0b, 0x0b, 0xca,
-	0x3b, 0x94, 0xed, 0x51, 0x1a, 0xe4, 0x5f, 0xc5, 0xe5, 0x1c, 0x77, 0x42, 0xab, 0xc0, 0x8b, 0xcf,
-	0x65, 0x9a, 0x7f, 0x3e, 0x03, 0xa5, 0xc7, 0xe2, 0x47, 0x1e, 0xfa, 0x45, 0x94, 0xc2, 0x5b, 0x71,
-	0x5b, 0x93, 0x0c, 0x34, 0xb9, 0x00, 0x0a, 0x1f, 0x86, 0xd1, 0x83, 0x11, 0xbe, 0x25, 0xa0, 0x5d,
-	0x47, 0x57, 0xb3, 0xd0, 0xa2, 0x5f, 0x8a, 0xe2, 0xef, 0xa0, 0x61, 0x26, 0xde, 0xa3, 0x27, 0x92,
-	0xa5, 0x07, 0x82, 0xff, 0xa2, 0x3c, 0x8d, 0x9a, 0x64, 0xf0, 0x4d, 0x01, 0xe8, 0x1a, 0xba, 0x32,
-	0x12, 0x10, 0xef, 0x13, 0x1b, 0x34, 0x76, 0xfd, 0x3d, 0x00, 0x9e, 0x12, 0xd1, 0x3c, 0x92, 0x0b,
-	0xa4, 0x51, 0x04, 0x64, 0x68, 0xa0, 0xc1, 0x57, 0x05, 0x86, 0x15, 0xb4, 0x3c, 0x12, 0xc3, 0x40,
-	0xba, 0xfb, 0xa1, 0x06, 0xb3, 0x2f, 0x59, 0x40, 0x49, 0x6f, 0x2b, 0x1e, 0x93, 0x72, 0x31, 0x5c,
-	0x4d, 0x30, 0x44, 0xc7, 0x5e, 0x0f, 0x7c, 0xb3, 0x3e, 0xb8, 0x53, 0x4f, 0x77, 0xc5, 0xb8, 0x21,
-	0x1c, 0xdf, 0x40, 0xeb, 0xf9, 0x9b, 0x8f, 0xeb, 0x34, 0x77, 0x7c, 0x5b, 0x43, 0x3f, 0xd3, 0x60,
-	0x2e, 0x42, 0xf1, 0x2a, 0x3d, 0xe1, 0xe5, 0x02, 0xb9, 0x75, 0x92, 0x53, 0x89, 0x01, 0x35, 0x05,
-	0xa0, 0x5b, 0x68, 0x33, 0x1f, 0x50, 0x42, 0x55, 0x98, 0x9a, 0xff, 0x1a, 0x87, 0x09, 0x3e, 0xda,
-	0xf3, 0xf3, 0x49, 0xc6, 0x89, 0x5c, 0x48, 0xcd, 0x22, 0x48, 0x1f, 0x8e, 0x24, 0xf8, 0xb2, 0x00,
-	0x76, 0x11, 0x5d, 0xc8, 0x02, 0x73, 0x5c, 0x87, 0x39, 0xa4, 0xeb, 0xbc, 0xa3, 0x16, 0xfa, 0x5c,
-	0x83, 0x33, 0x4f, 0x3d, 0xdb, 0x71, 0xd1, 0xcd, 0xc2, 0x9f, 0x48, 0xc9, 0x7f, 0x8e, 0xe2, 0x00,
-	0xa5, 0x7f, 0x5a, 0xa8, 0x26, 0x03, 0xcf, 0x65, 0x71, 0x74, 0xb9, 0x5f, 0xde, 0xca, 0x7e, 0xa1,
-	0x41, 0x89, 0xcf, 0x48, 0x7d, 0xff, 0x7f, 0x89, 0x62, 0x55, 0xa0, 0xb8, 0x80, 0x87, 0x1a, 0xdb,
-	0x50, 0x38, 0xe6, 0x30, 0xbe, 0x0b, 0xa5, 0xa7, 0x9e, 0xed, 0xf5, 0x59, 0xee, 0x21, 0xe4, 0xf5,
-	0x30, 0x39, 0xa6, 0xbb, 0xc2, 0xda, 0x43, 0x6d, 0x73, 0xab, 0xfa, 0xa7, 0xf7, 0x2b, 0xda, 0x5f,
-	0xde, 0xaf, 0x68, 0x7f, 0x7f, 0xbf, 0xa2, 0x1d, 0x94, 0x84, 0xfa, 0xdd, 0xff, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0x16, 0x34, 0x84, 0x21, 0xf4, 0x18, 0x00, 0x00,
+func (m *ChangePasswordRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// WalletClient is the client API for Wallet service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type WalletClient interface {
-	CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*CreateWalletResponse, error)
-	WalletConfig(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*WalletResponse, error)
-	GenerateMnemonic(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*GenerateMnemonicResponse, error)
-	ImportKeystores(ctx context.Context, in *ImportKeystoresRequest, opts ...grpc.CallOption) (*ImportKeystoresResponse, error)
+func (m *ChangePasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChangePasswordRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type walletClient struct {
-	cc *grpc.ClientConn
+func (m *ChangePasswordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePasswordRequest.Merge(m, src)
 }
-
-func NewWalletClient(cc *grpc.ClientConn) WalletClient {
-	return &walletClient{cc}
+func (m *ChangePasswordRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *walletClient) CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*CreateWalletResponse, error) {
-	out := new(CreateWalletResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Wallet/CreateWallet", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ChangePasswordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePasswordRequest.DiscardUnknown(m)
 }
 
-func (c *walletClient) WalletConfig(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*WalletResponse, error) {
-	out := new(WalletResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Wallet/WalletConfig", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ChangePasswordRequest proto.InternalMessageInfo
+
+func (m *ChangePasswordRequest) GetCurrentPassword() string {
+	if m != nil {
+		return m.CurrentPassword
 	}
-	return out, nil
+	return ""
 }
 
-func (c *walletClient) GenerateMnemonic(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*GenerateMnemonicResponse, error) {
-	out := new(GenerateMnemonicResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Wallet/GenerateMnemonic", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ChangePasswordRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
 	}
-	return out, nil
+	return ""
 }
 
-func (c *walletClient) ImportKeystores(ctx context.Context, in *ImportKeystoresRequest, opts ...grpc.CallOption) (*ImportKeystoresResponse, error) {
-	out := new(ImportKeystoresResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Wallet/ImportKeystores", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ChangePasswordRequest) GetPasswordConfirmation() string {
+	if m != nil {
+		return m.PasswordConfirmation
 	}
-	return out, nil
+	return ""
 }
 
-// WalletServer is the server API for Wallet service.
-type WalletServer interface {
-	CreateWallet(context.Context, *CreateWalletRequest) (*CreateWalletResponse, error)
-	WalletConfig(context.Context, *types.Empty) (*WalletResponse, error)
-	GenerateMnemonic(context.Context, *types.Empty) (*GenerateMnemonicResponse, error)
-	ImportKeystores(context.Context, *ImportKeystoresRequest) (*ImportKeystoresResponse, error)
+type HasWalletResponse struct {
+	WalletExists         bool     `protobuf:"varint,1,opt,name=wallet_exists,json=walletExists,proto3" json:"wallet_exists,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// UnimplementedWalletServer can be embedded to have forward compatible implementations.
-type UnimplementedWalletServer struct {
+func (m *HasWalletResponse) Reset()         { *m = HasWalletResponse{} }
+func (m *HasWalletResponse) String() string { return proto.CompactTextString(m) }
+func (*HasWalletResponse) ProtoMessage()    {}
+func (*HasWalletResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{20}
 }
-
-func (*UnimplementedWalletServer) CreateWallet(ctx context.Context, req *CreateWalletRequest) (*CreateWalletResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateWallet not implemented")
+func (m *HasWalletResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedWalletServer) WalletConfig(ctx context.Context, req *types.Empty) (*WalletResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WalletConfig not implemented")
+func (m *HasWalletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HasWalletResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedWalletServer) GenerateMnemonic(ctx context.Context, req *types.Empty) (*GenerateMnemonicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GenerateMnemonic not implemented")
+func (m *HasWalletResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HasWalletResponse.Merge(m, src)
 }
-func (*UnimplementedWalletServer) ImportKeystores(ctx context.Context, req *ImportKeystoresRequest) (*ImportKeystoresResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ImportKeystores not implemented")
+func (m *HasWalletResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterWalletServer(s *grpc.Server, srv WalletServer) {
-	s.RegisterService(&_Wallet_serviceDesc, srv)
+func (m *HasWalletResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HasWalletResponse.DiscardUnknown(m)
 }
 
-func _Wallet_CreateWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateWalletRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WalletServer).CreateWallet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Wallet/CreateWallet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServer).CreateWallet(ctx, req.(*CreateWalletRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_HasWalletResponse proto.InternalMessageInfo
 
-func _Wallet_WalletConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WalletServer).WalletConfig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Wallet/WalletConfig",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServer).WalletConfig(ctx, req.(*types.Empty))
+func (m *HasWalletResponse) GetWalletExists() bool {
+	if m != nil {
+		return m.WalletExists
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Wallet_GenerateMnemonic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WalletServer).GenerateMnemonic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Wallet/GenerateMnemonic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServer).GenerateMnemonic(ctx, req.(*types.Empty))
-	}
-	return interceptor(ctx, in, info, handler)
+type ImportKeystoresRequest struct {
+	KeystoresImported    []string `protobuf:"bytes,1,rep,name=keystores_imported,json=keystoresImported,proto3" json:"keystores_imported,omitempty"`
+	KeystoresPassword    string   `protobuf:"bytes,2,opt,name=keystores_password,json=keystoresPassword,proto3" json:"keystores_password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _Wallet_ImportKeystores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImportKeystoresRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WalletServer).ImportKeystores(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Wallet/ImportKeystores",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServer).ImportKeystores(ctx, req.(*ImportKeystoresRequest))
+func (m *ImportKeystoresRequest) Reset()         { *m = ImportKeystoresRequest{} }
+func (m *ImportKeystoresRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportKeystoresRequest) ProtoMessage()    {}
+func (*ImportKeystoresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{21}
+}
+func (m *ImportKeystoresRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportKeystoresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportKeystoresRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Wallet_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.validator.accounts.v2.Wallet",
-	HandlerType: (*WalletServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateWallet",
-			Handler:    _Wallet_CreateWallet_Handler,
-		},
-		{
-			MethodName: "WalletConfig",
-			Handler:    _Wallet_WalletConfig_Handler,
-		},
-		{
-			MethodName: "GenerateMnemonic",
-			Handler:    _Wallet_GenerateMnemonic_Handler,
-		},
-		{
-			MethodName: "ImportKeystores",
-			Handler:    _Wallet_ImportKeystores_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/validator/accounts/v2/web_api.proto",
+func (m *ImportKeystoresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportKeystoresRequest.Merge(m, src)
 }
-
-// AccountsClient is the client API for Accounts service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AccountsClient interface {
-	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
-	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*types.Empty, error)
+func (m *ImportKeystoresRequest) XXX_Size() int {
+	return m.Size()
 }
-
-type accountsClient struct {
-	cc *grpc.ClientConn
+func (m *ImportKeystoresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportKeystoresRequest.DiscardUnknown(m)
 }
 
-func NewAccountsClient(cc *grpc.ClientConn) AccountsClient {
-	return &accountsClient{cc}
-}
+var xxx_messageInfo_ImportKeystoresRequest proto.InternalMessageInfo
 
-func (c *accountsClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
-	out := new(ListAccountsResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Accounts/ListAccounts", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ImportKeystoresRequest) GetKeystoresImported() []string {
+	if m != nil {
+		return m.KeystoresImported
 	}
-	return out, nil
+	return nil
 }
 
-func (c *accountsClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Accounts/ChangePassword", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ImportKeystoresRequest) GetKeystoresPassword() string {
+	if m != nil {
+		return m.KeystoresPassword
 	}
-	return out, nil
+	return ""
 }
 
-// AccountsServer is the server API for Accounts service.
-type AccountsServer interface {
-	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
-	ChangePassword(context.Context, *ChangePasswordRequest) (*types.Empty, error)
+type ImportKeystoresResponse struct {
+	ImportedPublicKeys   [][]byte `protobuf:"bytes,1,rep,name=imported_public_keys,json=importedPublicKeys,proto3" json:"imported_public_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// UnimplementedAccountsServer can be embedded to have forward compatible implementations.
-type UnimplementedAccountsServer struct {
+func (m *ImportKeystoresResponse) Reset()         { *m = ImportKeystoresResponse{} }
+func (m *ImportKeystoresResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportKeystoresResponse) ProtoMessage()    {}
+func (*ImportKeystoresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{22}
 }
-
-func (*UnimplementedAccountsServer) ListAccounts(ctx context.Context, req *ListAccountsRequest) (*ListAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
+func (m *ImportKeystoresResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedAccountsServer) ChangePassword(ctx context.Context, req *ChangePasswordRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+func (m *ImportKeystoresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportKeystoresResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterAccountsServer(s *grpc.Server, srv AccountsServer) {
-	s.RegisterService(&_Accounts_serviceDesc, srv)
+func (m *ImportKeystoresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportKeystoresResponse.Merge(m, src)
+}
+func (m *ImportKeystoresResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportKeystoresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportKeystoresResponse.DiscardUnknown(m)
 }
 
-func _Accounts_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAccountsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_ImportKeystoresResponse proto.InternalMessageInfo
+
+func (m *ImportKeystoresResponse) GetImportedPublicKeys() [][]byte {
+	if m != nil {
+		return m.ImportedPublicKeys
 	}
-	if interceptor == nil {
-		return srv.(AccountsServer).ListAccounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Accounts/ListAccounts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountsServer).ListAccounts(ctx, req.(*ListAccountsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Accounts_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangePasswordRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AccountsServer).ChangePassword(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Accounts/ChangePassword",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountsServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type HasUsedWebResponse struct {
+	HasSignedUp          bool     `protobuf:"varint,1,opt,name=has_signed_up,json=hasSignedUp,proto3" json:"has_signed_up,omitempty"`
+	HasWallet            bool     `protobuf:"varint,2,opt,name=has_wallet,json=hasWallet,proto3" json:"has_wallet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-var _Accounts_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.validator.accounts.v2.Accounts",
-	HandlerType: (*AccountsServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ListAccounts",
-			Handler:    _Accounts_ListAccounts_Handler,
-		},
-		{
-			MethodName: "ChangePassword",
-			Handler:    _Accounts_ChangePassword_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/validator/accounts/v2/web_api.proto",
+func (m *HasUsedWebResponse) Reset()         { *m = HasUsedWebResponse{} }
+func (m *HasUsedWebResponse) String() string { return proto.CompactTextString(m) }
+func (*HasUsedWebResponse) ProtoMessage()    {}
+func (*HasUsedWebResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{23}
 }
-
-// BeaconClient is the client API for Beacon service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type BeaconClient interface {
-	GetBeaconStatus(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*BeaconStatusResponse, error)
-	GetValidatorParticipation(ctx context.Context, in *v1alpha1.GetValidatorParticipationRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorParticipationResponse, error)
-	GetValidatorPerformance(ctx context.Context, in *v1alpha1.ValidatorPerformanceRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorPerformanceResponse, error)
-	GetValidators(ctx context.Context, in *v1alpha1.ListValidatorsRequest, opts ...grpc.CallOption) (*v1alpha1.Validators, error)
-	GetValidatorBalances(ctx context.Context, in *v1alpha1.ListValidatorBalancesRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorBalances, error)
-	GetValidatorQueue(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1alpha1.ValidatorQueue, error)
-	GetPeers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1alpha1.Peers, error)
+func (m *HasUsedWebResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type beaconClient struct {
-	cc *grpc.ClientConn
+func (m *HasUsedWebResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HasUsedWebResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewBeaconClient(cc *grpc.ClientConn) BeaconClient {
-	return &beaconClient{cc}
+func (m *HasUsedWebResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HasUsedWebResponse.Merge(m, src)
 }
-
-func (c *beaconClient) GetBeaconStatus(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*BeaconStatusResponse, error) {
-	out := new(BeaconStatusResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Beacon/GetBeaconStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *HasUsedWebResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *beaconClient) GetValidatorParticipation(ctx context.Context, in *v1alpha1.GetValidatorParticipationRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorParticipationResponse, error) {
-	out := new(v1alpha1.ValidatorParticipationResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Beacon/GetValidatorParticipation", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *HasUsedWebResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HasUsedWebResponse.DiscardUnknown(m)
 }
 
-func (c *beaconClient) GetValidatorPerformance(ctx context.Context, in *v1alpha1.ValidatorPerformanceRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorPerformanceResponse, error) {
-	out := new(v1alpha1.ValidatorPerformanceResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Beacon/GetValidatorPerformance", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_HasUsedWebResponse proto.InternalMessageInfo
 
-func (c *beaconClient) GetValidators(ctx context.Context, in *v1alpha1.ListValidatorsRequest, opts ...grpc.CallOption) (*v1alpha1.Validators, error) {
-	out := new(v1alpha1.Validators)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Beacon/GetValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *HasUsedWebResponse) GetHasSignedUp() bool {
+	if m != nil {
+		return m.HasSignedUp
 	}
-	return out, nil
+	return false
 }
 
-func (c *beaconClient) GetValidatorBalances(ctx context.Context, in *v1alpha1.ListValidatorBalancesRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorBalances, error) {
-	out := new(v1alpha1.ValidatorBalances)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Beacon/GetValidatorBalances", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *HasUsedWebResponse) GetHasWallet() bool {
+	if m != nil {
+		return m.HasWallet
 	}
-	return out, nil
+	return false
 }
 
-func (c *beaconClient) GetValidatorQueue(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1alpha1.ValidatorQueue, error) {
-	out := new(v1alpha1.ValidatorQueue)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Beacon/GetValidatorQueue", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type LogsResponse struct {
+	Logs                 []string `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *beaconClient) GetPeers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1alpha1.Peers, error) {
-	out := new(v1alpha1.Peers)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Beacon/GetPeers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LogsResponse) Reset()         { *m = LogsResponse{} }
+func (m *LogsResponse) String() string { return proto.CompactTextString(m) }
+func (*LogsResponse) ProtoMessage()    {}
+func (*LogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{24}
+}
+func (m *LogsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// BeaconServer is the server API for Beacon service.
-type BeaconServer interface {
-	GetBeaconStatus(context.Context, *types.Empty) (*BeaconStatusResponse, error)
-	GetValidatorParticipation(context.Context, *v1alpha1.GetValidatorParticipationRequest) (*v1alpha1.ValidatorParticipationResponse, error)
-	GetValidatorPerformance(context.Context, *v1alpha1.ValidatorPerformanceRequest) (*v1alpha1.ValidatorPerformanceResponse, error)
-	GetValidators(context.Context, *v1alpha1.ListValidatorsRequest) (*v1alpha1.Validators, error)
-	GetValidatorBalances(context.Context, *v1alpha1.ListValidatorBalancesRequest) (*v1alpha1.ValidatorBalances, error)
-	GetValidatorQueue(context.Context, *types.Empty) (*v1alpha1.ValidatorQueue, error)
-	GetPeers(context.Context, *types.Empty) (*v1alpha1.Peers, error)
+func (m *LogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogsResponse.Merge(m, src)
+}
+func (m *LogsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogsResponse.DiscardUnknown(m)
 }
 
-// UnimplementedBeaconServer can be embedded to have forward compatible implementations.
-type UnimplementedBeaconServer struct {
+var xxx_messageInfo_LogsResponse proto.InternalMessageInfo
+
+func (m *LogsResponse) GetLogs() []string {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
 }
 
-func (*UnimplementedBeaconServer) GetBeaconStatus(ctx context.Context, req *types.Empty) (*BeaconStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBeaconStatus not implemented")
+type BeaconStatusResponse struct {
+	BeaconNodeEndpoint     string              `protobuf:"bytes,1,opt,name=beacon_node_endpoint,json=beaconNodeEndpoint,proto3" json:"beacon_node_endpoint,omitempty"`
+	Connected              bool                `protobuf:"varint,2,opt,name=connected,proto3" json:"connected,omitempty"`
+	Syncing                bool                `protobuf:"varint,3,opt,name=syncing,proto3" json:"syncing,omitempty"`
+	GenesisTime            uint64              `protobuf:"varint,4,opt,name=genesis_time,json=genesisTime,proto3" json:"genesis_time,omitempty"`
+	DepositContractAddress []byte              `protobuf:"bytes,5,opt,name=deposit_contract_address,json=depositContractAddress,proto3" json:"deposit_contract_address,omitempty"`
+	ChainHead              *v1alpha1.ChainHead `protobuf:"bytes,6,opt,name=chain_head,json=chainHead,proto3" json:"chain_head,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}            `json:"-"`
+	XXX_unrecognized       []byte              `json:"-"`
+	XXX_sizecache          int32               `json:"-"`
 }
-func (*UnimplementedBeaconServer) GetValidatorParticipation(ctx context.Context, req *v1alpha1.GetValidatorParticipationRequest) (*v1alpha1.ValidatorParticipationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorParticipation not implemented")
+
+func (m *BeaconStatusResponse) Reset()         { *m = BeaconStatusResponse{} }
+func (m *BeaconStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*BeaconStatusResponse) ProtoMessage()    {}
+func (*BeaconStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{25}
 }
-func (*UnimplementedBeaconServer) GetValidatorPerformance(ctx context.Context, req *v1alpha1.ValidatorPerformanceRequest) (*v1alpha1.ValidatorPerformanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorPerformance not implemented")
+func (m *BeaconStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedBeaconServer) GetValidators(ctx context.Context, req *v1alpha1.ListValidatorsRequest) (*v1alpha1.Validators, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetValidators not implemented")
+func (m *BeaconStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeaconStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedBeaconServer) GetValidatorBalances(ctx context.Context, req *v1alpha1.ListValidatorBalancesRequest) (*v1alpha1.ValidatorBalances, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorBalances not implemented")
+func (m *BeaconStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconStatusResponse.Merge(m, src)
 }
-func (*UnimplementedBeaconServer) GetValidatorQueue(ctx context.Context, req *types.Empty) (*v1alpha1.ValidatorQueue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorQueue not implemented")
+func (m *BeaconStatusResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedBeaconServer) GetPeers(ctx context.Context, req *types.Empty) (*v1alpha1.Peers, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPeers not implemented")
+func (m *BeaconStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconStatusResponse.DiscardUnknown(m)
 }
 
-func RegisterBeaconServer(s *grpc.Server, srv BeaconServer) {
-	s.RegisterService(&_Beacon_serviceDesc, srv)
-}
+var xxx_messageInfo_BeaconStatusResponse proto.InternalMessageInfo
 
-func _Beacon_GetBeaconStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *BeaconStatusResponse) GetBeaconNodeEndpoint() string {
+	if m != nil {
+		return m.BeaconNodeEndpoint
 	}
-	if interceptor == nil {
-		return srv.(BeaconServer).GetBeaconStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Beacon/GetBeaconStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconServer).GetBeaconStatus(ctx, req.(*types.Empty))
+	return ""
+}
+
+func (m *BeaconStatusResponse) GetConnected() bool {
+	if m != nil {
+		return m.Connected
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Beacon_GetValidatorParticipation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha1.GetValidatorParticipationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *BeaconStatusResponse) GetSyncing() bool {
+	if m != nil {
+		return m.Syncing
 	}
-	if interceptor == nil {
-		return srv.(BeaconServer).GetValidatorParticipation(ctx, in)
+	return false
+}
+
+func (m *BeaconStatusResponse) GetGenesisTime() uint64 {
+	if m != nil {
+		return m.GenesisTime
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Beacon/GetValidatorParticipation",
+	return 0
+}
+
+func (m *BeaconStatusResponse) GetDepositContractAddress() []byte {
+	if m != nil {
+		return m.DepositContractAddress
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconServer).GetValidatorParticipation(ctx, req.(*v1alpha1.GetValidatorParticipationRequest))
+	return nil
+}
+
+func (m *BeaconStatusResponse) GetChainHead() *v1alpha1.ChainHead {
+	if m != nil {
+		return m.ChainHead
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Beacon_GetValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha1.ValidatorPerformanceRequest)
-	if err := dec(in); err != nil {
+func init() {
+	proto.RegisterEnum("ethereum.validator.accounts.v2.KeymanagerKind", KeymanagerKind_name, KeymanagerKind_value)
+	proto.RegisterType((*CreateWalletRequest)(nil), "ethereum.validator.accounts.v2.CreateWalletRequest")
+	proto.RegisterType((*CreateWalletResponse)(nil), "ethereum.validator.accounts.v2.CreateWalletResponse")
+	proto.RegisterType((*EditWalletConfigRequest)(nil), "ethereum.validator.accounts.v2.EditWalletConfigRequest")
+	proto.RegisterType((*GenerateMnemonicResponse)(nil), "ethereum.validator.accounts.v2.GenerateMnemonicResponse")
+	proto.RegisterType((*WalletResponse)(nil), "ethereum.validator.accounts.v2.WalletResponse")
+	proto.RegisterType((*ListAccountsRequest)(nil), "ethereum.validator.accounts.v2.ListAccountsRequest")
+	proto.RegisterType((*ListAccountsResponse)(nil), "ethereum.validator.accounts.v2.ListAccountsResponse")
+	proto.RegisterType((*Account)(nil), "ethereum.validator.accounts.v2.Account")
+	proto.RegisterType((*PerformanceHistoryRequest)(nil), "ethereum.validator.accounts.v2.PerformanceHistoryRequest")
+	proto.RegisterType((*PerformanceHistoryResponse)(nil), "ethereum.validator.accounts.v2.PerformanceHistoryResponse")
+	proto.RegisterType((*ValidatorPerformanceHistory)(nil), "ethereum.validator.accounts.v2.ValidatorPerformanceHistory")
+	proto.RegisterType((*EpochPerformance)(nil), "ethereum.validator.accounts.v2.EpochPerformance")
+	proto.RegisterType((*PerformanceSummary)(nil), "ethereum.validator.accounts.v2.PerformanceSummary")
+	proto.RegisterType((*AccountRequest)(nil), "ethereum.validator.accounts.v2.AccountRequest")
+	proto.RegisterType((*AuthRequest)(nil), "ethereum.validator.accounts.v2.AuthRequest")
+	proto.RegisterType((*AuthResponse)(nil), "ethereum.validator.accounts.v2.AuthResponse")
+	proto.RegisterType((*NodeConnectionResponse)(nil), "ethereum.validator.accounts.v2.NodeConnectionResponse")
+	proto.RegisterType((*LogsEndpointResponse)(nil), "ethereum.validator.accounts.v2.LogsEndpointResponse")
+	proto.RegisterType((*VersionResponse)(nil), "ethereum.validator.accounts.v2.VersionResponse")
+	proto.RegisterType((*ChangePasswordRequest)(nil), "ethereum.validator.accounts.v2.ChangePasswordRequest")
+	proto.RegisterType((*HasWalletResponse)(nil), "ethereum.validator.accounts.v2.HasWalletResponse")
+	proto.RegisterType((*ImportKeystoresRequest)(nil), "ethereum.validator.accounts.v2.ImportKeystoresRequest")
+	proto.RegisterType((*ImportKeystoresResponse)(nil), "ethereum.validator.accounts.v2.ImportKeystoresResponse")
+	proto.RegisterType((*HasUsedWebResponse)(nil), "ethereum.validator.accounts.v2.HasUsedWebResponse")
+	proto.RegisterType((*LogsResponse)(nil), "ethereum.validator.accounts.v2.LogsResponse")
+	proto.RegisterType((*BeaconStatusResponse)(nil), "ethereum.validator.accounts.v2.BeaconStatusResponse")
+}
+
+func init() {
+	proto.RegisterFile("proto/validator/accounts/v2/web_api.proto", fileDescriptor_8a5153635bfe042e)
+}
+
+var fileDescriptor_8a5153635bfe042e = []byte{
+	// 2550 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0xa7, 0x3d, 0xf6, 0x78, 0xfc, 0x3c, 0xfe, 0x48, 0x79, 0xe2, 0x4c, 0x26, 0x8e, 0xed, 0x74,
+	0x92, 0x8d, 0xe3, 0x64, 0x67, 0x92, 0xc9, 0xee, 0x26, 0x04, 0x24, 0xe4, 0x2f, 0x62, 0xcb, 0xf9,
+	0x30, 0x6d, 0x6f, 0xa2, 0xbd, 0x6c, 0xab, 0xdc, 0x5d, 0x99, 0x69, 0x79, 0xa6, 0xbb, 0xb7, 0xbb,
+	0x66, 0x12, 0x87, 0x0b, 0x2c, 0x2b, 0x21, 0x90, 0x38, 0xc0, 0x1e, 0x10, 0x12, 0x42, 0x82, 0x33,
+	0x17, 0x24, 0xa4, 0x95, 0xb8, 0x70, 0x85, 0x1b, 0x12, 0xdc, 0x41, 0x11, 0x17, 0x40, 0xfc, 0x07,
+	0x1c, 0x50, 0x7d, 0xf5, 0xc7, 0xa4, 0x3b, 0x63, 0x83, 0xb8, 0x70, 0x9b, 0x7e, 0x9f, 0xbf, 0x7a,
+	0xf5, 0xea, 0xd5, 0x7b, 0x35, 0x70, 0xdd, 0x0f, 0x3c, 0xea, 0x35, 0xfa, 0xb8, 0xe3, 0xd8, 0x98,
+	0x7a, 0x41, 0x03, 0x5b, 0x96, 0xd7, 0x73, 0x69, 0xd8, 0xe8, 0x37, 0x1b, 0x2f, 0xc8, 0xa1, 0x89,
+	0x7d, 0xa7, 0xce, 0x65, 0xd0, 0x22, 0xa1, 0x6d, 0x12, 0x90, 0x5e, 0xb7, 0x1e, 0x49, 0xd7, 0x95,
+	0x74, 0xbd, 0xdf, 0xac, 0x2d, 0x0b, 0x53, 0x87, 0x04, 0x5b, 0x9e, 0xdb, 0x08, 0x7c, 0xab, 0xd1,
+	0xbf, 0xdd, 0x68, 0x13, 0xdc, 0xa1, 0x6d, 0x61, 0xa1, 0xb6, 0x44, 0x68, 0xbb, 0xd1, 0xbf, 0x8d,
+	0x3b, 0x7e, 0x1b, 0xdf, 0x96, 0x82, 0xa6, 0xd5, 0xc6, 0x8e, 0x2b, 0x05, 0xce, 0xa5, 0x04, 0x5c,
+	0xcf, 0x26, 0x92, 0xb1, 0x90, 0x62, 0xc4, 0xfe, 0x25, 0xb7, 0xe5, 0x79, 0xad, 0x0e, 0x69, 0x60,
+	0xdf, 0x69, 0x60, 0xd7, 0xf5, 0x28, 0xa6, 0x8e, 0xe7, 0x86, 0x92, 0x7b, 0x41, 0x72, 0xf9, 0xd7,
+	0x61, 0xef, 0x79, 0x83, 0x74, 0x7d, 0x7a, 0x2c, 0x99, 0xef, 0xb6, 0x1c, 0xda, 0xee, 0x1d, 0xd6,
+	0x2d, 0xaf, 0xdb, 0x68, 0x79, 0x2d, 0x2f, 0x96, 0x62, 0x5f, 0x62, 0x45, 0xec, 0x97, 0x10, 0xd7,
+	0xff, 0x31, 0x02, 0x73, 0x1b, 0x01, 0xc1, 0x94, 0x3c, 0xc3, 0x9d, 0x0e, 0xa1, 0x06, 0xf9, 0xa4,
+	0x47, 0x42, 0x8a, 0x1e, 0x03, 0x1c, 0x91, 0xe3, 0x2e, 0x76, 0x71, 0x8b, 0x04, 0x55, 0x6d, 0x59,
+	0x5b, 0x99, 0x6e, 0xd6, 0xeb, 0x6f, 0x0f, 0x58, 0x7d, 0x37, 0xd2, 0xd8, 0x75, 0x5c, 0xdb, 0x48,
+	0x58, 0x40, 0xd7, 0x60, 0xe6, 0x05, 0x77, 0x60, 0xfa, 0x38, 0x0c, 0x5f, 0x78, 0x81, 0x5d, 0x1d,
+	0x59, 0xd6, 0x56, 0x26, 0x8c, 0x69, 0x41, 0xde, 0x93, 0x54, 0x54, 0x83, 0x52, 0xd7, 0x25, 0x5d,
+	0xcf, 0x75, 0xac, 0x6a, 0x81, 0x4b, 0x44, 0xdf, 0xe8, 0x12, 0x94, 0xdd, 0x5e, 0xd7, 0x54, 0x2e,
+	0xab, 0xa3, 0xcb, 0xda, 0xca, 0xa8, 0x31, 0xe9, 0xf6, 0xba, 0x6b, 0x92, 0x84, 0x96, 0x60, 0x32,
+	0x20, 0x5d, 0x8f, 0x12, 0x13, 0xdb, 0x76, 0x50, 0x1d, 0xe3, 0x16, 0x40, 0x90, 0xd6, 0x6c, 0x3b,
+	0x40, 0xef, 0xc0, 0x8c, 0x14, 0xb0, 0x02, 0x06, 0x86, 0xb6, 0xab, 0x45, 0x2e, 0x34, 0x25, 0xc8,
+	0x1b, 0x01, 0xdd, 0xc3, 0xb4, 0x9d, 0x90, 0x3b, 0x22, 0xc7, 0x42, 0x6e, 0x3c, 0x29, 0xb7, 0x4b,
+	0x8e, 0xb9, 0xdc, 0x0d, 0x40, 0xca, 0x1e, 0x8e, 0x4d, 0x96, 0xb8, 0xa8, 0xb4, 0xb0, 0x81, 0xa5,
+	0x51, 0xfd, 0x63, 0xa8, 0xa4, 0x83, 0x1d, 0xfa, 0x9e, 0x1b, 0x12, 0xf4, 0x75, 0x28, 0x8a, 0x30,
+	0xf0, 0x48, 0x4f, 0x0e, 0x8f, 0x74, 0x5a, 0xdf, 0x90, 0xda, 0xfa, 0x17, 0x1a, 0x9c, 0xdb, 0xb2,
+	0x1d, 0x2a, 0xd8, 0x1b, 0x9e, 0xfb, 0xdc, 0x69, 0xa9, 0x1d, 0x1d, 0x88, 0x8c, 0x76, 0x92, 0xc8,
+	0x8c, 0x9c, 0x30, 0x32, 0x85, 0x93, 0x47, 0x66, 0x34, 0x3b, 0x32, 0x1f, 0x40, 0xf5, 0x01, 0x71,
+	0x49, 0x80, 0x29, 0x79, 0x24, 0xb7, 0x3b, 0x8a, 0x4e, 0x32, 0x25, 0xb4, 0x74, 0x4a, 0xe8, 0xdf,
+	0xd7, 0x60, 0x7a, 0x20, 0x98, 0x4b, 0x30, 0x19, 0xa5, 0x1a, 0x6d, 0xab, 0x85, 0xaa, 0x34, 0xa3,
+	0x6d, 0xf4, 0x0c, 0x66, 0xe2, 0xcc, 0x34, 0x8f, 0x1c, 0x57, 0xe4, 0xe2, 0xe9, 0x13, 0x7c, 0xfa,
+	0x28, 0xf5, 0xad, 0xff, 0x48, 0x83, 0xb9, 0x87, 0x4e, 0x48, 0x55, 0x36, 0xaa, 0xd0, 0xbf, 0x0b,
+	0x73, 0x2d, 0x42, 0x4d, 0x9b, 0xf8, 0x5e, 0xe8, 0x50, 0x93, 0xbe, 0x34, 0x6d, 0x4c, 0x31, 0x47,
+	0x56, 0x32, 0x66, 0x5b, 0x84, 0x6e, 0x0a, 0xce, 0xc1, 0xcb, 0x4d, 0x4c, 0x31, 0xba, 0x00, 0x13,
+	0x3e, 0x6e, 0x11, 0x33, 0x74, 0x5e, 0x11, 0x8e, 0x6c, 0xcc, 0x28, 0x31, 0xc2, 0xbe, 0xf3, 0x8a,
+	0xa0, 0x8b, 0x00, 0x9c, 0x49, 0xbd, 0x23, 0xe2, 0xca, 0xc0, 0x73, 0xf1, 0x03, 0x46, 0x40, 0xb3,
+	0x50, 0xc0, 0x9d, 0x0e, 0x8f, 0x72, 0xc9, 0x60, 0x3f, 0xf5, 0x5f, 0x68, 0x50, 0x49, 0x83, 0x92,
+	0x71, 0xda, 0x80, 0x52, 0x74, 0x92, 0xb4, 0xe5, 0xc2, 0xca, 0x64, 0xf3, 0xda, 0xb0, 0xf5, 0x4b,
+	0x1b, 0x46, 0xa4, 0xc8, 0x92, 0xc1, 0x25, 0x2f, 0xa9, 0x99, 0xc0, 0x24, 0x93, 0x86, 0x91, 0xf7,
+	0x22, 0x5c, 0x17, 0x01, 0xa8, 0x47, 0x71, 0x47, 0x2c, 0xaa, 0xc0, 0x17, 0x35, 0xc1, 0x29, 0x6c,
+	0x55, 0xfa, 0xaf, 0x34, 0x18, 0x97, 0xc6, 0x51, 0x13, 0xce, 0x4a, 0xef, 0x8e, 0xdb, 0x32, 0xfd,
+	0xde, 0x61, 0xc7, 0xb1, 0x58, 0xaa, 0xf1, 0x78, 0x95, 0x8d, 0xb9, 0x98, 0xb9, 0xc7, 0x79, 0xbb,
+	0xe4, 0x98, 0x55, 0x06, 0x09, 0xc9, 0x74, 0x71, 0x97, 0x48, 0x0c, 0x93, 0x92, 0xf6, 0x18, 0x77,
+	0x09, 0x43, 0x3a, 0xb8, 0x01, 0x05, 0x6e, 0x70, 0xca, 0x4e, 0x45, 0xff, 0x1a, 0x93, 0x0b, 0x9c,
+	0x3e, 0x2f, 0xb9, 0xc9, 0x9c, 0x9d, 0x8e, 0xc9, 0x3c, 0x65, 0x5f, 0xc1, 0xf9, 0x3d, 0x12, 0x3c,
+	0xf7, 0x82, 0x2e, 0x76, 0x2d, 0xb2, 0xed, 0x84, 0xd4, 0x0b, 0x8e, 0x13, 0xa7, 0x2d, 0x46, 0x2e,
+	0xe2, 0x5b, 0x36, 0xc0, 0x57, 0x80, 0x79, 0xa1, 0x0a, 0x29, 0x0e, 0xa8, 0x49, 0x7c, 0xcf, 0x12,
+	0x27, 0x6d, 0xd4, 0x00, 0x4e, 0xda, 0x62, 0x14, 0x96, 0x05, 0xc4, 0xb5, 0x25, 0xbb, 0xc0, 0xd9,
+	0x25, 0xe2, 0xda, 0x9c, 0xa9, 0xbf, 0x80, 0x5a, 0x96, 0x6f, 0xb9, 0xb3, 0x1f, 0xc1, 0x44, 0x9b,
+	0x93, 0x1c, 0xa2, 0xb6, 0xf6, 0x2b, 0xc3, 0xb6, 0xf6, 0xa9, 0xa2, 0x66, 0xd8, 0x8d, 0xad, 0xe9,
+	0x7f, 0xd2, 0xe0, 0xc2, 0x5b, 0x44, 0x79, 0x7a, 0x0e, 0xee, 0xd8, 0x44, 0xb4, 0x6c, 0xb4, 0x0d,
+	0x45, 0xbe, 0xa0, 0xb0, 0x3a, 0xc2, 0x61, 0xdd, 0x1a, 0x06, 0x8b, 0x2f, 0x37, 0xe1, 0xc7, 0x90,
+	0xfa, 0xe8, 0x21, 0x8c, 0x87, 0xbd, 0x6e, 0x17, 0x07, 0xc7, 0x3c, 0x38, 0x93, 0xcd, 0xe6, 0x30,
+	0x53, 0x09, 0x2b, 0xfb, 0x42, 0xd3, 0x50, 0x26, 0xf4, 0xdf, 0x8e, 0xc2, 0xec, 0xa0, 0x2b, 0x54,
+	0x81, 0x31, 0x11, 0x7d, 0x8d, 0x47, 0x5f, 0x7c, 0xa0, 0xdb, 0x50, 0xc1, 0x94, 0x92, 0x50, 0xdc,
+	0xc9, 0xa6, 0xe3, 0x5a, 0x9d, 0x9e, 0x4d, 0x44, 0x09, 0x29, 0x19, 0x73, 0x09, 0xde, 0x8e, 0x64,
+	0xa1, 0xeb, 0x30, 0x9b, 0x54, 0xe9, 0x60, 0x2a, 0x8e, 0x40, 0xc9, 0x98, 0x49, 0xd0, 0x1f, 0x62,
+	0x4a, 0xd0, 0x55, 0x98, 0xe6, 0x16, 0x43, 0x26, 0x18, 0x76, 0x3c, 0x2a, 0x2f, 0xb9, 0xa9, 0x88,
+	0xba, 0xdf, 0xf1, 0x58, 0x45, 0x41, 0xb1, 0x98, 0xed, 0x84, 0x94, 0x01, 0xe6, 0xb7, 0xdd, 0xa8,
+	0x71, 0x26, 0xe2, 0x6c, 0x4a, 0x06, 0x7a, 0x0f, 0xe6, 0x2d, 0x2f, 0x08, 0x88, 0x45, 0x3b, 0xc7,
+	0x66, 0xdf, 0xa3, 0xc4, 0x36, 0x43, 0xaf, 0x17, 0x58, 0x84, 0xdf, 0x7d, 0x25, 0xa3, 0x12, 0x71,
+	0x9f, 0x32, 0xe6, 0x3e, 0xe7, 0x65, 0x69, 0x51, 0x1c, 0xb4, 0x08, 0xad, 0x8e, 0x67, 0x69, 0x1d,
+	0x70, 0x1e, 0xba, 0x05, 0x95, 0x41, 0xad, 0x36, 0xc1, 0x36, 0xbf, 0x12, 0x4b, 0x06, 0x4a, 0xeb,
+	0x6c, 0x13, 0x6c, 0xb3, 0x35, 0xfb, 0x81, 0xe7, 0x7b, 0x21, 0xee, 0x84, 0x66, 0x17, 0xdb, 0xa4,
+	0x3a, 0x21, 0xd6, 0x1c, 0x51, 0x1f, 0x61, 0x9b, 0xb0, 0x28, 0x26, 0xc4, 0x9c, 0x30, 0x24, 0x76,
+	0x15, 0xb8, 0xe0, 0x4c, 0x2c, 0xc8, 0xc9, 0xcc, 0xe2, 0x21, 0xee, 0xb0, 0xa5, 0x9b, 0x87, 0xe4,
+	0xb9, 0x17, 0x90, 0xea, 0xa4, 0xb0, 0x28, 0xa9, 0xeb, 0x9c, 0x88, 0x2e, 0x83, 0x22, 0x98, 0xf8,
+	0x39, 0x25, 0x41, 0xb5, 0xcc, 0xa5, 0xca, 0x92, 0xb8, 0xc6, 0x68, 0x49, 0x5b, 0x56, 0x1b, 0xbb,
+	0x2d, 0x52, 0x9d, 0x5a, 0xd6, 0x56, 0x0a, 0x91, 0xad, 0x0d, 0x4e, 0xd4, 0xff, 0x55, 0x00, 0xf4,
+	0x66, 0x86, 0xa1, 0x3b, 0x70, 0x56, 0x65, 0x88, 0x99, 0xd8, 0xeb, 0x50, 0xe6, 0x54, 0x45, 0x31,
+	0xd7, 0x12, 0x3c, 0xd4, 0x80, 0x39, 0xb1, 0xbe, 0xb4, 0x8a, 0xa8, 0x11, 0x48, 0xb0, 0x52, 0x0a,
+	0x37, 0xe0, 0x0c, 0x4b, 0xaa, 0xb4, 0xb8, 0xa8, 0x19, 0xb3, 0x8c, 0x91, 0x12, 0xfe, 0x2a, 0xd4,
+	0x70, 0x9f, 0x04, 0xac, 0x60, 0x67, 0xe4, 0x10, 0x4b, 0x37, 0xcd, 0xa8, 0x4a, 0x89, 0x9d, 0x53,
+	0xa4, 0x92, 0xc8, 0xbe, 0xd3, 0xa6, 0x52, 0x31, 0x4b, 0x6b, 0x48, 0x2a, 0x8d, 0x8b, 0x40, 0x9c,
+	0x28, 0x95, 0x4a, 0x27, 0x4d, 0xa5, 0x89, 0xa1, 0xa9, 0x24, 0xb7, 0x1f, 0xb2, 0xb6, 0x7f, 0x17,
+	0xa6, 0xd5, 0xe5, 0x78, 0xd2, 0x1b, 0xa0, 0x0a, 0xe3, 0x8e, 0x6b, 0x3b, 0x16, 0x11, 0xc5, 0x70,
+	0xd4, 0x50, 0x9f, 0xfa, 0xc7, 0x30, 0xb9, 0xd6, 0xa3, 0x6d, 0x65, 0xa9, 0x06, 0xa5, 0xa8, 0x69,
+	0x96, 0xfd, 0x8f, 0xfa, 0x66, 0xf9, 0xa5, 0x7e, 0x9b, 0x16, 0xeb, 0xf7, 0x82, 0x2e, 0xdf, 0x66,
+	0x79, 0x03, 0x56, 0x14, 0x73, 0x23, 0xc1, 0xd3, 0x9f, 0x40, 0x59, 0xd8, 0x97, 0xf7, 0x45, 0x05,
+	0xc6, 0xc4, 0xd5, 0x2d, 0xac, 0x8b, 0x0f, 0x16, 0x24, 0xfe, 0xc3, 0x24, 0x2f, 0x7d, 0x27, 0x88,
+	0xad, 0x8e, 0x1a, 0x33, 0x9c, 0xbe, 0x15, 0x91, 0xf5, 0x3f, 0x6b, 0x30, 0xff, 0xd8, 0xb3, 0xc9,
+	0x86, 0xe7, 0xba, 0xc4, 0x62, 0xa4, 0xc8, 0xf6, 0x2d, 0xa8, 0xc8, 0xb9, 0x88, 0x4d, 0x3f, 0x26,
+	0x71, 0x6d, 0xdf, 0x73, 0x5c, 0x2a, 0x5d, 0x21, 0xc1, 0x63, 0xba, 0x5b, 0x92, 0x83, 0x16, 0x60,
+	0xc2, 0x12, 0x76, 0xa2, 0xaa, 0x1a, 0x13, 0x58, 0xd4, 0xc2, 0x63, 0xd7, 0x72, 0xdc, 0x96, 0x2c,
+	0xa1, 0xea, 0x93, 0xf5, 0x00, 0x2d, 0xe2, 0x92, 0xd0, 0x09, 0x4d, 0xea, 0x74, 0x89, 0x9a, 0x0e,
+	0x24, 0xed, 0xc0, 0xe9, 0x12, 0x74, 0x0f, 0xaa, 0xaa, 0x07, 0xb0, 0x3c, 0x97, 0x06, 0xd8, 0xa2,
+	0xbc, 0x1b, 0x26, 0x61, 0xc8, 0xd3, 0xb7, 0x6c, 0xcc, 0x4b, 0xfe, 0x86, 0x64, 0xaf, 0x09, 0xae,
+	0xfe, 0x2d, 0xd6, 0x45, 0x79, 0xad, 0x50, 0xa1, 0x8c, 0xd6, 0xf7, 0x01, 0x9c, 0x8b, 0xae, 0x1b,
+	0xb3, 0xe3, 0xb5, 0xc2, 0xc1, 0x25, 0x9e, 0x8d, 0xd8, 0x49, 0xfd, 0x44, 0x5c, 0xd2, 0x4a, 0x23,
+	0xc9, 0xb8, 0x24, 0x35, 0xf4, 0x07, 0x30, 0xf3, 0x94, 0x04, 0x61, 0x32, 0xb8, 0xf3, 0x50, 0x14,
+	0x82, 0xd2, 0x97, 0xfc, 0x62, 0x21, 0x8c, 0xbc, 0x4a, 0x8b, 0x31, 0x41, 0xff, 0x5c, 0x83, 0xb3,
+	0x22, 0x6d, 0xd5, 0xd4, 0xa5, 0x32, 0xed, 0x3a, 0xcc, 0x5a, 0xbd, 0x20, 0x20, 0x6e, 0x62, 0x4c,
+	0x13, 0x96, 0x67, 0x24, 0x3d, 0x39, 0xa7, 0x0d, 0x4c, 0x72, 0x27, 0x48, 0xca, 0xc2, 0x5b, 0x92,
+	0xf2, 0x1e, 0x9c, 0xd9, 0xc6, 0xe1, 0x40, 0x2f, 0x7f, 0x19, 0xa6, 0x64, 0x2f, 0x4f, 0x5e, 0x3a,
+	0x21, 0x0d, 0x65, 0xcf, 0x5c, 0x16, 0xc4, 0x2d, 0x4e, 0xd3, 0xfb, 0x30, 0xbf, 0xd3, 0xf5, 0xbd,
+	0x80, 0xb2, 0x63, 0x45, 0xbd, 0x80, 0x24, 0x1a, 0x6f, 0x74, 0xa4, 0x68, 0xa6, 0xc3, 0x65, 0x88,
+	0xcd, 0x8f, 0xe2, 0x84, 0x71, 0x26, 0xe2, 0xec, 0x48, 0x46, 0x5a, 0x7c, 0x60, 0x75, 0xb1, 0xb8,
+	0x0a, 0x81, 0xbe, 0x0b, 0xe7, 0xde, 0xf0, 0x1b, 0x67, 0xbd, 0x72, 0x67, 0xbe, 0x59, 0x05, 0x90,
+	0xe2, 0x45, 0x0d, 0x6c, 0xa8, 0x3f, 0x03, 0xb4, 0x8d, 0xc3, 0x0f, 0x43, 0x62, 0x3f, 0x23, 0x87,
+	0x91, 0x1d, 0x1d, 0xa6, 0xda, 0x38, 0x34, 0x43, 0xa7, 0xe5, 0x12, 0xdb, 0xec, 0xf9, 0x72, 0xfd,
+	0x93, 0x6d, 0x1c, 0xee, 0x73, 0xda, 0x87, 0x3e, 0x6b, 0xb9, 0x98, 0x8c, 0x1c, 0x20, 0xe5, 0x81,
+	0x69, 0xab, 0x50, 0xea, 0x3a, 0x94, 0x59, 0x1a, 0x45, 0x26, 0x11, 0x8c, 0xb2, 0x8c, 0x93, 0x51,
+	0xe0, 0xbf, 0xf5, 0x9f, 0x8d, 0x40, 0x65, 0x9d, 0xa7, 0xce, 0x3e, 0xc5, 0xb4, 0x17, 0xfe, 0x9f,
+	0x9d, 0x5e, 0xf4, 0x35, 0x00, 0xfe, 0x2a, 0x23, 0xae, 0x8f, 0x22, 0xef, 0x17, 0x97, 0xe3, 0x7e,
+	0x91, 0xd0, 0x76, 0x5d, 0xbd, 0xc5, 0xd4, 0x37, 0x98, 0x20, 0xbb, 0x4c, 0x8c, 0x09, 0x4b, 0xfd,
+	0x5c, 0xbd, 0x0b, 0xd3, 0xe9, 0xd9, 0x0f, 0x4d, 0xc2, 0xf8, 0xe6, 0x96, 0xb1, 0xf3, 0x74, 0x6b,
+	0x73, 0xf6, 0x4b, 0xa8, 0x0c, 0xa5, 0x9d, 0x47, 0x7b, 0x4f, 0x8c, 0x83, 0xad, 0xcd, 0x59, 0x0d,
+	0x01, 0x14, 0x8d, 0xad, 0x47, 0x4f, 0x0e, 0xb6, 0x66, 0x47, 0x9a, 0x7f, 0x1b, 0x85, 0xa2, 0xd8,
+	0x08, 0xf4, 0x73, 0x0d, 0xca, 0xc9, 0xe9, 0x1f, 0xdd, 0x19, 0xd6, 0xb1, 0x66, 0x3c, 0xcc, 0xd4,
+	0xde, 0x3b, 0x9d, 0x92, 0xd8, 0x47, 0xfd, 0x9d, 0x4f, 0xff, 0xf8, 0xd7, 0xcf, 0x47, 0x96, 0xf5,
+	0x0b, 0xec, 0x15, 0x2c, 0xd2, 0x6b, 0x88, 0x9c, 0x69, 0x58, 0x5c, 0xe5, 0xbe, 0xb6, 0x8a, 0x28,
+	0x94, 0x93, 0x6f, 0x07, 0x68, 0xbe, 0x2e, 0xde, 0x9a, 0xea, 0xea, 0x15, 0xa9, 0xbe, 0xc5, 0xde,
+	0x9a, 0x6a, 0xa7, 0x7c, 0xa0, 0xd0, 0x17, 0xb8, 0xff, 0x79, 0x54, 0xc9, 0xf2, 0x8f, 0x7e, 0xa0,
+	0xc1, 0xec, 0xe0, 0xf4, 0x9f, 0xeb, 0xfa, 0xde, 0x30, 0xd7, 0x79, 0xef, 0x08, 0xfa, 0x35, 0x0e,
+	0xe2, 0x12, 0x5a, 0x4a, 0x83, 0x50, 0x6f, 0x09, 0x8d, 0x96, 0x54, 0x44, 0xbf, 0xd6, 0x60, 0x66,
+	0xe0, 0x64, 0xa3, 0x0f, 0x86, 0xb9, 0xcd, 0x2e, 0x41, 0xb5, 0xbb, 0xa7, 0xd6, 0x93, 0x68, 0x6f,
+	0x71, 0xb4, 0xab, 0xfa, 0xd5, 0xcc, 0x2d, 0x8b, 0xaa, 0x51, 0x43, 0xd4, 0x92, 0xfb, 0xda, 0x6a,
+	0xf3, 0x9f, 0x05, 0x28, 0x45, 0x0f, 0x61, 0x3f, 0xd1, 0xa0, 0x9c, 0x1c, 0xfb, 0x87, 0x67, 0x5b,
+	0xc6, 0xcb, 0x45, 0xed, 0xbd, 0xd3, 0x29, 0x49, 0xe8, 0x8b, 0x1c, 0x7a, 0x15, 0xcd, 0xa7, 0xa1,
+	0x2b, 0x3d, 0xf4, 0x5d, 0x0d, 0xa6, 0xd3, 0x17, 0x10, 0x7a, 0x7f, 0x68, 0x5a, 0x67, 0x5d, 0x58,
+	0xb5, 0x9c, 0x24, 0xc9, 0xcb, 0x77, 0x55, 0xd3, 0x1b, 0xc4, 0x76, 0x58, 0xc8, 0xd0, 0x6f, 0x34,
+	0x38, 0xfb, 0x80, 0xd0, 0x8c, 0x41, 0xf6, 0xcb, 0xa7, 0x18, 0x27, 0xd3, 0xb3, 0x7f, 0xed, 0xfe,
+	0x7f, 0xa2, 0x2a, 0x43, 0xb7, 0xca, 0x81, 0x5f, 0x41, 0x7a, 0x76, 0xe8, 0x1a, 0x7e, 0xac, 0xda,
+	0xfc, 0x76, 0x09, 0x8a, 0xa2, 0x6a, 0xa3, 0xcf, 0x34, 0x98, 0x79, 0x40, 0x68, 0xb2, 0x86, 0xe7,
+	0x1e, 0xa0, 0xa1, 0x7b, 0x9a, 0x75, 0x13, 0xe8, 0x97, 0x39, 0xb0, 0x8b, 0x68, 0x20, 0xa2, 0xf2,
+	0x71, 0x3c, 0x14, 0x2e, 0xbf, 0xd0, 0xe0, 0xfc, 0x03, 0x42, 0xe3, 0x07, 0x02, 0x1c, 0x50, 0xc7,
+	0x72, 0x7c, 0x7e, 0xc3, 0xa3, 0xbb, 0x39, 0x15, 0x37, 0x57, 0x43, 0x05, 0xf4, 0xfd, 0x1c, 0xc5,
+	0x3c, 0xad, 0xb7, 0xc7, 0x52, 0x42, 0xf6, 0x53, 0xd8, 0x7e, 0xa9, 0xc1, 0xb9, 0x14, 0x8e, 0xc4,
+	0x3b, 0x40, 0x73, 0xa8, 0xfb, 0x58, 0x58, 0x41, 0xbe, 0x73, 0x2a, 0x1d, 0x09, 0x78, 0x85, 0x03,
+	0xd6, 0xd1, 0x72, 0x36, 0xe0, 0x04, 0xa4, 0xef, 0x69, 0x30, 0x95, 0x84, 0x1b, 0xa2, 0x9b, 0x39,
+	0x0e, 0xd9, 0x01, 0x8d, 0xc5, 0x14, 0xbc, 0x4b, 0xc3, 0xe0, 0x85, 0x79, 0xd5, 0x52, 0x82, 0xe9,
+	0xc7, 0x9e, 0x7f, 0xaa, 0x41, 0x25, 0x89, 0x65, 0x5d, 0x0c, 0x46, 0xa9, 0x8a, 0x93, 0x0f, 0x49,
+	0x49, 0x2b, 0x64, 0x2b, 0xc3, 0x90, 0x29, 0x05, 0xfd, 0x2a, 0x07, 0xb8, 0x84, 0x2e, 0x66, 0x02,
+	0x3c, 0x54, 0x28, 0xfa, 0x70, 0x26, 0x89, 0xee, 0x1b, 0x3d, 0xd2, 0x23, 0xb9, 0x67, 0xe3, 0xea,
+	0x30, 0xef, 0x5c, 0x5d, 0xd7, 0xb9, 0xeb, 0x05, 0x54, 0xcb, 0x74, 0xfd, 0x09, 0x77, 0x61, 0x43,
+	0x89, 0x57, 0x16, 0x12, 0xe4, 0x1f, 0xc5, 0x85, 0x1c, 0x77, 0x5c, 0x6b, 0x88, 0x17, 0x9f, 0xc9,
+	0x34, 0x7f, 0x3f, 0x06, 0xc5, 0x6d, 0xfe, 0x97, 0x14, 0xfa, 0xb1, 0x48, 0xe1, 0xf5, 0xa8, 0x27,
+	0x8b, 0xa7, 0xb1, 0x5c, 0x00, 0x43, 0x6f, 0xb5, 0xec, 0xa9, 0x4e, 0xbf, 0xc9, 0xa1, 0xbd, 0x83,
+	0xae, 0xa4, 0xa1, 0x89, 0x3f, 0xc7, 0xf8, 0xff, 0x5c, 0xa6, 0x15, 0x7b, 0x17, 0xf7, 0x3b, 0x4d,
+	0x4e, 0x33, 0xff, 0x45, 0x79, 0xca, 0x1a, 0xc3, 0xf4, 0x1b, 0x1c, 0xd0, 0x55, 0x74, 0x39, 0x13,
+	0x10, 0x6b, 0x72, 0x1b, 0x24, 0x72, 0xfd, 0x4d, 0x00, 0x96, 0x12, 0x62, 0x98, 0xca, 0x05, 0xd2,
+	0x18, 0xfa, 0x64, 0x9a, 0x9e, 0xc6, 0xf4, 0x2b, 0x1c, 0xc3, 0x22, 0x5a, 0xc8, 0xc4, 0xd0, 0x97,
+	0xee, 0xbe, 0xa3, 0xc1, 0xec, 0x3e, 0x0d, 0x08, 0xee, 0xae, 0x47, 0x33, 0x5e, 0x2e, 0x86, 0x2b,
+	0x31, 0x06, 0xb1, 0xed, 0xf5, 0xc0, 0xb7, 0xea, 0xfd, 0xdb, 0xf5, 0x64, 0x4b, 0xaf, 0x37, 0xb8,
+	0xe3, 0xeb, 0xe8, 0x5a, 0xfe, 0xe2, 0xa3, 0x3a, 0xcd, 0x1c, 0xdf, 0xd2, 0xd0, 0x0f, 0x35, 0x98,
+	0x13, 0x28, 0x9e, 0x26, 0xc7, 0xd3, 0x5c, 0x20, 0x37, 0x4f, 0xb2, 0x2b, 0x11, 0xa0, 0x26, 0x07,
+	0x74, 0x13, 0xad, 0xe6, 0x03, 0x8a, 0xa9, 0x0a, 0x53, 0xf3, 0xef, 0x05, 0x18, 0x65, 0xef, 0x12,
+	0x6c, 0x7f, 0xe2, 0x59, 0x28, 0x17, 0xd2, 0xd0, 0x07, 0xdf, 0x37, 0xe7, 0x29, 0xfd, 0x12, 0x07,
+	0x76, 0x01, 0x9d, 0x4f, 0x03, 0x73, 0x5c, 0x87, 0x3a, 0xb8, 0xe3, 0xbc, 0x22, 0x36, 0xfa, 0x54,
+	0x83, 0xb1, 0x87, 0x5e, 0xcb, 0x71, 0xd1, 0x8d, 0xa1, 0x7f, 0x87, 0xc4, 0x8f, 0x34, 0xb5, 0x9b,
+	0x27, 0x13, 0x4e, 0x77, 0x48, 0xfa, 0x5c, 0x1a, 0x47, 0x87, 0xf9, 0x65, 0x7d, 0xc9, 0x67, 0x1a,
+	0x14, 0xd9, 0x80, 0xd7, 0xf3, 0xff, 0x97, 0x28, 0x96, 0x38, 0x8a, 0xf3, 0xfa, 0x40, 0x57, 0x1e,
+	0x72, 0xc7, 0x0c, 0xc6, 0x47, 0x50, 0x7c, 0xe8, 0xb5, 0xbc, 0x1e, 0xcd, 0xdd, 0x84, 0x1c, 0x7a,
+	0x9e, 0xe9, 0x0e, 0xb7, 0x76, 0x5f, 0x5b, 0x5d, 0x2f, 0xff, 0xee, 0xf5, 0xa2, 0xf6, 0x87, 0xd7,
+	0x8b, 0xda, 0x5f, 0x5e, 0x2f, 0x6a, 0x87, 0x45, 0xae, 0x7e, 0xe7, 0xdf, 0x03, 0x00, 0xcf, 0x49,
+	0x65, 0x29, 0xbe, 0x1f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// WalletClient is the client API for Wallet service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WalletClient interface {
+	CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*CreateWalletResponse, error)
+	WalletConfig(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*WalletResponse, error)
+	GenerateMnemonic(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*GenerateMnemonicResponse, error)
+	ImportKeystores(ctx context.Context, in *ImportKeystoresRequest, opts ...grpc.CallOption) (*ImportKeystoresResponse, error)
+}
+
+type walletClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWalletClient(cc *grpc.ClientConn) WalletClient {
+	return &walletClient{cc}
+}
+
+func (c *walletClient) CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*CreateWalletResponse, error) {
+	out := new(CreateWalletResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Wallet/CreateWallet", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(BeaconServer).GetValidatorPerformance(ctx, in)
+	return out, nil
+}
+
+func (c *walletClient) WalletConfig(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*WalletResponse, error) {
+	out := new(WalletResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Wallet/WalletConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Beacon/GetValidatorPerformance",
+	return out, nil
+}
+
+func (c *walletClient) GenerateMnemonic(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*GenerateMnemonicResponse, error) {
+	out := new(GenerateMnemonicResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Wallet/GenerateMnemonic", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconServer).GetValidatorPerformance(ctx, req.(*v1alpha1.ValidatorPerformanceRequest))
+	return out, nil
+}
+
+func (c *walletClient) ImportKeystores(ctx context.Context, in *ImportKeystoresRequest, opts ...grpc.CallOption) (*ImportKeystoresResponse, error) {
+	out := new(ImportKeystoresResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Wallet/ImportKeystores", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Beacon_GetValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha1.ListValidatorsRequest)
+// WalletServer is the server API for Wallet service.
+type WalletServer interface {
+	CreateWallet(context.Context, *CreateWalletRequest) (*CreateWalletResponse, error)
+	WalletConfig(context.Context, *types.Empty) (*WalletResponse, error)
+	GenerateMnemonic(context.Context, *types.Empty) (*GenerateMnemonicResponse, error)
+	ImportKeystores(context.Context, *ImportKeystoresRequest) (*ImportKeystoresResponse, error)
+}
+
+// UnimplementedWalletServer can be embedded to have forward compatible implementations.
+type UnimplementedWalletServer struct {
+}
+
+func (*UnimplementedWalletServer) CreateWallet(ctx context.Context, req *CreateWalletRequest) (*CreateWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWallet not implemented")
+}
+func (*UnimplementedWalletServer) WalletConfig(ctx context.Context, req *types.Empty) (*WalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WalletConfig not implemented")
+}
+func (*UnimplementedWalletServer) GenerateMnemonic(ctx context.Context, req *types.Empty) (*GenerateMnemonicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateMnemonic not implemented")
+}
+func (*UnimplementedWalletServer) ImportKeystores(ctx context.Context, req *ImportKeystoresRequest) (*ImportKeystoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportKeystores not implemented")
+}
+
+func RegisterWalletServer(s *grpc.Server, srv WalletServer) {
+	s.RegisterService(&_Wallet_serviceDesc, srv)
+}
+
+func _Wallet_CreateWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWalletRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BeaconServer).GetValidators(ctx, in)
+		return srv.(WalletServer).CreateWallet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Beacon/GetValidators",
+		FullMethod: "/ethereum.validator.accounts.v2.Wallet/CreateWallet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconServer).GetValidators(ctx, req.(*v1alpha1.ListValidatorsRequest))
+		return srv.(WalletServer).CreateWallet(ctx, req.(*CreateWalletRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Beacon_GetValidatorBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha1.ListValidatorBalancesRequest)
+func _Wallet_WalletConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BeaconServer).GetValidatorBalances(ctx, in)
+		return srv.(WalletServer).WalletConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Beacon/GetValidatorBalances",
+		FullMethod: "/ethereum.validator.accounts.v2.Wallet/WalletConfig",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconServer).GetValidatorBalances(ctx, req.(*v1alpha1.ListValidatorBalancesRequest))
+		return srv.(WalletServer).WalletConfig(ctx, req.(*types.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Beacon_GetValidatorQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Wallet_GenerateMnemonic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(types.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BeaconServer).GetValidatorQueue(ctx, in)
+		return srv.(WalletServer).GenerateMnemonic(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Beacon/GetValidatorQueue",
+		FullMethod: "/ethereum.validator.accounts.v2.Wallet/GenerateMnemonic",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconServer).GetValidatorQueue(ctx, req.(*types.Empty))
+		return srv.(WalletServer).GenerateMnemonic(ctx, req.(*types.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Beacon_GetPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
+func _Wallet_ImportKeystores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportKeystoresRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BeaconServer).GetPeers(ctx, in)
+		return srv.(WalletServer).ImportKeystores(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Beacon/GetPeers",
+		FullMethod: "/ethereum.validator.accounts.v2.Wallet/ImportKeystores",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconServer).GetPeers(ctx, req.(*types.Empty))
+		return srv.(WalletServer).ImportKeystores(ctx, req.(*ImportKeystoresRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Beacon_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.validator.accounts.v2.Beacon",
-	HandlerType: (*BeaconServer)(nil),
+var _Wallet_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.validator.accounts.v2.Wallet",
+	HandlerType: (*WalletServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "GetBeaconStatus",
-			Handler:    _Beacon_GetBeaconStatus_Handler,
-		},
-		{
-			MethodName: "GetValidatorParticipation",
-			Handler:    _Beacon_GetValidatorParticipation_Handler,
-		},
-		{
-			MethodName: "GetValidatorPerformance",
-			Handler:    _Beacon_GetValidatorPerformance_Handler,
-		},
-		{
-			MethodName: "GetValidators",
-			Handler:    _Beacon_GetValidators_Handler,
+			MethodName: "CreateWallet",
+			Handler:    _Wallet_CreateWallet_Handler,
 		},
 		{
-			MethodName: "GetValidatorBalances",
-			Handler:    _Beacon_GetValidatorBalances_Handler,
+			MethodName: "WalletConfig",
+			Handler:    _Wallet_WalletConfig_Handler,
 		},
 		{
-			MethodName: "GetValidatorQueue",
-			Handler:    _Beacon_GetValidatorQueue_Handler,
+			MethodName: "GenerateMnemonic",
+			Handler:    _Wallet_GenerateMnemonic_Handler,
 		},
 		{
-			MethodName: "GetPeers",
-			Handler:    _Beacon_GetPeers_Handler,
+			MethodName: "ImportKeystores",
+			Handler:    _Wallet_ImportKeystores_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/validator/accounts/v2/web_api.proto",
 }
 
-// HealthClient is the client API for Health service.
+// AccountsClient is the client API for Accounts service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type HealthClient interface {
-	GetBeaconNodeConnection(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*NodeConnectionResponse, error)
-	GetLogsEndpoints(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*LogsEndpointResponse, error)
-	GetVersion(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*VersionResponse, error)
-	StreamBeaconLogs(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (Health_StreamBeaconLogsClient, error)
-	StreamValidatorLogs(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (Health_StreamValidatorLogsClient, error)
+type AccountsClient interface {
+	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	GetPerformanceHistory(ctx context.Context, in *PerformanceHistoryRequest, opts ...grpc.CallOption) (*PerformanceHistoryResponse, error)
 }
 
-type healthClient struct {
+type accountsClient struct {
 	cc *grpc.ClientConn
 }
 
-func NewHealthClient(cc *grpc.ClientConn) HealthClient {
-	return &healthClient{cc}
+func NewAccountsClient(cc *grpc.ClientConn) AccountsClient {
+	return &accountsClient{cc}
 }
 
-func (c *healthClient) GetBeaconNodeConnection(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*NodeConnectionResponse, error) {
-	out := new(NodeConnectionResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Health/GetBeaconNodeConnection", in, out, opts...)
+func (c *accountsClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
+	out := new(ListAccountsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Accounts/ListAccounts", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *healthClient) GetLogsEndpoints(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*LogsEndpointResponse, error) {
-	out := new(LogsEndpointResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Health/GetLogsEndpoints", in, out, opts...)
+func (c *accountsClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Accounts/ChangePassword", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *healthClient) GetVersion(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*VersionResponse, error) {
-	out := new(VersionResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Health/GetVersion", in, out, opts...)
+func (c *accountsClient) GetPerformanceHistory(ctx context.Context, in *PerformanceHistoryRequest, opts ...grpc.CallOption) (*PerformanceHistoryResponse, error) {
+	out := new(PerformanceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Accounts/GetPerformanceHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *healthClient) StreamBeaconLogs(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (Health_StreamBeaconLogsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Health_serviceDesc.Streams[0], "/ethereum.validator.accounts.v2.Health/StreamBeaconLogs", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &healthStreamBeaconLogsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
-}
-
-type Health_StreamBeaconLogsClient interface {
-	Recv() (*v1.LogsResponse, error)
-	grpc.ClientStream
-}
-
-type healthStreamBeaconLogsClient struct {
-	grpc.ClientStream
-}
-
-func (x *healthStreamBeaconLogsClient) Recv() (*v1.LogsResponse, error) {
-	m := new(v1.LogsResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func (c *healthClient) StreamValidatorLogs(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (Health_StreamValidatorLogsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Health_serviceDesc.Streams[1], "/ethereum.validator.accounts.v2.Health/StreamValidatorLogs", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &healthStreamValidatorLogsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
-}
-
-type Health_StreamValidatorLogsClient interface {
-	Recv() (*LogsResponse, error)
-	grpc.ClientStream
-}
-
-type healthStreamValidatorLogsClient struct {
-	grpc.ClientStream
-}
-
-func (x *healthStreamValidatorLogsClient) Recv() (*LogsResponse, error) {
-	m := new(LogsResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-// HealthServer is the server API for Health service.
-type HealthServer interface {
-	GetBeaconNodeConnection(context.Context, *types.Empty) (*NodeConnectionResponse, error)
-	GetLogsEndpoints(context.Context, *types.Empty) (*LogsEndpointResponse, error)
-	GetVersion(context.Context, *types.Empty) (*VersionResponse, error)
-	StreamBeaconLogs(*types.Empty, Health_StreamBeaconLogsServer) error
-	StreamValidatorLogs(*types.Empty, Health_StreamValidatorLogsServer) error
+// AccountsServer is the server API for Accounts service.
+type AccountsServer interface {
+	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
+	ChangePassword(context.Context, *ChangePasswordRequest) (*types.Empty, error)
+	GetPerformanceHistory(context.Context, *PerformanceHistoryRequest) (*PerformanceHistoryResponse, error)
 }
 
-// UnimplementedHealthServer can be embedded to have forward compatible implementations.
-type UnimplementedHealthServer struct {
+// UnimplementedAccountsServer can be embedded to have forward compatible implementations.
+type UnimplementedAccountsServer struct {
 }
 
-func (*UnimplementedHealthServer) GetBeaconNodeConnection(ctx context.Context, req *types.Empty) (*NodeConnectionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBeaconNodeConnection not implemented")
-}
-func (*UnimplementedHealthServer) GetLogsEndpoints(ctx context.Context, req *types.Empty) (*LogsEndpointResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLogsEndpoints not implemented")
-}
-func (*UnimplementedHealthServer) GetVersion(ctx context.Context, req *types.Empty) (*VersionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVersion not implemented")
+func (*UnimplementedAccountsServer) ListAccounts(ctx context.Context, req *ListAccountsRequest) (*ListAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
 }
-func (*UnimplementedHealthServer) StreamBeaconLogs(req *types.Empty, srv Health_StreamBeaconLogsServer) error {
-	return status.Errorf(codes.Unimplemented, "method StreamBeaconLogs not implemented")
+func (*UnimplementedAccountsServer) ChangePassword(ctx context.Context, req *ChangePasswordRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
 }
-func (*UnimplementedHealthServer) StreamValidatorLogs(req *types.Empty, srv Health_StreamValidatorLogsServer) error {
-	return status.Errorf(codes.Unimplemented, "method StreamValidatorLogs not implemented")
+func (*UnimplementedAccountsServer) GetPerformanceHistory(ctx context.Context, req *PerformanceHistoryRequest) (*PerformanceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPerformanceHistory not implemented")
 }
 
-func RegisterHealthServer(s *grpc.Server, srv HealthServer) {
-	s.RegisterService(&_Health_serviceDesc, srv)
+func RegisterAccountsServer(s *grpc.Server, srv AccountsServer) {
+	s.RegisterService(&_Accounts_serviceDesc, srv)
 }
 
-func _Health_GetBeaconNodeConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
+func _Accounts_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthServer).GetBeaconNodeConnection(ctx, in)
+		return srv.(AccountsServer).ListAccounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Health/GetBeaconNodeConnection",
+		FullMethod: "/ethereum.validator.accounts.v2.Accounts/ListAccounts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthServer).GetBeaconNodeConnection(ctx, req.(*types.Empty))
+		return srv.(AccountsServer).ListAccounts(ctx, req.(*ListAccountsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Health_GetLogsEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
+func _Accounts_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthServer).GetLogsEndpoints(ctx, in)
+		return srv.(AccountsServer).ChangePassword(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Health/GetLogsEndpoints",
+		FullMethod: "/ethereum.validator.accounts.v2.Accounts/ChangePassword",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthServer).GetLogsEndpoints(ctx, req.(*types.Empty))
+		return srv.(AccountsServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Health_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
+func _Accounts_GetPerformanceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PerformanceHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthServer).GetVersion(ctx, in)
+		return srv.(AccountsServer).GetPerformanceHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Health/GetVersion",
+		FullMethod: "/ethereum.validator.accounts.v2.Accounts/GetPerformanceHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthServer).GetVersion(ctx, req.(*types.Empty))
+		return srv.(AccountsServer).GetPerformanceHistory(ctx, req.(*PerformanceHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Health_StreamBeaconLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(types.Empty)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(HealthServer).StreamBeaconLogs(m, &healthStreamBeaconLogsServer{stream})
-}
-
-type Health_StreamBeaconLogsServer interface {
-	Send(*v1.LogsResponse) error
-	grpc.ServerStream
-}
-
-type healthStreamBeaconLogsServer struct {
-	grpc.ServerStream
-}
-
-func (x *healthStreamBeaconLogsServer) Send(m *v1.LogsResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _Health_StreamValidatorLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(types.Empty)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(HealthServer).StreamValidatorLogs(m, &healthStreamValidatorLogsServer{stream})
-}
-
-type Health_StreamValidatorLogsServer interface {
-	Send(*LogsResponse) error
-	grpc.ServerStream
-}
-
-type healthStreamValidatorLogsServer struct {
-	grpc.ServerStream
-}
-
-func (x *healthStreamValidatorLogsServer) Send(m *LogsResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-var _Health_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.validator.accounts.v2.Health",
-	HandlerType: (*HealthServer)(nil),
+var _Accounts_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.validator.accounts.v2.Accounts",
+	HandlerType: (*AccountsServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "GetBeaconNodeConnection",
-			Handler:    _Health_GetBeaconNodeConnection_Handler,
-		},
-		{
-			MethodName: "GetLogsEndpoints",
-			Handler:    _Health_GetLogsEndpoints_Handler,
-		},
-		{
-			MethodName: "GetVersion",
-			Handler:    _Health_GetVersion_Handler,
+			MethodName: "ListAccounts",
+			Handler:    _Accounts_ListAccounts_Handler,
 		},
-	},
-	Streams: []grpc.StreamDesc{
 		{
-			StreamName:    "StreamBeaconLogs",
-			Handler:       _Health_StreamBeaconLogs_Handler,
-			ServerStreams: true,
+			MethodName: "ChangePassword",
+			Handler:    _Accounts_ChangePassword_Handler,
 		},
 		{
-			StreamName:    "StreamValidatorLogs",
-			Handler:       _Health_StreamValidatorLogs_Handler,
-			ServerStreams: true,
+			MethodName: "GetPerformanceHistory",
+			Handler:    _Accounts_GetPerformanceHistory_Handler,
 		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/validator/accounts/v2/web_api.proto",
 }
 
-// AuthClient is the client API for Auth service.
+// BeaconClient is the client API for Beacon service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AuthClient interface {
-	HasUsedWeb(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*HasUsedWebResponse, error)
-	Login(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error)
-	Signup(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error)
-	Logout(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error)
+type BeaconClient interface {
+	GetBeaconStatus(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*BeaconStatusResponse, error)
+	GetValidatorParticipation(ctx context.Context, in *v1alpha1.GetValidatorParticipationRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorParticipationResponse, error)
+	GetValidatorPerformance(ctx context.Context, in *v1alpha1.ValidatorPerformanceRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorPerformanceResponse, error)
+	GetValidators(ctx context.Context, in *v1alpha1.ListValidatorsRequest, opts ...grpc.CallOption) (*v1alpha1.Validators, error)
+	GetValidatorBalances(ctx context.Context, in *v1alpha1.ListValidatorBalancesRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorBalances, error)
+	GetValidatorQueue(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1alpha1.ValidatorQueue, error)
+	GetPeers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1alpha1.Peers, error)
 }
 
-type authClient struct {
+type beaconClient struct {
 	cc *grpc.ClientConn
 }
 
-func NewAuthClient(cc *grpc.ClientConn) AuthClient {
-	return &authClient{cc}
+func NewBeaconClient(cc *grpc.ClientConn) BeaconClient {
+	return &beaconClient{cc}
 }
 
-func (c *authClient) HasUsedWeb(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*HasUsedWebResponse, error) {
-	out := new(HasUsedWebResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Auth/HasUsedWeb", in, out, opts...)
+func (c *beaconClient) GetBeaconStatus(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*BeaconStatusResponse, error) {
+	out := new(BeaconStatusResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Beacon/GetBeaconStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *authClient) Login(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
-	out := new(AuthResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Auth/Login", in, out, opts...)
+func (c *beaconClient) GetValidatorParticipation(ctx context.Context, in *v1alpha1.GetValidatorParticipationRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorParticipationResponse, error) {
+	out := new(v1alpha1.ValidatorParticipationResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Beacon/GetValidatorParticipation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *authClient) Signup(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
-	out := new(AuthResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Auth/Signup", in, out, opts...)
+func (c *beaconClient) GetValidatorPerformance(ctx context.Context, in *v1alpha1.ValidatorPerformanceRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorPerformanceResponse, error) {
+	out := new(v1alpha1.ValidatorPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Beacon/GetValidatorPerformance", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *authClient) Logout(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Auth/Logout", in, out, opts...)
+func (c *beaconClient) GetValidators(ctx context.Context, in *v1alpha1.ListValidatorsRequest, opts ...grpc.CallOption) (*v1alpha1.Validators, error) {
+	out := new(v1alpha1.Validators)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Beacon/GetValidators", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// AuthServer is the server API for Auth service.
-type AuthServer interface {
-	HasUsedWeb(context.Context, *types.Empty) (*HasUsedWebResponse, error)
-	Login(context.Context, *AuthRequest) (*AuthResponse, error)
-	Signup(context.Context, *AuthRequest) (*AuthResponse, error)
-	Logout(context.Context, *types.Empty) (*types.Empty, error)
+func (c *beaconClient) GetValidatorBalances(ctx context.Context, in *v1alpha1.ListValidatorBalancesRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorBalances, error) {
+	out := new(v1alpha1.ValidatorBalances)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Beacon/GetValidatorBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedAuthServer can be embedded to have forward compatible implementations.
-type UnimplementedAuthServer struct {
+func (c *beaconClient) GetValidatorQueue(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1alpha1.ValidatorQueue, error) {
+	out := new(v1alpha1.ValidatorQueue)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Beacon/GetValidatorQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (*UnimplementedAuthServer) HasUsedWeb(ctx context.Context, req *types.Empty) (*HasUsedWebResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HasUsedWeb not implemented")
+func (c *beaconClient) GetPeers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1alpha1.Peers, error) {
+	out := new(v1alpha1.Peers)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Beacon/GetPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedAuthServer) Login(ctx context.Context, req *AuthRequest) (*AuthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+
+// BeaconServer is the server API for Beacon service.
+type BeaconServer interface {
+	GetBeaconStatus(context.Context, *types.Empty) (*BeaconStatusResponse, error)
+	GetValidatorParticipation(context.Context, *v1alpha1.GetValidatorParticipationRequest) (*v1alpha1.ValidatorParticipationResponse, error)
+	GetValidatorPerformance(context.Context, *v1alpha1.ValidatorPerformanceRequest) (*v1alpha1.ValidatorPerformanceResponse, error)
+	GetValidators(context.Context, *v1alpha1.ListValidatorsRequest) (*v1alpha1.Validators, error)
+	GetValidatorBalances(context.Context, *v1alpha1.ListValidatorBalancesRequest) (*v1alpha1.ValidatorBalances, error)
+	GetValidatorQueue(context.Context, *types.Empty) (*v1alpha1.ValidatorQueue, error)
+	GetPeers(context.Context, *types.Empty) (*v1alpha1.Peers, error)
 }
-func (*UnimplementedAuthServer) Signup(ctx context.Context, req *AuthRequest) (*AuthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Signup not implemented")
+
+// UnimplementedBeaconServer can be embedded to have forward compatible implementations.
+type UnimplementedBeaconServer struct {
 }
-func (*UnimplementedAuthServer) Logout(ctx context.Context, req *types.Empty) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+
+func (*UnimplementedBeaconServer) GetBeaconStatus(ctx context.Context, req *types.Empty) (*BeaconStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBeaconStatus not implemented")
+}
+func (*UnimplementedBeaconServer) GetValidatorParticipation(ctx context.Context, req *v1alpha1.GetValidatorParticipationRequest) (*v1alpha1.ValidatorParticipationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorParticipation not implemented")
+}
+func (*UnimplementedBeaconServer) GetValidatorPerformance(ctx context.Context, req *v1alpha1.ValidatorPerformanceRequest) (*v1alpha1.ValidatorPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorPerformance not implemented")
+}
+func (*UnimplementedBeaconServer) GetValidators(ctx context.Context, req *v1alpha1.ListValidatorsRequest) (*v1alpha1.Validators, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidators not implemented")
+}
+func (*UnimplementedBeaconServer) GetValidatorBalances(ctx context.Context, req *v1alpha1.ListValidatorBalancesRequest) (*v1alpha1.ValidatorBalances, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorBalances not implemented")
+}
+func (*UnimplementedBeaconServer) GetValidatorQueue(ctx context.Context, req *types.Empty) (*v1alpha1.ValidatorQueue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorQueue not implemented")
+}
+func (*UnimplementedBeaconServer) GetPeers(ctx context.Context, req *types.Empty) (*v1alpha1.Peers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeers not implemented")
 }
 
-func RegisterAuthServer(s *grpc.Server, srv AuthServer) {
-	s.RegisterService(&_Auth_serviceDesc, srv)
+func RegisterBeaconServer(s *grpc.Server, srv BeaconServer) {
+	s.RegisterService(&_Beacon_serviceDesc, srv)
 }
 
-func _Auth_HasUsedWeb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Beacon_GetBeaconStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(types.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServer).HasUsedWeb(ctx, in)
+		return srv.(BeaconServer).GetBeaconStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Auth/HasUsedWeb",
+		FullMethod: "/ethereum.validator.accounts.v2.Beacon/GetBeaconStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServer).HasUsedWeb(ctx, req.(*types.Empty))
+		return srv.(BeaconServer).GetBeaconStatus(ctx, req.(*types.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Auth_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthRequest)
+func _Beacon_GetValidatorParticipation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetValidatorParticipationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServer).Login(ctx, in)
+		return srv.(BeaconServer).GetValidatorParticipation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Auth/Login",
+		FullMethod: "/ethereum.validator.accounts.v2.Beacon/GetValidatorParticipation",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServer).Login(ctx, req.(*AuthRequest))
+		return srv.(BeaconServer).GetValidatorParticipation(ctx, req.(*v1alpha1.GetValidatorParticipationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Auth_Signup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthRequest)
+func _Beacon_GetValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.ValidatorPerformanceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServer).Signup(ctx, in)
+		return srv.(BeaconServer).GetValidatorPerformance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Auth/Signup",
+		FullMethod: "/ethereum.validator.accounts.v2.Beacon/GetValidatorPerformance",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServer).Signup(ctx, req.(*AuthRequest))
+		return srv.(BeaconServer).GetValidatorPerformance(ctx, req.(*v1alpha1.ValidatorPerformanceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Auth_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Beacon_GetValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.ListValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServer).GetValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Beacon/GetValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServer).GetValidators(ctx, req.(*v1alpha1.ListValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Beacon_GetValidatorBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.ListValidatorBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServer).GetValidatorBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Beacon/GetValidatorBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServer).GetValidatorBalances(ctx, req.(*v1alpha1.ListValidatorBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Beacon_GetValidatorQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(types.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServer).Logout(ctx, in)
+		return srv.(BeaconServer).GetValidatorQueue(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Auth/Logout",
+		FullMethod: "/ethereum.validator.accounts.v2.Beacon/GetValidatorQueue",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServer).Logout(ctx, req.(*types.Empty))
+		return srv.(BeaconServer).GetValidatorQueue(ctx, req.(*types.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Auth_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.validator.accounts.v2.Auth",
-	HandlerType: (*AuthServer)(nil),
+func _Beacon_GetPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServer).GetPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Beacon/GetPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServer).GetPeers(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Beacon_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.validator.accounts.v2.Beacon",
+	HandlerType: (*BeaconServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "HasUsedWeb",
-			Handler:    _Auth_HasUsedWeb_Handler,
+			MethodName: "GetBeaconStatus",
+			Handler:    _Beacon_GetBeaconStatus_Handler,
 		},
 		{
-			MethodName: "Login",
-			Handler:    _Auth_Login_Handler,
+			MethodName: "GetValidatorParticipation",
+			Handler:    _Beacon_GetValidatorParticipation_Handler,
 		},
 		{
-			MethodName: "Signup",
-			Handler:    _Auth_Signup_Handler,
+			MethodName: "GetValidatorPerformance",
+			Handler:    _Beacon_GetValidatorPerformance_Handler,
 		},
 		{
-			MethodName: "Logout",
-			Handler:    _Auth_Logout_Handler,
+			MethodName: "GetValidators",
+			Handler:    _Beacon_GetValidators_Handler,
+		},
+		{
+			MethodName: "GetValidatorBalances",
+			Handler:    _Beacon_GetValidatorBalances_Handler,
+		},
+		{
+			MethodName: "GetValidatorQueue",
+			Handler:    _Beacon_GetValidatorQueue_Handler,
+		},
+		{
+			MethodName: "GetPeers",
+			Handler:    _Beacon_GetPeers_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/validator/accounts/v2/web_api.proto",
 }
 
-func (m *CreateWalletRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// HealthClient is the client API for Health service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type HealthClient interface {
+	GetBeaconNodeConnection(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*NodeConnectionResponse, error)
+	GetLogsEndpoints(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*LogsEndpointResponse, error)
+	GetVersion(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*VersionResponse, error)
+	StreamBeaconLogs(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (Health_StreamBeaconLogsClient, error)
+	StreamValidatorLogs(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (Health_StreamValidatorLogsClient, error)
+}
+
+type healthClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewHealthClient(cc *grpc.ClientConn) HealthClient {
+	return &healthClient{cc}
+}
+
+func (c *healthClient) GetBeaconNodeConnection(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*NodeConnectionResponse, error) {
+	out := new(NodeConnectionResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Health/GetBeaconNodeConnection", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *CreateWalletRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *healthClient) GetLogsEndpoints(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*LogsEndpointResponse, error) {
+	out := new(LogsEndpointResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Health/GetLogsEndpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *CreateWalletRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.RemoteCaCrtPath) > 0 {
-		i -= len(m.RemoteCaCrtPath)
-		copy(dAtA[i:], m.RemoteCaCrtPath)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteCaCrtPath)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.RemoteKeyPath) > 0 {
-		i -= len(m.RemoteKeyPath)
-		copy(dAtA[i:], m.RemoteKeyPath)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteKeyPath)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.RemoteCrtPath) > 0 {
-		i -= len(m.RemoteCrtPath)
-		copy(dAtA[i:], m.RemoteCrtPath)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteCrtPath)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.RemoteAddr) > 0 {
-		i -= len(m.RemoteAddr)
-		copy(dAtA[i:], m.RemoteAddr)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteAddr)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.NumAccounts != 0 {
-		i = encodeVarintWebApi(dAtA, i, uint64(m.NumAccounts))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Mnemonic) > 0 {
-		i -= len(m.Mnemonic)
-		copy(dAtA[i:], m.Mnemonic)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Mnemonic)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.WalletPassword) > 0 {
-		i -= len(m.WalletPassword)
-		copy(dAtA[i:], m.WalletPassword)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.WalletPassword)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Keymanager != 0 {
-		i = encodeVarintWebApi(dAtA, i, uint64(m.Keymanager))
-		i--
-		dAtA[i] = 0x8
+func (c *healthClient) GetVersion(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*VersionResponse, error) {
+	out := new(VersionResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Health/GetVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *CreateWalletResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *healthClient) StreamBeaconLogs(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (Health_StreamBeaconLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Health_serviceDesc.Streams[0], "/ethereum.validator.accounts.v2.Health/StreamBeaconLogs", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CreateWalletResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CreateWalletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	x := &healthStreamBeaconLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.Wallet != nil {
-		{
-			size, err := m.Wallet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintWebApi(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return x, nil
 }
 
-func (m *EditWalletConfigRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type Health_StreamBeaconLogsClient interface {
+	Recv() (*v1.LogsResponse, error)
+	grpc.ClientStream
 }
 
-func (m *EditWalletConfigRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type healthStreamBeaconLogsClient struct {
+	grpc.ClientStream
 }
 
-func (m *EditWalletConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.RemoteCaCrtPath) > 0 {
-		i -= len(m.RemoteCaCrtPath)
-		copy(dAtA[i:], m.RemoteCaCrtPath)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteCaCrtPath)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.RemoteKeyPath) > 0 {
-		i -= len(m.RemoteKeyPath)
-		copy(dAtA[i:], m.RemoteKeyPath)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteKeyPath)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.RemoteCrtPath) > 0 {
-		i -= len(m.RemoteCrtPath)
-		copy(dAtA[i:], m.RemoteCrtPath)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteCrtPath)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RemoteAddr) > 0 {
-		i -= len(m.RemoteAddr)
-		copy(dAtA[i:], m.RemoteAddr)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteAddr)))
-		i--
-		dAtA[i] = 0xa
+func (x *healthStreamBeaconLogsClient) Recv() (*v1.LogsResponse, error) {
+	m := new(v1.LogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *GenerateMnemonicResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *healthClient) StreamValidatorLogs(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (Health_StreamValidatorLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Health_serviceDesc.Streams[1], "/ethereum.validator.accounts.v2.Health/StreamValidatorLogs", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &healthStreamValidatorLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *GenerateMnemonicResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Health_StreamValidatorLogsClient interface {
+	Recv() (*LogsResponse, error)
+	grpc.ClientStream
 }
 
-func (m *GenerateMnemonicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Mnemonic) > 0 {
-		i -= len(m.Mnemonic)
-		copy(dAtA[i:], m.Mnemonic)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Mnemonic)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type healthStreamValidatorLogsClient struct {
+	grpc.ClientStream
 }
 
-func (m *WalletResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func (x *healthStreamValidatorLogsClient) Recv() (*LogsResponse, error) {
+	m := new(LogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *WalletResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// HealthServer is the server API for Health service.
+type HealthServer interface {
+	GetBeaconNodeConnection(context.Context, *types.Empty) (*NodeConnectionResponse, error)
+	GetLogsEndpoints(context.Context, *types.Empty) (*LogsEndpointResponse, error)
+	GetVersion(context.Context, *types.Empty) (*VersionResponse, error)
+	StreamBeaconLogs(*types.Empty, Health_StreamBeaconLogsServer) error
+	StreamValidatorLogs(*types.Empty, Health_StreamValidatorLogsServer) error
 }
 
-func (m *WalletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.KeymanagerKind != 0 {
-		i = encodeVarintWebApi(dAtA, i, uint64(m.KeymanagerKind))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.WalletPath) > 0 {
-		i -= len(m.WalletPath)
-		copy(dAtA[i:], m.WalletPath)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.WalletPath)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedHealthServer can be embedded to have forward compatible implementations.
+type UnimplementedHealthServer struct {
 }
 
-func (m *ListAccountsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedHealthServer) GetBeaconNodeConnection(ctx context.Context, req *types.Empty) (*NodeConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBeaconNodeConnection not implemented")
 }
-
-func (m *ListAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedHealthServer) GetLogsEndpoints(ctx context.Context, req *types.Empty) (*LogsEndpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogsEndpoints not implemented")
 }
-
-func (m *ListAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.All {
-		i--
-		if m.All {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.PageToken) > 0 {
-		i -= len(m.PageToken)
-		copy(dAtA[i:], m.PageToken)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.PageToken)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.PageSize != 0 {
-		i = encodeVarintWebApi(dAtA, i, uint64(m.PageSize))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.GetDepositTxData {
-		i--
-		if m.GetDepositTxData {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedHealthServer) GetVersion(ctx context.Context, req *types.Empty) (*VersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVersion not implemented")
 }
-
-func (m *ListAccountsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedHealthServer) StreamBeaconLogs(req *types.Empty, srv Health_StreamBeaconLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBeaconLogs not implemented")
+}
+func (*UnimplementedHealthServer) StreamValidatorLogs(req *types.Empty, srv Health_StreamValidatorLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamValidatorLogs not implemented")
 }
 
-func (m *ListAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterHealthServer(s *grpc.Server, srv HealthServer) {
+	s.RegisterService(&_Health_serviceDesc, srv)
 }
 
-func (m *ListAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _Health_GetBeaconNodeConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.TotalSize != 0 {
-		i = encodeVarintWebApi(dAtA, i, uint64(m.TotalSize))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(HealthServer).GetBeaconNodeConnection(ctx, in)
 	}
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Health/GetBeaconNodeConnection",
 	}
-	if len(m.Accounts) > 0 {
-		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintWebApi(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServer).GetBeaconNodeConnection(ctx, req.(*types.Empty))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Account) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Health_GetLogsEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Account) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(HealthServer).GetLogsEndpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Health/GetLogsEndpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServer).GetLogsEndpoints(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Account) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.DerivationPath) > 0 {
-		i -= len(m.DerivationPath)
-		copy(dAtA[i:], m.DerivationPath)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.DerivationPath)))
-		i--
-		dAtA[i] = 0x22
+func _Health_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.DepositTxData) > 0 {
-		i -= len(m.DepositTxData)
-		copy(dAtA[i:], m.DepositTxData)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.DepositTxData)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(HealthServer).GetVersion(ctx, in)
 	}
-	if len(m.AccountName) > 0 {
-		i -= len(m.AccountName)
-		copy(dAtA[i:], m.AccountName)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.AccountName)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Health/GetVersion",
 	}
-	if len(m.ValidatingPublicKey) > 0 {
-		i -= len(m.ValidatingPublicKey)
-		copy(dAtA[i:], m.ValidatingPublicKey)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.ValidatingPublicKey)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServer).GetVersion(ctx, req.(*types.Empty))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _Health_StreamBeaconLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(types.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(HealthServer).StreamBeaconLogs(m, &healthStreamBeaconLogsServer{stream})
 }
 
-func (m *AccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Health_StreamBeaconLogsServer interface {
+	Send(*v1.LogsResponse) error
+	grpc.ServerStream
 }
 
-func (m *AccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Indices) > 0 {
-		dAtA3 := make([]byte, len(m.Indices)*10)
-		var j2 int
-		for _, num := range m.Indices {
-			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j2++
-			}
-			dAtA3[j2] = uint8(num)
-			j2++
-		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintWebApi(dAtA, i, uint64(j2))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PublicKeys) > 0 {
-		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PublicKeys[iNdEx])
-			copy(dAtA[i:], m.PublicKeys[iNdEx])
-			i = encodeVarintWebApi(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+type healthStreamBeaconLogsServer struct {
+	grpc.ServerStream
 }
 
-func (m *AuthRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (x *healthStreamBeaconLogsServer) Send(m *v1.LogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Health_StreamValidatorLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(types.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(HealthServer).StreamValidatorLogs(m, &healthStreamValidatorLogsServer{stream})
 }
 
-func (m *AuthRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Health_StreamValidatorLogsServer interface {
+	Send(*LogsResponse) error
+	grpc.ServerStream
 }
 
-func (m *AuthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+type healthStreamValidatorLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *healthStreamValidatorLogsServer) Send(m *LogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Health_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.validator.accounts.v2.Health",
+	HandlerType: (*HealthServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBeaconNodeConnection",
+			Handler:    _Health_GetBeaconNodeConnection_Handler,
+		},
+		{
+			MethodName: "GetLogsEndpoints",
+			Handler:    _Health_GetLogsEndpoints_Handler,
+		},
+		{
+			MethodName: "GetVersion",
+			Handler:    _Health_GetVersion_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBeaconLogs",
+			Handler:       _Health_StreamBeaconLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamValidatorLogs",
+			Handler:       _Health_StreamValidatorLogs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/validator/accounts/v2/web_api.proto",
+}
+
+// AuthClient is the client API for Auth service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AuthClient interface {
+	HasUsedWeb(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*HasUsedWebResponse, error)
+	Login(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	Signup(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	Logout(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error)
+}
+
+type authClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAuthClient(cc *grpc.ClientConn) AuthClient {
+	return &authClient{cc}
+}
+
+func (c *authClient) HasUsedWeb(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*HasUsedWebResponse, error) {
+	out := new(HasUsedWebResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Auth/HasUsedWeb", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PasswordConfirmation) > 0 {
-		i -= len(m.PasswordConfirmation)
-		copy(dAtA[i:], m.PasswordConfirmation)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.PasswordConfirmation)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *authClient) Login(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Auth/Login", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Password) > 0 {
-		i -= len(m.Password)
-		copy(dAtA[i:], m.Password)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Password)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *authClient) Signup(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Auth/Signup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *AuthResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *authClient) Logout(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Auth/Logout", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *AuthResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AuthServer is the server API for Auth service.
+type AuthServer interface {
+	HasUsedWeb(context.Context, *types.Empty) (*HasUsedWebResponse, error)
+	Login(context.Context, *AuthRequest) (*AuthResponse, error)
+	Signup(context.Context, *AuthRequest) (*AuthResponse, error)
+	Logout(context.Context, *types.Empty) (*types.Empty, error)
 }
 
-func (m *AuthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+// UnimplementedAuthServer can be embedded to have forward compatible implementations.
+type UnimplementedAuthServer struct {
+}
+
+func (*UnimplementedAuthServer) HasUsedWeb(ctx context.Context, req *types.Empty) (*HasUsedWebResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HasUsedWeb not implemented")
+}
+func (*UnimplementedAuthServer) Login(ctx context.Context, req *AuthRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (*UnimplementedAuthServer) Signup(ctx context.Context, req *AuthRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Signup not implemented")
+}
+func (*UnimplementedAuthServer) Logout(ctx context.Context, req *types.Empty) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+
+func RegisterAuthServer(s *grpc.Server, srv AuthServer) {
+	s.RegisterService(&_Auth_serviceDesc, srv)
+}
+
+func _Auth_HasUsedWeb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.TokenExpiration != 0 {
-		i = encodeVarintWebApi(dAtA, i, uint64(m.TokenExpiration))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(AuthServer).HasUsedWeb(ctx, in)
 	}
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Auth/HasUsedWeb",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).HasUsedWeb(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *NodeConnectionResponse) Marshal() (dAtA []byte, err error) {
+func _Auth_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Auth/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).Login(ctx, req.(*AuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_Signup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).Signup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Auth/Signup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).Signup(ctx, req.(*AuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Auth/Logout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).Logout(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Auth_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.validator.accounts.v2.Auth",
+	HandlerType: (*AuthServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "HasUsedWeb",
+			Handler:    _Auth_HasUsedWeb_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _Auth_Login_Handler,
+		},
+		{
+			MethodName: "Signup",
+			Handler:    _Auth_Signup_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _Auth_Logout_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/validator/accounts/v2/web_api.proto",
+}
+
+func (m *CreateWalletRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3097,12 +3058,12 @@ func (m *NodeConnectionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NodeConnectionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *CreateWalletRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NodeConnectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CreateWalletRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3111,49 +3072,62 @@ func (m *NodeConnectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.DepositContractAddress) > 0 {
-		i -= len(m.DepositContractAddress)
-		copy(dAtA[i:], m.DepositContractAddress)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.DepositContractAddress)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.GenesisTime != 0 {
-		i = encodeVarintWebApi(dAtA, i, uint64(m.GenesisTime))
+	if len(m.RemoteCaCrtPath) > 0 {
+		i -= len(m.RemoteCaCrtPath)
+		copy(dAtA[i:], m.RemoteCaCrtPath)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteCaCrtPath)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x42
 	}
-	if m.Syncing {
+	if len(m.RemoteKeyPath) > 0 {
+		i -= len(m.RemoteKeyPath)
+		copy(dAtA[i:], m.RemoteKeyPath)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteKeyPath)))
 		i--
-		if m.Syncing {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x3a
+	}
+	if len(m.RemoteCrtPath) > 0 {
+		i -= len(m.RemoteCrtPath)
+		copy(dAtA[i:], m.RemoteCrtPath)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteCrtPath)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x32
 	}
-	if m.Connected {
+	if len(m.RemoteAddr) > 0 {
+		i -= len(m.RemoteAddr)
+		copy(dAtA[i:], m.RemoteAddr)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteAddr)))
 		i--
-		if m.Connected {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x2a
+	}
+	if m.NumAccounts != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.NumAccounts))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x20
 	}
-	if len(m.BeaconNodeEndpoint) > 0 {
-		i -= len(m.BeaconNodeEndpoint)
-		copy(dAtA[i:], m.BeaconNodeEndpoint)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.BeaconNodeEndpoint)))
+	if len(m.Mnemonic) > 0 {
+		i -= len(m.Mnemonic)
+		copy(dAtA[i:], m.Mnemonic)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Mnemonic)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
+	}
+	if len(m.WalletPassword) > 0 {
+		i -= len(m.WalletPassword)
+		copy(dAtA[i:], m.WalletPassword)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.WalletPassword)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Keymanager != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.Keymanager))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *LogsEndpointResponse) Marshal() (dAtA []byte, err error) {
+func (m *CreateWalletResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3163,12 +3137,12 @@ func (m *LogsEndpointResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LogsEndpointResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *CreateWalletResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LogsEndpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CreateWalletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3177,24 +3151,22 @@ func (m *LogsEndpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.BeaconLogsEndpoint) > 0 {
-		i -= len(m.BeaconLogsEndpoint)
-		copy(dAtA[i:], m.BeaconLogsEndpoint)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.BeaconLogsEndpoint)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ValidatorLogsEndpoint) > 0 {
-		i -= len(m.ValidatorLogsEndpoint)
-		copy(dAtA[i:], m.ValidatorLogsEndpoint)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.ValidatorLogsEndpoint)))
+	if m.Wallet != nil {
+		{
+			size, err := m.Wallet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWebApi(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *VersionResponse) Marshal() (dAtA []byte, err error) {
+func (m *EditWalletConfigRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3204,12 +3176,12 @@ func (m *VersionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VersionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *EditWalletConfigRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EditWalletConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3218,24 +3190,38 @@ func (m *VersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Validator)))
+	if len(m.RemoteCaCrtPath) > 0 {
+		i -= len(m.RemoteCaCrtPath)
+		copy(dAtA[i:], m.RemoteCaCrtPath)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteCaCrtPath)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RemoteKeyPath) > 0 {
+		i -= len(m.RemoteKeyPath)
+		copy(dAtA[i:], m.RemoteKeyPath)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteKeyPath)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RemoteCrtPath) > 0 {
+		i -= len(m.RemoteCrtPath)
+		copy(dAtA[i:], m.RemoteCrtPath)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteCrtPath)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Beacon) > 0 {
-		i -= len(m.Beacon)
-		copy(dAtA[i:], m.Beacon)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Beacon)))
+	if len(m.RemoteAddr) > 0 {
+		i -= len(m.RemoteAddr)
+		copy(dAtA[i:], m.RemoteAddr)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ChangePasswordRequest) Marshal() (dAtA []byte, err error) {
+func (m *GenerateMnemonicResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3245,12 +3231,12 @@ func (m *ChangePasswordRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ChangePasswordRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GenerateMnemonicResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChangePasswordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GenerateMnemonicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3259,31 +3245,17 @@ func (m *ChangePasswordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.PasswordConfirmation) > 0 {
-		i -= len(m.PasswordConfirmation)
-		copy(dAtA[i:], m.PasswordConfirmation)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.PasswordConfirmation)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Password) > 0 {
-		i -= len(m.Password)
-		copy(dAtA[i:], m.Password)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Password)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.CurrentPassword) > 0 {
-		i -= len(m.CurrentPassword)
-		copy(dAtA[i:], m.CurrentPassword)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.CurrentPassword)))
+	if len(m.Mnemonic) > 0 {
+		i -= len(m.Mnemonic)
+		copy(dAtA[i:], m.Mnemonic)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Mnemonic)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *HasWalletResponse) Marshal() (dAtA []byte, err error) {
+func (m *WalletResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3293,12 +3265,12 @@ func (m *HasWalletResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HasWalletResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *WalletResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HasWalletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WalletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3307,20 +3279,22 @@ func (m *HasWalletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.WalletExists {
+	if m.KeymanagerKind != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.KeymanagerKind))
 		i--
-		if m.WalletExists {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x10
+	}
+	if len(m.WalletPath) > 0 {
+		i -= len(m.WalletPath)
+		copy(dAtA[i:], m.WalletPath)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.WalletPath)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ImportKeystoresRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListAccountsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3330,12 +3304,12 @@ func (m *ImportKeystoresRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ImportKeystoresRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ImportKeystoresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3344,26 +3318,42 @@ func (m *ImportKeystoresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.KeystoresPassword) > 0 {
-		i -= len(m.KeystoresPassword)
-		copy(dAtA[i:], m.KeystoresPassword)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.KeystoresPassword)))
+	if m.All {
 		i--
-		dAtA[i] = 0x12
+		if m.All {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.KeystoresImported) > 0 {
-		for iNdEx := len(m.KeystoresImported) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.KeystoresImported[iNdEx])
-			copy(dAtA[i:], m.KeystoresImported[iNdEx])
-			i = encodeVarintWebApi(dAtA, i, uint64(len(m.KeystoresImported[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GetDepositTxData {
+		i--
+		if m.GetDepositTxData {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ImportKeystoresResponse) Marshal() (dAtA []byte, err error) {
+func (m *ListAccountsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3373,12 +3363,12 @@ func (m *ImportKeystoresResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ImportKeystoresResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ImportKeystoresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3387,11 +3377,28 @@ func (m *ImportKeystoresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ImportedPublicKeys) > 0 {
-		for iNdEx := len(m.ImportedPublicKeys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ImportedPublicKeys[iNdEx])
-			copy(dAtA[i:], m.ImportedPublicKeys[iNdEx])
-			i = encodeVarintWebApi(dAtA, i, uint64(len(m.ImportedPublicKeys[iNdEx])))
+	if m.TotalSize != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.TotalSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWebApi(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0xa
 		}
@@ -3399,7 +3406,7 @@ func (m *ImportKeystoresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *HasUsedWebResponse) Marshal() (dAtA []byte, err error) {
+func (m *Account) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3409,12 +3416,12 @@ func (m *HasUsedWebResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HasUsedWebResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *Account) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HasUsedWebResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Account) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3423,30 +3430,38 @@ func (m *HasUsedWebResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.HasWallet {
+	if len(m.DerivationPath) > 0 {
+		i -= len(m.DerivationPath)
+		copy(dAtA[i:], m.DerivationPath)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.DerivationPath)))
 		i--
-		if m.HasWallet {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x22
+	}
+	if len(m.DepositTxData) > 0 {
+		i -= len(m.DepositTxData)
+		copy(dAtA[i:], m.DepositTxData)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.DepositTxData)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1a
 	}
-	if m.HasSignedUp {
+	if len(m.AccountName) > 0 {
+		i -= len(m.AccountName)
+		copy(dAtA[i:], m.AccountName)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.AccountName)))
 		i--
-		if m.HasSignedUp {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatingPublicKey) > 0 {
+		i -= len(m.ValidatingPublicKey)
+		copy(dAtA[i:], m.ValidatingPublicKey)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.ValidatingPublicKey)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *LogsResponse) Marshal() (dAtA []byte, err error) {
+func (m *PerformanceHistoryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3456,12 +3471,12 @@ func (m *LogsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LogsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PerformanceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PerformanceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3470,11 +3485,21 @@ func (m *LogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Logs) > 0 {
-		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Logs[iNdEx])
-			copy(dAtA[i:], m.Logs[iNdEx])
-			i = encodeVarintWebApi(dAtA, i, uint64(len(m.Logs[iNdEx])))
+	if m.EndEpoch != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.EndEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintWebApi(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -3482,7 +3507,7 @@ func (m *LogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *BeaconStatusResponse) Marshal() (dAtA []byte, err error) {
+func (m *PerformanceHistoryResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3492,12 +3517,12 @@ func (m *BeaconStatusResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BeaconStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PerformanceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BeaconStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PerformanceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3506,9 +3531,50 @@ func (m *BeaconStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ChainHead != nil {
+	if len(m.Histories) > 0 {
+		for iNdEx := len(m.Histories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Histories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWebApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPerformanceHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformanceHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformanceHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Summary != nil {
 		{
-			size, err := m.ChainHead.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Summary.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3516,23 +3582,124 @@ func (m *BeaconStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintWebApi(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x1a
 	}
-	if len(m.DepositContractAddress) > 0 {
-		i -= len(m.DepositContractAddress)
-		copy(dAtA[i:], m.DepositContractAddress)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.DepositContractAddress)))
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWebApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.PublicKey)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0xa
 	}
-	if m.GenesisTime != 0 {
-		i = encodeVarintWebApi(dAtA, i, uint64(m.GenesisTime))
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BalanceChange != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.BalanceChange))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.BalanceAfter != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.BalanceAfter))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.BalanceBefore != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.BalanceBefore))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ProposalsMissed != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.ProposalsMissed))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ProposalsMade != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.ProposalsMade))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CorrectlyVotedHead {
+		i--
+		if m.CorrectlyVotedHead {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CorrectlyVotedTarget {
+		i--
+		if m.CorrectlyVotedTarget {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CorrectlyVotedSource {
+		i--
+		if m.CorrectlyVotedSource {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.InclusionDistance != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.InclusionDistance))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InclusionSlot != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.InclusionSlot))
 		i--
 		dAtA[i] = 0x20
 	}
-	if m.Syncing {
+	if m.AttestationLate {
 		i--
-		if m.Syncing {
+		if m.AttestationLate {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -3540,9 +3707,9 @@ func (m *BeaconStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.Connected {
+	if m.AttestationIncluded {
 		i--
-		if m.Connected {
+		if m.AttestationIncluded {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -3550,330 +3717,743 @@ func (m *BeaconStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.BeaconNodeEndpoint) > 0 {
-		i -= len(m.BeaconNodeEndpoint)
-		copy(dAtA[i:], m.BeaconNodeEndpoint)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.BeaconNodeEndpoint)))
+	if m.Epoch != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.Epoch))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintWebApi(dAtA []byte, offset int, v uint64) int {
-	offset -= sovWebApi(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PerformanceSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *CreateWalletRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PerformanceSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PerformanceSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Keymanager != 0 {
-		n += 1 + sovWebApi(uint64(m.Keymanager))
-	}
-	l = len(m.WalletPassword)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Mnemonic)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if m.BalanceChange != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.BalanceChange))
+		i--
+		dAtA[i] = 0x50
 	}
-	if m.NumAccounts != 0 {
-		n += 1 + sovWebApi(uint64(m.NumAccounts))
+	if m.ProposalsMissed != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.ProposalsMissed))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.RemoteAddr)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if m.ProposalsMade != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.ProposalsMade))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.RemoteCrtPath)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if m.CorrectlyVotedHead != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.CorrectlyVotedHead))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.RemoteKeyPath)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if m.CorrectlyVotedTarget != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.CorrectlyVotedTarget))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.RemoteCaCrtPath)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if m.CorrectlyVotedSource != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.CorrectlyVotedSource))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.AverageInclusionDistance != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.AverageInclusionDistance))))
+		i--
+		dAtA[i] = 0x21
 	}
-	return n
-}
-
-func (m *CreateWalletResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.LateAttestations != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.LateAttestations))
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	if m.Wallet != nil {
-		l = m.Wallet.Size()
-		n += 1 + l + sovWebApi(uint64(l))
+	if m.MissedAttestations != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.MissedAttestations))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.IncludedAttestations != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.IncludedAttestations))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EditWalletConfigRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RemoteAddr)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	l = len(m.RemoteCrtPath)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	l = len(m.RemoteKeyPath)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.RemoteCaCrtPath)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if len(m.Indices) > 0 {
+		dAtA4 := make([]byte, len(m.Indices)*10)
+		var j3 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintWebApi(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintWebApi(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GenerateMnemonicResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AuthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Mnemonic)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.PasswordConfirmation) > 0 {
+		i -= len(m.PasswordConfirmation)
+		copy(dAtA[i:], m.PasswordConfirmation)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.PasswordConfirmation)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *WalletResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AuthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.WalletPath)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.KeymanagerKind != 0 {
-		n += 1 + sovWebApi(uint64(m.KeymanagerKind))
+	if m.TokenExpiration != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.TokenExpiration))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ListAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NodeConnectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeConnectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeConnectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.GetDepositTxData {
-		n += 2
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.PageSize != 0 {
-		n += 1 + sovWebApi(uint64(m.PageSize))
+	if len(m.DepositContractAddress) > 0 {
+		i -= len(m.DepositContractAddress)
+		copy(dAtA[i:], m.DepositContractAddress)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.DepositContractAddress)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.PageToken)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if m.GenesisTime != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.GenesisTime))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.All {
-		n += 2
+	if m.Syncing {
+		i--
+		if m.Syncing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Connected {
+		i--
+		if m.Connected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.BeaconNodeEndpoint) > 0 {
+		i -= len(m.BeaconNodeEndpoint)
+		copy(dAtA[i:], m.BeaconNodeEndpoint)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.BeaconNodeEndpoint)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ListAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LogsEndpointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LogsEndpointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogsEndpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovWebApi(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	if m.TotalSize != 0 {
-		n += 1 + sovWebApi(uint64(m.TotalSize))
+	if len(m.BeaconLogsEndpoint) > 0 {
+		i -= len(m.BeaconLogsEndpoint)
+		copy(dAtA[i:], m.BeaconLogsEndpoint)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.BeaconLogsEndpoint)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.ValidatorLogsEndpoint) > 0 {
+		i -= len(m.ValidatorLogsEndpoint)
+		copy(dAtA[i:], m.ValidatorLogsEndpoint)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.ValidatorLogsEndpoint)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Account) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *VersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *VersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatingPublicKey)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	l = len(m.AccountName)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	l = len(m.DepositTxData)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.DerivationPath)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Beacon) > 0 {
+		i -= len(m.Beacon)
+		copy(dAtA[i:], m.Beacon)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Beacon)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ChangePasswordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangePasswordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangePasswordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovWebApi(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Indices) > 0 {
-		l = 0
-		for _, e := range m.Indices {
-			l += sovWebApi(uint64(e))
-		}
-		n += 1 + sovWebApi(uint64(l)) + l
+	if len(m.PasswordConfirmation) > 0 {
+		i -= len(m.PasswordConfirmation)
+		copy(dAtA[i:], m.PasswordConfirmation)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.PasswordConfirmation)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.CurrentPassword) > 0 {
+		i -= len(m.CurrentPassword)
+		copy(dAtA[i:], m.CurrentPassword)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.CurrentPassword)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AuthRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *HasWalletResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *HasWalletResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HasWalletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Password)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	l = len(m.PasswordConfirmation)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.WalletExists {
+		i--
+		if m.WalletExists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AuthResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ImportKeystoresRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportKeystoresRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportKeystoresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.TokenExpiration != 0 {
-		n += 1 + sovWebApi(uint64(m.TokenExpiration))
+	if len(m.KeystoresPassword) > 0 {
+		i -= len(m.KeystoresPassword)
+		copy(dAtA[i:], m.KeystoresPassword)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.KeystoresPassword)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.KeystoresImported) > 0 {
+		for iNdEx := len(m.KeystoresImported) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.KeystoresImported[iNdEx])
+			copy(dAtA[i:], m.KeystoresImported[iNdEx])
+			i = encodeVarintWebApi(dAtA, i, uint64(len(m.KeystoresImported[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *NodeConnectionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ImportKeystoresResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportKeystoresResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportKeystoresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BeaconNodeEndpoint)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Connected {
-		n += 2
+	if len(m.ImportedPublicKeys) > 0 {
+		for iNdEx := len(m.ImportedPublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ImportedPublicKeys[iNdEx])
+			copy(dAtA[i:], m.ImportedPublicKeys[iNdEx])
+			i = encodeVarintWebApi(dAtA, i, uint64(len(m.ImportedPublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	if m.Syncing {
-		n += 2
+	return len(dAtA) - i, nil
+}
+
+func (m *HasUsedWebResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.GenesisTime != 0 {
-		n += 1 + sovWebApi(uint64(m.GenesisTime))
+	return dAtA[:n], nil
+}
+
+func (m *HasUsedWebResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HasUsedWebResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.DepositContractAddress)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if m.HasWallet {
+		i--
+		if m.HasWallet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HasSignedUp {
+		i--
+		if m.HasSignedUp {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LogsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Logs[iNdEx])
+			copy(dAtA[i:], m.Logs[iNdEx])
+			i = encodeVarintWebApi(dAtA, i, uint64(len(m.Logs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *LogsEndpointResponse) Size() (n int) {
+func (m *BeaconStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeaconStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ChainHead != nil {
+		{
+			size, err := m.ChainHead.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWebApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DepositContractAddress) > 0 {
+		i -= len(m.DepositContractAddress)
+		copy(dAtA[i:], m.DepositContractAddress)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.DepositContractAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.GenesisTime != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.GenesisTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Syncing {
+		i--
+		if m.Syncing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Connected {
+		i--
+		if m.Connected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BeaconNodeEndpoint) > 0 {
+		i -= len(m.BeaconNodeEndpoint)
+		copy(dAtA[i:], m.BeaconNodeEndpoint)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.BeaconNodeEndpoint)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWebApi(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWebApi(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CreateWalletRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ValidatorLogsEndpoint)
+	if m.Keymanager != 0 {
+		n += 1 + sovWebApi(uint64(m.Keymanager))
+	}
+	l = len(m.WalletPassword)
 	if l > 0 {
 		n += 1 + l + sovWebApi(uint64(l))
 	}
-	l = len(m.BeaconLogsEndpoint)
+	l = len(m.Mnemonic)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.NumAccounts != 0 {
+		n += 1 + sovWebApi(uint64(m.NumAccounts))
+	}
+	l = len(m.RemoteAddr)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.RemoteCrtPath)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.RemoteKeyPath)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.RemoteCaCrtPath)
 	if l > 0 {
 		n += 1 + l + sovWebApi(uint64(l))
 	}
@@ -3883,18 +4463,14 @@ func (m *LogsEndpointResponse) Size() (n int) {
 	return n
 }
 
-func (m *VersionResponse) Size() (n int) {
+func (m *CreateWalletResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Beacon)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	l = len(m.Validator)
-	if l > 0 {
+	if m.Wallet != nil {
+		l = m.Wallet.Size()
 		n += 1 + l + sovWebApi(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -3903,21 +4479,25 @@ func (m *VersionResponse) Size() (n int) {
 	return n
 }
 
-func (m *ChangePasswordRequest) Size() (n int) {
+func (m *EditWalletConfigRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.CurrentPassword)
+	l = len(m.RemoteAddr)
 	if l > 0 {
 		n += 1 + l + sovWebApi(uint64(l))
 	}
-	l = len(m.Password)
+	l = len(m.RemoteCrtPath)
 	if l > 0 {
 		n += 1 + l + sovWebApi(uint64(l))
 	}
-	l = len(m.PasswordConfirmation)
+	l = len(m.RemoteKeyPath)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.RemoteCaCrtPath)
 	if l > 0 {
 		n += 1 + l + sovWebApi(uint64(l))
 	}
@@ -3927,14 +4507,15 @@ func (m *ChangePasswordRequest) Size() (n int) {
 	return n
 }
 
-func (m *HasWalletResponse) Size() (n int) {
+func (m *GenerateMnemonicResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.WalletExists {
-		n += 2
+	l = len(m.Mnemonic)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -3942,83 +4523,329 @@ func (m *HasWalletResponse) Size() (n int) {
 	return n
 }
 
-func (m *ImportKeystoresRequest) Size() (n int) {
+func (m *WalletResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.KeystoresImported) > 0 {
-		for _, s := range m.KeystoresImported {
-			l = len(s)
-			n += 1 + l + sovWebApi(uint64(l))
-		}
-	}
-	l = len(m.KeystoresPassword)
+	l = len(m.WalletPath)
 	if l > 0 {
 		n += 1 + l + sovWebApi(uint64(l))
 	}
+	if m.KeymanagerKind != 0 {
+		n += 1 + sovWebApi(uint64(m.KeymanagerKind))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ImportKeystoresResponse) Size() (n int) {
+func (m *ListAccountsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ImportedPublicKeys) > 0 {
-		for _, b := range m.ImportedPublicKeys {
-			l = len(b)
-			n += 1 + l + sovWebApi(uint64(l))
-		}
+	if m.GetDepositTxData {
+		n += 2
 	}
-	if m.XXX_unrecognized != nil {
+	if m.PageSize != 0 {
+		n += 1 + sovWebApi(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.All {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *HasUsedWebResponse) Size() (n int) {
+func (m *ListAccountsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.HasSignedUp {
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovWebApi(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovWebApi(uint64(m.TotalSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Account) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatingPublicKey)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.AccountName)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.DepositTxData)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.DerivationPath)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PerformanceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovWebApi(uint64(l))
+		}
+	}
+	if m.StartEpoch != 0 {
+		n += 1 + sovWebApi(uint64(m.StartEpoch))
+	}
+	if m.EndEpoch != 0 {
+		n += 1 + sovWebApi(uint64(m.EndEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PerformanceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Histories) > 0 {
+		for _, e := range m.Histories {
+			l = e.Size()
+			n += 1 + l + sovWebApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorPerformanceHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovWebApi(uint64(l))
+		}
+	}
+	if m.Summary != nil {
+		l = m.Summary.Size()
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EpochPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovWebApi(uint64(m.Epoch))
+	}
+	if m.AttestationIncluded {
 		n += 2
 	}
-	if m.HasWallet {
+	if m.AttestationLate {
+		n += 2
+	}
+	if m.InclusionSlot != 0 {
+		n += 1 + sovWebApi(uint64(m.InclusionSlot))
+	}
+	if m.InclusionDistance != 0 {
+		n += 1 + sovWebApi(uint64(m.InclusionDistance))
+	}
+	if m.CorrectlyVotedSource {
+		n += 2
+	}
+	if m.CorrectlyVotedTarget {
 		n += 2
 	}
+	if m.CorrectlyVotedHead {
+		n += 2
+	}
+	if m.ProposalsMade != 0 {
+		n += 1 + sovWebApi(uint64(m.ProposalsMade))
+	}
+	if m.ProposalsMissed != 0 {
+		n += 1 + sovWebApi(uint64(m.ProposalsMissed))
+	}
+	if m.BalanceBefore != 0 {
+		n += 1 + sovWebApi(uint64(m.BalanceBefore))
+	}
+	if m.BalanceAfter != 0 {
+		n += 1 + sovWebApi(uint64(m.BalanceAfter))
+	}
+	if m.BalanceChange != 0 {
+		n += 1 + sovWebApi(uint64(m.BalanceChange))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *LogsResponse) Size() (n int) {
+func (m *PerformanceSummary) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Logs) > 0 {
-		for _, s := range m.Logs {
-			l = len(s)
+	if m.IncludedAttestations != 0 {
+		n += 1 + sovWebApi(uint64(m.IncludedAttestations))
+	}
+	if m.MissedAttestations != 0 {
+		n += 1 + sovWebApi(uint64(m.MissedAttestations))
+	}
+	if m.LateAttestations != 0 {
+		n += 1 + sovWebApi(uint64(m.LateAttestations))
+	}
+	if m.AverageInclusionDistance != 0 {
+		n += 9
+	}
+	if m.CorrectlyVotedSource != 0 {
+		n += 1 + sovWebApi(uint64(m.CorrectlyVotedSource))
+	}
+	if m.CorrectlyVotedTarget != 0 {
+		n += 1 + sovWebApi(uint64(m.CorrectlyVotedTarget))
+	}
+	if m.CorrectlyVotedHead != 0 {
+		n += 1 + sovWebApi(uint64(m.CorrectlyVotedHead))
+	}
+	if m.ProposalsMade != 0 {
+		n += 1 + sovWebApi(uint64(m.ProposalsMade))
+	}
+	if m.ProposalsMissed != 0 {
+		n += 1 + sovWebApi(uint64(m.ProposalsMissed))
+	}
+	if m.BalanceChange != 0 {
+		n += 1 + sovWebApi(uint64(m.BalanceChange))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
 			n += 1 + l + sovWebApi(uint64(l))
 		}
 	}
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovWebApi(uint64(e))
+		}
+		n += 1 + sovWebApi(uint64(l)) + l
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *BeaconStatusResponse) Size() (n int) {
+func (m *AuthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.PasswordConfirmation)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AuthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.TokenExpiration != 0 {
+		n += 1 + sovWebApi(uint64(m.TokenExpiration))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NodeConnectionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4041,23 +4868,954 @@ func (m *BeaconStatusResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWebApi(uint64(l))
 	}
-	if m.ChainHead != nil {
-		l = m.ChainHead.Size()
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LogsEndpointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorLogsEndpoint)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.BeaconLogsEndpoint)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Beacon)
+	if l > 0 {
 		n += 1 + l + sovWebApi(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangePasswordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrentPassword)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.PasswordConfirmation)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HasWalletResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WalletExists {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImportKeystoresRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.KeystoresImported) > 0 {
+		for _, s := range m.KeystoresImported {
+			l = len(s)
+			n += 1 + l + sovWebApi(uint64(l))
+		}
+	}
+	l = len(m.KeystoresPassword)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImportKeystoresResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ImportedPublicKeys) > 0 {
+		for _, b := range m.ImportedPublicKeys {
+			l = len(b)
+			n += 1 + l + sovWebApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HasUsedWebResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasSignedUp {
+		n += 2
+	}
+	if m.HasWallet {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LogsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for _, s := range m.Logs {
+			l = len(s)
+			n += 1 + l + sovWebApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BeaconStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BeaconNodeEndpoint)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.Connected {
+		n += 2
+	}
+	if m.Syncing {
+		n += 2
+	}
+	if m.GenesisTime != 0 {
+		n += 1 + sovWebApi(uint64(m.GenesisTime))
+	}
+	l = len(m.DepositContractAddress)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.ChainHead != nil {
+		l = m.ChainHead.Size()
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovWebApi(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWebApi(x uint64) (n int) {
+	return sovWebApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CreateWalletRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateWalletRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateWalletRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keymanager", wireType)
+			}
+			m.Keymanager = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Keymanager |= KeymanagerKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletPassword", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletPassword = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mnemonic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mnemonic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumAccounts", wireType)
+			}
+			m.NumAccounts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumAccounts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCrtPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteCrtPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteKeyPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteKeyPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCaCrtPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteCaCrtPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateWalletResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateWalletResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateWalletResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wallet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Wallet == nil {
+				m.Wallet = &WalletResponse{}
+			}
+			if err := m.Wallet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EditWalletConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EditWalletConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EditWalletConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCrtPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteCrtPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteKeyPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteKeyPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCaCrtPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteCaCrtPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenerateMnemonicResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenerateMnemonicResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenerateMnemonicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mnemonic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mnemonic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *WalletResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WalletResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WalletResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeymanagerKind", wireType)
+			}
+			m.KeymanagerKind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeymanagerKind |= KeymanagerKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func sovWebApi(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozWebApi(x uint64) (n int) {
-	return sovWebApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *CreateWalletRequest) Unmarshal(dAtA []byte) error {
+func (m *ListAccountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4080,17 +5838,17 @@ func (m *CreateWalletRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateWalletRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListAccountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateWalletRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keymanager", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GetDepositTxData", wireType)
 			}
-			m.Keymanager = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -4100,16 +5858,142 @@ func (m *CreateWalletRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Keymanager |= KeymanagerKind(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.GetDepositTxData = bool(v != 0)
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WalletPassword", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.All = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -4119,27 +6003,29 @@ func (m *CreateWalletRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthWebApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthWebApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WalletPassword = string(dAtA[iNdEx:postIndex])
+			m.Accounts = append(m.Accounts, &Account{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mnemonic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4167,13 +6053,13 @@ func (m *CreateWalletRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mnemonic = string(dAtA[iNdEx:postIndex])
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumAccounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
 			}
-			m.NumAccounts = 0
+			m.TotalSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -4183,48 +6069,70 @@ func (m *CreateWalletRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumAccounts |= uint64(b&0x7F) << shift
+				m.TotalSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWebApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthWebApi
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthWebApi
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoteAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Account) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Account: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Account: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCrtPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatingPublicKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -4234,27 +6142,29 @@ func (m *CreateWalletRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthWebApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthWebApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoteCrtPath = string(dAtA[iNdEx:postIndex])
+			m.ValidatingPublicKey = append(m.ValidatingPublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatingPublicKey == nil {
+				m.ValidatingPublicKey = []byte{}
+			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteKeyPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4282,13 +6192,13 @@ func (m *CreateWalletRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoteKeyPath = string(dAtA[iNdEx:postIndex])
+			m.AccountName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCaCrtPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositTxData", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -4298,83 +6208,31 @@ func (m *CreateWalletRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthWebApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthWebApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoteCaCrtPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipWebApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CreateWalletResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowWebApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DepositTxData = append(m.DepositTxData[:0], dAtA[iNdEx:postIndex]...)
+			if m.DepositTxData == nil {
+				m.DepositTxData = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreateWalletResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateWalletResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wallet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivationPath", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -4384,27 +6242,23 @@ func (m *CreateWalletResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthWebApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthWebApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Wallet == nil {
-				m.Wallet = &WalletResponse{}
-			}
-			if err := m.Wallet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DerivationPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4431,7 +6285,7 @@ func (m *CreateWalletResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EditWalletConfigRequest) Unmarshal(dAtA []byte) error {
+func (m *PerformanceHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4454,49 +6308,17 @@ func (m *EditWalletConfigRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EditWalletConfigRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PerformanceHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EditWalletConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PerformanceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWebApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RemoteAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCrtPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -4506,29 +6328,29 @@ func (m *EditWalletConfigRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthWebApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthWebApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoteCrtPath = string(dAtA[iNdEx:postIndex])
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteKeyPath", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
 			}
-			var stringLen uint64
+			m.StartEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -4538,29 +6360,16 @@ func (m *EditWalletConfigRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RemoteKeyPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCaCrtPath", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
 			}
-			var stringLen uint64
+			m.EndEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -4570,24 +6379,11 @@ func (m *EditWalletConfigRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EndEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RemoteCaCrtPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWebApi(dAtA[iNdEx:])
@@ -4613,7 +6409,7 @@ func (m *EditWalletConfigRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenerateMnemonicResponse) Unmarshal(dAtA []byte) error {
+func (m *PerformanceHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4636,17 +6432,17 @@ func (m *GenerateMnemonicResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenerateMnemonicResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PerformanceHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenerateMnemonicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PerformanceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mnemonic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Histories", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -4656,23 +6452,25 @@ func (m *GenerateMnemonicResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthWebApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthWebApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mnemonic = string(dAtA[iNdEx:postIndex])
+			m.Histories = append(m.Histories, &ValidatorPerformanceHistory{})
+			if err := m.Histories[len(m.Histories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4699,7 +6497,7 @@ func (m *GenerateMnemonicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WalletResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorPerformanceHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4722,17 +6520,17 @@ func (m *WalletResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WalletResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorPerformanceHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WalletResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorPerformanceHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WalletPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -4742,29 +6540,31 @@ func (m *WalletResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthWebApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthWebApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WalletPath = string(dAtA[iNdEx:postIndex])
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeymanagerKind", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
 			}
-			m.KeymanagerKind = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -4774,11 +6574,62 @@ func (m *WalletResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KeymanagerKind |= KeymanagerKind(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, &EpochPerformance{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Summary == nil {
+				m.Summary = &PerformanceSummary{}
+			}
+			if err := m.Summary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWebApi(dAtA[iNdEx:])
@@ -4804,7 +6655,7 @@ func (m *WalletResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *EpochPerformance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4827,15 +6678,152 @@ func (m *ListAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochPerformance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GetDepositTxData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationIncluded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AttestationIncluded = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationLate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AttestationLate = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionSlot", wireType)
+			}
+			m.InclusionSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusionSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionDistance", wireType)
+			}
+			m.InclusionDistance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusionDistance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectlyVotedSource", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CorrectlyVotedSource = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectlyVotedTarget", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CorrectlyVotedTarget = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectlyVotedHead", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -4852,12 +6840,12 @@ func (m *ListAccountsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.GetDepositTxData = bool(v != 0)
-		case 2:
+			m.CorrectlyVotedHead = bool(v != 0)
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalsMade", wireType)
 			}
-			m.PageSize = 0
+			m.ProposalsMade = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -4867,16 +6855,16 @@ func (m *ListAccountsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PageSize |= int32(b&0x7F) << shift
+				m.ProposalsMade |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalsMissed", wireType)
 			}
-			var stringLen uint64
+			m.ProposalsMissed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -4886,29 +6874,54 @@ func (m *ListAccountsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalsMissed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthWebApi
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceBefore", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWebApi
+			m.BalanceBefore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BalanceBefore |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceAfter", wireType)
 			}
-			m.PageToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			m.BalanceAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BalanceAfter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceChange", wireType)
 			}
-			var v int
+			m.BalanceChange = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -4918,12 +6931,11 @@ func (m *ListAccountsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.BalanceChange |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.All = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWebApi(dAtA[iNdEx:])
@@ -4949,7 +6961,7 @@ func (m *ListAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *PerformanceSummary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4972,17 +6984,17 @@ func (m *ListAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PerformanceSummary: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PerformanceSummary: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludedAttestations", wireType)
 			}
-			var msglen int
+			m.IncludedAttestations = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -4992,31 +7004,16 @@ func (m *ListAccountsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.IncludedAttestations |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Accounts = append(m.Accounts, &Account{})
-			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedAttestations", wireType)
 			}
-			var stringLen uint64
+			m.MissedAttestations = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -5026,29 +7023,16 @@ func (m *ListAccountsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MissedAttestations |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NextPageToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LateAttestations", wireType)
 			}
-			m.TotalSize = 0
+			m.LateAttestations = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -5058,70 +7042,27 @@ func (m *ListAccountsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalSize |= int32(b&0x7F) << shift
+				m.LateAttestations |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipWebApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Account) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowWebApi
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageInclusionDistance", wireType)
 			}
-			if iNdEx >= l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Account: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Account: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatingPublicKey", wireType)
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.AverageInclusionDistance = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectlyVotedSource", wireType)
 			}
-			var byteLen int
+			m.CorrectlyVotedSource = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -5131,31 +7072,16 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.CorrectlyVotedSource |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatingPublicKey = append(m.ValidatingPublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValidatingPublicKey == nil {
-				m.ValidatingPublicKey = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountName", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectlyVotedTarget", wireType)
 			}
-			var stringLen uint64
+			m.CorrectlyVotedTarget = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -5165,29 +7091,16 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CorrectlyVotedTarget |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AccountName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositTxData", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectlyVotedHead", wireType)
 			}
-			var byteLen int
+			m.CorrectlyVotedHead = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -5197,31 +7110,35 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.CorrectlyVotedHead |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalsMade", wireType)
 			}
-			m.DepositTxData = append(m.DepositTxData[:0], dAtA[iNdEx:postIndex]...)
-			if m.DepositTxData == nil {
-				m.DepositTxData = []byte{}
+			m.ProposalsMade = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalsMade |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DerivationPath", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalsMissed", wireType)
 			}
-			var stringLen uint64
+			m.ProposalsMissed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -5231,24 +7148,30 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalsMissed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWebApi
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceChange", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.BalanceChange = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BalanceChange |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.DerivationPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWebApi(dAtA[iNdEx:])
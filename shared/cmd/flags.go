@@ -91,6 +91,12 @@ var (
 		Name:  "peer",
 		Usage: "Connect with this peer. This flag may be used multiple times.",
 	}
+	// TrustedPeers specifies a set of peers which are always kept connected, regardless of the peer limits.
+	TrustedPeers = &cli.StringSliceFlag{
+		Name: "trusted-peer",
+		Usage: "Connect with this peer and keep it connected, regardless of the peer limits and its score. " +
+			"The multiaddress must contain the peer ID. This flag may be used multiple times.",
+	}
 	// BootstrapNode tells the beacon node which bootstrap node to connect to
 	BootstrapNode = &cli.StringSliceFlag{
 		Name:  "bootstrap-node",
@@ -51,6 +51,7 @@ type Flags struct {
 	EnableLargerGossipHistory          bool // EnableLargerGossipHistory increases the gossip history we store in our caches.
 	WriteWalletPasswordOnWebOnboarding bool // WriteWalletPasswordOnWebOnboarding writes the password to disk after Prysm web signup.
 	DisableAttestingHistoryDBCache     bool // DisableAttestingHistoryDBCache for the validator client increases disk reads/writes.
+	EnableDoppelgangerDetection        bool // EnableDoppelgangerDetection watches the chain for messages by our keys from other instances before performing duties.
 
 	// Logging related toggles.
 	DisableGRPCConnectionLogs bool // Disables logging when a new grpc client has connected.
@@ -228,6 +229,10 @@ func ConfigureValidator(ctx *cli.Context) {
 		log.Warn("Disabled attesting history DB cache, likely increasing disk reads and writes significantly")
 		cfg.DisableAttestingHistoryDBCache = true
 	}
+	if ctx.Bool(enableDoppelgangerDetection.Name) {
+		log.Warn("Enabled doppelganger detection, validator duties will start after watching the chain for a few epochs")
+		cfg.EnableDoppelgangerDetection = true
+	}
 	cfg.EnableBlst = true
 	if ctx.Bool(disableBlst.Name) {
 		log.Warn("Disabling new BLS library blst")
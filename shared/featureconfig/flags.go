@@ -33,7 +33,9 @@ var (
 	}
 	kafkaBootstrapServersFlag = &cli.StringFlag{
 		Name:  "kafka-url",
-		Usage: "Stream attestations and blocks to specified kafka servers. This field is used for bootstrap.servers kafka config field.",
+		Usage: "Stream blocks, heads, finalized checkpoints, reorgs and received operations to specified kafka servers. " +
+			"Every event is published once by the event exporter, the database no longer publishes the blocks it saves. " +
+			"This field is used for bootstrap.servers kafka config field.",
 	}
 	enableExternalSlasherProtectionFlag = &cli.BoolFlag{
 		Name: "enable-external-slasher-protection",
@@ -91,6 +93,11 @@ var (
 		Usage: "(Danger): Writes the wallet password to the wallet directory on completing Prysm web onboarding. " +
 			"We recommend against this flag unless you are an advanced user.",
 	}
+	enableDoppelgangerDetection = &cli.BoolFlag{
+		Name: "enable-doppelganger-detection",
+		Usage: "Enables the validator to watch the chain for a few epochs after activation for attestations " +
+			"and blocks by its keys which it did not sign, and to refuse to perform duties for those keys",
+	}
 	disableAttestingHistoryDBCache = &cli.BoolFlag{
 		Name: "disable-attesting-history-db-cache",
 		Usage: "(Danger): Disables the cache for attesting history in the validator DB, greatly increasing " +
@@ -118,6 +125,7 @@ var ValidatorFlags = append(deprecatedFlags, []cli.Flag{
 	writeWalletPasswordOnWebOnboarding,
 	enableExternalSlasherProtectionFlag,
 	disableAttestingHistoryDBCache,
+	enableDoppelgangerDetection,
 	ToledoTestnet,
 	PyrmontTestnet,
 	Mainnet,
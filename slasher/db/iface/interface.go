@@ -38,6 +38,12 @@ type ReadOnlyDatabase interface {
 	// MinMaxSpan related methods.
 	EpochSpans(ctx context.Context, epoch uint64, fromCache bool) (*detectionTypes.EpochStore, error)
 
+	// Span chunk related methods.
+	SpanChunks(ctx context.Context, kind detectionTypes.ChunkKind, chunkKeys []uint64) (map[uint64][]uint16, error)
+	LatestEpochWrittenForValidatorChunk(ctx context.Context, validatorChunkIndex uint64) (uint64, bool, error)
+	HasLegacyEpochSpans(ctx context.Context) (bool, error)
+	AttestationRecords(ctx context.Context, targetEpoch uint64, validatorIndices []uint64) (map[uint64]*detectionTypes.AttestationRecord, error)
+
 	// ProposerSlashing related methods.
 	ProposalSlashingsByStatus(ctx context.Context, status types.SlashingStatus) ([]*ethpb.ProposerSlashing, error)
 	HasProposerSlashing(ctx context.Context, slashing *ethpb.ProposerSlashing) (bool, types.SlashingStatus, error)
@@ -47,6 +53,7 @@ type ReadOnlyDatabase interface {
 
 	// Chain data related methods.
 	ChainHead(ctx context.Context) (*ethpb.ChainHead, error)
+	LatestEpochReplayed(ctx context.Context) (uint64, bool, error)
 
 	// Cache management methods.
 	RemoveOldestFromCache(ctx context.Context) uint64
@@ -76,6 +83,10 @@ type WriteAccessDatabase interface {
 	// MinMaxSpan related methods.
 	SaveEpochSpans(ctx context.Context, epoch uint64, spans *detectionTypes.EpochStore, toCache bool) error
 
+	// Span chunk related methods.
+	SaveSpanChunks(ctx context.Context, validatorChunkIndex, latestEpoch uint64, chunks map[detectionTypes.ChunkKind]map[uint64][]uint16) error
+	SaveAttestationRecords(ctx context.Context, targetEpoch uint64, records map[uint64]*detectionTypes.AttestationRecord) error
+
 	// ProposerSlashing related methods.
 	DeleteProposerSlashing(ctx context.Context, slashing *ethpb.ProposerSlashing) error
 	SaveProposerSlashing(ctx context.Context, status types.SlashingStatus, slashing *ethpb.ProposerSlashing) error
@@ -87,6 +98,7 @@ type WriteAccessDatabase interface {
 
 	// Chain data related methods.
 	SaveChainHead(ctx context.Context, head *ethpb.ChainHead) error
+	SaveLatestEpochReplayed(ctx context.Context, epoch uint64) error
 }
 
 // FullAccessDatabase represents a full access database with only DB interaction functions.
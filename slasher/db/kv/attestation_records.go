@@ -0,0 +1,63 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// AttestationRecords returns the attestation records of the given validators for a target epoch.
+// Validators without a record for the target epoch are absent from the returned map.
+func (db *Store) AttestationRecords(
+	ctx context.Context,
+	targetEpoch uint64,
+	validatorIndices []uint64,
+) (map[uint64]*types.AttestationRecord, error) {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.AttestationRecords")
+	defer span.End()
+	records := make(map[uint64]*types.AttestationRecord, len(validatorIndices))
+	err := db.view(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(attestationRecordsByTargetBucket)
+		for _, idx := range validatorIndices {
+			enc := bkt.Get(encodeEpochValidatorID(targetEpoch, idx))
+			if enc == nil {
+				continue
+			}
+			record, err := types.UnmarshalAttestationRecord(enc)
+			if err != nil {
+				return errors.Wrapf(err, "could not decode attestation record of validator %d", idx)
+			}
+			records[idx] = record
+		}
+		return nil
+	})
+	return records, err
+}
+
+// SaveAttestationRecords saves the attestation records of validators for a target epoch.
+// Existing records are kept, as the first attestation seen for a target epoch is the one
+// any conflicting attestation is checked against.
+func (db *Store) SaveAttestationRecords(
+	ctx context.Context,
+	targetEpoch uint64,
+	records map[uint64]*types.AttestationRecord,
+) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.SaveAttestationRecords")
+	defer span.End()
+	return db.update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(attestationRecordsByTargetBucket)
+		for idx, record := range records {
+			key := encodeEpochValidatorID(targetEpoch, idx)
+			if bkt.Get(key) != nil {
+				continue
+			}
+			if err := bkt.Put(key, record.Marshal()); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
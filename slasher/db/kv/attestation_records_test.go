@@ -0,0 +1,48 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
+)
+
+func TestStore_AttestationRecords(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	first := &types.AttestationRecord{SigningRoot: [32]byte{1}, SigBytes: [2]byte{1, 2}}
+	second := &types.AttestationRecord{SigningRoot: [32]byte{2}, SigBytes: [2]byte{3, 4}}
+	require.NoError(t, db.SaveAttestationRecords(ctx, 5, map[uint64]*types.AttestationRecord{1: first}))
+	// Existing records are not overwritten.
+	require.NoError(t, db.SaveAttestationRecords(ctx, 5, map[uint64]*types.AttestationRecord{1: second, 2: second}))
+
+	records, err := db.AttestationRecords(ctx, 5, []uint64{1, 2, 3})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(records))
+	assert.DeepEqual(t, first, records[1])
+	assert.DeepEqual(t, second, records[2])
+
+	records, err = db.AttestationRecords(ctx, 6, []uint64{1, 2})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(records))
+}
+
+func TestStore_PruneAttHistory_AttestationRecords(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	record := &types.AttestationRecord{SigningRoot: [32]byte{1}}
+	for epoch := uint64(1); epoch <= 10; epoch++ {
+		require.NoError(t, db.SaveAttestationRecords(ctx, epoch, map[uint64]*types.AttestationRecord{1: record}))
+	}
+	require.NoError(t, db.PruneAttHistory(ctx, 10, 5))
+
+	for epoch := uint64(1); epoch <= 10; epoch++ {
+		records, err := db.AttestationRecords(ctx, epoch, []uint64{1})
+		require.NoError(t, err)
+		assert.Equal(t, epoch > 5, len(records) == 1, "Unexpected records for epoch %d", epoch)
+	}
+}
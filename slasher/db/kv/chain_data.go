@@ -6,6 +6,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	bolt "go.etcd.io/bbolt"
 	"go.opencensus.io/trace"
 )
@@ -45,3 +46,31 @@ func (db *Store) SaveChainHead(ctx context.Context, head *ethpb.ChainHead) error
 		return err
 	})
 }
+
+// LatestEpochReplayed returns the latest epoch of a beacon node database replayed through
+// detection, and whether any epoch was replayed at all.
+func (db *Store) LatestEpochReplayed(ctx context.Context) (uint64, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.LatestEpochReplayed")
+	defer span.End()
+	var epoch uint64
+	var exists bool
+	err := db.view(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(chainDataBucket).Get([]byte(latestEpochReplayedKey))
+		if enc == nil {
+			return nil
+		}
+		epoch = bytesutil.FromBytes8(enc)
+		exists = true
+		return nil
+	})
+	return epoch, exists, err
+}
+
+// SaveLatestEpochReplayed checkpoints the latest epoch of a beacon node database replayed through detection.
+func (db *Store) SaveLatestEpochReplayed(ctx context.Context, epoch uint64) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.SaveLatestEpochReplayed")
+	defer span.End()
+	return db.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(chainDataBucket).Put([]byte(latestEpochReplayedKey), bytesutil.Bytes8(epoch))
+	})
+}
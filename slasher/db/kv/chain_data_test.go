@@ -46,3 +46,23 @@ func TestChainHead(t *testing.T) {
 		assert.DeepEqual(t, tt.head, head)
 	}
 }
+
+func TestLatestEpochReplayed(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	_, exists, err := db.LatestEpochReplayed(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, false, exists)
+
+	require.NoError(t, db.SaveLatestEpochReplayed(ctx, 0))
+	epoch, exists, err := db.LatestEpochReplayed(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, uint64(0), epoch)
+
+	require.NoError(t, db.SaveLatestEpochReplayed(ctx, 42))
+	epoch, _, err = db.LatestEpochReplayed(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), epoch)
+}
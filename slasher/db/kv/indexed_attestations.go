@@ -179,6 +179,13 @@ func (db *Store) PruneAttHistory(ctx context.Context, currentEpoch, pruningEpoch
 				return errors.Wrap(err, "failed to delete indexed attestation from historical bucket")
 			}
 		}
+		recordsBucket := tx.Bucket(attestationRecordsByTargetBucket)
+		c = recordsBucket.Cursor()
+		for k, _ := c.First(); k != nil && bytes.Compare(k[:8], max) <= 0; k, _ = c.Next() {
+			if err := recordsBucket.Delete(k); err != nil {
+				return errors.Wrap(err, "failed to delete attestation record")
+			}
+		}
 		return nil
 	})
 }
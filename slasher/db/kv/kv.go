@@ -134,6 +134,9 @@ func NewKVStore(dirPath string, cfg *Config) (*Store, error) {
 			validatorsPublicKeysBucket,
 			validatorsMinMaxSpanBucket,
 			validatorsMinMaxSpanBucketNew,
+			spanChunksBucket,
+			spanChunksLatestEpochBucket,
+			attestationRecordsByTargetBucket,
 			slashingBucket,
 			chainDataBucket,
 			highestAttestationBucket,
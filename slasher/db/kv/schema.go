@@ -3,11 +3,13 @@ package kv
 import (
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/slasher/db/types"
+	detectionTypes "github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
 )
 
 const (
-	latestEpochKey = "LATEST_EPOCH_DETECTED"
-	chainHeadKey   = "CHAIN_HEAD"
+	latestEpochKey         = "LATEST_EPOCH_DETECTED"
+	chainHeadKey           = "CHAIN_HEAD"
+	latestEpochReplayedKey = "LATEST_EPOCH_REPLAYED"
 )
 
 var (
@@ -26,6 +28,11 @@ var (
 	// see https://github.com/protolambda/eth2-surround/blob/master/README.md#min-max-surround
 	validatorsMinMaxSpanBucket    = []byte("validators-min-max-span-bucket")
 	validatorsMinMaxSpanBucketNew = []byte("validators-min-max-span-bucket-new")
+	// Chunked min and max spans, stored as compressed 2D chunks of validators by epochs,
+	// along with the latest epoch written for each chunk of validators.
+	spanChunksBucket                 = []byte("span-chunks-bucket")
+	spanChunksLatestEpochBucket      = []byte("span-chunks-latest-epoch-bucket")
+	attestationRecordsByTargetBucket = []byte("attestation-records-by-target-bucket")
 )
 
 func encodeSlotValidatorID(slot, validatorID uint64) []byte {
@@ -39,6 +46,14 @@ func encodeSlotValidatorIDSig(slot, validatorID uint64, sig []byte) []byte {
 func encodeEpochSig(targetEpoch uint64, sig []byte) []byte {
 	return append(bytesutil.Bytes8(targetEpoch), sig...)
 }
+func encodeChunkKey(kind detectionTypes.ChunkKind, chunkKey uint64) []byte {
+	return append([]byte{byte(kind)}, bytesutil.Bytes8(chunkKey)...)
+}
+
+func encodeEpochValidatorID(epoch, validatorID uint64) []byte {
+	return append(bytesutil.Bytes8(epoch), bytesutil.Bytes8(validatorID)...)
+}
+
 func encodeType(st types.SlashingType) []byte {
 	return []byte{byte(st)}
 }
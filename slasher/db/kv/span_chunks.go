@@ -0,0 +1,129 @@
+package kv
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SpanChunks returns the min or max span chunks stored under the given chunk keys.
+// Chunks which were never written are absent from the returned map.
+func (db *Store) SpanChunks(ctx context.Context, kind types.ChunkKind, chunkKeys []uint64) (map[uint64][]uint16, error) {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.SpanChunks")
+	defer span.End()
+	chunks := make(map[uint64][]uint16, len(chunkKeys))
+	err := db.view(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(spanChunksBucket)
+		for _, chunkKey := range chunkKeys {
+			enc := bkt.Get(encodeChunkKey(kind, chunkKey))
+			if enc == nil {
+				continue
+			}
+			chunk, err := decodeSpanChunk(enc)
+			if err != nil {
+				return errors.Wrapf(err, "could not decode span chunk %d", chunkKey)
+			}
+			chunks[chunkKey] = chunk
+		}
+		return nil
+	})
+	return chunks, err
+}
+
+// SaveSpanChunks persists min and max span chunks keyed by chunk key, along with the
+// latest epoch written for the chunk of validators they belong to, in a single transaction.
+func (db *Store) SaveSpanChunks(
+	ctx context.Context,
+	validatorChunkIndex uint64,
+	latestEpoch uint64,
+	chunks map[types.ChunkKind]map[uint64][]uint16,
+) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.SaveSpanChunks")
+	defer span.End()
+	return db.update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(spanChunksBucket)
+		for kind, kindChunks := range chunks {
+			for chunkKey, chunk := range kindChunks {
+				if err := bkt.Put(encodeChunkKey(kind, chunkKey), encodeSpanChunk(chunk)); err != nil {
+					return err
+				}
+			}
+		}
+		return tx.Bucket(spanChunksLatestEpochBucket).Put(
+			bytesutil.Bytes8(validatorChunkIndex),
+			bytesutil.Bytes8(latestEpoch),
+		)
+	})
+}
+
+// LatestEpochWrittenForValidatorChunk returns the latest epoch for which the span chunks
+// of a chunk of validators were written, and whether any were written at all.
+func (db *Store) LatestEpochWrittenForValidatorChunk(ctx context.Context, validatorChunkIndex uint64) (uint64, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.LatestEpochWrittenForValidatorChunk")
+	defer span.End()
+	var epoch uint64
+	var exists bool
+	err := db.view(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(spanChunksLatestEpochBucket).Get(bytesutil.Bytes8(validatorChunkIndex))
+		if enc == nil {
+			return nil
+		}
+		epoch = bytesutil.FromBytes8(enc)
+		exists = true
+		return nil
+	})
+	return epoch, exists, err
+}
+
+// HasLegacyEpochSpans returns whether the database holds min-max spans stored per epoch by
+// previous versions of the slasher, but no span chunks. Those spans cannot be converted into
+// span chunks, as these require the attestation records the spans were computed from.
+func (db *Store) HasLegacyEpochSpans(ctx context.Context) (bool, error) {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.HasLegacyEpochSpans")
+	defer span.End()
+	var legacy bool
+	err := db.view(func(tx *bolt.Tx) error {
+		if k, _ := tx.Bucket(spanChunksLatestEpochBucket).Cursor().First(); k != nil {
+			return nil
+		}
+		for _, bucket := range [][]byte{validatorsMinMaxSpanBucket, validatorsMinMaxSpanBucketNew} {
+			if b := tx.Bucket(bucket); b != nil {
+				if k, _ := b.Cursor().First(); k != nil {
+					legacy = true
+					return nil
+				}
+			}
+		}
+		return nil
+	})
+	return legacy, err
+}
+
+func encodeSpanChunk(chunk []uint16) []byte {
+	enc := make([]byte, len(chunk)*2)
+	for i, s := range chunk {
+		binary.LittleEndian.PutUint16(enc[i*2:], s)
+	}
+	return snappy.Encode(nil, enc)
+}
+
+func decodeSpanChunk(enc []byte) ([]uint16, error) {
+	dec, err := snappy.Decode(nil, enc)
+	if err != nil {
+		return nil, err
+	}
+	if len(dec)%2 != 0 {
+		return nil, errors.New("wrong data length for span chunk")
+	}
+	chunk := make([]uint16, len(dec)/2)
+	for i := range chunk {
+		chunk[i] = binary.LittleEndian.Uint16(dec[i*2:])
+	}
+	return chunk, nil
+}
@@ -0,0 +1,76 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
+)
+
+func TestStore_SpanChunks(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	_, exists, err := db.LatestEpochWrittenForValidatorChunk(ctx, 3)
+	require.NoError(t, err)
+	assert.Equal(t, false, exists)
+
+	minChunk := []uint16{65535, 1, 2, 65535}
+	maxChunk := []uint16{0, 4, 0, 3}
+	require.NoError(t, db.SaveSpanChunks(ctx, 3, 10, map[types.ChunkKind]map[uint64][]uint16{
+		types.MinSpan: {7: minChunk},
+		types.MaxSpan: {7: maxChunk},
+	}))
+
+	epoch, exists, err := db.LatestEpochWrittenForValidatorChunk(ctx, 3)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, uint64(10), epoch)
+
+	chunks, err := db.SpanChunks(ctx, types.MinSpan, []uint64{7, 8})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(chunks))
+	assert.DeepEqual(t, minChunk, chunks[7])
+
+	chunks, err = db.SpanChunks(ctx, types.MaxSpan, []uint64{7})
+	require.NoError(t, err)
+	assert.DeepEqual(t, maxChunk, chunks[7])
+}
+
+func TestSpanChunk_EncodeDecode(t *testing.T) {
+	chunk := make([]uint16, 4096)
+	for i := range chunk {
+		chunk[i] = uint16(i % 7)
+	}
+	enc := encodeSpanChunk(chunk)
+	assert.Equal(t, true, len(enc) < len(chunk)*2, "Expected chunk to be compressed")
+	dec, err := decodeSpanChunk(enc)
+	require.NoError(t, err)
+	assert.DeepEqual(t, chunk, dec)
+}
+
+func TestStore_HasLegacyEpochSpans(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	legacy, err := db.HasLegacyEpochSpans(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, false, legacy, "Empty database should not hold legacy spans")
+
+	es, err := types.NewEpochStore([]byte{})
+	require.NoError(t, err)
+	es, err = es.SetValidatorSpan(1, types.Span{MinSpan: 1, MaxSpan: 2})
+	require.NoError(t, err)
+	require.NoError(t, db.SaveEpochSpans(ctx, 2, es, false))
+	legacy, err = db.HasLegacyEpochSpans(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, legacy)
+
+	// A database holding span chunks was already synced by this version of the slasher.
+	require.NoError(t, db.SaveSpanChunks(ctx, 0, 2, map[types.ChunkKind]map[uint64][]uint16{}))
+	legacy, err = db.HasLegacyEpochSpans(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, false, legacy)
+}
@@ -0,0 +1,391 @@
+// Package attestations defines an implementation of a
+// slashable attestation detector using min-max surround vote checking.
+package attestations
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/slasher/db"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/iface"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
+	"go.opencensus.io/trace"
+)
+
+var (
+	attestationsOutsideSpanHistory = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_attestations_outside_span_history_total",
+		Help: "The number of attestations too old for the history of chunked min-max spans",
+	})
+	spanChunkBatchSize = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "slasher_span_chunk_batch_size",
+		Help: "The number of attestations in the latest batch processed by the chunked span detector",
+	})
+	sourceLargerThenTargetObserved = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "attestation_source_larger_then_target",
+		Help: "The number of attestation data source epoch that aren larger then target epoch.",
+	})
+)
+
+var _ iface.SpanDetector = (*ChunkedSpanDetector)(nil)
+
+// ChunkedSpanDetector detects surround votes using min-max spans stored as compressed
+// 2D chunks of validators by epochs, and double votes using an attestation record per
+// validator and target epoch. Attestations are processed in batches, so that every
+// chunk touched by a batch is read and written once rather than once per attestation.
+type ChunkedSpanDetector struct {
+	slasherDB db.Database
+	params    *Parameters
+}
+
+// NewChunkedSpanDetector creates a chunked span detector with the given chunk layout,
+// or the default one if none is given.
+func NewChunkedSpanDetector(db db.Database, params *Parameters) (*ChunkedSpanDetector, error) {
+	if params == nil {
+		params = DefaultParams()
+	}
+	if err := params.validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid span chunk parameters")
+	}
+	return &ChunkedSpanDetector{
+		slasherDB: db,
+		params:    params,
+	}, nil
+}
+
+// Identifies an attesting validator of an attestation within a batch.
+type validatorAttestation struct {
+	attIdx       int
+	validatorIdx uint64
+}
+
+// Source and target epochs of an attestation, ordered.
+type attestationEpochs struct {
+	source uint64
+	target uint64
+}
+
+// DetectSlashingsForAttestation detects slashable offenses of the attesting validators
+// of an attestation without updating their spans.
+func (s *ChunkedSpanDetector) DetectSlashingsForAttestation(
+	ctx context.Context,
+	att *ethpb.IndexedAttestation,
+) ([]*types.DetectionResult, error) {
+	results, err := s.process(ctx, 0, []*ethpb.IndexedAttestation{att}, true /* detect */, false /* update */)
+	if err != nil {
+		return nil, err
+	}
+	return results[0], nil
+}
+
+// UpdateSpans updates the spans and attestation records of the attesting validators of an attestation.
+func (s *ChunkedSpanDetector) UpdateSpans(ctx context.Context, att *ethpb.IndexedAttestation) error {
+	_, err := s.process(ctx, 0, []*ethpb.IndexedAttestation{att}, false /* detect */, true /* update */)
+	return err
+}
+
+// DetectAndUpdateSpans processes a batch of attestations in order, detecting the slashable offenses
+// of each against the history including the attestations before it in the batch. The returned
+// results are indexed like the attestations.
+func (s *ChunkedSpanDetector) DetectAndUpdateSpans(
+	ctx context.Context,
+	currentEpoch uint64,
+	atts []*ethpb.IndexedAttestation,
+) ([][]*types.DetectionResult, error) {
+	ctx, span := trace.StartSpan(ctx, "chunkedSpanner.DetectAndUpdateSpans")
+	defer span.End()
+	spanChunkBatchSize.Set(float64(len(atts)))
+	return s.process(ctx, currentEpoch, atts, true /* detect */, true /* update */)
+}
+
+func (s *ChunkedSpanDetector) process(
+	ctx context.Context,
+	currentEpoch uint64,
+	atts []*ethpb.IndexedAttestation,
+	detect, update bool,
+) ([][]*types.DetectionResult, error) {
+	epochs := make([]attestationEpochs, len(atts))
+	byValidatorChunk := make(map[uint64][]validatorAttestation)
+	var validatorChunkIndices []uint64
+	for i, att := range atts {
+		if att.Data == nil || att.Data.Source == nil || att.Data.Target == nil {
+			return nil, errors.New("attestation is missing data")
+		}
+		source, target := att.Data.Source.Epoch, att.Data.Target.Epoch
+		// Handle source > target like the surround vote it is slashable as.
+		if source > target {
+			source, target = target, source
+			sourceLargerThenTargetObserved.Inc()
+		}
+		epochs[i] = attestationEpochs{source: source, target: target}
+		if target > currentEpoch {
+			currentEpoch = target
+		}
+		for _, idx := range att.AttestingIndices {
+			validatorChunkIdx := s.params.validatorChunkIndex(idx)
+			if _, ok := byValidatorChunk[validatorChunkIdx]; !ok {
+				validatorChunkIndices = append(validatorChunkIndices, validatorChunkIdx)
+			}
+			byValidatorChunk[validatorChunkIdx] = append(
+				byValidatorChunk[validatorChunkIdx],
+				validatorAttestation{attIdx: i, validatorIdx: idx},
+			)
+		}
+	}
+
+	doubleVotes, err := s.checkAndRecordDoubleVotes(ctx, atts, detect, update)
+	if err != nil {
+		return nil, err
+	}
+	results := make([][]*types.DetectionResult, len(atts))
+	for _, validatorChunkIdx := range validatorChunkIndices {
+		if ctx.Err() != nil {
+			return nil, errors.Wrap(ctx.Err(), "could not process span chunks")
+		}
+		if err := s.processValidatorChunk(
+			ctx,
+			validatorChunkIdx,
+			currentEpoch,
+			byValidatorChunk[validatorChunkIdx],
+			epochs,
+			doubleVotes,
+			results,
+			detect,
+			update,
+		); err != nil {
+			return nil, errors.Wrapf(err, "could not process spans of validator chunk %d", validatorChunkIdx)
+		}
+	}
+	return results, nil
+}
+
+// processValidatorChunk detects surround votes of and updates the spans of the validators of a
+// single validator chunk, falling back to any double vote found for a validator attestation.
+func (s *ChunkedSpanDetector) processValidatorChunk(
+	ctx context.Context,
+	validatorChunkIdx uint64,
+	currentEpoch uint64,
+	validatorAtts []validatorAttestation,
+	epochs []attestationEpochs,
+	doubleVotes map[validatorAttestation]*types.DetectionResult,
+	results [][]*types.DetectionResult,
+	detect, update bool,
+) error {
+	spans := newValidatorChunkSpans(s.params, s.slasherDB, validatorChunkIdx)
+	latestEpoch, exists, err := s.slasherDB.LatestEpochWrittenForValidatorChunk(ctx, validatorChunkIdx)
+	if err != nil {
+		return err
+	}
+	if exists && latestEpoch > currentEpoch {
+		currentEpoch = latestEpoch
+	}
+	if exists && latestEpoch < currentEpoch {
+		if err := spans.resetEpochs(ctx, latestEpoch+1, currentEpoch); err != nil {
+			return err
+		}
+	}
+	var minEpoch uint64
+	if currentEpoch >= s.params.HistoryLength {
+		minEpoch = currentEpoch + 1 - s.params.HistoryLength
+	}
+
+	for _, va := range validatorAtts {
+		attEpochs := epochs[va.attIdx]
+		if attEpochs.source < minEpoch {
+			attestationsOutsideSpanHistory.Inc()
+			continue
+		}
+		if detect {
+			result, err := s.checkSurroundVote(ctx, spans, va.validatorIdx, attEpochs)
+			if err != nil {
+				return err
+			}
+			if result == nil {
+				result = doubleVotes[va]
+			}
+			if result != nil {
+				results[va.attIdx] = append(results[va.attIdx], result)
+			}
+		}
+		if update {
+			if err := s.updateMinSpan(ctx, spans, va.validatorIdx, attEpochs, minEpoch); err != nil {
+				return err
+			}
+			if err := s.updateMaxSpan(ctx, spans, va.validatorIdx, attEpochs); err != nil {
+				return err
+			}
+		}
+	}
+	if !update {
+		return nil
+	}
+	return spans.save(ctx, currentEpoch)
+}
+
+// checkSurroundVote uses the min span of a validator at the source epoch of an attestation to
+// find an attestation it surrounds, and the max span to find an attestation surrounding it.
+func (s *ChunkedSpanDetector) checkSurroundVote(
+	ctx context.Context,
+	spans *validatorChunkSpans,
+	validatorIdx uint64,
+	attEpochs attestationEpochs,
+) (*types.DetectionResult, error) {
+	distance := attEpochs.target - attEpochs.source
+	minSpan, err := spans.span(ctx, types.MinSpan, validatorIdx, attEpochs.source)
+	if err != nil {
+		return nil, err
+	}
+	slashableEpoch := uint64(0)
+	if minSpan != neutralSpan(types.MinSpan) && uint64(minSpan) < distance {
+		slashableEpoch = attEpochs.source + uint64(minSpan)
+	} else {
+		maxSpan, err := spans.span(ctx, types.MaxSpan, validatorIdx, attEpochs.source)
+		if err != nil {
+			return nil, err
+		}
+		if uint64(maxSpan) <= distance {
+			return nil, nil
+		}
+		slashableEpoch = attEpochs.source + uint64(maxSpan)
+	}
+	records, err := s.slasherDB.AttestationRecords(ctx, slashableEpoch, []uint64{validatorIdx})
+	if err != nil {
+		return nil, err
+	}
+	var sigBytes [2]byte
+	if record, ok := records[validatorIdx]; ok {
+		sigBytes = record.SigBytes
+	}
+	return &types.DetectionResult{
+		ValidatorIndex: validatorIdx,
+		Kind:           types.SurroundVote,
+		SlashableEpoch: slashableEpoch,
+		SigBytes:       sigBytes,
+	}, nil
+}
+
+// Updates the min spans of a validator for the epochs before the source epoch of an attestation,
+// stopping at the first epoch whose min span is already lower, as are all the ones before it.
+func (s *ChunkedSpanDetector) updateMinSpan(
+	ctx context.Context,
+	spans *validatorChunkSpans,
+	validatorIdx uint64,
+	attEpochs attestationEpochs,
+	minEpoch uint64,
+) error {
+	for epoch := attEpochs.source; epoch > minEpoch; {
+		epoch--
+		newMinSpan := uint16(attEpochs.target - epoch)
+		minSpan, err := spans.span(ctx, types.MinSpan, validatorIdx, epoch)
+		if err != nil {
+			return err
+		}
+		if minSpan <= newMinSpan {
+			break
+		}
+		if err := spans.setSpan(ctx, types.MinSpan, validatorIdx, epoch, newMinSpan); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Updates the max spans of a validator for the epochs between the source and target epochs of an
+// attestation, stopping at the first epoch whose max span is already higher, as are all the ones after it.
+func (s *ChunkedSpanDetector) updateMaxSpan(
+	ctx context.Context,
+	spans *validatorChunkSpans,
+	validatorIdx uint64,
+	attEpochs attestationEpochs,
+) error {
+	for epoch := attEpochs.source + 1; epoch < attEpochs.target; epoch++ {
+		newMaxSpan := uint16(attEpochs.target - epoch)
+		maxSpan, err := spans.span(ctx, types.MaxSpan, validatorIdx, epoch)
+		if err != nil {
+			return err
+		}
+		if maxSpan >= newMaxSpan {
+			break
+		}
+		if err := spans.setSpan(ctx, types.MaxSpan, validatorIdx, epoch, newMaxSpan); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkAndRecordDoubleVotes compares the attestations of a batch against the attestation records
+// of their validators for the same target epoch, including records created earlier in the batch.
+func (s *ChunkedSpanDetector) checkAndRecordDoubleVotes(
+	ctx context.Context,
+	atts []*ethpb.IndexedAttestation,
+	detect, update bool,
+) (map[validatorAttestation]*types.DetectionResult, error) {
+	byTarget := make(map[uint64][]int)
+	var targets []uint64
+	for i, att := range atts {
+		target := att.Data.Target.Epoch
+		if _, ok := byTarget[target]; !ok {
+			targets = append(targets, target)
+		}
+		byTarget[target] = append(byTarget[target], i)
+	}
+
+	doubleVotes := make(map[validatorAttestation]*types.DetectionResult)
+	for _, target := range targets {
+		var indices []uint64
+		for _, i := range byTarget[target] {
+			indices = append(indices, atts[i].AttestingIndices...)
+		}
+		records, err := s.slasherDB.AttestationRecords(ctx, target, indices)
+		if err != nil {
+			return nil, err
+		}
+		newRecords := make(map[uint64]*types.AttestationRecord)
+		for _, i := range byTarget[target] {
+			record, err := attestationRecord(atts[i])
+			if err != nil {
+				return nil, err
+			}
+			for _, idx := range atts[i].AttestingIndices {
+				existing, ok := records[idx]
+				if !ok {
+					if update {
+						records[idx] = record
+						newRecords[idx] = record
+					}
+					continue
+				}
+				if detect && existing.SigningRoot != record.SigningRoot {
+					doubleVotes[validatorAttestation{attIdx: i, validatorIdx: idx}] = &types.DetectionResult{
+						ValidatorIndex: idx,
+						Kind:           types.DoubleVote,
+						SlashableEpoch: target,
+						SigBytes:       existing.SigBytes,
+					}
+				}
+			}
+		}
+		if len(newRecords) > 0 {
+			if err := s.slasherDB.SaveAttestationRecords(ctx, target, newRecords); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return doubleVotes, nil
+}
+
+func attestationRecord(att *ethpb.IndexedAttestation) (*types.AttestationRecord, error) {
+	root, err := att.Data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attestation data root")
+	}
+	record := &types.AttestationRecord{SigningRoot: root}
+	if len(att.Signature) > 1 {
+		record.SigBytes = [2]byte{att.Signature[0], att.Signature[1]}
+	}
+	return record, nil
+}
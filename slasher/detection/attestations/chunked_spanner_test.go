@@ -0,0 +1,231 @@
+package attestations
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
+)
+
+func chunkedAttestation(source, target uint64, blockRoot byte, indices ...uint64) *ethpb.IndexedAttestation {
+	return &ethpb.IndexedAttestation{
+		AttestingIndices: indices,
+		Data: &ethpb.AttestationData{
+			BeaconBlockRoot: bytesutil.PadTo([]byte{blockRoot}, 32),
+			Source:          &ethpb.Checkpoint{Epoch: source, Root: make([]byte, 32)},
+			Target:          &ethpb.Checkpoint{Epoch: target, Root: make([]byte, 32)},
+		},
+		Signature: bytesutil.PadTo([]byte{blockRoot, byte(target)}, 96),
+	}
+}
+
+func TestParameters_Validate(t *testing.T) {
+	require.NoError(t, DefaultParams().validate())
+	assert.ErrorContains(t, "multiple of the chunk size", (&Parameters{
+		ChunkSize:          3,
+		ValidatorChunkSize: 1,
+		HistoryLength:      8,
+	}).validate())
+	assert.ErrorContains(t, "smaller than the maximum uint16", (&Parameters{
+		ChunkSize:          1,
+		ValidatorChunkSize: 1,
+		HistoryLength:      1 << 16,
+	}).validate())
+	_, err := NewChunkedSpanDetector(testDB.SetupSlasherDB(t, false), &Parameters{})
+	assert.ErrorContains(t, "invalid span chunk parameters", err)
+}
+
+func TestChunkedSpanDetector_DetectAndUpdateSpans(t *testing.T) {
+	tests := []struct {
+		name           string
+		saved          []*ethpb.IndexedAttestation
+		incoming       *ethpb.IndexedAttestation
+		kind           types.DetectionKind
+		slashableEpoch uint64
+		detected       bool
+	}{
+		{
+			name:           "surrounding vote",
+			saved:          []*ethpb.IndexedAttestation{chunkedAttestation(3, 4, 1, 5)},
+			incoming:       chunkedAttestation(2, 5, 1, 5),
+			kind:           types.SurroundVote,
+			slashableEpoch: 4,
+			detected:       true,
+		},
+		{
+			name:           "surrounded vote",
+			saved:          []*ethpb.IndexedAttestation{chunkedAttestation(1, 8, 1, 5)},
+			incoming:       chunkedAttestation(3, 6, 1, 5),
+			kind:           types.SurroundVote,
+			slashableEpoch: 8,
+			detected:       true,
+		},
+		{
+			name:           "double vote",
+			saved:          []*ethpb.IndexedAttestation{chunkedAttestation(1, 2, 1, 5)},
+			incoming:       chunkedAttestation(1, 2, 2, 5),
+			kind:           types.DoubleVote,
+			slashableEpoch: 2,
+			detected:       true,
+		},
+		{
+			name:     "same attestation",
+			saved:    []*ethpb.IndexedAttestation{chunkedAttestation(1, 2, 1, 5)},
+			incoming: chunkedAttestation(1, 2, 1, 5),
+		},
+		{
+			name:     "different validator",
+			saved:    []*ethpb.IndexedAttestation{chunkedAttestation(3, 4, 1, 5)},
+			incoming: chunkedAttestation(2, 5, 1, 6),
+		},
+		{
+			name:     "consecutive votes",
+			saved:    []*ethpb.IndexedAttestation{chunkedAttestation(1, 2, 1, 5), chunkedAttestation(2, 3, 1, 5)},
+			incoming: chunkedAttestation(3, 4, 1, 5),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			sd, err := NewChunkedSpanDetector(testDB.SetupSlasherDB(t, false), DefaultParams())
+			require.NoError(t, err)
+			for _, att := range tt.saved {
+				require.NoError(t, sd.UpdateSpans(ctx, att))
+			}
+			results, err := sd.DetectSlashingsForAttestation(ctx, tt.incoming)
+			require.NoError(t, err)
+			if !tt.detected {
+				require.Equal(t, 0, len(results))
+				return
+			}
+			require.Equal(t, 1, len(results))
+			assert.Equal(t, tt.kind, results[0].Kind)
+			assert.Equal(t, tt.slashableEpoch, results[0].SlashableEpoch)
+			assert.Equal(t, uint64(5), results[0].ValidatorIndex)
+			// The signature bytes must point to the saved attestation.
+			var sigBytes [2]byte
+			for _, att := range tt.saved {
+				if att.Data.Target.Epoch == tt.slashableEpoch {
+					copy(sigBytes[:], att.Signature[:2])
+				}
+			}
+			assert.Equal(t, sigBytes, results[0].SigBytes)
+		})
+	}
+}
+
+func TestChunkedSpanDetector_ConflictsWithinBatch(t *testing.T) {
+	ctx := context.Background()
+	sd, err := NewChunkedSpanDetector(testDB.SetupSlasherDB(t, false), DefaultParams())
+	require.NoError(t, err)
+	batch := []*ethpb.IndexedAttestation{
+		chunkedAttestation(3, 4, 1, 1, 300),
+		chunkedAttestation(3, 4, 2, 300),
+		chunkedAttestation(2, 5, 1, 1),
+	}
+	results, err := sd.DetectAndUpdateSpans(ctx, 5, batch)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(results))
+	assert.Equal(t, 0, len(results[0]))
+	require.Equal(t, 1, len(results[1]))
+	assert.Equal(t, types.DoubleVote, results[1][0].Kind)
+	assert.Equal(t, uint64(300), results[1][0].ValidatorIndex)
+	require.Equal(t, 1, len(results[2]))
+	assert.Equal(t, types.SurroundVote, results[2][0].Kind)
+	assert.Equal(t, uint64(4), results[2][0].SlashableEpoch)
+}
+
+func TestChunkedSpanDetector_ResetsSpansOutsideHistory(t *testing.T) {
+	ctx := context.Background()
+	sd, err := NewChunkedSpanDetector(testDB.SetupSlasherDB(t, false), &Parameters{
+		ChunkSize:          2,
+		ValidatorChunkSize: 2,
+		HistoryLength:      8,
+	})
+	require.NoError(t, err)
+	// Sets the max spans of epochs 1 to 5, whose cells are reused by epochs 9 to 13.
+	results, err := sd.DetectAndUpdateSpans(ctx, 6, []*ethpb.IndexedAttestation{chunkedAttestation(0, 6, 1, 1)})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(results[0]))
+
+	results, err = sd.DetectAndUpdateSpans(ctx, 12, []*ethpb.IndexedAttestation{chunkedAttestation(9, 10, 1, 1)})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(results[0]), "Expected no slashing from spans outside the history")
+
+	// Attestations older than the history are ignored.
+	results, err = sd.DetectAndUpdateSpans(ctx, 12, []*ethpb.IndexedAttestation{chunkedAttestation(1, 12, 1, 1)})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(results[0]))
+}
+
+// Compares the chunked detector against a brute force search over all previous attestations.
+func TestChunkedSpanDetector_MatchesBruteForce(t *testing.T) {
+	ctx := context.Background()
+	sd, err := NewChunkedSpanDetector(testDB.SetupSlasherDB(t, false), &Parameters{
+		ChunkSize:          4,
+		ValidatorChunkSize: 3,
+		HistoryLength:      64,
+	})
+	require.NoError(t, err)
+	r := rand.New(rand.NewSource(1))
+	const numValidators = 10
+	var history []*ethpb.IndexedAttestation
+	for target := uint64(1); target < 48; target++ {
+		var batch []*ethpb.IndexedAttestation
+		for i := 0; i < 6; i++ {
+			source := target - uint64(r.Intn(int(min(target, 8))+1))
+			if source == target {
+				source--
+			}
+			batch = append(batch, chunkedAttestation(source, target, byte(r.Intn(2)), uint64(r.Intn(numValidators))))
+		}
+		results, err := sd.DetectAndUpdateSpans(ctx, target, batch)
+		require.NoError(t, err)
+		for i, att := range batch {
+			kind, slashable := bruteForceDetection(history, att)
+			history = append(history, att)
+			if !slashable {
+				assert.Equal(t, 0, len(results[i]), "Unexpected slashing for attestation %d->%d", att.Data.Source.Epoch, target)
+				continue
+			}
+			require.Equal(t, 1, len(results[i]), "Missed slashing for attestation %d->%d", att.Data.Source.Epoch, target)
+			assert.Equal(t, kind, results[i][0].Kind)
+		}
+	}
+}
+
+func bruteForceDetection(history []*ethpb.IndexedAttestation, att *ethpb.IndexedAttestation) (types.DetectionKind, bool) {
+	idx := att.AttestingIndices[0]
+	s, t := att.Data.Source.Epoch, att.Data.Target.Epoch
+	var first *ethpb.IndexedAttestation
+	for _, prev := range history {
+		if prev.AttestingIndices[0] != idx {
+			continue
+		}
+		ps, pt := prev.Data.Source.Epoch, prev.Data.Target.Epoch
+		if (s < ps && pt < t) || (ps < s && t < pt) {
+			return types.SurroundVote, true
+		}
+		if pt == t && first == nil {
+			first = prev
+		}
+	}
+	if first != nil && !proto.Equal(first.Data, att.Data) {
+		return types.DoubleVote, true
+	}
+	return 0, false
+}
+
+func min(a, b uint64) uint64 {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,121 @@
+package attestations
+
+import (
+	"context"
+	"math"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/slasher/db"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
+)
+
+// neutralSpan returns the value of a span no attestation has contributed to.
+func neutralSpan(kind types.ChunkKind) uint16 {
+	if kind == types.MinSpan {
+		return math.MaxUint16
+	}
+	return 0
+}
+
+// validatorChunkSpans holds the span chunks of a chunk of validators while a batch of
+// attestations is processed, so each chunk is read and written at most once per batch.
+type validatorChunkSpans struct {
+	params    *Parameters
+	slasherDB db.Database
+	index     uint64
+	chunks    map[types.ChunkKind]map[uint64][]uint16
+	dirty     map[types.ChunkKind]map[uint64]bool
+}
+
+func newValidatorChunkSpans(params *Parameters, slasherDB db.Database, validatorChunkIdx uint64) *validatorChunkSpans {
+	return &validatorChunkSpans{
+		params:    params,
+		slasherDB: slasherDB,
+		index:     validatorChunkIdx,
+		chunks: map[types.ChunkKind]map[uint64][]uint16{
+			types.MinSpan: make(map[uint64][]uint16),
+			types.MaxSpan: make(map[uint64][]uint16),
+		},
+		dirty: map[types.ChunkKind]map[uint64]bool{
+			types.MinSpan: make(map[uint64]bool),
+			types.MaxSpan: make(map[uint64]bool),
+		},
+	}
+}
+
+// chunk returns the chunk of the given kind holding spans at an epoch, loading
+// it from the database or creating a neutral one on first access.
+func (c *validatorChunkSpans) chunk(ctx context.Context, kind types.ChunkKind, epoch uint64) ([]uint16, uint64, error) {
+	key := c.params.chunkKey(c.index, epoch)
+	if chunk, ok := c.chunks[kind][key]; ok {
+		return chunk, key, nil
+	}
+	loaded, err := c.slasherDB.SpanChunks(ctx, kind, []uint64{key})
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "could not load span chunk %d", key)
+	}
+	chunk, ok := loaded[key]
+	if !ok || uint64(len(chunk)) != c.params.chunkLength() {
+		chunk = make([]uint16, c.params.chunkLength())
+		neutral := neutralSpan(kind)
+		for i := range chunk {
+			chunk[i] = neutral
+		}
+	}
+	c.chunks[kind][key] = chunk
+	return chunk, key, nil
+}
+
+func (c *validatorChunkSpans) span(ctx context.Context, kind types.ChunkKind, validatorIdx, epoch uint64) (uint16, error) {
+	chunk, _, err := c.chunk(ctx, kind, epoch)
+	if err != nil {
+		return 0, err
+	}
+	return chunk[c.params.cellIndex(validatorIdx, epoch)], nil
+}
+
+func (c *validatorChunkSpans) setSpan(ctx context.Context, kind types.ChunkKind, validatorIdx, epoch uint64, span uint16) error {
+	chunk, key, err := c.chunk(ctx, kind, epoch)
+	if err != nil {
+		return err
+	}
+	chunk[c.params.cellIndex(validatorIdx, epoch)] = span
+	c.dirty[kind][key] = true
+	return nil
+}
+
+// resetEpochs sets the spans of all validators in the chunk back to neutral values for
+// the epochs in [fromEpoch, toEpoch], whose cells still hold spans of epochs which fell
+// out of the history.
+func (c *validatorChunkSpans) resetEpochs(ctx context.Context, fromEpoch, toEpoch uint64) error {
+	if toEpoch-fromEpoch >= c.params.HistoryLength {
+		fromEpoch = toEpoch + 1 - c.params.HistoryLength
+	}
+	firstValidator := c.index * c.params.ValidatorChunkSize
+	for _, kind := range []types.ChunkKind{types.MinSpan, types.MaxSpan} {
+		neutral := neutralSpan(kind)
+		for epoch := fromEpoch; epoch <= toEpoch; epoch++ {
+			chunk, key, err := c.chunk(ctx, kind, epoch)
+			if err != nil {
+				return err
+			}
+			for i := uint64(0); i < c.params.ValidatorChunkSize; i++ {
+				chunk[c.params.cellIndex(firstValidator+i, epoch)] = neutral
+			}
+			c.dirty[kind][key] = true
+		}
+	}
+	return nil
+}
+
+// save persists the modified chunks along with the latest epoch written for the chunk of validators.
+func (c *validatorChunkSpans) save(ctx context.Context, latestEpoch uint64) error {
+	toSave := make(map[types.ChunkKind]map[uint64][]uint16, len(c.dirty))
+	for kind, keys := range c.dirty {
+		toSave[kind] = make(map[uint64][]uint16, len(keys))
+		for key := range keys {
+			toSave[kind][key] = c.chunks[kind][key]
+		}
+	}
+	return c.slasherDB.SaveSpanChunks(ctx, c.index, latestEpoch, toSave)
+}
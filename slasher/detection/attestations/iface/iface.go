@@ -18,4 +18,11 @@ type SpanDetector interface {
 
 	// Write functions.
 	UpdateSpans(ctx context.Context, att *ethpb.IndexedAttestation) error
+
+	// Batch functions.
+	DetectAndUpdateSpans(
+		ctx context.Context,
+		currentEpoch uint64,
+		atts []*ethpb.IndexedAttestation,
+	) ([][]*types.DetectionResult, error)
 }
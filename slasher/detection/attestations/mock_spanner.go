@@ -76,3 +76,20 @@ func (s *MockSpanDetector) DeleteValidatorSpansByEpoch(_ context.Context, _, _ u
 func (s *MockSpanDetector) UpdateSpans(_ context.Context, _ *ethpb.IndexedAttestation) error {
 	return nil
 }
+
+// DetectAndUpdateSpans mocks detection for a batch of attestations, one attestation at a time.
+func (s *MockSpanDetector) DetectAndUpdateSpans(
+	ctx context.Context,
+	_ uint64,
+	atts []*ethpb.IndexedAttestation,
+) ([][]*types.DetectionResult, error) {
+	results := make([][]*types.DetectionResult, len(atts))
+	for i, att := range atts {
+		attResults, err := s.DetectSlashingsForAttestation(ctx, att)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = attResults
+	}
+	return results, nil
+}
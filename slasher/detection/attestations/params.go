@@ -0,0 +1,60 @@
+package attestations
+
+import (
+	"errors"
+	"math"
+)
+
+// Parameters define the layout of chunked min-max spans. A chunk holds the spans of
+// ValidatorChunkSize validators over ChunkSize epochs, and the spans of the latest
+// HistoryLength epochs are kept for every validator, reusing chunks in a circular fashion.
+type Parameters struct {
+	ChunkSize          uint64
+	ValidatorChunkSize uint64
+	HistoryLength      uint64
+}
+
+// DefaultParams returns the chunk layout used by the slasher, which keeps chunks small
+// enough to be read and written cheaply for every batch of attestations.
+func DefaultParams() *Parameters {
+	return &Parameters{
+		ChunkSize:          16,
+		ValidatorChunkSize: 256,
+		HistoryLength:      4096,
+	}
+}
+
+func (p *Parameters) validate() error {
+	if p.ChunkSize == 0 || p.ValidatorChunkSize == 0 {
+		return errors.New("chunk sizes must be positive")
+	}
+	if p.HistoryLength%p.ChunkSize != 0 {
+		return errors.New("history length must be a multiple of the chunk size")
+	}
+	// Span distances are stored as uint16 values, the maximum being reserved as the neutral min span.
+	if p.HistoryLength == 0 || p.HistoryLength >= math.MaxUint16 {
+		return errors.New("history length must be positive and smaller than the maximum uint16")
+	}
+	return nil
+}
+
+// validatorChunkIndex returns the index of the chunk of validators a validator belongs to.
+func (p *Parameters) validatorChunkIndex(validatorIdx uint64) uint64 {
+	return validatorIdx / p.ValidatorChunkSize
+}
+
+// chunkKey returns the key of the chunk holding the spans of a chunk of validators at an epoch.
+func (p *Parameters) chunkKey(validatorChunkIdx, epoch uint64) uint64 {
+	chunksPerValidatorChunk := p.HistoryLength / p.ChunkSize
+	return validatorChunkIdx*chunksPerValidatorChunk + (epoch%p.HistoryLength)/p.ChunkSize
+}
+
+// cellIndex returns the position of the span of a validator at an epoch within its chunk.
+func (p *Parameters) cellIndex(validatorIdx, epoch uint64) uint64 {
+	return (validatorIdx%p.ValidatorChunkSize)*p.ChunkSize + epoch%p.ChunkSize
+}
+
+// chunkLength returns the number of spans held by a chunk.
+func (p *Parameters) chunkLength() uint64 {
+	return p.ChunkSize * p.ValidatorChunkSize
+}
@@ -90,3 +90,45 @@ func (span Span) Marshal() []byte {
 		attested,
 	}
 }
+
+// ChunkKind defines whether a span chunk holds min or max spans.
+type ChunkKind uint8
+
+const (
+	// MinSpan chunks hold, for each validator and epoch, the minimum distance
+	// to the target of an attestation with a later source epoch.
+	MinSpan ChunkKind = iota
+	// MaxSpan chunks hold, for each validator and epoch, the maximum distance
+	// to the target of an attestation with an earlier source epoch.
+	MaxSpan
+)
+
+// AttestationRecord is the minimal information kept for every validator
+// attestation by target epoch, used to detect double votes and to find the
+// conflicting attestation of a slashing.
+type AttestationRecord struct {
+	SigningRoot [32]byte
+	SigBytes    [2]byte
+}
+
+// AttestationRecordEncodedLength the byte length of an attestation record.
+var AttestationRecordEncodedLength = 34
+
+// Marshal converts the attestation record into a flattened byte array.
+func (r *AttestationRecord) Marshal() []byte {
+	enc := make([]byte, AttestationRecordEncodedLength)
+	copy(enc, r.SigningRoot[:])
+	copy(enc[32:], r.SigBytes[:])
+	return enc
+}
+
+// UnmarshalAttestationRecord returns an attestation record from an encoded byte array.
+func UnmarshalAttestationRecord(enc []byte) (*AttestationRecord, error) {
+	if len(enc) != AttestationRecordEncodedLength {
+		return nil, errors.New("wrong data length for attestation record")
+	}
+	r := &AttestationRecord{}
+	copy(r.SigningRoot[:], enc[:32])
+	copy(r.SigBytes[:], enc[32:])
+	return r, nil
+}
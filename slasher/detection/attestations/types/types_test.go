@@ -20,6 +20,19 @@ func TestSpan_Marshal_Unmarshal(t *testing.T) {
 	require.DeepEqual(t, span, unmarshaled, "expected unmarshaled to be equal")
 }
 
+func TestAttestationRecord_Marshal_Unmarshal(t *testing.T) {
+	record := &types.AttestationRecord{
+		SigningRoot: [32]byte{1, 2, 3},
+		SigBytes:    [2]byte{255, 254},
+	}
+	unmarshaled, err := types.UnmarshalAttestationRecord(record.Marshal())
+	require.NoError(t, err)
+	require.DeepEqual(t, record, unmarshaled, "expected unmarshaled to be equal")
+
+	_, err = types.UnmarshalAttestationRecord([]byte{1, 2})
+	require.ErrorContains(t, "wrong data length", err)
+}
+
 func BenchmarkSpan_Marshal(b *testing.B) {
 	span := types.Span{
 		MinSpan:     40,
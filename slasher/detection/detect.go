@@ -27,6 +27,40 @@ func (ds *Service) DetectAttesterSlashings(
 	if err != nil {
 		return nil, err
 	}
+	return ds.attesterSlashingsFromResults(ctx, att, results)
+}
+
+// DetectAttesterSlashingsBatch detects double, surround and surrounding attestation offences for
+// a batch of attestations, updating the spans of their validators along the way.
+func (ds *Service) DetectAttesterSlashingsBatch(
+	ctx context.Context,
+	currentEpoch uint64,
+	atts []*ethpb.IndexedAttestation,
+) ([]*ethpb.AttesterSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "detection.DetectAttesterSlashingsBatch")
+	defer span.End()
+	batchResults, err := ds.minMaxSpanDetector.DetectAndUpdateSpans(ctx, currentEpoch, atts)
+	if err != nil {
+		return nil, err
+	}
+	var slashings []*ethpb.AttesterSlashing
+	for i, results := range batchResults {
+		attSlashings, err := ds.attesterSlashingsFromResults(ctx, atts[i], results)
+		if err != nil {
+			return nil, err
+		}
+		slashings = append(slashings, attSlashings...)
+	}
+	return slashings, nil
+}
+
+// attesterSlashingsFromResults finds the attestations conflicting with an attestation for the
+// given detection results, saving and returning the resulting slashings.
+func (ds *Service) attesterSlashingsFromResults(
+	ctx context.Context,
+	att *ethpb.IndexedAttestation,
+	results []*types.DetectionResult,
+) ([]*ethpb.AttesterSlashing, error) {
 	// If the response is nil, there was no slashing detected.
 	if len(results) == 0 {
 		return nil, nil
@@ -79,6 +113,41 @@ func (ds *Service) DetectAttesterSlashings(
 	return slashingList, nil
 }
 
+// MarkIncludedSlashings sets the status of slashings found by the slasher which are included
+// in the given block body to included, so they can be told apart from pending ones.
+func (ds *Service) MarkIncludedSlashings(ctx context.Context, body *ethpb.BeaconBlockBody) error {
+	ctx, span := trace.StartSpan(ctx, "detection.MarkIncludedSlashings")
+	defer span.End()
+	if body == nil {
+		return nil
+	}
+	for _, slashing := range body.AttesterSlashings {
+		found, st, err := ds.slasherDB.HasAttesterSlashing(ctx, slashing)
+		if err != nil {
+			return errors.Wrap(err, "could not check attester slashing")
+		}
+		if !found || st == status.Included {
+			continue
+		}
+		if err := ds.slasherDB.SaveAttesterSlashing(ctx, status.Included, slashing); err != nil {
+			return errors.Wrap(err, "could not mark attester slashing as included")
+		}
+	}
+	for _, slashing := range body.ProposerSlashings {
+		found, st, err := ds.slasherDB.HasProposerSlashing(ctx, slashing)
+		if err != nil {
+			return errors.Wrap(err, "could not check proposer slashing")
+		}
+		if !found || st == status.Included {
+			continue
+		}
+		if err := ds.slasherDB.SaveProposerSlashing(ctx, status.Included, slashing); err != nil {
+			return errors.Wrap(err, "could not mark proposer slashing as included")
+		}
+	}
+	return nil
+}
+
 // UpdateSpans passthrough function that updates span maps given an indexed attestation.
 func (ds *Service) UpdateSpans(ctx context.Context, att *ethpb.IndexedAttestation) error {
 	return ds.minMaxSpanDetector.UpdateSpans(ctx, att)
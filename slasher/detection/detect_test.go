@@ -170,10 +170,12 @@ func TestDetect_detectAttesterSlashings_Surround(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			db := testDB.SetupSlasherDB(t, false)
 			ctx := context.Background()
+			spanDetector, err := attestations.NewChunkedSpanDetector(db, attestations.DefaultParams())
+			require.NoError(t, err)
 			ds := Service{
 				ctx:                ctx,
 				slasherDB:          db,
-				minMaxSpanDetector: attestations.NewSpanDetector(db),
+				minMaxSpanDetector: spanDetector,
 			}
 			require.NoError(t, db.SaveIndexedAttestations(ctx, tt.savedAtts))
 			for _, att := range tt.savedAtts {
@@ -321,10 +323,12 @@ func TestDetect_detectAttesterSlashings_Double(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			db := testDB.SetupSlasherDB(t, false)
 			ctx := context.Background()
+			spanDetector, err := attestations.NewChunkedSpanDetector(db, attestations.DefaultParams())
+			require.NoError(t, err)
 			ds := Service{
 				ctx:                ctx,
 				slasherDB:          db,
-				minMaxSpanDetector: attestations.NewSpanDetector(db),
+				minMaxSpanDetector: spanDetector,
 			}
 			require.NoError(t, db.SaveIndexedAttestations(ctx, tt.savedAtts))
 			for _, att := range tt.savedAtts {
@@ -627,6 +631,35 @@ func TestDetect_detectProposerSlashingNoUpdate(t *testing.T) {
 	}
 }
 
+func TestDetect_MarkIncludedSlashings(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	ctx := context.Background()
+	ds := Service{ctx: ctx, slasherDB: db}
+	sigBlk1, err := testDetect.SignedBlockHeader(0, 0)
+	require.NoError(t, err)
+	sigBlk2, err := testDetect.SignedBlockHeader(0, 0)
+	require.NoError(t, err)
+	included := &ethpb.ProposerSlashing{Header_1: sigBlk1, Header_2: sigBlk2}
+	pending := &ethpb.ProposerSlashing{Header_1: sigBlk2, Header_2: sigBlk1}
+	unknown := &ethpb.ProposerSlashing{Header_1: sigBlk1, Header_2: sigBlk1}
+	require.NoError(t, db.SaveProposerSlashings(ctx, status.Active, []*ethpb.ProposerSlashing{included, pending}))
+
+	require.NoError(t, ds.MarkIncludedSlashings(ctx, &ethpb.BeaconBlockBody{
+		ProposerSlashings: []*ethpb.ProposerSlashing{included, unknown},
+	}))
+	found, st, err := db.HasProposerSlashing(ctx, included)
+	require.NoError(t, err)
+	assert.Equal(t, true, found)
+	assert.Equal(t, status.SlashingStatus(status.Included), st)
+	_, st, err = db.HasProposerSlashing(ctx, pending)
+	require.NoError(t, err)
+	assert.Equal(t, status.SlashingStatus(status.Active), st)
+	found, _, err = db.HasProposerSlashing(ctx, unknown)
+	require.NoError(t, err)
+	assert.Equal(t, false, found, "Slashings unknown to the slasher should not be saved")
+	require.NoError(t, ds.MarkIncludedSlashings(ctx, nil))
+}
+
 func TestServer_MapResultsToAtts(t *testing.T) {
 	db := testDB.SetupSlasherDB(t, false)
 	ctx := context.Background()
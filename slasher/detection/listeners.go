@@ -8,16 +8,19 @@ package detection
 
 import (
 	"context"
+	"sort"
+	"time"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/blockutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"go.opencensus.io/trace"
 )
 
 // detectIncomingBlocks subscribes to an event feed for
 // block objects from a notifier interface. Upon receiving
-// a signed beacon block from the feed, we run proposer slashing
-// detection on the block.
+// a signed beacon block from the feed, we mark the slashings it
+// includes and run proposer slashing detection on the block.
 func (ds *Service) detectIncomingBlocks(ctx context.Context, ch chan *ethpb.SignedBeaconBlock) {
 	ctx, span := trace.StartSpan(ctx, "detection.detectIncomingBlocks")
 	defer span.End()
@@ -26,6 +29,9 @@ func (ds *Service) detectIncomingBlocks(ctx context.Context, ch chan *ethpb.Sign
 	for {
 		select {
 		case signedBlock := <-ch:
+			if err := ds.MarkIncludedSlashings(ctx, signedBlock.Block.Body); err != nil {
+				log.WithError(err).Error("Could not mark included slashings")
+			}
 			signedBlkHdr, err := blockutil.SignedBeaconBlockHeaderFromBlock(signedBlock)
 			if err != nil {
 				log.WithError(err).Error("Could not get block header from block")
@@ -48,32 +54,24 @@ func (ds *Service) detectIncomingBlocks(ctx context.Context, ch chan *ethpb.Sign
 }
 
 // detectIncomingAttestations subscribes to an event feed for
-// attestation objects from a notifier interface. Upon receiving
-// an attestation from the feed, we run surround vote and double vote
-// detection on the attestation.
+// attestation objects from a notifier interface. Received attestations
+// are queued and, every slot, run through surround vote and double vote
+// detection in batches grouped by target epoch.
 func (ds *Service) detectIncomingAttestations(ctx context.Context, ch chan *ethpb.IndexedAttestation) {
 	ctx, span := trace.StartSpan(ctx, "detection.detectIncomingAttestations")
 	defer span.End()
 	sub := ds.notifier.AttestationFeed().Subscribe(ch)
 	defer sub.Unsubscribe()
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	defer ticker.Stop()
+	var queue []*ethpb.IndexedAttestation
 	for {
 		select {
 		case indexedAtt := <-ch:
-			slashings, err := ds.DetectAttesterSlashings(ctx, indexedAtt)
-			if err != nil {
-				log.WithError(err).Error("Could not detect attester slashings")
-				continue
-			}
-			if len(slashings) < 1 {
-				if err := ds.minMaxSpanDetector.UpdateSpans(ctx, indexedAtt); err != nil {
-					log.WithError(err).Error("Could not update spans")
-				}
-			}
-			ds.submitAttesterSlashings(ctx, slashings)
-
-			if err := ds.UpdateHighestAttestation(ctx, indexedAtt); err != nil {
-				log.WithError(err).Error("Could not update highest attestation")
-			}
+			queue = append(queue, indexedAtt)
+		case <-ticker.C:
+			ds.detectQueuedAttestations(ctx, queue)
+			queue = nil
 		case <-sub.Err():
 			log.Error("Subscriber closed, exiting goroutine")
 			return
@@ -83,3 +81,26 @@ func (ds *Service) detectIncomingAttestations(ctx context.Context, ch chan *ethp
 		}
 	}
 }
+
+// detectQueuedAttestations runs detection on queued attestations in batches
+// grouped by target epoch, from the lowest target epoch to the highest.
+func (ds *Service) detectQueuedAttestations(ctx context.Context, queue []*ethpb.IndexedAttestation) {
+	byTarget := make(map[uint64][]*ethpb.IndexedAttestation)
+	var targets []uint64
+	for _, att := range queue {
+		if att.Data == nil || att.Data.Target == nil || att.Data.Source == nil {
+			continue
+		}
+		target := att.Data.Target.Epoch
+		if _, ok := byTarget[target]; !ok {
+			targets = append(targets, target)
+		}
+		byTarget[target] = append(byTarget[target], att)
+	}
+	sort.Slice(targets, func(i, j int) bool {
+		return targets[i] < targets[j]
+	})
+	for _, target := range targets {
+		ds.detectAttestationBatch(ctx, target, byTarget[target])
+	}
+}
@@ -2,8 +2,8 @@ package detection
 
 import (
 	"context"
-	"errors"
 
+	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/slasher/beaconclient"
@@ -77,7 +77,11 @@ type Config struct {
 }
 
 // NewService instantiation.
-func NewService(ctx context.Context, cfg *Config) *Service {
+func NewService(ctx context.Context, cfg *Config) (*Service, error) {
+	spanDetector, err := attestations.NewChunkedSpanDetector(cfg.SlasherDB, attestations.DefaultParams())
+	if err != nil {
+		return nil, err
+	}
 	ctx, cancel := context.WithCancel(ctx)
 	return &Service{
 		ctx:                   ctx,
@@ -90,11 +94,11 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		attsChan:              make(chan *ethpb.IndexedAttestation, 1),
 		attesterSlashingsFeed: cfg.AttesterSlashingsFeed,
 		proposerSlashingsFeed: cfg.ProposerSlashingsFeed,
-		minMaxSpanDetector:    attestations.NewSpanDetector(cfg.SlasherDB),
+		minMaxSpanDetector:    spanDetector,
 		proposalsDetector:     proposals.NewProposeDetector(cfg.SlasherDB),
 		historicalDetection:   cfg.HistoricalDetection,
 		status:                None,
-	}
+	}, nil
 }
 
 // Stop the notifier service.
@@ -180,33 +184,16 @@ func (ds *Service) detectHistoricalChainData(ctx context.Context) {
 			return
 		}
 
-		for _, att := range indexedAtts {
-			if ctx.Err() == context.Canceled {
-				log.WithError(ctx.Err()).Error("context has been canceled, ending detection")
-				return
-			}
-			slashings, err := ds.DetectAttesterSlashings(ctx, att)
-			if err != nil {
-				log.WithError(err).Error("Could not detect attester slashings")
-				continue
-			}
-			if len(slashings) < 1 {
-				if err := ds.minMaxSpanDetector.UpdateSpans(ctx, att); err != nil {
-					log.WithError(err).Error("Could not update spans")
-				}
-			}
-			ds.submitAttesterSlashings(ctx, slashings)
-
-			if err := ds.UpdateHighestAttestation(ctx, att); err != nil {
-				log.WithError(err).Errorf("Could not update highest attestation")
-			}
+		if ctx.Err() == context.Canceled {
+			log.WithError(ctx.Err()).Error("context has been canceled, ending detection")
+			return
 		}
+		ds.detectAttestationBatch(ctx, epoch, indexedAtts)
 		latestStoredHead = &ethpb.ChainHead{HeadEpoch: epoch}
 		if err := ds.slasherDB.SaveChainHead(ctx, latestStoredHead); err != nil {
 			log.WithError(err).Error("Could not persist chain head to disk")
 		}
 		storedEpoch = epoch
-		ds.slasherDB.RemoveOldestFromCache(ctx)
 		if epoch == currentChainHead.HeadEpoch-1 {
 			currentChainHead, err = ds.chainFetcher.ChainHead(ctx)
 			if err != nil {
@@ -221,6 +208,67 @@ func (ds *Service) detectHistoricalChainData(ctx context.Context) {
 	log.Infof("Completed slashing detection on historical chain data up to epoch %d", storedEpoch)
 }
 
+// detectAttestationBatch runs detection on a batch of attestations for an epoch, submitting
+// any slashings found and updating the highest attestations of their validators.
+func (ds *Service) detectAttestationBatch(ctx context.Context, epoch uint64, atts []*ethpb.IndexedAttestation) {
+	ctx, span := trace.StartSpan(ctx, "detection.detectAttestationBatch")
+	defer span.End()
+	if len(atts) == 0 {
+		return
+	}
+	slashings, err := ds.DetectAttesterSlashingsBatch(ctx, epoch, atts)
+	if err != nil {
+		log.WithError(err).Error("Could not detect attester slashings")
+		return
+	}
+	ds.submitAttesterSlashings(ctx, slashings)
+	for _, att := range atts {
+		if err := ds.UpdateHighestAttestation(ctx, att); err != nil {
+			log.WithError(err).Error("Could not update highest attestation")
+		}
+	}
+}
+
+// DetectHistoricalEpoch runs detection on the block headers and indexed attestations of an
+// epoch of historical chain data, such as the blocks of a replayed beacon node database, and
+// returns the slashings found. Unlike detection on live data, any failure is returned so the
+// caller does not checkpoint an epoch which was not fully processed.
+func (ds *Service) DetectHistoricalEpoch(
+	ctx context.Context,
+	epoch uint64,
+	headers []*ethpb.SignedBeaconBlockHeader,
+	atts []*ethpb.IndexedAttestation,
+) ([]*ethpb.ProposerSlashing, []*ethpb.AttesterSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "detection.DetectHistoricalEpoch")
+	defer span.End()
+	proposerSlashings := make([]*ethpb.ProposerSlashing, 0)
+	for _, header := range headers {
+		slashing, err := ds.DetectDoubleProposals(ctx, header)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not detect proposer slashing for slot %d", header.Header.Slot)
+		}
+		if slashing != nil {
+			proposerSlashings = append(proposerSlashings, slashing)
+		}
+	}
+	if len(atts) == 0 {
+		return proposerSlashings, nil, nil
+	}
+	if err := ds.slasherDB.SaveIndexedAttestations(ctx, atts); err != nil {
+		return nil, nil, errors.Wrap(err, "could not save indexed attestations")
+	}
+	attesterSlashings, err := ds.DetectAttesterSlashingsBatch(ctx, epoch, atts)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not detect attester slashings")
+	}
+	for _, att := range atts {
+		if err := ds.UpdateHighestAttestation(ctx, att); err != nil {
+			return nil, nil, errors.Wrap(err, "could not update highest attestation")
+		}
+	}
+	return proposerSlashings, attesterSlashings, nil
+}
+
 func (ds *Service) submitAttesterSlashings(ctx context.Context, slashings []*ethpb.AttesterSlashing) {
 	ctx, span := trace.StartSpan(ctx, "detection.submitAttesterSlashings")
 	defer span.End()
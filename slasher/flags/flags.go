@@ -46,6 +46,18 @@ var (
 		Usage: "RPC port exposed by the slasher",
 		Value: 4002,
 	}
+	// GRPCGatewayHost specifies the host on which the slasher gRPC gateway listens.
+	GRPCGatewayHost = &cli.StringFlag{
+		Name:  "grpc-gateway-host",
+		Usage: "The host on which the gateway server runs on",
+		Value: "127.0.0.1",
+	}
+	// GRPCGatewayPort enables a gRPC gateway to be exposed for the slasher.
+	GRPCGatewayPort = &cli.IntFlag{
+		Name:  "grpc-gateway-port",
+		Usage: "Enable gRPC gateway for JSON requests on this port, disabled if 0",
+		Value: 0,
+	}
 	// EnableHistoricalDetectionFlag is a flag to enable historical detection for the slasher. Requires --historical-slasher-node on the beacon node.
 	EnableHistoricalDetectionFlag = &cli.BoolFlag{
 		Name:  "enable-historical-detection",
@@ -63,4 +75,9 @@ var (
 		Usage: "Sets the highest attestation cache size.",
 		Value: 3000,
 	}
+	// BeaconDBPathFlag defines the data directory of the beacon node whose database is replayed.
+	BeaconDBPathFlag = &cli.StringFlag{
+		Name:  "beacon-datadir",
+		Usage: "Data directory of the beacon node database to replay. The beacon node must be stopped while replaying.",
+	}
 )
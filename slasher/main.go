@@ -19,6 +19,7 @@ import (
 	"github.com/prysmaticlabs/prysm/slasher/db"
 	"github.com/prysmaticlabs/prysm/slasher/flags"
 	"github.com/prysmaticlabs/prysm/slasher/node"
+	"github.com/prysmaticlabs/prysm/slasher/replay"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 	prefixed "github.com/x-cray/logrus-prefixed-formatter"
@@ -74,6 +75,8 @@ var appFlags = []cli.Flag{
 	debug.TraceFlag,
 	flags.RPCPort,
 	flags.RPCHost,
+	flags.GRPCGatewayHost,
+	flags.GRPCGatewayPort,
 	flags.CertFlag,
 	flags.KeyFlag,
 	flags.BeaconCertFlag,
@@ -95,6 +98,7 @@ func main() {
 	app.Version = version.GetVersion()
 	app.Commands = []*cli.Command{
 		db.DatabaseCommands,
+		replay.Command,
 	}
 	app.Flags = appFlags
 	app.Action = startSlasher
@@ -30,6 +30,7 @@ import (
 	"github.com/prysmaticlabs/prysm/slasher/detection"
 	"github.com/prysmaticlabs/prysm/slasher/flags"
 	"github.com/prysmaticlabs/prysm/slasher/rpc"
+	"github.com/prysmaticlabs/prysm/slasher/rpc/gateway"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
@@ -111,6 +112,12 @@ func NewSlasherNode(cliCtx *cli.Context) (*SlasherNode, error) {
 		return nil, err
 	}
 
+	if cliCtx.Int(flags.GRPCGatewayPort.Name) != 0 {
+		if err := slasher.registerRPCGatewayService(); err != nil {
+			return nil, err
+		}
+	}
+
 	return slasher, nil
 }
 
@@ -217,6 +224,22 @@ func (s *SlasherNode) startDB() error {
 		}
 	}
 	log.WithField("database-path", baseDir).Info("Checking DB")
+	legacySpans, err := d.HasLegacyEpochSpans(s.ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not check for min-max spans of a previous version")
+	}
+	if legacySpans {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+		log.Errorf(
+			"The slasher database holds min-max spans of a previous version of the slasher, which cannot "+
+				"be migrated to span chunks. Restart the slasher with --%s and --%s to re-sync the database "+
+				"from the beacon node",
+			cmd.ClearDB.Name, flags.EnableHistoricalDetectionFlag.Name,
+		)
+		return errors.New("slasher database must be re-synced")
+	}
 	s.db = d
 	return nil
 }
@@ -246,7 +269,7 @@ func (s *SlasherNode) registerDetectionService() error {
 	if err := s.services.FetchService(&bs); err != nil {
 		panic(err)
 	}
-	ds := detection.NewService(s.ctx, &detection.Config{
+	ds, err := detection.NewService(s.ctx, &detection.Config{
 		Notifier:              bs,
 		SlasherDB:             s.db,
 		BeaconClient:          bs,
@@ -255,6 +278,9 @@ func (s *SlasherNode) registerDetectionService() error {
 		ProposerSlashingsFeed: s.proposerSlashingsFeed,
 		HistoricalDetection:   s.cliCtx.Bool(flags.EnableHistoricalDetectionFlag.Name),
 	})
+	if err != nil {
+		return err
+	}
 	return s.services.RegisterService(ds)
 }
 
@@ -283,3 +309,13 @@ func (s *SlasherNode) registerRPCService() error {
 
 	return s.services.RegisterService(rpcService)
 }
+
+func (s *SlasherNode) registerRPCGatewayService() error {
+	rpcAddr := fmt.Sprintf("%s:%d", s.cliCtx.String(flags.RPCHost.Name), s.cliCtx.Int(flags.RPCPort.Name))
+	gatewayAddr := fmt.Sprintf(
+		"%s:%d",
+		s.cliCtx.String(flags.GRPCGatewayHost.Name),
+		s.cliCtx.Int(flags.GRPCGatewayPort.Name),
+	)
+	return s.services.RegisterService(gateway.New(s.ctx, rpcAddr, gatewayAddr))
+}
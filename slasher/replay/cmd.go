@@ -0,0 +1,103 @@
+package replay
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"path"
+	"syscall"
+
+	"github.com/pkg/errors"
+	beaconkv "github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/tos"
+	"github.com/prysmaticlabs/prysm/slasher/db"
+	"github.com/prysmaticlabs/prysm/slasher/db/kv"
+	"github.com/prysmaticlabs/prysm/slasher/detection"
+	"github.com/prysmaticlabs/prysm/slasher/flags"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// Command replays the finalized chain of a beacon node database through slashing detection.
+var Command = &cli.Command{
+	Name:     "replay",
+	Category: "replay",
+	Usage:    "detects historical slashings by replaying the finalized chain of a stopped beacon node's database",
+	Flags: cmd.WrapFlags(append([]cli.Flag{
+		flags.BeaconDBPathFlag,
+		cmd.DataDirFlag,
+		flags.SpanCacheSize,
+		flags.HighestAttCacheSize,
+	}, featureconfig.SlasherFlags...)),
+	Before: tos.VerifyTosAcceptedOrPrompt,
+	Action: func(cliCtx *cli.Context) error {
+		if err := replay(cliCtx); err != nil {
+			logrus.Fatalf("Could not replay beacon node database: %v", err)
+		}
+		return nil
+	},
+}
+
+func replay(cliCtx *cli.Context) error {
+	featureconfig.ConfigureSlasher(cliCtx)
+	cmd.ConfigureSlasher(cliCtx)
+	beaconDir := cliCtx.String(flags.BeaconDBPathFlag.Name)
+	if beaconDir == "" {
+		return errors.Errorf("--%s must be set", flags.BeaconDBPathFlag.Name)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		sigc := make(chan os.Signal, 1)
+		signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+		defer signal.Stop(sigc)
+		select {
+		case <-sigc:
+			log.Info("Got interrupt, stopping replay after the current epoch")
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	beaconDB, err := beaconkv.NewReadOnlyKVStore(ctx, path.Join(beaconDir, beaconkv.BeaconNodeDbDirName))
+	if err != nil {
+		return errors.Wrap(err, "could not open beacon node database")
+	}
+	defer func() {
+		if err := beaconDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close beacon node database")
+		}
+	}()
+
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	slasherDB, err := db.NewDB(path.Join(dataDir, kv.SlasherDbDirName), &kv.Config{
+		SpanCacheSize:               cliCtx.Int(flags.SpanCacheSize.Name),
+		HighestAttestationCacheSize: cliCtx.Int(flags.HighestAttCacheSize.Name),
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not open slasher database")
+	}
+	defer func() {
+		if err := slasherDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close slasher database")
+		}
+	}()
+
+	detector, err := detection.NewService(ctx, &detection.Config{
+		SlasherDB:             slasherDB,
+		AttesterSlashingsFeed: new(event.Feed),
+		ProposerSlashingsFeed: new(event.Feed),
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not create detection service")
+	}
+	return New(&Config{
+		BeaconDB:  beaconDB,
+		SlasherDB: slasherDB,
+		Detector:  detector,
+	}).Run(ctx)
+}
@@ -0,0 +1,177 @@
+// Package replay runs slashing detection over the finalized history stored in a beacon
+// node database, so slashable offenses which happened while no slasher was watching the
+// chain can still be found and recorded in the slasher database.
+package replay
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	beacondb "github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/blockutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	"github.com/prysmaticlabs/prysm/slasher/db"
+	"github.com/prysmaticlabs/prysm/slasher/detection"
+	"github.com/sirupsen/logrus"
+)
+
+// Config options for the replayer.
+type Config struct {
+	BeaconDB  beacondb.NoHeadAccessDatabase
+	SlasherDB db.Database
+	Detector  *detection.Service
+}
+
+// Replayer feeds the finalized blocks of a beacon node database, epoch by epoch, through
+// slashing detection.
+type Replayer struct {
+	beaconDB  beacondb.NoHeadAccessDatabase
+	slasherDB db.Database
+	detector  *detection.Service
+	stateGen  *stategen.State
+}
+
+// New creates a replayer from the given config.
+func New(cfg *Config) *Replayer {
+	return &Replayer{
+		beaconDB:  cfg.BeaconDB,
+		slasherDB: cfg.SlasherDB,
+		detector:  cfg.Detector,
+		stateGen:  stategen.New(cfg.BeaconDB),
+	}
+}
+
+// Run replays every epoch before the finalized checkpoint of the beacon node database,
+// starting after the latest epoch replayed by a previous run. Progress is checkpointed in
+// the slasher database after each epoch, so an interrupted replay resumes where it stopped.
+func (r *Replayer) Run(ctx context.Context) error {
+	finalized, err := r.beaconDB.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	if finalized.Epoch == 0 {
+		log.Info("No finalized epochs to replay")
+		return nil
+	}
+	startEpoch := uint64(0)
+	latest, ok, err := r.slasherDB.LatestEpochReplayed(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get latest epoch replayed")
+	}
+	if ok {
+		startEpoch = latest + 1
+	}
+	if startEpoch >= finalized.Epoch {
+		log.WithField("finalizedEpoch", finalized.Epoch).Info("Finalized epochs were already replayed")
+		return nil
+	}
+	log.WithFields(logrus.Fields{
+		"startEpoch":     startEpoch,
+		"finalizedEpoch": finalized.Epoch,
+	}).Info("Replaying finalized epochs")
+
+	for epoch := startEpoch; epoch < finalized.Epoch; epoch++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := r.replayEpoch(ctx, epoch); err != nil {
+			return errors.Wrapf(err, "could not replay epoch %d", epoch)
+		}
+		if err := r.slasherDB.SaveLatestEpochReplayed(ctx, epoch); err != nil {
+			return errors.Wrap(err, "could not save latest epoch replayed")
+		}
+	}
+	log.Info("Replay completed")
+	return nil
+}
+
+func (r *Replayer) replayEpoch(ctx context.Context, epoch uint64) error {
+	// Orphaned blocks are stored as well and are needed to find double proposals.
+	blocks, _, err := r.beaconDB.Blocks(ctx, filters.NewFilter().SetStartEpoch(epoch).SetEndEpoch(epoch))
+	if err != nil {
+		return errors.Wrap(err, "could not get blocks")
+	}
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].Block.Slot < blocks[j].Block.Slot
+	})
+	headers := make([]*ethpb.SignedBeaconBlockHeader, 0, len(blocks))
+	atts := make([]*ethpb.Attestation, 0)
+	for _, blk := range blocks {
+		header, err := blockutil.SignedBeaconBlockHeaderFromBlock(blk)
+		if err != nil {
+			return errors.Wrapf(err, "could not get header of block at slot %d", blk.Block.Slot)
+		}
+		headers = append(headers, header)
+		atts = append(atts, blk.Block.Body.Attestations...)
+	}
+	indexedAtts, err := r.indexedAttestations(ctx, atts)
+	if err != nil {
+		return err
+	}
+	proposerSlashings, attesterSlashings, err := r.detector.DetectHistoricalEpoch(ctx, epoch, headers, indexedAtts)
+	if err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"epoch":             epoch,
+		"blocks":            len(blocks),
+		"attestations":      len(indexedAtts),
+		"proposerSlashings": len(proposerSlashings),
+		"attesterSlashings": len(attesterSlashings),
+	}).Debug("Replayed epoch")
+	for _, slashing := range proposerSlashings {
+		log.WithFields(logrus.Fields{
+			"epoch":         epoch,
+			"slot":          slashing.Header_1.Header.Slot,
+			"proposerIndex": slashing.Header_1.Header.ProposerIndex,
+		}).Warn("Found historical proposer slashing")
+	}
+	for _, slashing := range attesterSlashings {
+		log.WithFields(logrus.Fields{
+			"epoch":          epoch,
+			"sourceEpoch":    slashing.Attestation_1.Data.Source.Epoch,
+			"targetEpoch":    slashing.Attestation_1.Data.Target.Epoch,
+			"slashedIndices": sliceutil.IntersectionUint64(slashing.Attestation_1.AttestingIndices, slashing.Attestation_2.AttestingIndices),
+		}).Warn("Found historical attester slashing")
+	}
+	return nil
+}
+
+// indexedAttestations converts attestations into indexed form, using the committees of the
+// state at their target root. Attestations whose target state cannot be found are skipped.
+func (r *Replayer) indexedAttestations(ctx context.Context, atts []*ethpb.Attestation) ([]*ethpb.IndexedAttestation, error) {
+	targetRoots := make([][32]byte, 0)
+	attsByTarget := make(map[[32]byte][]*ethpb.Attestation)
+	for _, att := range atts {
+		root := bytesutil.ToBytes32(att.Data.Target.Root)
+		if _, ok := attsByTarget[root]; !ok {
+			targetRoots = append(targetRoots, root)
+		}
+		attsByTarget[root] = append(attsByTarget[root], att)
+	}
+	indexedAtts := make([]*ethpb.IndexedAttestation, 0, len(atts))
+	for _, root := range targetRoots {
+		attState, err := r.stateGen.StateByRoot(ctx, root)
+		if errors.Is(err, stategen.ErrUnknownStateSummary) {
+			log.WithField("targetRoot", root).Warn("Could not get state for attestation target root, skipping attestations")
+			continue
+		} else if err != nil {
+			return nil, errors.Wrapf(err, "could not retrieve state for attestation target root %#x", root)
+		}
+		for _, att := range attsByTarget[root] {
+			committee, err := helpers.BeaconCommitteeFromState(attState, att.Data.Slot, att.Data.CommitteeIndex)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not retrieve committee from state")
+			}
+			indexedAtts = append(indexedAtts, attestationutil.ConvertToIndexed(ctx, att, committee))
+		}
+	}
+	return indexedAtts, nil
+}
@@ -0,0 +1,99 @@
+package replay
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	beaconkv "github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+	"github.com/prysmaticlabs/prysm/slasher/db/types"
+	"github.com/prysmaticlabs/prysm/slasher/detection"
+)
+
+func TestReplayer_Run(t *testing.T) {
+	ctx := context.Background()
+	beaconDir := path.Join(t.TempDir(), beaconkv.BeaconNodeDbDirName)
+	beaconDB, err := beaconkv.NewKVStore(ctx, beaconDir)
+	require.NoError(t, err)
+
+	genesisState, _ := testutil.DeterministicGenesisState(t, 64)
+	stateRoot, err := genesisState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis := testutil.NewBeaconBlock()
+	genesis.Block.StateRoot = stateRoot[:]
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, genesis))
+	require.NoError(t, beaconDB.SaveState(ctx, genesisState, genesisRoot))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+
+	committee, err := helpers.BeaconCommitteeFromState(genesisState, 1, 0)
+	require.NoError(t, err)
+	aggregationBits := bitfield.NewBitlist(uint64(len(committee)))
+	aggregationBits.SetBitAt(0, true)
+	// Two blocks proposed by the same validator at slot 1, each including a conflicting vote.
+	for i := byte(1); i <= 2; i++ {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = 1
+		blk.Block.ProposerIndex = 3
+		blk.Block.ParentRoot = genesisRoot[:]
+		blk.Block.StateRoot = bytesutil.PadTo([]byte{i}, 32)
+		blk.Signature = bytesutil.PadTo([]byte{i}, 96)
+		blk.Block.Body.Attestations = []*ethpb.Attestation{{
+			AggregationBits: aggregationBits,
+			Data: &ethpb.AttestationData{
+				Slot:            1,
+				BeaconBlockRoot: bytesutil.PadTo([]byte{i}, 32),
+				Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+				Target:          &ethpb.Checkpoint{Root: genesisRoot[:]},
+			},
+			Signature: make([]byte, 96),
+		}}
+		require.NoError(t, beaconDB.SaveBlock(ctx, blk))
+	}
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: genesisRoot[:]}))
+	require.NoError(t, beaconDB.Close())
+
+	readOnlyDB, err := beaconkv.NewReadOnlyKVStore(ctx, beaconDir)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, readOnlyDB.Close())
+	}()
+	slasherDB := testDB.SetupSlasherDB(t, false)
+	detector, err := detection.NewService(ctx, &detection.Config{
+		SlasherDB:             slasherDB,
+		AttesterSlashingsFeed: new(event.Feed),
+		ProposerSlashingsFeed: new(event.Feed),
+	})
+	require.NoError(t, err)
+	r := New(&Config{BeaconDB: readOnlyDB, SlasherDB: slasherDB, Detector: detector})
+	require.NoError(t, r.Run(ctx))
+
+	proposerSlashings, err := slasherDB.ProposalSlashingsByStatus(ctx, types.Active)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(proposerSlashings))
+	assert.Equal(t, uint64(3), proposerSlashings[0].Header_1.Header.ProposerIndex)
+	attesterSlashings, err := slasherDB.AttesterSlashings(ctx, types.Active)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(attesterSlashings))
+	assert.DeepEqual(t, []uint64{committee[0]}, attesterSlashings[0].Attestation_1.AttestingIndices)
+	epoch, ok, err := slasherDB.LatestEpochReplayed(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, uint64(0), epoch)
+
+	// Replaying again is a no-op, as every finalized epoch was replayed.
+	require.NoError(t, r.Run(ctx))
+	attesterSlashings, err = slasherDB.AttesterSlashings(ctx, types.Active)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(attesterSlashings))
+}
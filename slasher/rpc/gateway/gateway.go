@@ -0,0 +1,88 @@
+// Package gateway defines a gRPC gateway to serve HTTP-JSON
+// traffic as a proxy and forward it to the slasher's gRPC service.
+package gateway
+
+import (
+	"context"
+	"net/http"
+
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	pb "github.com/prysmaticlabs/prysm/proto/slashing_gateway"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+var _ shared.Service = (*Gateway)(nil)
+
+var log = logrus.WithField("prefix", "gateway")
+
+// Gateway is the gRPC gateway to serve HTTP JSON traffic as a
+// proxy and forward it to the slasher gRPC server.
+type Gateway struct {
+	ctx          context.Context
+	cancel       context.CancelFunc
+	gatewayAddr  string
+	remoteAddr   string
+	server       *http.Server
+	startFailure error
+}
+
+// New returns a new gateway server which translates HTTP into gRPC.
+func New(ctx context.Context, remoteAddress, gatewayAddress string) *Gateway {
+	return &Gateway{
+		remoteAddr:  remoteAddress,
+		gatewayAddr: gatewayAddress,
+		ctx:         ctx,
+	}
+}
+
+// Start the gateway service. This serves the HTTP JSON traffic.
+func (g *Gateway) Start() {
+	ctx, cancel := context.WithCancel(g.ctx)
+	g.cancel = cancel
+
+	gwmux := gwruntime.NewServeMux(
+		gwruntime.WithMarshalerOption(
+			gwruntime.MIMEWildcard,
+			&gwruntime.JSONPb{OrigName: false, EmitDefaults: true},
+		),
+	)
+	opts := []grpc.DialOption{grpc.WithInsecure()}
+	if err := pb.RegisterSlasherHandlerFromEndpoint(ctx, gwmux, g.remoteAddr, opts); err != nil {
+		log.WithError(err).Error("Could not register API handler with grpc endpoint")
+		g.startFailure = err
+		return
+	}
+	g.server = &http.Server{
+		Addr:    g.gatewayAddr,
+		Handler: gwmux,
+	}
+
+	go func() {
+		log.WithField("address", g.gatewayAddr).Info("Starting gRPC gateway")
+		if err := g.server.ListenAndServe(); err != http.ErrServerClosed {
+			log.WithError(err).Error("Failed to listen and serve")
+			g.startFailure = err
+			return
+		}
+	}()
+}
+
+// Status of grpc gateway. Returns an error if this service is unhealthy.
+func (g *Gateway) Status() error {
+	return g.startFailure
+}
+
+// Stop the gateway with a graceful shutdown.
+func (g *Gateway) Stop() error {
+	if g.server != nil {
+		if err := g.server.Shutdown(g.ctx); err != nil {
+			log.WithError(err).Error("Failed to shut down server")
+		}
+	}
+	if g.cancel != nil {
+		g.cancel()
+	}
+	return nil
+}
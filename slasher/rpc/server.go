@@ -12,8 +12,10 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/p2putils"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 	"github.com/prysmaticlabs/prysm/slasher/beaconclient"
 	"github.com/prysmaticlabs/prysm/slasher/db"
+	dbtypes "github.com/prysmaticlabs/prysm/slasher/db/types"
 	"github.com/prysmaticlabs/prysm/slasher/detection"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -232,3 +234,150 @@ func (ss *Server) IsSlashableBlockNoUpdate(ctx context.Context, req *ethpb.Beaco
 	sl.Slashable = slash
 	return sl, nil
 }
+
+// AttesterSlashings returns the attester slashings found by the slasher which slash any of the
+// requested validators, have a conflicting attestation targeting an epoch in the requested range
+// and match the requested inclusion status.
+func (ss *Server) AttesterSlashings(ctx context.Context, req *slashpb.SlashingsRequest) (*slashpb.AttesterSlashingsResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "history.AttesterSlashings")
+	defer span.End()
+
+	if err := validateSlashingsRequest(req); err != nil {
+		return nil, err
+	}
+	requested := make(map[uint64]bool, len(req.ValidatorIndices))
+	for _, idx := range req.ValidatorIndices {
+		requested[idx] = true
+	}
+	records := make([]*slashpb.AttesterSlashingRecord, 0)
+	for _, dbStatus := range slashingStatusesToQuery(req.Status) {
+		slashings, err := ss.slasherDB.AttesterSlashings(ctx, dbStatus)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve attester slashings: %v", err)
+		}
+		for _, slashing := range slashings {
+			target1, target2, ok := slashingTargetEpochs(slashing)
+			if !ok || (!epochInRange(req, target1) && !epochInRange(req, target2)) {
+				continue
+			}
+			slashed := sliceutil.IntersectionUint64(
+				slashing.Attestation_1.AttestingIndices,
+				slashing.Attestation_2.AttestingIndices,
+			)
+			if len(requested) > 0 && !containsRequestedIndex(requested, slashed) {
+				continue
+			}
+			records = append(records, &slashpb.AttesterSlashingRecord{
+				Slashing:       slashing,
+				SlashedIndices: slashed,
+				Status:         slashingStatusFromDB(dbStatus),
+			})
+		}
+	}
+	return &slashpb.AttesterSlashingsResponse{
+		Slashings: records,
+	}, nil
+}
+
+// ProposerSlashings returns the proposer slashings found by the slasher for any of the requested
+// validators, whose conflicting headers are in the requested epoch range and which match the
+// requested inclusion status.
+func (ss *Server) ProposerSlashings(ctx context.Context, req *slashpb.SlashingsRequest) (*slashpb.ProposerSlashingsResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "history.ProposerSlashings")
+	defer span.End()
+
+	if err := validateSlashingsRequest(req); err != nil {
+		return nil, err
+	}
+	requested := make(map[uint64]bool, len(req.ValidatorIndices))
+	for _, idx := range req.ValidatorIndices {
+		requested[idx] = true
+	}
+	records := make([]*slashpb.ProposerSlashingRecord, 0)
+	for _, dbStatus := range slashingStatusesToQuery(req.Status) {
+		slashings, err := ss.slasherDB.ProposalSlashingsByStatus(ctx, dbStatus)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve proposer slashings: %v", err)
+		}
+		for _, slashing := range slashings {
+			if slashing.Header_1 == nil || slashing.Header_1.Header == nil {
+				continue
+			}
+			header := slashing.Header_1.Header
+			if !epochInRange(req, helpers.SlotToEpoch(header.Slot)) {
+				continue
+			}
+			if len(requested) > 0 && !requested[header.ProposerIndex] {
+				continue
+			}
+			records = append(records, &slashpb.ProposerSlashingRecord{
+				Slashing:      slashing,
+				ProposerIndex: header.ProposerIndex,
+				Status:        slashingStatusFromDB(dbStatus),
+			})
+		}
+	}
+	return &slashpb.ProposerSlashingsResponse{
+		Slashings: records,
+	}, nil
+}
+
+func validateSlashingsRequest(req *slashpb.SlashingsRequest) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "nil request provided")
+	}
+	if req.EndEpoch != 0 && req.StartEpoch > req.EndEpoch {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"Start epoch %d cannot be greater than end epoch %d",
+			req.StartEpoch,
+			req.EndEpoch,
+		)
+	}
+	if _, ok := slashpb.SlashingStatus_name[int32(req.Status)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "Unknown slashing status %d", req.Status)
+	}
+	return nil
+}
+
+func slashingTargetEpochs(slashing *ethpb.AttesterSlashing) (uint64, uint64, bool) {
+	for _, att := range []*ethpb.IndexedAttestation{slashing.Attestation_1, slashing.Attestation_2} {
+		if att == nil || att.Data == nil || att.Data.Target == nil {
+			return 0, 0, false
+		}
+	}
+	return slashing.Attestation_1.Data.Target.Epoch, slashing.Attestation_2.Data.Target.Epoch, true
+}
+
+func epochInRange(req *slashpb.SlashingsRequest, epoch uint64) bool {
+	return epoch >= req.StartEpoch && (req.EndEpoch == 0 || epoch <= req.EndEpoch)
+}
+
+func containsRequestedIndex(requested map[uint64]bool, indices []uint64) bool {
+	for _, idx := range indices {
+		if requested[idx] {
+			return true
+		}
+	}
+	return false
+}
+
+// slashingStatusesToQuery returns the database statuses of slashings matching a requested status.
+// Reverted slashings are relevant again and therefore reported as pending.
+func slashingStatusesToQuery(s slashpb.SlashingStatus) []dbtypes.SlashingStatus {
+	switch s {
+	case slashpb.SlashingStatus_PENDING:
+		return []dbtypes.SlashingStatus{dbtypes.Active, dbtypes.Reverted}
+	case slashpb.SlashingStatus_INCLUDED:
+		return []dbtypes.SlashingStatus{dbtypes.Included}
+	default:
+		return []dbtypes.SlashingStatus{dbtypes.Active, dbtypes.Reverted, dbtypes.Included}
+	}
+}
+
+func slashingStatusFromDB(s dbtypes.SlashingStatus) slashpb.SlashingStatus {
+	if s == dbtypes.Included {
+		return slashpb.SlashingStatus_INCLUDED
+	}
+	return slashpb.SlashingStatus_PENDING
+}
@@ -10,6 +10,7 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/mock"
@@ -20,6 +21,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	"github.com/prysmaticlabs/prysm/slasher/beaconclient"
 	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+	dbtypes "github.com/prysmaticlabs/prysm/slasher/db/types"
 	"github.com/prysmaticlabs/prysm/slasher/detection"
 )
 
@@ -64,7 +66,8 @@ func TestServer_IsSlashableAttestation(t *testing.T) {
 	bcCfg := &beaconclient.Config{BeaconClient: bClient, NodeClient: nClient, SlasherDB: db}
 	bs, err := beaconclient.NewService(ctx, bcCfg)
 	require.NoError(t, err)
-	ds := detection.NewService(ctx, cfg)
+	ds, err := detection.NewService(ctx, cfg)
+	require.NoError(t, err)
 	server := Server{ctx: ctx, detector: ds, slasherDB: db, beaconClient: bs}
 	nClient.EXPECT().GetGenesis(gomock.Any(), gomock.Any()).Return(wantedGenesis, nil).AnyTimes()
 	bClient.EXPECT().ListValidators(
@@ -166,7 +169,8 @@ func TestServer_IsSlashableAttestationNoUpdate(t *testing.T) {
 	bcCfg := &beaconclient.Config{BeaconClient: bClient, NodeClient: nClient, SlasherDB: db}
 	bs, err := beaconclient.NewService(ctx, bcCfg)
 	require.NoError(t, err)
-	ds := detection.NewService(ctx, cfg)
+	ds, err := detection.NewService(ctx, cfg)
+	require.NoError(t, err)
 	server := Server{ctx: ctx, detector: ds, slasherDB: db, beaconClient: bs}
 	slashings, err := server.IsSlashableAttestation(ctx, savedAttestation)
 	require.NoError(t, err, "Got error while trying to detect slashing")
@@ -225,7 +229,8 @@ func TestServer_IsSlashableBlock(t *testing.T) {
 	bcCfg := &beaconclient.Config{BeaconClient: bClient, NodeClient: nClient, SlasherDB: db}
 	bs, err := beaconclient.NewService(ctx, bcCfg)
 	require.NoError(t, err)
-	ds := detection.NewService(ctx, cfg)
+	ds, err := detection.NewService(ctx, cfg)
+	require.NoError(t, err)
 	server := Server{ctx: ctx, detector: ds, slasherDB: db, beaconClient: bs}
 
 	wg := sync.WaitGroup{}
@@ -314,7 +319,8 @@ func TestServer_IsSlashableBlockNoUpdate(t *testing.T) {
 	bcCfg := &beaconclient.Config{BeaconClient: bClient, NodeClient: nClient, SlasherDB: db}
 	bs, err := beaconclient.NewService(ctx, bcCfg)
 	require.NoError(t, err)
-	ds := detection.NewService(ctx, cfg)
+	ds, err := detection.NewService(ctx, cfg)
+	require.NoError(t, err)
 	server := Server{ctx: ctx, detector: ds, slasherDB: db, beaconClient: bs}
 	slashings, err := server.IsSlashableBlock(ctx, savedBlock)
 	require.NoError(t, err, "Got error while trying to detect slashing")
@@ -323,3 +329,138 @@ func TestServer_IsSlashableBlockNoUpdate(t *testing.T) {
 	require.NoError(t, err, "Got error while trying to detect slashing")
 	require.Equal(t, true, sl.Slashable, "Block should be found to be slashable")
 }
+
+func testAttesterSlashing(target1, target2 uint64, indices1, indices2 []uint64) *ethpb.AttesterSlashing {
+	att := func(target uint64, indices []uint64) *ethpb.IndexedAttestation {
+		return &ethpb.IndexedAttestation{
+			AttestingIndices: indices,
+			Data: &ethpb.AttestationData{
+				BeaconBlockRoot: make([]byte, 32),
+				Source:          &ethpb.Checkpoint{Epoch: 0, Root: make([]byte, 32)},
+				Target:          &ethpb.Checkpoint{Epoch: target, Root: make([]byte, 32)},
+			},
+			Signature: make([]byte, 96),
+		}
+	}
+	return &ethpb.AttesterSlashing{
+		Attestation_1: att(target1, indices1),
+		Attestation_2: att(target2, indices2),
+	}
+}
+
+func testProposerSlashing(slot, proposerIndex uint64) *ethpb.ProposerSlashing {
+	header := func(root string) *ethpb.SignedBeaconBlockHeader {
+		return &ethpb.SignedBeaconBlockHeader{
+			Header: &ethpb.BeaconBlockHeader{
+				Slot:          slot,
+				ProposerIndex: proposerIndex,
+				ParentRoot:    make([]byte, 32),
+				StateRoot:     make([]byte, 32),
+				BodyRoot:      bytesutil.PadTo([]byte(root), 32),
+			},
+			Signature: make([]byte, 96),
+		}
+	}
+	return &ethpb.ProposerSlashing{
+		Header_1: header("a"),
+		Header_2: header("b"),
+	}
+}
+
+func TestServer_AttesterSlashings(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	ctx := context.Background()
+	pending := testAttesterSlashing(3, 3, []uint64{1, 2}, []uint64{2, 3})
+	included := testAttesterSlashing(5, 7, []uint64{4}, []uint64{4})
+	require.NoError(t, db.SaveAttesterSlashing(ctx, dbtypes.Active, pending))
+	require.NoError(t, db.SaveAttesterSlashing(ctx, dbtypes.Included, included))
+	server := Server{ctx: ctx, slasherDB: db}
+
+	tests := []struct {
+		name     string
+		req      *slashpb.SlashingsRequest
+		expected []*ethpb.AttesterSlashing
+	}{
+		{
+			name:     "all",
+			req:      &slashpb.SlashingsRequest{},
+			expected: []*ethpb.AttesterSlashing{pending, included},
+		},
+		{
+			name:     "pending",
+			req:      &slashpb.SlashingsRequest{Status: slashpb.SlashingStatus_PENDING},
+			expected: []*ethpb.AttesterSlashing{pending},
+		},
+		{
+			name:     "included",
+			req:      &slashpb.SlashingsRequest{Status: slashpb.SlashingStatus_INCLUDED},
+			expected: []*ethpb.AttesterSlashing{included},
+		},
+		{
+			name:     "slashed validator",
+			req:      &slashpb.SlashingsRequest{ValidatorIndices: []uint64{2}},
+			expected: []*ethpb.AttesterSlashing{pending},
+		},
+		{
+			name:     "attesting but not slashed validator",
+			req:      &slashpb.SlashingsRequest{ValidatorIndices: []uint64{1}},
+			expected: []*ethpb.AttesterSlashing{},
+		},
+		{
+			name:     "epoch range matching either attestation",
+			req:      &slashpb.SlashingsRequest{StartEpoch: 6, EndEpoch: 10},
+			expected: []*ethpb.AttesterSlashing{included},
+		},
+		{
+			name:     "open ended epoch range",
+			req:      &slashpb.SlashingsRequest{StartEpoch: 4},
+			expected: []*ethpb.AttesterSlashing{included},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := server.AttesterSlashings(ctx, tt.req)
+			require.NoError(t, err)
+			require.Equal(t, len(tt.expected), len(res.Slashings))
+			for i, record := range res.Slashings {
+				assert.DeepEqual(t, tt.expected[i], record.Slashing)
+			}
+		})
+	}
+
+	res, err := server.AttesterSlashings(ctx, &slashpb.SlashingsRequest{ValidatorIndices: []uint64{2}})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Slashings))
+	assert.DeepEqual(t, []uint64{2}, res.Slashings[0].SlashedIndices)
+	assert.Equal(t, slashpb.SlashingStatus_PENDING, res.Slashings[0].Status)
+
+	_, err = server.AttesterSlashings(ctx, &slashpb.SlashingsRequest{StartEpoch: 5, EndEpoch: 4})
+	assert.ErrorContains(t, "cannot be greater than end epoch", err)
+}
+
+func TestServer_ProposerSlashings(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	ctx := context.Background()
+	pending := testProposerSlashing(params.BeaconConfig().SlotsPerEpoch, 1)
+	included := testProposerSlashing(3*params.BeaconConfig().SlotsPerEpoch, 2)
+	require.NoError(t, db.SaveProposerSlashing(ctx, dbtypes.Reverted, pending))
+	require.NoError(t, db.SaveProposerSlashing(ctx, dbtypes.Included, included))
+	server := Server{ctx: ctx, slasherDB: db}
+
+	res, err := server.ProposerSlashings(ctx, &slashpb.SlashingsRequest{Status: slashpb.SlashingStatus_PENDING})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Slashings))
+	assert.DeepEqual(t, pending, res.Slashings[0].Slashing)
+	assert.Equal(t, uint64(1), res.Slashings[0].ProposerIndex)
+	assert.Equal(t, slashpb.SlashingStatus_PENDING, res.Slashings[0].Status)
+
+	res, err = server.ProposerSlashings(ctx, &slashpb.SlashingsRequest{ValidatorIndices: []uint64{2}})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Slashings))
+	assert.DeepEqual(t, included, res.Slashings[0].Slashing)
+	assert.Equal(t, slashpb.SlashingStatus_INCLUDED, res.Slashings[0].Status)
+
+	res, err = server.ProposerSlashings(ctx, &slashpb.SlashingsRequest{StartEpoch: 2, EndEpoch: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.Slashings))
+}
@@ -85,6 +85,8 @@ var appHelpFlagGroups = []flagGroup{
 			flags.KeyFlag,
 			flags.RPCPort,
 			flags.RPCHost,
+			flags.GRPCGatewayHost,
+			flags.GRPCGatewayPort,
 			flags.BeaconRPCProviderFlag,
 			flags.EnableHistoricalDetectionFlag,
 			flags.SpanCacheSize,
@@ -196,6 +196,8 @@ func main() {
 				return nil
 			},
 		},
+		transitionCommand,
+		replayCommand,
 	}
 	if err := app.Run(os.Args); err != nil {
 		log.Error(err.Error())
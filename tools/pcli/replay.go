@@ -0,0 +1,298 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	noise "github.com/libp2p/go-libp2p-noise"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/rpctrace"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	replayTraceFileFlag = &cli.StringFlag{
+		Name:     "trace-file",
+		Usage:    "Path to a req/resp trace file, recorded by a beacon node with --p2p-reqresp-trace-file",
+		Required: true,
+	}
+	replayPeerFlag = &cli.StringFlag{
+		Name:     "peer",
+		Usage:    "Multiaddress of the beacon node to replay the session against, e.g. /ip4/127.0.0.1/tcp/13000/p2p/16Uiu2HAm...",
+		Required: true,
+	}
+	replayForkDigestFlag = &cli.StringFlag{
+		Name:     "fork-digest",
+		Usage:    "Fork digest of the network of the beacon node in hex, as found in its gossip topics, e.g. 0xb5303f2a",
+		Required: true,
+	}
+	replayProtocolFlag = &cli.StringFlag{
+		Name:  "protocol",
+		Usage: "Only replay the exchanges whose protocol ID contains the given string, e.g. beacon_blocks_by_range",
+	}
+	replayDirectionFlag = &cli.StringFlag{
+		Name:  "direction",
+		Usage: "Direction of the recorded exchanges to replay: inbound (requests sent by peers), outbound (requests sent by the node) or all",
+		Value: "inbound",
+	}
+	replayPreserveTimingFlag = &cli.BoolFlag{
+		Name:  "preserve-timing",
+		Usage: "Wait as long between requests as between the recorded ones, e.g. to stay within the rate limits of the node",
+	}
+	replayTimeoutFlag = &cli.DurationFlag{
+		Name:  "timeout",
+		Usage: "Maximum time to wait for the complete response to a request",
+		Value: 10 * time.Second,
+	}
+)
+
+var replayCommand = &cli.Command{
+	Name:     "replay",
+	Category: "p2p",
+	Usage:    "Subcommand to replay a recorded req/resp session against a beacon node, comparing its responses to the recorded ones",
+	Flags: []cli.Flag{
+		replayTraceFileFlag,
+		replayPeerFlag,
+		replayForkDigestFlag,
+		replayProtocolFlag,
+		replayDirectionFlag,
+		replayPreserveTimingFlag,
+		replayTimeoutFlag,
+	},
+	Action: runReplay,
+}
+
+func runReplay(c *cli.Context) error {
+	records, err := rpctrace.ReadFile(c.String(replayTraceFileFlag.Name))
+	if err != nil {
+		if len(records) == 0 {
+			return err
+		}
+		// The last record may be incomplete if the node was killed while writing it.
+		log.WithError(err).Warnf("Could only read the first %d records of the trace file", len(records))
+	}
+	records, err = filterRecords(records, c.String(replayProtocolFlag.Name), c.String(replayDirectionFlag.Name))
+	if err != nil {
+		return err
+	}
+	addr, err := multiaddr.NewMultiaddr(c.String(replayPeerFlag.Name))
+	if err != nil {
+		return errors.Wrap(err, "could not parse peer multiaddress")
+	}
+	info, err := peer.AddrInfoFromP2pAddr(addr)
+	if err != nil {
+		return errors.Wrap(err, "could not parse peer multiaddress")
+	}
+	forkDigest, err := hex.DecodeString(strings.TrimPrefix(c.String(replayForkDigestFlag.Name), "0x"))
+	if err != nil {
+		return errors.Wrap(err, "could not parse fork digest")
+	}
+	if len(forkDigest) != 4 {
+		return errors.Errorf("fork digest must be 4 bytes, got %d", len(forkDigest))
+	}
+
+	ctx := context.Background()
+	h, err := libp2p.New(
+		ctx,
+		libp2p.Security(noise.ID, noise.New),
+		libp2p.NoListenAddrs,
+		libp2p.DisableRelay(),
+		libp2p.Ping(false),
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not create libp2p host")
+	}
+	defer func() {
+		if err := h.Close(); err != nil {
+			log.WithError(err).Error("Could not close libp2p host")
+		}
+	}()
+
+	log.WithFields(log.Fields{
+		"records": len(records),
+		"peer":    info.ID,
+	}).Info("Replaying req/resp session")
+	timeout := c.Duration(replayTimeoutFlag.Name)
+	replayed, mismatches, failures := 0, 0, 0
+	var previousStart time.Time
+	for _, record := range records {
+		start := time.Unix(0, record.StartTime)
+		if c.Bool(replayPreserveTimingFlag.Name) && !previousStart.IsZero() && start.After(previousStart) {
+			time.Sleep(start.Sub(previousStart))
+		}
+		previousStart = start
+
+		fields := log.Fields{
+			"protocol":         record.ProtocolId,
+			"recordedPeer":     record.PeerId,
+			"recordedCode":     record.ResponseCode,
+			"recordedSize":     len(record.Response),
+			"recordedDuration": time.Duration(record.Duration),
+		}
+		// A goodbye request makes the node disconnect, without a response.
+		if strings.Contains(record.ProtocolId, p2p.RPCGoodByeTopic) {
+			log.WithFields(fields).Info("Skipping goodbye request")
+			continue
+		}
+		if h.Network().Connectedness(info.ID) != network.Connected {
+			if err := replayHandshake(ctx, h, *info, forkDigest, timeout); err != nil {
+				return errors.Wrap(err, "could not handshake with node")
+			}
+		}
+		replayed++
+		replayStart := time.Now()
+		response, err := replayRequest(ctx, h, info.ID, record, timeout)
+		fields["duration"] = time.Since(replayStart)
+		if err != nil {
+			failures++
+			log.WithError(err).WithFields(fields).Error("Could not replay request")
+			continue
+		}
+		fields["size"] = len(response)
+		if len(response) > 0 {
+			fields["code"] = response[0]
+		}
+		switch {
+		case record.Truncated:
+			log.WithFields(fields).Info("Replayed request, the recorded response is truncated")
+		case bytes.Equal(response, record.Response):
+			log.WithFields(fields).Info("Replayed request, the response matches the recorded one")
+		default:
+			mismatches++
+			log.WithFields(fields).Warn("Replayed request, the response differs from the recorded one")
+		}
+	}
+	log.WithFields(log.Fields{
+		"replayed":   replayed,
+		"mismatches": mismatches,
+		"failures":   failures,
+	}).Info("Finished replaying req/resp session")
+	return nil
+}
+
+// filterRecords returns the records of the given direction, whose protocol ID contains the
+// given string.
+func filterRecords(records []*dbpb.RPCTraceRecord, protocolID, direction string) ([]*dbpb.RPCTraceRecord, error) {
+	directions := make(map[dbpb.RPCTraceRecord_Direction]bool)
+	switch direction {
+	case "inbound":
+		directions[dbpb.RPCTraceRecord_INBOUND] = true
+	case "outbound":
+		directions[dbpb.RPCTraceRecord_OUTBOUND] = true
+	case "all":
+		directions[dbpb.RPCTraceRecord_INBOUND] = true
+		directions[dbpb.RPCTraceRecord_OUTBOUND] = true
+	default:
+		return nil, fmt.Errorf("invalid direction %q, expected inbound, outbound or all", direction)
+	}
+	filtered := make([]*dbpb.RPCTraceRecord, 0, len(records))
+	for _, record := range records {
+		if directions[record.Direction] && strings.Contains(record.ProtocolId, protocolID) {
+			filtered = append(filtered, record)
+		}
+	}
+	return filtered, nil
+}
+
+// replayHandshake connects to the node and exchanges status messages, so that the node keeps
+// the connection open. The status sent is the one of a node at genesis on the network of the
+// given fork digest, which the node accepts whatever its own status.
+func replayHandshake(ctx context.Context, h host.Host, info peer.AddrInfo, forkDigest []byte, timeout time.Duration) error {
+	if err := h.Connect(ctx, info); err != nil {
+		return errors.Wrap(err, "could not connect to node")
+	}
+	nodeStatus, err := replayStatus(ctx, h, info.ID, &pb.Status{
+		ForkDigest:    forkDigest,
+		FinalizedRoot: make([]byte, 32),
+		HeadRoot:      make([]byte, 32),
+	}, timeout)
+	if err != nil {
+		return errors.Wrap(err, "could not exchange status with node")
+	}
+	log.WithFields(log.Fields{
+		"forkDigest":     fmt.Sprintf("%#x", nodeStatus.ForkDigest),
+		"finalizedEpoch": nodeStatus.FinalizedEpoch,
+		"headSlot":       nodeStatus.HeadSlot,
+	}).Info("Exchanged status with node")
+	return nil
+}
+
+// replayStatus sends the given status to the node, returning the status the node responds with.
+func replayStatus(ctx context.Context, h host.Host, pid peer.ID, status *pb.Status, timeout time.Duration) (*pb.Status, error) {
+	enc := encoder.SszNetworkEncoder{}
+	stream, err := h.NewStream(ctx, pid, protocol.ID(p2p.RPCStatusTopic+enc.ProtocolSuffix()))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := stream.Close(); err != nil {
+			log.WithError(err).Debug("Could not close stream")
+		}
+	}()
+	if err := stream.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return nil, err
+	}
+	if _, err := enc.EncodeWithMaxLength(stream, status); err != nil {
+		return nil, err
+	}
+	if err := stream.CloseWrite(); err != nil {
+		return nil, err
+	}
+	code := make([]byte, 1)
+	if _, err := io.ReadFull(stream, code); err != nil {
+		return nil, err
+	}
+	if code[0] != 0 {
+		return nil, errors.Errorf("node responded with result code %d", code[0])
+	}
+	resp := &pb.Status{}
+	if err := enc.DecodeWithMaxLength(stream, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// replayRequest sends the recorded request payload to the node, returning the complete
+// response payload.
+func replayRequest(ctx context.Context, h host.Host, pid peer.ID, record *dbpb.RPCTraceRecord, timeout time.Duration) ([]byte, error) {
+	stream, err := h.NewStream(ctx, pid, protocol.ID(record.ProtocolId))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open stream")
+	}
+	defer func() {
+		if err := stream.Close(); err != nil {
+			log.WithError(err).Debug("Could not close stream")
+		}
+	}()
+	if err := stream.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return nil, errors.Wrap(err, "could not set stream deadline")
+	}
+	if _, err := stream.Write(record.Request); err != nil {
+		return nil, errors.Wrap(err, "could not write request")
+	}
+	if err := stream.CloseWrite(); err != nil {
+		return nil, errors.Wrap(err, "could not close stream for writing")
+	}
+	response, err := ioutil.ReadAll(stream)
+	if err != nil {
+		return response, errors.Wrap(err, "could not read response")
+	}
+	return response, nil
+}
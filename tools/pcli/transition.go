@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/pkg/errors"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc"
+)
+
+var (
+	transitionBeaconRPCFlag = &cli.StringFlag{
+		Name:  "beacon-rpc-provider",
+		Usage: "Beacon node gRPC endpoint, with debug RPC endpoints enabled",
+		Value: "localhost:4000",
+	}
+	transitionBlockPathFlag = &cli.StringFlag{
+		Name:     "block-path",
+		Usage:    "Path to signed block file(ssz)",
+		Required: true,
+	}
+	transitionPreStatePathFlag = &cli.StringFlag{
+		Name:  "pre-state-path",
+		Usage: "Path to pre state file(ssz)",
+	}
+	transitionStateIDFlag = &cli.StringFlag{
+		Name:  "state-id",
+		Usage: "ID of a pre state known to the beacon node: head, genesis, finalized, justified, a slot or a 0x-prefixed state root",
+	}
+)
+
+var transitionCommand = &cli.Command{
+	Name:     "transition",
+	Category: "state-transition",
+	Usage:    "Subcommand to run a state transition on a beacon node, reporting the duration of every step and the failing operation",
+	Flags: []cli.Flag{
+		transitionBeaconRPCFlag,
+		transitionBlockPathFlag,
+		transitionPreStatePathFlag,
+		transitionStateIDFlag,
+	},
+	Action: runTransition,
+}
+
+func runTransition(c *cli.Context) error {
+	req := &pbrpc.StateTransitionRequest{}
+	var err error
+	req.SignedBlockSsz, err = ioutil.ReadFile(c.String(transitionBlockPathFlag.Name))
+	if err != nil {
+		return errors.Wrap(err, "could not read block")
+	}
+	preStatePath := c.String(transitionPreStatePathFlag.Name)
+	stateID := c.String(transitionStateIDFlag.Name)
+	switch {
+	case preStatePath != "" && stateID != "":
+		return errors.New("only one of a pre state path and a state ID can be provided")
+	case preStatePath != "":
+		encoded, err := ioutil.ReadFile(preStatePath)
+		if err != nil {
+			return errors.Wrap(err, "could not read pre state")
+		}
+		req.PreState = &pbrpc.StateTransitionRequest_PreStateSsz{PreStateSsz: encoded}
+	case stateID != "":
+		req.PreState = &pbrpc.StateTransitionRequest_StateId{StateId: stateID}
+	default:
+		return errors.New("either a pre state path or a state ID must be provided")
+	}
+
+	conn, err := grpc.Dial(c.String(transitionBeaconRPCFlag.Name), grpc.WithInsecure())
+	if err != nil {
+		return errors.Wrap(err, "could not dial beacon node")
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Error("Could not close connection to beacon node")
+		}
+	}()
+	res, err := pbrpc.NewDebugClient(conn).ExecuteStateTransition(context.Background(), req)
+	if err != nil {
+		return errors.Wrap(err, "could not execute state transition")
+	}
+
+	log.Infof("Performing state transition on pre state root of %#x", res.PreStateRoot)
+	var total time.Duration
+	for _, step := range res.Steps {
+		duration := time.Duration(step.DurationNanoseconds)
+		total += duration
+		fields := log.Fields{
+			"step":     step.Name,
+			"duration": duration,
+		}
+		if step.Operation {
+			fields["operationIndex"] = fmt.Sprintf("%d", step.OperationIndex)
+		}
+		log.WithFields(fields).Info("Executed step")
+	}
+	if res.Error != nil {
+		fields := log.Fields{
+			"step": res.Error.Step.Name,
+		}
+		if res.Error.Step.Operation {
+			fields["operationIndex"] = fmt.Sprintf("%d", res.Error.Step.OperationIndex)
+		}
+		if len(res.PostStateRoot) > 0 {
+			fields["postStateRoot"] = fmt.Sprintf("%#x", res.PostStateRoot)
+		}
+		log.WithFields(fields).Errorf("State transition failed after %v: %s", total, res.Error.Message)
+		return errors.New("invalid state transition")
+	}
+	log.Infof("Finished state transition in %v with post state root of %#x", total, res.PostStateRoot)
+	return nil
+}
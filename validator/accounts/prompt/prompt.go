@@ -12,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/promptutil"
 	"github.com/prysmaticlabs/prysm/validator/flags"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
@@ -145,6 +146,61 @@ func InputRemoteKeymanagerConfig(cliCtx *cli.Context) (*remote.KeymanagerOpts, e
 	return newCfg, nil
 }
 
+// InputWeb3SignerKeymanagerConfig via the cli.
+func InputWeb3SignerKeymanagerConfig(cliCtx *cli.Context) (*web3signer.KeymanagerOpts, error) {
+	url := cliCtx.String(flags.Web3SignerURLFlag.Name)
+	gvr := cliCtx.String(flags.Web3SignerGenesisValidatorsRootFlag.Name)
+	crt := cliCtx.String(flags.RemoteSignerCertPathFlag.Name)
+	key := cliCtx.String(flags.RemoteSignerKeyPathFlag.Name)
+	ca := cliCtx.String(flags.RemoteSignerCACertPathFlag.Name)
+	log.Info("Input desired configuration")
+	var err error
+	if url == "" {
+		url, err = promptutil.ValidatePrompt(
+			os.Stdin,
+			"Remote signer URL (such as https://signer.example.com:9000)",
+			promptutil.NotEmpty)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if gvr == "" {
+		gvr, err = promptutil.ValidatePrompt(
+			os.Stdin,
+			"Genesis validators root of the chain (such as 0x04700007...)",
+			promptutil.NotEmpty)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	newCfg := &web3signer.KeymanagerOpts{
+		BaseURL:               strings.TrimSpace(url),
+		GenesisValidatorsRoot: strings.TrimSpace(gvr),
+	}
+	if crt != "" || key != "" || ca != "" {
+		newCfg.TLS = &web3signer.TLSConfig{}
+		for _, p := range []struct {
+			input  string
+			output *string
+		}{
+			{crt, &newCfg.TLS.ClientCertPath},
+			{key, &newCfg.TLS.ClientKeyPath},
+			{ca, &newCfg.TLS.CACertPath},
+		} {
+			if p.input == "" {
+				continue
+			}
+			*p.output, err = fileutil.ExpandPath(strings.TrimRight(p.input, "\r\n"))
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not determine absolute path for %s", p.input)
+			}
+		}
+	}
+	fmt.Printf("%s\n", newCfg)
+	return newCfg, nil
+}
+
 func validateCertPath(input string) error {
 	if input == "" {
 		return errors.New("crt path cannot be empty")
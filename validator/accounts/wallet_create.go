@@ -15,16 +15,18 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
 	"github.com/urfave/cli/v2"
 )
 
 // CreateWalletConfig defines the parameters needed to call the create wallet functions.
 type CreateWalletConfig struct {
-	WalletCfg            *wallet.Config
-	RemoteKeymanagerOpts *remote.KeymanagerOpts
-	SkipMnemonicConfirm  bool
-	Mnemonic25thWord     string
-	NumAccounts          int
+	WalletCfg                *wallet.Config
+	RemoteKeymanagerOpts     *remote.KeymanagerOpts
+	Web3SignerKeymanagerOpts *web3signer.KeymanagerOpts
+	SkipMnemonicConfirm      bool
+	Mnemonic25thWord         string
+	NumAccounts              int
 }
 
 // CreateAndSaveWalletCli from user input with a desired keymanager. If a
@@ -97,6 +99,13 @@ func CreateWalletWithKeymanager(ctx context.Context, cfg *CreateWalletConfig) (*
 		log.WithField("--wallet-dir", cfg.WalletCfg.WalletDir).Info(
 			"Successfully created wallet with remote keymanager configuration",
 		)
+	case keymanager.Web3Signer:
+		if err = createWeb3SignerKeymanagerWallet(ctx, w, cfg.Web3SignerKeymanagerOpts); err != nil {
+			return nil, errors.Wrap(err, "could not initialize wallet")
+		}
+		log.WithField("--wallet-dir", cfg.WalletCfg.WalletDir).Info(
+			"Successfully created wallet with web3signer keymanager configuration",
+		)
 	default:
 		return nil, errors.Wrapf(err, msgKeymanagerNotSupported, w.KeymanagerKind())
 	}
@@ -174,6 +183,13 @@ func extractWalletCreationConfigFromCli(cliCtx *cli.Context, keymanagerKind keym
 		}
 		createWalletConfig.RemoteKeymanagerOpts = opts
 	}
+	if keymanagerKind == keymanager.Web3Signer {
+		opts, err := prompt.InputWeb3SignerKeymanagerConfig(cliCtx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not input web3signer keymanager config")
+		}
+		createWalletConfig.Web3SignerKeymanagerOpts = opts
+	}
 	return createWalletConfig, nil
 }
 
@@ -230,6 +246,20 @@ func createRemoteKeymanagerWallet(ctx context.Context, wallet *wallet.Wallet, op
 	return nil
 }
 
+func createWeb3SignerKeymanagerWallet(ctx context.Context, wallet *wallet.Wallet, opts *web3signer.KeymanagerOpts) error {
+	keymanagerConfig, err := web3signer.MarshalOptionsFile(ctx, opts)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal config file")
+	}
+	if err := wallet.SaveWallet(); err != nil {
+		return errors.Wrap(err, "could not save wallet to disk")
+	}
+	if err := wallet.WriteKeymanagerConfigToDisk(ctx, keymanagerConfig); err != nil {
+		return errors.Wrap(err, "could not write keymanager config to disk")
+	}
+	return nil
+}
+
 func inputKeymanagerKind(cliCtx *cli.Context) (keymanager.Kind, error) {
 	if cliCtx.IsSet(flags.KeymanagerKindFlag.Name) {
 		return keymanager.ParseKind(cliCtx.String(flags.KeymanagerKindFlag.Name))
@@ -240,6 +270,7 @@ func inputKeymanagerKind(cliCtx *cli.Context) (keymanager.Kind, error) {
 			wallet.KeymanagerKindSelections[keymanager.Imported],
 			wallet.KeymanagerKindSelections[keymanager.Derived],
 			wallet.KeymanagerKindSelections[keymanager.Remote],
+			wallet.KeymanagerKindSelections[keymanager.Web3Signer],
 		},
 	}
 	selection, _, err := promptSelect.Run()
@@ -17,6 +17,7 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/flags"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
 	"github.com/sirupsen/logrus"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/urfave/cli/v2"
@@ -267,3 +268,51 @@ func TestCreateWallet_Remote(t *testing.T) {
 	// We assert the created configuration was as desired.
 	assert.DeepEqual(t, wantCfg, cfg)
 }
+
+func TestCreateWallet_Web3Signer(t *testing.T) {
+	walletDir, _, walletPasswordFile := setupWalletAndPasswordsDir(t)
+	wantCfg := &web3signer.KeymanagerOpts{
+		BaseURL:               "https://signer.example.com:9000",
+		GenesisValidatorsRoot: "0x0400000000000000000000000000000000000000000000000000000000000000",
+		TLS: &web3signer.TLSConfig{
+			CACertPath: "/tmp/ca.crt",
+		},
+	}
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	keymanagerKind := "web3signer"
+	set.String(flags.WalletDirFlag.Name, walletDir, "")
+	set.String(flags.WalletPasswordFileFlag.Name, walletDir, "")
+	set.String(flags.KeymanagerKindFlag.Name, keymanagerKind, "")
+	set.String(flags.Web3SignerURLFlag.Name, wantCfg.BaseURL, "")
+	set.String(flags.Web3SignerGenesisValidatorsRootFlag.Name, wantCfg.GenesisValidatorsRoot, "")
+	set.String(flags.RemoteSignerCACertPathFlag.Name, wantCfg.TLS.CACertPath, "")
+	assert.NoError(t, set.Set(flags.WalletDirFlag.Name, walletDir))
+	assert.NoError(t, set.Set(flags.WalletPasswordFileFlag.Name, walletPasswordFile))
+	assert.NoError(t, set.Set(flags.KeymanagerKindFlag.Name, keymanagerKind))
+	assert.NoError(t, set.Set(flags.Web3SignerURLFlag.Name, wantCfg.BaseURL))
+	assert.NoError(t, set.Set(flags.Web3SignerGenesisValidatorsRootFlag.Name, wantCfg.GenesisValidatorsRoot))
+	assert.NoError(t, set.Set(flags.RemoteSignerCACertPathFlag.Name, wantCfg.TLS.CACertPath))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	// We attempt to create the wallet.
+	_, err := CreateAndSaveWalletCli(cliCtx)
+	require.NoError(t, err)
+
+	// We attempt to open the newly created wallet.
+	ctx := context.Background()
+	w, err := wallet.OpenWallet(cliCtx.Context, &wallet.Config{
+		WalletDir: walletDir,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, keymanager.Web3Signer, w.KeymanagerKind())
+
+	// We read the keymanager config for the newly created wallet.
+	encoded, err := w.ReadKeymanagerConfigFromDisk(ctx)
+	assert.NoError(t, err)
+	cfg, err := web3signer.UnmarshalOptionsFile(encoded)
+	assert.NoError(t, err)
+
+	// We assert the created configuration was as desired.
+	assert.DeepEqual(t, wantCfg, cfg)
+}
@@ -131,6 +131,7 @@ func (v *validator) signSlot(ctx context.Context, pubKey [48]byte, slot uint64)
 		PublicKey:       pubKey[:],
 		SigningRoot:     root[:],
 		SignatureDomain: domain.SignatureDomain,
+		SigningEpoch:    helpers.SlotToEpoch(slot),
 		Object:          &validatorpb.SignRequest_Slot{Slot: slot},
 	})
 	if err != nil {
@@ -184,6 +185,7 @@ func (v *validator) aggregateAndProofSig(ctx context.Context, pubKey [48]byte, a
 		PublicKey:       pubKey[:],
 		SigningRoot:     root[:],
 		SignatureDomain: d.SignatureDomain,
+		SigningEpoch:    helpers.SlotToEpoch(agg.Aggregate.Data.Slot),
 		Object:          &validatorpb.SignRequest_AggregateAttestationAndProof{AggregateAttestationAndProof: agg},
 	})
 	if err != nil {
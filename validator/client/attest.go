@@ -182,6 +182,7 @@ func (v *validator) signAtt(ctx context.Context, pubKey [48]byte, data *ethpb.At
 		PublicKey:       pubKey[:],
 		SigningRoot:     root[:],
 		SignatureDomain: domain.SignatureDomain,
+		SigningEpoch:    data.Target.Epoch,
 		Object:          &validatorpb.SignRequest_AttestationData{AttestationData: data},
 	})
 	if err != nil {
@@ -35,7 +35,7 @@ func (v *validator) slashableAttestationCheck(
 	if err != nil {
 		return errors.Wrap(err, "could not get attester history")
 	}
-	slashable, err := isNewAttSlashable(
+	slashable, err := IsNewAttSlashable(
 		ctx,
 		attesterHistory,
 		indexedAtt.Data.Source.Epoch,
@@ -86,16 +86,16 @@ func (v *validator) slashableAttestationCheck(
 	return nil
 }
 
-// isNewAttSlashable uses the attestation history to determine if an attestation of sourceEpoch
+// IsNewAttSlashable uses the attestation history to determine if an attestation of sourceEpoch
 // and targetEpoch would be slashable. It can detect double, surrounding, and surrounded votes.
-func isNewAttSlashable(
+func IsNewAttSlashable(
 	ctx context.Context,
 	history kv.EncHistoryData,
 	sourceEpoch,
 	targetEpoch uint64,
 	signingRoot [32]byte,
 ) (bool, error) {
-	ctx, span := trace.StartSpan(ctx, "isNewAttSlashable")
+	ctx, span := trace.StartSpan(ctx, "IsNewAttSlashable")
 	defer span.End()
 
 	if history == nil {
@@ -0,0 +1,214 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// Number of epochs, starting from the current one, watched for messages of our
+// validators before their duties are started.
+const doppelgangerEpochs = 2
+
+// CheckDoppelgangers watches the chain for a few epochs for attestations and blocks from
+// our validator indices which are not recorded in our slashing protection database.
+// Such messages mean the same keys are already signing elsewhere, so the duties of
+// these keys are not started by this validator client.
+func (v *validator) CheckDoppelgangers(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "validator.CheckDoppelgangers")
+	defer span.End()
+
+	indices, err := v.activeValidatorIndices(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get validator indices")
+	}
+	if len(indices) == 0 {
+		return nil
+	}
+	currentEpoch := slotutil.EpochsSinceGenesis(time.Unix(int64(v.genesisTime), 0))
+	startEpoch := currentEpoch
+	if startEpoch > 0 {
+		startEpoch--
+	}
+	log.WithFields(logrus.Fields{
+		"startEpoch": startEpoch,
+		"endEpoch":   currentEpoch + doppelgangerEpochs - 1,
+	}).Info("Watching the chain for doppelgangers before starting validator duties")
+	for epoch := startEpoch; epoch < currentEpoch+doppelgangerEpochs; epoch++ {
+		// Blocks of the epoch and one more slot for them to propagate.
+		nextStartSlot, err := helpers.StartSlot(epoch + 1)
+		if err != nil {
+			return err
+		}
+		deadline := slotutil.SlotStartTime(v.genesisTime, nextStartSlot+1)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Until(deadline)):
+		}
+		if err := v.checkDoppelgangersForEpoch(ctx, epoch, indices); err != nil {
+			return errors.Wrapf(err, "could not check doppelgangers at epoch %d", epoch)
+		}
+	}
+	if len(v.doppelgangerKeys) == 0 {
+		log.Info("No doppelganger found, starting validator duties")
+	}
+	return nil
+}
+
+// activeValidatorIndices maps the indices of our validators which may be signing on chain
+// to their public keys.
+func (v *validator) activeValidatorIndices(ctx context.Context) (map[uint64][48]byte, error) {
+	validatingKeys, err := v.keyManager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(validatingKeys) == 0 {
+		return nil, nil
+	}
+	resp, err := v.validatorClient.MultipleValidatorStatus(ctx, &ethpb.MultipleValidatorStatusRequest{
+		PublicKeys: bytesutil.FromBytes48Array(validatingKeys),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Statuses) != len(resp.PublicKeys) || len(resp.Indices) != len(resp.PublicKeys) {
+		return nil, errors.New("number of status responses did not match number of public keys")
+	}
+	indices := make(map[uint64][48]byte, len(resp.PublicKeys))
+	for i, status := range resp.Statuses {
+		switch status.Status {
+		case ethpb.ValidatorStatus_ACTIVE,
+			ethpb.ValidatorStatus_EXITING,
+			ethpb.ValidatorStatus_SLASHING,
+			ethpb.ValidatorStatus_EXITED:
+			indices[resp.Indices[i]] = bytesutil.ToBytes48(resp.PublicKeys[i])
+		}
+	}
+	return indices, nil
+}
+
+// checkDoppelgangersForEpoch looks through the attestations and blocks of an epoch for
+// messages of our validators and marks the keys of those we did not sign as doppelgangers.
+func (v *validator) checkDoppelgangersForEpoch(ctx context.Context, epoch uint64, indices map[uint64][48]byte) error {
+	attReq := &ethpb.ListIndexedAttestationsRequest{
+		QueryFilter: &ethpb.ListIndexedAttestationsRequest_Epoch{Epoch: epoch},
+	}
+	for {
+		resp, err := v.beaconClient.ListIndexedAttestations(ctx, attReq)
+		if err != nil {
+			return errors.Wrap(err, "could not list indexed attestations")
+		}
+		for _, att := range resp.IndexedAttestations {
+			for _, index := range att.AttestingIndices {
+				pubKey, ok := indices[index]
+				if !ok || v.doppelgangerKeys[pubKey] {
+					continue
+				}
+				signed, err := v.signedAttestation(ctx, pubKey, att.Data)
+				if err != nil {
+					return err
+				}
+				if !signed {
+					v.markDoppelganger(pubKey, index, fmt.Sprintf(
+						"attestation with source %d and target %d",
+						att.Data.Source.Epoch,
+						att.Data.Target.Epoch,
+					))
+				}
+			}
+		}
+		if resp.NextPageToken == "" || len(resp.IndexedAttestations) == 0 {
+			break
+		}
+		attReq.PageToken = resp.NextPageToken
+	}
+
+	blockReq := &ethpb.ListBlocksRequest{
+		QueryFilter: &ethpb.ListBlocksRequest_Epoch{Epoch: epoch},
+	}
+	for {
+		resp, err := v.beaconClient.ListBlocks(ctx, blockReq)
+		if err != nil {
+			return errors.Wrap(err, "could not list blocks")
+		}
+		for _, container := range resp.BlockContainers {
+			if container.Block == nil || container.Block.Block == nil {
+				continue
+			}
+			blk := container.Block.Block
+			pubKey, ok := indices[blk.ProposerIndex]
+			if !ok || v.doppelgangerKeys[pubKey] {
+				continue
+			}
+			_, signed, err := v.db.ProposalHistoryForSlot(ctx, pubKey, blk.Slot)
+			if err != nil {
+				return errors.Wrapf(err, "could not get proposal history for key %#x", pubKey)
+			}
+			if !signed {
+				v.markDoppelganger(pubKey, blk.ProposerIndex, fmt.Sprintf("block at slot %d", blk.Slot))
+			}
+		}
+		if resp.NextPageToken == "" || len(resp.BlockContainers) == 0 {
+			break
+		}
+		blockReq.PageToken = resp.NextPageToken
+	}
+	return nil
+}
+
+// signedAttestation checks if our attesting history holds an attestation with the source
+// and target epochs of the given attestation data.
+func (v *validator) signedAttestation(ctx context.Context, pubKey [48]byte, data *ethpb.AttestationData) (bool, error) {
+	history, err := v.db.AttestationHistoryForPubKeyV2(ctx, pubKey)
+	if err != nil {
+		return false, errors.Wrapf(err, "could not get attester history for key %#x", pubKey)
+	}
+	if history == nil {
+		return false, nil
+	}
+	latestEpochWritten, err := history.GetLatestEpochWritten(ctx)
+	if err != nil {
+		return false, err
+	}
+	// Attestations older than the weak subjectivity period are pruned from our history.
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	if latestEpochWritten >= wsPeriod && data.Target.Epoch <= latestEpochWritten-wsPeriod {
+		return true, nil
+	}
+	if data.Target.Epoch > latestEpochWritten {
+		return false, nil
+	}
+	hd, err := history.GetTargetData(ctx, data.Target.Epoch)
+	if err != nil {
+		return false, errors.Wrapf(err, "could not get target data for epoch: %d", data.Target.Epoch)
+	}
+	// Epochs skipped while growing the history are empty. Entries without a signing root,
+	// e.g. imported from a slashing protection interchange file, are still our attestations.
+	if hd.IsEmpty() {
+		return false, nil
+	}
+	return hd.Source == data.Source.Epoch, nil
+}
+
+func (v *validator) markDoppelganger(pubKey [48]byte, index uint64, message string) {
+	if v.doppelgangerKeys == nil {
+		v.doppelgangerKeys = make(map[[48]byte]bool)
+	}
+	v.doppelgangerKeys[pubKey] = true
+	log.WithFields(logrus.Fields{
+		"pubKey":         fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+		"validatorIndex": index,
+		"message":        message,
+	}).Error("Found a message signed by this validator which is not in its slashing protection history, " +
+		"another client may be running the same key. Its duties will not be performed")
+}
@@ -0,0 +1,205 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	testing2 "github.com/prysmaticlabs/prysm/validator/db/testing"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func attestationForDoppelganger(index, source, target uint64) *ethpb.IndexedAttestation {
+	return &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{index},
+		Data: &ethpb.AttestationData{
+			BeaconBlockRoot: make([]byte, 32),
+			Source:          &ethpb.Checkpoint{Epoch: source, Root: make([]byte, 32)},
+			Target:          &ethpb.Checkpoint{Epoch: target, Root: make([]byte, 32)},
+		},
+	}
+}
+
+func blockForDoppelganger(proposerIndex, slot uint64) *ethpb.BeaconBlockContainer {
+	return &ethpb.BeaconBlockContainer{
+		Block: &ethpb.SignedBeaconBlock{
+			Block: &ethpb.BeaconBlock{
+				ProposerIndex: proposerIndex,
+				Slot:          slot,
+			},
+		},
+	}
+}
+
+func TestActiveValidatorIndices(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	activeKey, err := bls.RandKey()
+	require.NoError(t, err)
+	pendingKey, err := bls.RandKey()
+	require.NoError(t, err)
+	km := &mockKeymanager{
+		keysMap: map[[48]byte]bls.SecretKey{
+			bytesutil.ToBytes48(activeKey.PublicKey().Marshal()):  activeKey,
+			bytesutil.ToBytes48(pendingKey.PublicKey().Marshal()): pendingKey,
+		},
+	}
+	v := &validator{
+		keyManager:      km,
+		validatorClient: client,
+	}
+	client.EXPECT().MultipleValidatorStatus(gomock.Any(), gomock.Any()).Return(&ethpb.MultipleValidatorStatusResponse{
+		PublicKeys: [][]byte{activeKey.PublicKey().Marshal(), pendingKey.PublicKey().Marshal()},
+		Statuses: []*ethpb.ValidatorStatusResponse{
+			{Status: ethpb.ValidatorStatus_ACTIVE},
+			{Status: ethpb.ValidatorStatus_PENDING},
+		},
+		Indices: []uint64{10, 11},
+	}, nil)
+
+	indices, err := v.activeValidatorIndices(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(indices))
+	assert.Equal(t, bytesutil.ToBytes48(activeKey.PublicKey().Marshal()), indices[10])
+}
+
+func TestCheckDoppelgangersForEpoch_IgnoresOwnMessages(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+	pubKey := [48]byte{1}
+	valDB := testing2.SetupDB(t, [][48]byte{pubKey})
+	v := &validator{
+		db:           valDB,
+		beaconClient: beaconClient,
+	}
+
+	history, err := valDB.AttestationHistoryForPubKeyV2(ctx, pubKey)
+	require.NoError(t, err)
+	history, err = kv.MarkAllAsAttestedSinceLatestWrittenEpoch(ctx, history, 3, &kv.HistoryData{
+		Source:      2,
+		SigningRoot: bytesutil.PadTo([]byte("root"), 32),
+	})
+	require.NoError(t, err)
+	require.NoError(t, valDB.SaveAttestationHistoryForPubKeyV2(ctx, pubKey, history))
+	require.NoError(t, valDB.SaveProposalHistoryForSlot(ctx, pubKey, 97, bytesutil.PadTo([]byte("root"), 32)))
+
+	beaconClient.EXPECT().ListIndexedAttestations(gomock.Any(), gomock.Any()).Return(&ethpb.ListIndexedAttestationsResponse{
+		IndexedAttestations: []*ethpb.IndexedAttestation{attestationForDoppelganger(5, 2, 3)},
+	}, nil)
+	beaconClient.EXPECT().ListBlocks(gomock.Any(), gomock.Any()).Return(&ethpb.ListBlocksResponse{
+		BlockContainers: []*ethpb.BeaconBlockContainer{blockForDoppelganger(5, 97)},
+	}, nil)
+
+	require.NoError(t, v.checkDoppelgangersForEpoch(ctx, 3, map[uint64][48]byte{5: pubKey}))
+	assert.Equal(t, 0, len(v.doppelgangerKeys))
+}
+
+func TestSignedAttestation_WithoutSigningRoot(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	valDB := testing2.SetupDB(t, [][48]byte{pubKey})
+	v := &validator{db: valDB}
+
+	// Epochs 1 and 2 are skipped while marking the attestation of epoch 3, which has no signing root.
+	history, err := valDB.AttestationHistoryForPubKeyV2(ctx, pubKey)
+	require.NoError(t, err)
+	history, err = kv.MarkAllAsAttestedSinceLatestWrittenEpoch(ctx, history, 3, &kv.HistoryData{
+		Source:      2,
+		SigningRoot: make([]byte, 32),
+	})
+	require.NoError(t, err)
+	require.NoError(t, valDB.SaveAttestationHistoryForPubKeyV2(ctx, pubKey, history))
+
+	signed, err := v.signedAttestation(ctx, pubKey, attestationForDoppelganger(5, 2, 3).Data)
+	require.NoError(t, err)
+	assert.Equal(t, true, signed, "Attestation without signing root should be our own")
+	signed, err = v.signedAttestation(ctx, pubKey, attestationForDoppelganger(5, 1, 2).Data)
+	require.NoError(t, err)
+	assert.Equal(t, false, signed, "Skipped epoch should not hold our attestation")
+}
+
+func TestCheckDoppelgangersForEpoch_FindsForeignMessages(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+	attesterKey := [48]byte{1}
+	proposerKey := [48]byte{2}
+	valDB := testing2.SetupDB(t, [][48]byte{attesterKey, proposerKey})
+	v := &validator{
+		db:           valDB,
+		beaconClient: beaconClient,
+	}
+
+	// The attestation of the first page and the block of the second page were signed elsewhere.
+	beaconClient.EXPECT().ListIndexedAttestations(gomock.Any(), gomock.Any()).Return(&ethpb.ListIndexedAttestationsResponse{
+		IndexedAttestations: []*ethpb.IndexedAttestation{attestationForDoppelganger(5, 2, 3)},
+		NextPageToken:       "1",
+	}, nil)
+	beaconClient.EXPECT().ListIndexedAttestations(gomock.Any(), gomock.Any()).Return(&ethpb.ListIndexedAttestationsResponse{
+		IndexedAttestations: []*ethpb.IndexedAttestation{attestationForDoppelganger(7, 2, 3)},
+	}, nil)
+	beaconClient.EXPECT().ListBlocks(gomock.Any(), gomock.Any()).Return(&ethpb.ListBlocksResponse{
+		BlockContainers: []*ethpb.BeaconBlockContainer{blockForDoppelganger(7, 96)},
+		NextPageToken:   "1",
+	}, nil)
+	beaconClient.EXPECT().ListBlocks(gomock.Any(), gomock.Any()).Return(&ethpb.ListBlocksResponse{
+		BlockContainers: []*ethpb.BeaconBlockContainer{blockForDoppelganger(6, 97)},
+	}, nil)
+
+	indices := map[uint64][48]byte{5: attesterKey, 6: proposerKey}
+	require.NoError(t, v.checkDoppelgangersForEpoch(ctx, 3, indices))
+	assert.Equal(t, 2, len(v.doppelgangerKeys))
+	assert.Equal(t, true, v.doppelgangerKeys[attesterKey])
+	assert.Equal(t, true, v.doppelgangerKeys[proposerKey])
+	require.LogsContain(t, hook, "attestation with source 2 and target 3")
+	require.LogsContain(t, hook, "block at slot 97")
+}
+
+func TestUpdateDuties_SkipsDoppelgangerKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	doppelgangerKey, err := bls.RandKey()
+	require.NoError(t, err)
+	pubKey := bytesutil.ToBytes48(key.PublicKey().Marshal())
+	doppelgangerPubKey := bytesutil.ToBytes48(doppelgangerKey.PublicKey().Marshal())
+	km := &mockKeymanager{
+		keysMap: map[[48]byte]bls.SecretKey{
+			pubKey:             key,
+			doppelgangerPubKey: doppelgangerKey,
+		},
+	}
+	v := validator{
+		keyManager:       km,
+		validatorClient:  client,
+		doppelgangerKeys: map[[48]byte]bool{doppelgangerPubKey: true},
+	}
+	client.EXPECT().GetDuties(
+		gomock.Any(),
+		gomock.Any(),
+	).DoAndReturn(func(_ context.Context, req *ethpb.DutiesRequest) (*ethpb.DutiesResponse, error) {
+		require.Equal(t, 1, len(req.PublicKeys))
+		assert.DeepEqual(t, pubKey[:], req.PublicKeys[0])
+		return &ethpb.DutiesResponse{}, nil
+	}).Times(2)
+	client.EXPECT().SubscribeCommitteeSubnets(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(nil, nil)
+
+	require.NoError(t, v.UpdateDuties(context.Background(), 0))
+}
@@ -0,0 +1,324 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	ptypes "github.com/gogo/protobuf/types"
+	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Number of slots the head of the beacon node in use may fall behind the best
+// beacon node before switching over to it.
+const maxHeadSlotLag = 2
+
+// Methods publishing signed messages, which may be sent to all beacon nodes at once.
+var broadcastMethods = map[string]bool{
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/ProposeBlock":                        true,
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/ProposeAttestation":                  true,
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/SubmitSignedAggregateSelectionProof": true,
+}
+
+type failoverBypassKey struct{}
+
+// beaconNodeHealth is the state of a beacon node as seen by its last health check.
+type beaconNodeHealth struct {
+	reachable bool
+	syncing   bool
+	headSlot  uint64
+	peers     int
+}
+
+func (h *beaconNodeHealth) healthy() bool {
+	return h != nil && h.reachable && !h.syncing
+}
+
+// beaconNodeFailover routes the requests of the validator client to the healthiest of
+// several beacon nodes. Every beacon node has its own connection which is dialed with
+// the interceptors of the failover, so the gRPC clients of the validator can be built
+// from any of them. Requests failing because a beacon node went down are retried on the
+// next healthy one, and signed blocks and attestations can be sent to all of them.
+type beaconNodeFailover struct {
+	endpoints  []string
+	conns      []*grpc.ClientConn
+	broadcast  bool
+	lock       sync.RWMutex
+	current    int
+	generation uint64
+	health     []*beaconNodeHealth
+}
+
+func newBeaconNodeFailover(endpoints []string, broadcast bool) *beaconNodeFailover {
+	health := make([]*beaconNodeHealth, len(endpoints))
+	for i := range health {
+		// Nodes are assumed healthy until checked, preferring them in the given order.
+		health[i] = &beaconNodeHealth{reachable: true}
+	}
+	return &beaconNodeFailover{
+		endpoints: endpoints,
+		conns:     make([]*grpc.ClientConn, len(endpoints)),
+		broadcast: broadcast,
+		health:    health,
+	}
+}
+
+// dialOptions returns the options routing the requests of a connection through the failover.
+func (f *beaconNodeFailover) dialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(f.unaryInterceptor),
+		grpc.WithChainStreamInterceptor(f.streamInterceptor),
+	}
+}
+
+// conn returns the connection requests of the validator client are made with.
+func (f *beaconNodeFailover) conn() *grpc.ClientConn {
+	return f.conns[0]
+}
+
+// selected returns the index of the beacon node in use.
+func (f *beaconNodeFailover) selected() int {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	return f.current
+}
+
+// switchedSince reports whether the beacon node in use changed since the given generation,
+// returning the current generation. It is safe to call on a nil failover.
+func (f *beaconNodeFailover) switchedSince(generation uint64) (bool, uint64) {
+	if f == nil {
+		return false, 0
+	}
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	return f.generation != generation, f.generation
+}
+
+// run checks the health of all beacon nodes every slot until the context is canceled.
+func (f *beaconNodeFailover) run(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	defer ticker.Stop()
+	for {
+		f.checkHealth(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkHealth queries the sync status, head slot and peer count of every beacon node
+// and switches to the best one if the node in use fell behind.
+func (f *beaconNodeFailover) checkHealth(ctx context.Context) {
+	health := make([]*beaconNodeHealth, len(f.conns))
+	var wg sync.WaitGroup
+	for i, conn := range f.conns {
+		wg.Add(1)
+		go func(i int, conn *grpc.ClientConn) {
+			defer wg.Done()
+			health[i] = queryBeaconNodeHealth(ctx, conn)
+		}(i, conn)
+	}
+	wg.Wait()
+	if ctx.Err() != nil {
+		return
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.health = health
+	f.switchTo(selectBeaconNode(f.current, health))
+}
+
+func queryBeaconNodeHealth(ctx context.Context, conn *grpc.ClientConn) *beaconNodeHealth {
+	ctx, cancel := context.WithTimeout(context.WithValue(ctx, failoverBypassKey{}, true), time.Second)
+	defer cancel()
+	opt := grpc_retry.Disable()
+	syncStatus, err := ethpb.NewNodeClient(conn).GetSyncStatus(ctx, &ptypes.Empty{}, opt)
+	if err != nil {
+		return &beaconNodeHealth{}
+	}
+	head, err := ethpb.NewBeaconChainClient(conn).GetChainHead(ctx, &ptypes.Empty{}, opt)
+	if err != nil {
+		return &beaconNodeHealth{}
+	}
+	peers, err := ethpb.NewNodeClient(conn).ListPeers(ctx, &ptypes.Empty{}, opt)
+	if err != nil {
+		return &beaconNodeHealth{}
+	}
+	return &beaconNodeHealth{
+		reachable: true,
+		syncing:   syncStatus.Syncing,
+		headSlot:  head.HeadSlot,
+		peers:     len(peers.Peers),
+	}
+}
+
+// selectBeaconNode returns the beacon node to use given their health. The current node is
+// kept while it is healthy, has peers and its head is close enough to the best head seen.
+// Otherwise the healthy node with the highest head slot and then the most peers is used.
+func selectBeaconNode(current int, health []*beaconNodeHealth) int {
+	best := -1
+	for i, h := range health {
+		if !h.healthy() {
+			continue
+		}
+		if best == -1 ||
+			h.headSlot > health[best].headSlot ||
+			(h.headSlot == health[best].headSlot && h.peers > health[best].peers) {
+			best = i
+		}
+	}
+	if best == -1 {
+		// No healthy beacon node, keep the current one until one recovers.
+		return current
+	}
+	c := health[current]
+	if c.healthy() && c.headSlot+maxHeadSlotLag >= health[best].headSlot && (c.peers > 0 || health[best].peers == 0) {
+		return current
+	}
+	return best
+}
+
+// switchTo changes the beacon node in use. The lock must be held by the caller.
+func (f *beaconNodeFailover) switchTo(index int) {
+	if index == f.current {
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"previousEndpoint": f.endpoints[f.current],
+		"endpoint":         f.endpoints[index],
+	}).Warn("Switching to another beacon node")
+	f.current = index
+	f.generation++
+}
+
+// markUnreachable records a beacon node as down after a failed request and switches to the
+// best remaining one. It returns the index of the beacon node in use afterwards.
+func (f *beaconNodeFailover) markUnreachable(index int) int {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.health[index] = &beaconNodeHealth{}
+	f.switchTo(selectBeaconNode(f.current, f.health))
+	return f.current
+}
+
+func (f *beaconNodeFailover) unaryInterceptor(
+	ctx context.Context,
+	method string,
+	req, reply interface{},
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	if ctx.Value(failoverBypassKey{}) != nil {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	if f.broadcast && broadcastMethods[method] {
+		return f.broadcastCall(ctx, method, req, reply, opts...)
+	}
+	index := f.selected()
+	var err error
+	// Try every beacon node at most once, in order of health.
+	for tried := make(map[int]bool); !tried[index]; index = f.markUnreachable(index) {
+		tried[index] = true
+		err = f.invoke(ctx, index, method, req, reply, opts...)
+		if status.Code(err) != codes.Unavailable || ctx.Err() != nil {
+			return err
+		}
+		log.WithError(err).WithField("endpoint", f.endpoints[index]).Warn("Beacon node is unavailable")
+	}
+	return err
+}
+
+// broadcastCall sends a request to all beacon nodes and waits for their responses. It succeeds
+// if any beacon node accepted the request, preferring the response of the node in use.
+func (f *beaconNodeFailover) broadcastCall(
+	ctx context.Context,
+	method string,
+	req, reply interface{},
+	opts ...grpc.CallOption,
+) error {
+	current := f.selected()
+	replies := make([]interface{}, len(f.conns))
+	errs := make([]error, len(f.conns))
+	var wg sync.WaitGroup
+	for i := range f.conns {
+		replies[i] = reply
+		if i != current {
+			r := proto.Clone(reply.(proto.Message))
+			r.Reset()
+			replies[i] = r
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = f.invoke(ctx, i, method, req, replies[i], opts...)
+			if errs[i] != nil && i != current {
+				log.WithError(errs[i]).WithField("endpoint", f.endpoints[i]).Debug("Could not broadcast to beacon node")
+			}
+		}(i)
+	}
+	wg.Wait()
+	if errs[current] == nil {
+		return nil
+	}
+	for i, err := range errs {
+		if err == nil {
+			proto.Merge(reply.(proto.Message), replies[i].(proto.Message))
+			return nil
+		}
+	}
+	return errs[current]
+}
+
+func (f *beaconNodeFailover) invoke(
+	ctx context.Context,
+	index int,
+	method string,
+	req, reply interface{},
+	opts ...grpc.CallOption,
+) error {
+	ctx = context.WithValue(ctx, failoverBypassKey{}, true)
+	// The request is already retried by the connection it was made with.
+	opts = append(opts, grpc_retry.Disable())
+	return f.conns[index].Invoke(ctx, method, req, reply, opts...)
+}
+
+func (f *beaconNodeFailover) streamInterceptor(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	cc *grpc.ClientConn,
+	method string,
+	streamer grpc.Streamer,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	if ctx.Value(failoverBypassKey{}) != nil {
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+	ctx = context.WithValue(ctx, failoverBypassKey{}, true)
+	return f.conns[f.selected()].NewStream(ctx, desc, method, opts...)
+}
+
+// close closes the connections to all beacon nodes.
+func (f *beaconNodeFailover) close() error {
+	var firstErr error
+	for i, conn := range f.conns {
+		if conn == nil {
+			continue
+		}
+		if err := conn.Close(); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("could not close connection to %s: %v", f.endpoints[i], err)
+		}
+	}
+	return firstErr
+}
@@ -0,0 +1,211 @@
+package client
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+type fakeBeaconNode struct {
+	ethpb.UnimplementedNodeServer
+	ethpb.UnimplementedBeaconChainServer
+	ethpb.UnimplementedBeaconNodeValidatorServer
+	syncing      bool
+	headSlot     uint64
+	peers        int
+	attestations int32
+}
+
+func (n *fakeBeaconNode) GetSyncStatus(_ context.Context, _ *ptypes.Empty) (*ethpb.SyncStatus, error) {
+	return &ethpb.SyncStatus{Syncing: n.syncing}, nil
+}
+
+func (n *fakeBeaconNode) GetChainHead(_ context.Context, _ *ptypes.Empty) (*ethpb.ChainHead, error) {
+	return &ethpb.ChainHead{HeadSlot: n.headSlot}, nil
+}
+
+func (n *fakeBeaconNode) ListPeers(_ context.Context, _ *ptypes.Empty) (*ethpb.Peers, error) {
+	peers := make([]*ethpb.Peer, n.peers)
+	for i := range peers {
+		peers[i] = &ethpb.Peer{}
+	}
+	return &ethpb.Peers{Peers: peers}, nil
+}
+
+func (n *fakeBeaconNode) ProposeAttestation(_ context.Context, _ *ethpb.Attestation) (*ethpb.AttestResponse, error) {
+	atomic.AddInt32(&n.attestations, 1)
+	return &ethpb.AttestResponse{AttestationDataRoot: []byte("root")}, nil
+}
+
+// startFakeBeaconNode serves a fake beacon node and returns its endpoint. A nil node returns
+// the endpoint of a beacon node which is down.
+func startFakeBeaconNode(t *testing.T, node *fakeBeaconNode) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	if node == nil {
+		require.NoError(t, lis.Close())
+		return lis.Addr().String()
+	}
+	server := grpc.NewServer()
+	ethpb.RegisterNodeServer(server, node)
+	ethpb.RegisterBeaconChainServer(server, node)
+	ethpb.RegisterBeaconNodeValidatorServer(server, node)
+	go func() {
+		if err := server.Serve(lis); err != nil {
+			t.Log(err)
+		}
+	}()
+	t.Cleanup(server.Stop)
+	return lis.Addr().String()
+}
+
+func setupFailover(t *testing.T, broadcast bool, endpoints ...string) *beaconNodeFailover {
+	f := newBeaconNodeFailover(endpoints, broadcast)
+	for i, endpoint := range endpoints {
+		conn, err := grpc.Dial(endpoint, append([]grpc.DialOption{grpc.WithInsecure()}, f.dialOptions()...)...)
+		require.NoError(t, err)
+		f.conns[i] = conn
+	}
+	t.Cleanup(func() {
+		require.NoError(t, f.close())
+	})
+	return f
+}
+
+func TestSelectBeaconNode(t *testing.T) {
+	tests := []struct {
+		name    string
+		current int
+		health  []*beaconNodeHealth
+		want    int
+	}{
+		{
+			name:    "keeps healthy node",
+			current: 0,
+			health: []*beaconNodeHealth{
+				{reachable: true, headSlot: 100, peers: 10},
+				{reachable: true, headSlot: 100, peers: 50},
+			},
+			want: 0,
+		},
+		{
+			name:    "keeps node slightly behind",
+			current: 0,
+			health: []*beaconNodeHealth{
+				{reachable: true, headSlot: 98, peers: 10},
+				{reachable: true, headSlot: 100, peers: 10},
+			},
+			want: 0,
+		},
+		{
+			name:    "leaves stalled node",
+			current: 0,
+			health: []*beaconNodeHealth{
+				{reachable: true, headSlot: 90, peers: 10},
+				{reachable: true, headSlot: 100, peers: 10},
+				{reachable: true, headSlot: 99, peers: 10},
+			},
+			want: 1,
+		},
+		{
+			name:    "leaves syncing node",
+			current: 0,
+			health: []*beaconNodeHealth{
+				{reachable: true, syncing: true, headSlot: 100, peers: 10},
+				{reachable: true, headSlot: 100, peers: 10},
+			},
+			want: 1,
+		},
+		{
+			name:    "leaves node without peers",
+			current: 0,
+			health: []*beaconNodeHealth{
+				{reachable: true, headSlot: 100},
+				{reachable: true, headSlot: 100, peers: 1},
+			},
+			want: 1,
+		},
+		{
+			name:    "prefers more peers on same head",
+			current: 0,
+			health: []*beaconNodeHealth{
+				{},
+				{reachable: true, headSlot: 100, peers: 1},
+				{reachable: true, headSlot: 100, peers: 5},
+			},
+			want: 2,
+		},
+		{
+			name:    "keeps node when none is healthy",
+			current: 1,
+			health: []*beaconNodeHealth{
+				{},
+				{reachable: true, syncing: true},
+			},
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, selectBeaconNode(tt.current, tt.health))
+		})
+	}
+}
+
+func TestBeaconNodeFailover_CheckHealth(t *testing.T) {
+	syncing := &fakeBeaconNode{syncing: true, headSlot: 10, peers: 5}
+	synced := &fakeBeaconNode{headSlot: 100, peers: 5}
+	f := setupFailover(t, false, startFakeBeaconNode(t, syncing), startFakeBeaconNode(t, synced))
+
+	f.checkHealth(context.Background())
+	assert.Equal(t, 1, f.selected())
+	switched, generation := f.switchedSince(0)
+	assert.Equal(t, true, switched)
+	assert.Equal(t, uint64(1), generation)
+}
+
+func TestBeaconNodeFailover_SwitchesOnUnavailableNode(t *testing.T) {
+	node := &fakeBeaconNode{headSlot: 100, peers: 5}
+	f := setupFailover(t, false, startFakeBeaconNode(t, nil), startFakeBeaconNode(t, node))
+
+	resp, err := ethpb.NewNodeClient(f.conn()).GetSyncStatus(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, false, resp.Syncing)
+	assert.Equal(t, 1, f.selected())
+}
+
+func TestBeaconNodeFailover_AllNodesUnavailable(t *testing.T) {
+	f := setupFailover(t, false, startFakeBeaconNode(t, nil), startFakeBeaconNode(t, nil))
+
+	_, err := ethpb.NewNodeClient(f.conn()).GetSyncStatus(context.Background(), &ptypes.Empty{})
+	require.ErrorContains(t, "Unavailable", err)
+}
+
+func TestBeaconNodeFailover_Broadcast(t *testing.T) {
+	first := &fakeBeaconNode{}
+	second := &fakeBeaconNode{}
+	f := setupFailover(t, true, startFakeBeaconNode(t, first), startFakeBeaconNode(t, nil), startFakeBeaconNode(t, second))
+
+	resp, err := ethpb.NewBeaconNodeValidatorClient(f.conn()).ProposeAttestation(context.Background(), &ethpb.Attestation{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte("root"), resp.AttestationDataRoot)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&first.attestations))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&second.attestations))
+}
+
+func TestBeaconNodeFailover_BroadcastWithNodeInUseDown(t *testing.T) {
+	node := &fakeBeaconNode{}
+	f := setupFailover(t, true, startFakeBeaconNode(t, nil), startFakeBeaconNode(t, node))
+
+	resp, err := ethpb.NewBeaconNodeValidatorClient(f.conn()).ProposeAttestation(context.Background(), &ethpb.Attestation{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte("root"), resp.AttestationDataRoot)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&node.attestations))
+}
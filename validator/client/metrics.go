@@ -161,6 +161,61 @@ var (
 			"pubkey",
 		},
 	)
+	// ValidatorIncludedAttestationsVec used to count attestations included on chain.
+	ValidatorIncludedAttestationsVec = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "included_attestations",
+			Help:      "Count the epochs in which the attestation was included on chain.",
+		},
+		[]string{
+			"pubkey",
+		},
+	)
+	// ValidatorMissedAttestationsVec used to count attestations missing on chain.
+	ValidatorMissedAttestationsVec = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "missed_attestations",
+			Help:      "Count the epochs in which no attestation was included on chain.",
+		},
+		[]string{
+			"pubkey",
+		},
+	)
+	// ValidatorLateAttestationsVec used to count attestations included later than the next slot.
+	ValidatorLateAttestationsVec = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "late_attestations",
+			Help:      "Count the attestations included later than the next slot.",
+		},
+		[]string{
+			"pubkey",
+		},
+	)
+	// ValidatorMissedProposalsVec used to count block proposals the validator failed to make.
+	ValidatorMissedProposalsVec = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "missed_proposals",
+			Help:      "Count the assigned block proposals which were not made.",
+		},
+		[]string{
+			"pubkey",
+		},
+	)
+	// ValidatorBalanceChangeGaugeVec used to keep track of the balance change of the last epoch by public key.
+	ValidatorBalanceChangeGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "balance_change_gwei",
+			Help:      "Balance change in Gwei over the last epoch transition.",
+		},
+		[]string{
+			"pubkey",
+		},
+	)
 	// ValidatorAttestFailVecSlasher used to count failed attestations by slashing protection.
 	ValidatorAttestFailVecSlasher = promauto.NewCounterVec(
 		prometheus.CounterOpts{
@@ -176,15 +231,13 @@ var (
 // LogValidatorGainsAndLosses logs important metrics related to this validator client's
 // responsibilities throughout the beacon chain's lifecycle. It logs absolute accrued rewards
 // and penalties over time, percentage gain/loss, and gives the end user a better idea
-// of how the validator performs with respect to the rest.
+// of how the validator performs with respect to the rest. The performance of the validators
+// is recorded even if logging their balances is disabled.
 func (v *validator) LogValidatorGainsAndLosses(ctx context.Context, slot uint64) error {
 	if !helpers.IsEpochEnd(slot) || slot <= params.BeaconConfig().SlotsPerEpoch {
 		// Do nothing unless we are at the end of the epoch, and not in the first epoch.
 		return nil
 	}
-	if !v.logValidatorBalances {
-		return nil
-	}
 
 	var pks [][48]byte
 	var err error
@@ -202,6 +255,17 @@ func (v *validator) LogValidatorGainsAndLosses(ctx context.Context, slot uint64)
 		return err
 	}
 
+	prevEpoch := uint64(0)
+	if slot >= params.BeaconConfig().SlotsPerEpoch {
+		prevEpoch = (slot / params.BeaconConfig().SlotsPerEpoch) - 1
+	}
+	if err := v.savePerformance(ctx, prevEpoch, resp); err != nil {
+		log.WithError(err).Error("Could not save validator performance")
+	}
+	if !v.logValidatorBalances {
+		return nil
+	}
+
 	if v.emitAccountMetrics {
 		for _, missingPubKey := range resp.MissingValidators {
 			fmtKey := fmt.Sprintf("%#x", missingPubKey)
@@ -209,12 +273,8 @@ func (v *validator) LogValidatorGainsAndLosses(ctx context.Context, slot uint64)
 		}
 	}
 
-	prevEpoch := uint64(0)
-	if slot >= params.BeaconConfig().SlotsPerEpoch {
-		prevEpoch = (slot / params.BeaconConfig().SlotsPerEpoch) - 1
-		if v.voteStats.startEpoch == ^uint64(0) { // Handles unknown first epoch.
-			v.voteStats.startEpoch = prevEpoch
-		}
+	if slot >= params.BeaconConfig().SlotsPerEpoch && v.voteStats.startEpoch == ^uint64(0) { // Handles unknown first epoch.
+		v.voteStats.startEpoch = prevEpoch
 	}
 	gweiPerEth := float64(params.BeaconConfig().GweiPerEth)
 	v.prevBalanceLock.Lock()
@@ -29,6 +29,7 @@ type FakeValidator struct {
 	SaveProtectionsCalled             bool
 	DeleteProtectionCalled            bool
 	SlotDeadlineCalled                bool
+	CheckDoppelgangersCalled          bool
 	ProposeBlockArg1                  uint64
 	AttestToBlockHeadArg1             uint64
 	RoleAtArg1                        uint64
@@ -186,3 +187,9 @@ func (fv *FakeValidator) AllValidatorsAreExited(ctx context.Context) (bool, erro
 	}
 	return ctx.Value(allValidatorsAreExitedCtxKey).(bool), nil
 }
+
+// CheckDoppelgangers for mocking
+func (fv *FakeValidator) CheckDoppelgangers(_ context.Context) error {
+	fv.CheckDoppelgangersCalled = true
+	return nil
+}
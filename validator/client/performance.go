@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+)
+
+// proposalCounts are the block proposals of a validator in an epoch.
+type proposalCounts struct {
+	made   uint64
+	missed uint64
+}
+
+// recordProposal counts a block proposal of a validator as made or missed.
+func (v *validator) recordProposal(pubKey [48]byte, epoch uint64, proposed bool) {
+	v.proposalStatsLock.Lock()
+	defer v.proposalStatsLock.Unlock()
+	if v.proposalStats == nil {
+		v.proposalStats = make(map[uint64]map[[48]byte]*proposalCounts)
+	}
+	if v.proposalStats[epoch] == nil {
+		v.proposalStats[epoch] = make(map[[48]byte]*proposalCounts)
+	}
+	counts, ok := v.proposalStats[epoch][pubKey]
+	if !ok {
+		counts = &proposalCounts{}
+		v.proposalStats[epoch][pubKey] = counts
+	}
+	if proposed {
+		counts.made++
+	} else {
+		counts.missed++
+	}
+}
+
+// takeProposalStats returns the block proposals counted in an epoch and forgets those of
+// the epoch and any earlier one.
+func (v *validator) takeProposalStats(epoch uint64) map[[48]byte]*proposalCounts {
+	v.proposalStatsLock.Lock()
+	defer v.proposalStatsLock.Unlock()
+	stats := v.proposalStats[epoch]
+	for e := range v.proposalStats {
+		if e <= epoch {
+			delete(v.proposalStats, e)
+		}
+	}
+	return stats
+}
+
+// savePerformance records the performance of our validators in an epoch, as reported by the
+// beacon node at the end of the next epoch, to the validator database and to the
+// per-key Prometheus metrics.
+func (v *validator) savePerformance(ctx context.Context, epoch uint64, resp *ethpb.ValidatorPerformanceResponse) error {
+	proposals := v.takeProposalStats(epoch)
+	records := make(map[[48]byte]*kv.PerformanceRecord, len(resp.PublicKeys))
+	for i, pubKey := range resp.PublicKeys {
+		included := resp.InclusionSlots[i] != ^uint64(0)
+		record := &kv.PerformanceRecord{
+			Epoch:                epoch,
+			AttestationReported:  true,
+			AttestationIncluded:  included,
+			AttestationLate:      included && resp.InclusionDistances[i] > params.BeaconConfig().MinAttestationInclusionDelay,
+			CorrectlyVotedSource: resp.CorrectlyVotedSource[i],
+			CorrectlyVotedTarget: resp.CorrectlyVotedTarget[i],
+			CorrectlyVotedHead:   resp.CorrectlyVotedHead[i],
+			BalanceBefore:        resp.BalancesBeforeEpochTransition[i],
+			BalanceAfter:         resp.BalancesAfterEpochTransition[i],
+		}
+		if included {
+			record.InclusionSlot = resp.InclusionSlots[i]
+			record.InclusionDistance = resp.InclusionDistances[i]
+		}
+		records[bytesutil.ToBytes48(pubKey)] = record
+	}
+	// Proposals of validators the beacon node reported nothing about are kept as well.
+	for pubKey, counts := range proposals {
+		record, ok := records[pubKey]
+		if !ok {
+			record = &kv.PerformanceRecord{Epoch: epoch}
+			records[pubKey] = record
+		}
+		record.ProposalsMade = counts.made
+		record.ProposalsMissed = counts.missed
+	}
+
+	if v.emitAccountMetrics {
+		for pubKey, record := range records {
+			fmtKey := fmt.Sprintf("%#x", pubKey)
+			ValidatorMissedProposalsVec.WithLabelValues(fmtKey).Add(float64(record.ProposalsMissed))
+			if !record.AttestationReported {
+				continue
+			}
+			if record.AttestationIncluded {
+				ValidatorIncludedAttestationsVec.WithLabelValues(fmtKey).Inc()
+			} else {
+				ValidatorMissedAttestationsVec.WithLabelValues(fmtKey).Inc()
+			}
+			if record.AttestationLate {
+				ValidatorLateAttestationsVec.WithLabelValues(fmtKey).Inc()
+			}
+			ValidatorBalanceChangeGaugeVec.WithLabelValues(fmtKey).Set(
+				float64(record.BalanceAfter) - float64(record.BalanceBefore),
+			)
+		}
+	}
+	return v.db.SavePerformanceRecords(ctx, records)
+}
@@ -0,0 +1,153 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	testing2 "github.com/prysmaticlabs/prysm/validator/db/testing"
+)
+
+func TestLogValidatorGainsAndLosses_SavesPerformance(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+	attesterKey, err := bls.RandKey()
+	require.NoError(t, err)
+	missedKey, err := bls.RandKey()
+	require.NoError(t, err)
+	attesterPubKey := bytesutil.ToBytes48(attesterKey.PublicKey().Marshal())
+	missedPubKey := bytesutil.ToBytes48(missedKey.PublicKey().Marshal())
+	valDB := testing2.SetupDB(t, [][48]byte{attesterPubKey, missedPubKey})
+	v := &validator{
+		db:                   valDB,
+		beaconClient:         beaconClient,
+		logValidatorBalances: true,
+		keyManager: &mockKeymanager{
+			keysMap: map[[48]byte]bls.SecretKey{
+				attesterPubKey: attesterKey,
+				missedPubKey:   missedKey,
+			},
+		},
+		startBalances: make(map[[48]byte]uint64),
+		prevBalance:   make(map[[48]byte]uint64),
+		voteStats:     voteStats{startEpoch: ^uint64(0)},
+	}
+	v.recordProposal(missedPubKey, 1, true)
+	v.recordProposal(missedPubKey, 1, false)
+	v.recordProposal(missedPubKey, 2, true)
+
+	beaconClient.EXPECT().GetValidatorPerformance(gomock.Any(), gomock.Any()).Return(&ethpb.ValidatorPerformanceResponse{
+		PublicKeys:                    [][]byte{attesterPubKey[:], missedPubKey[:]},
+		CorrectlyVotedSource:          []bool{true, false},
+		CorrectlyVotedTarget:          []bool{true, false},
+		CorrectlyVotedHead:            []bool{false, false},
+		InclusionSlots:                []uint64{params.BeaconConfig().SlotsPerEpoch + 3, ^uint64(0)},
+		InclusionDistances:            []uint64{2, ^uint64(0)},
+		BalancesBeforeEpochTransition: []uint64{32000000000, 32000000000},
+		BalancesAfterEpochTransition:  []uint64{32000001000, 31999999000},
+	}, nil)
+
+	slot := 3*params.BeaconConfig().SlotsPerEpoch - 1
+	require.NoError(t, v.LogValidatorGainsAndLosses(ctx, slot))
+
+	records, err := valDB.PerformanceRecords(ctx, attesterPubKey, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(records))
+	assert.DeepEqual(t, &kv.PerformanceRecord{
+		Epoch:                1,
+		AttestationReported:  true,
+		AttestationIncluded:  true,
+		AttestationLate:      true,
+		InclusionSlot:        params.BeaconConfig().SlotsPerEpoch + 3,
+		InclusionDistance:    2,
+		CorrectlyVotedSource: true,
+		CorrectlyVotedTarget: true,
+		BalanceBefore:        32000000000,
+		BalanceAfter:         32000001000,
+	}, records[0])
+
+	records, err = valDB.PerformanceRecords(ctx, missedPubKey, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(records))
+	assert.DeepEqual(t, &kv.PerformanceRecord{
+		Epoch:               1,
+		AttestationReported: true,
+		ProposalsMade:       1,
+		ProposalsMissed:     1,
+		BalanceBefore:       32000000000,
+		BalanceAfter:        31999999000,
+	}, records[0])
+
+	// Proposals of the current epoch are kept for the next record.
+	stats := v.takeProposalStats(2)
+	require.Equal(t, 1, len(stats))
+	assert.Equal(t, uint64(1), stats[missedPubKey].made)
+}
+
+func TestLogValidatorGainsAndLosses_SavesPerformanceWithoutLogging(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	pubKey := bytesutil.ToBytes48(key.PublicKey().Marshal())
+	unreportedPubKey := [48]byte{1}
+	valDB := testing2.SetupDB(t, [][48]byte{pubKey, unreportedPubKey})
+	v := &validator{
+		db:           valDB,
+		beaconClient: beaconClient,
+		keyManager: &mockKeymanager{
+			keysMap: map[[48]byte]bls.SecretKey{pubKey: key},
+		},
+		emitAccountMetrics: true,
+	}
+	v.recordProposal(unreportedPubKey, 0, true)
+	v.recordProposal(unreportedPubKey, 1, false)
+
+	beaconClient.EXPECT().GetValidatorPerformance(gomock.Any(), gomock.Any()).Return(&ethpb.ValidatorPerformanceResponse{
+		PublicKeys:                    [][]byte{pubKey[:]},
+		CorrectlyVotedSource:          []bool{true},
+		CorrectlyVotedTarget:          []bool{true},
+		CorrectlyVotedHead:            []bool{true},
+		InclusionSlots:                []uint64{params.BeaconConfig().SlotsPerEpoch + 1},
+		InclusionDistances:            []uint64{1},
+		BalancesBeforeEpochTransition: []uint64{32000000000},
+		BalancesAfterEpochTransition:  []uint64{32000001000},
+	}, nil)
+
+	slot := 3*params.BeaconConfig().SlotsPerEpoch - 1
+	require.NoError(t, v.LogValidatorGainsAndLosses(ctx, slot))
+
+	records, err := valDB.PerformanceRecords(ctx, pubKey, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(records))
+	assert.Equal(t, true, records[0].AttestationIncluded)
+
+	// The proposal-only record of a key the beacon node did not report holds no attestation.
+	records, err = valDB.PerformanceRecords(ctx, unreportedPubKey, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(records))
+	assert.DeepEqual(t, &kv.PerformanceRecord{
+		Epoch:           1,
+		ProposalsMissed: 1,
+	}, records[0])
+	fmtKey := fmt.Sprintf("%#x", unreportedPubKey)
+	assert.Equal(t, float64(0), testutil.ToFloat64(ValidatorMissedAttestationsVec.WithLabelValues(fmtKey)))
+	assert.Equal(t, float64(1), testutil.ToFloat64(ValidatorMissedProposalsVec.WithLabelValues(fmtKey)))
+
+	// Proposals of the recorded epoch and earlier ones were drained.
+	assert.Equal(t, 0, len(v.proposalStats))
+}
@@ -49,6 +49,10 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 
 	// Sign randao reveal, it's used to request block from beacon node
 	epoch := slot / params.BeaconConfig().SlotsPerEpoch
+	proposed := false
+	defer func() {
+		v.recordProposal(pubKey, epoch, proposed)
+	}()
 	randaoReveal, err := v.signRandaoReveal(ctx, pubKey, epoch)
 	if err != nil {
 		log.WithError(err).Error("Failed to sign randao reveal")
@@ -132,6 +136,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 		"numDeposits":     len(b.Body.Deposits),
 		"graffiti":        string(b.Body.Graffiti),
 	}).Info("Submitted new block")
+	proposed = true
 
 	if v.emitAccountMetrics {
 		ValidatorProposeSuccessVec.WithLabelValues(fmtKey).Inc()
@@ -199,6 +204,7 @@ func (v *validator) signRandaoReveal(ctx context.Context, pubKey [48]byte, epoch
 		PublicKey:       pubKey[:],
 		SigningRoot:     root[:],
 		SignatureDomain: domain.SignatureDomain,
+		SigningEpoch:    epoch,
 		Object:          &validatorpb.SignRequest_Epoch{Epoch: epoch},
 	})
 	if err != nil {
@@ -226,6 +232,7 @@ func (v *validator) signBlock(ctx context.Context, pubKey [48]byte, epoch uint64
 		PublicKey:       pubKey[:],
 		SigningRoot:     blockRoot[:],
 		SignatureDomain: domain.SignatureDomain,
+		SigningEpoch:    epoch,
 		Object:          &validatorpb.SignRequest_Block{Block: b},
 	})
 	if err != nil {
@@ -264,6 +271,7 @@ func signVoluntaryExit(
 		PublicKey:       pubKey,
 		SigningRoot:     exitRoot[:],
 		SignatureDomain: domain.SignatureDomain,
+		SigningEpoch:    exit.Epoch,
 		Object:          &validatorpb.SignRequest_Exit{Exit: exit},
 	})
 	if err != nil {
@@ -36,6 +36,7 @@ type Validator interface {
 	UpdateDomainDataCaches(ctx context.Context, slot uint64)
 	WaitForWalletInitialization(ctx context.Context) error
 	AllValidatorsAreExited(ctx context.Context) (bool, error)
+	CheckDoppelgangers(ctx context.Context) error
 }
 
 // Run the main validator routine. This routine exits if the context is
@@ -44,6 +45,7 @@ type Validator interface {
 // Order of operations:
 // 1 - Initialize validator data
 // 2 - Wait for validator activation
+// 2a - Watch the chain for doppelgangers, if enabled
 // 3 - Wait for the next slot start
 // 4 - Update assignments
 // 5 - Determine role at current slot
@@ -70,6 +72,11 @@ func run(ctx context.Context, v Validator) {
 	if err := v.WaitForActivation(ctx); err != nil {
 		log.Fatalf("Could not wait for validator activation: %v", err)
 	}
+	if featureconfig.Get().EnableDoppelgangerDetection {
+		if err := v.CheckDoppelgangers(ctx); err != nil {
+			log.Fatalf("Could not check for doppelgangers: %v", err)
+		}
+	}
 	headSlot, err := v.CanonicalHeadSlot(ctx)
 	if err != nil {
 		log.Fatalf("Could not get current canonical head slot: %v", err)
@@ -47,6 +47,16 @@ func TestCancelledContext_ChecksSlasherReady(t *testing.T) {
 	assert.Equal(t, true, v.SlasherReadyCalled, "Expected SlasherReady() to be called")
 }
 
+func TestCancelledContext_ChecksDoppelgangers(t *testing.T) {
+	v := &FakeValidator{}
+	reset := featureconfig.InitWithReset(&featureconfig.Flags{
+		EnableDoppelgangerDetection: true,
+	})
+	defer reset()
+	run(cancelledContext(), v)
+	assert.Equal(t, true, v.CheckDoppelgangersCalled, "Expected CheckDoppelgangers() to be called")
+}
+
 func TestUpdateDuties_NextSlot(t *testing.T) {
 	v := &FakeValidator{}
 	ctx, cancel := context.WithCancel(context.Background())
@@ -23,7 +23,6 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
-	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
 	slashingprotection "github.com/prysmaticlabs/prysm/validator/slashing-protection"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/plugin/ocgrpc"
@@ -54,6 +53,8 @@ type ValidatorService struct {
 	logValidatorBalances  bool
 	logDutyCountDown      bool
 	conn                  *grpc.ClientConn
+	failover              *beaconNodeFailover
+	broadcast             bool
 	grpcRetryDelay        time.Duration
 	grpcRetries           uint
 	maxCallRecvMsgSize    int
@@ -92,6 +93,7 @@ type Config struct {
 	DataDir                    string
 	GrpcHeadersFlag            string
 	GraffitiStruct             *graffiti.Graffiti
+	BroadcastToAllNodes        bool
 }
 
 // NewValidatorService creates a new validator service for the service
@@ -119,6 +121,7 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		useWeb:                cfg.UseWeb,
 		graffitiStruct:        cfg.GraffitiStruct,
 		logDutyCountDown:      cfg.LogDutyCountDown,
+		broadcast:             cfg.BroadcastToAllNodes,
 	}, nil
 }
 
@@ -152,16 +155,35 @@ func (v *ValidatorService) Start() {
 		}
 	}
 
-	conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpts...)
-	if err != nil {
-		log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
-		return
+	endpoints := strings.Split(v.endpoint, ",")
+	if len(endpoints) > 1 {
+		// Each beacon node gets its own connection so their health can be tracked apart.
+		v.failover = newBeaconNodeFailover(endpoints, v.broadcast)
+		for i, endpoint := range endpoints {
+			conn, err := grpc.DialContext(v.ctx, endpoint, append(dialOpts, v.failover.dialOptions()...)...)
+			if err != nil {
+				log.Errorf("Could not dial endpoint: %s, %v", endpoint, err)
+				if err := v.failover.close(); err != nil {
+					log.WithError(err).Error("Could not close beacon node connections")
+				}
+				return
+			}
+			v.failover.conns[i] = conn
+		}
+		v.conn = v.failover.conn()
+		log.WithField("endpoints", endpoints).Info("Using beacon node failover")
+	} else {
+		conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpts...)
+		if err != nil {
+			log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
+			return
+		}
+		v.conn = conn
 	}
 	if v.withCert != "" {
 		log.Info("Established secure gRPC connection")
 	}
 
-	v.conn = conn
 	cache, err := ristretto.NewCache(&ristretto.Config{
 		NumCounters: 1920, // number of keys to track.
 		MaxCost:     192,  // maximum cost of cache, 1 item = 1 cost.
@@ -197,6 +219,10 @@ func (v *ValidatorService) Start() {
 		walletInitializedFeed:          v.walletInitializedFeed,
 		graffitiStruct:                 v.graffitiStruct,
 		logDutyCountDown:               v.logDutyCountDown,
+		failover:                       v.failover,
+	}
+	if v.failover != nil {
+		go v.failover.run(v.ctx)
 	}
 	go run(v.ctx, v.validator)
 	go v.recheckKeys(v.ctx)
@@ -206,6 +232,9 @@ func (v *ValidatorService) Start() {
 func (v *ValidatorService) Stop() error {
 	v.cancel()
 	log.Info("Stopping service")
+	if v.failover != nil {
+		return v.failover.close()
+	}
 	if v.conn != nil {
 		return v.conn.Close()
 	}
@@ -323,15 +352,21 @@ func (v *ValidatorService) GenesisInfo(ctx context.Context) (*ethpb.Genesis, err
 	return nc.GetGenesis(ctx, &ptypes.Empty{})
 }
 
+// accountChangesSubscriber is implemented by keymanagers whose validating keys may change at runtime,
+// such as the imported and web3signer keymanagers.
+type accountChangesSubscriber interface {
+	SubscribeAccountChanges(pubKeysChan chan [][48]byte) event.Subscription
+}
+
 // to accounts changes in the keymanager, then updates those keys'
 // buckets in bolt DB if a bucket for a key does not exist.
 func recheckValidatingKeysBucket(ctx context.Context, valDB db.Database, km keymanager.IKeymanager) {
-	importedKeymanager, ok := km.(*imported.Keymanager)
+	subscriber, ok := km.(accountChangesSubscriber)
 	if !ok {
 		return
 	}
 	validatingPubKeysChan := make(chan [][48]byte, 1)
-	sub := importedKeymanager.SubscribeAccountChanges(validatingPubKeysChan)
+	sub := subscriber.SubscribeAccountChanges(validatingPubKeysChan)
 	defer sub.Unsubscribe()
 	for {
 		select {
@@ -86,6 +86,11 @@ type validator struct {
 	graffiti                           []byte
 	voteStats                          voteStats
 	graffitiStruct                     *graffiti.Graffiti
+	doppelgangerKeys                   map[[48]byte]bool
+	failover                           *beaconNodeFailover
+	dutiesGeneration                   uint64
+	proposalStatsLock                  sync.Mutex
+	proposalStats                      map[uint64]map[[48]byte]*proposalCounts
 }
 
 // Done cleans up the validator.
@@ -313,10 +318,13 @@ func (v *validator) SlotDeadline(slot uint64) time.Time {
 // list of upcoming assignments needs to be updated. For example, at the
 // beginning of a new epoch.
 func (v *validator) UpdateDuties(ctx context.Context, slot uint64) error {
-	if slot%params.BeaconConfig().SlotsPerEpoch != 0 && v.duties != nil {
+	// Duties are fetched again after switching beacon nodes, to subscribe the new one to our subnets.
+	switched, generation := v.failover.switchedSince(v.dutiesGeneration)
+	if slot%params.BeaconConfig().SlotsPerEpoch != 0 && v.duties != nil && !switched {
 		// Do nothing if not epoch start AND assignments already exist.
 		return nil
 	}
+	v.dutiesGeneration = generation
 	// Set deadline to end of epoch.
 	ss, err := helpers.StartSlot(helpers.SlotToEpoch(slot) + 1)
 	if err != nil {
@@ -331,6 +339,16 @@ func (v *validator) UpdateDuties(ctx context.Context, slot uint64) error {
 	if err != nil {
 		return err
 	}
+	if len(v.doppelgangerKeys) > 0 {
+		// Keys found signing elsewhere are not given any duties.
+		filteredKeys := make([][48]byte, 0, len(validatingKeys))
+		for _, key := range validatingKeys {
+			if !v.doppelgangerKeys[key] {
+				filteredKeys = append(filteredKeys, key)
+			}
+		}
+		validatingKeys = filteredKeys
+	}
 	req := &ethpb.DutiesRequest{
 		Epoch:      slot / params.BeaconConfig().SlotsPerEpoch,
 		PublicKeys: bytesutil.FromBytes48Array(validatingKeys),
@@ -39,4 +39,9 @@ type ValidatorDB interface {
 	AttestationHistoryForPubKeyV2(ctx context.Context, publicKey [48]byte) (kv.EncHistoryData, error)
 	SaveAttestationHistoryForPubKeyV2(ctx context.Context, publicKey [48]byte, history kv.EncHistoryData) error
 	AttestedPublicKeys(ctx context.Context) ([][48]byte, error)
+
+	// Validator performance related methods.
+	SavePerformanceRecords(ctx context.Context, records map[[48]byte]*kv.PerformanceRecord) error
+	PerformanceRecords(ctx context.Context, publicKey [48]byte, startEpoch, endEpoch uint64) ([]*kv.PerformanceRecord, error)
+	PerformancePublicKeys(ctx context.Context) ([][48]byte, error)
 }
@@ -102,6 +102,7 @@ func NewKVStore(ctx context.Context, dirPath string, pubKeys [][48]byte) (*Store
 			lowestSignedTargetBucket,
 			lowestSignedProposalsBucket,
 			highestSignedProposalsBucket,
+			performanceBucket,
 			migrationsBucket,
 		)
 	}); err != nil {
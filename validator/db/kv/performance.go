@@ -0,0 +1,185 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// Bit flags of the boolean fields of an encoded performance record.
+const (
+	performanceIncludedFlag byte = 1 << iota
+	performanceLateFlag
+	performanceSourceFlag
+	performanceTargetFlag
+	performanceHeadFlag
+	performanceReportedFlag
+)
+
+// A flags byte followed by six uint64 values.
+const performanceRecordSize = 1 + 6*8
+
+// PerformanceRecord is the performance of a validator in a single epoch.
+type PerformanceRecord struct {
+	Epoch uint64
+	// AttestationReported is whether the beacon node reported the attestation performance of
+	// the validator, records of validators it did not report only hold their proposals.
+	AttestationReported  bool
+	AttestationIncluded  bool
+	AttestationLate      bool
+	InclusionSlot        uint64
+	InclusionDistance    uint64
+	CorrectlyVotedSource bool
+	CorrectlyVotedTarget bool
+	CorrectlyVotedHead   bool
+	ProposalsMade        uint64
+	ProposalsMissed      uint64
+	BalanceBefore        uint64
+	BalanceAfter         uint64
+}
+
+func (r *PerformanceRecord) encode() []byte {
+	enc := make([]byte, performanceRecordSize)
+	var flags byte
+	for flag, set := range map[byte]bool{
+		performanceReportedFlag: r.AttestationReported,
+		performanceIncludedFlag: r.AttestationIncluded,
+		performanceLateFlag:     r.AttestationLate,
+		performanceSourceFlag:   r.CorrectlyVotedSource,
+		performanceTargetFlag:   r.CorrectlyVotedTarget,
+		performanceHeadFlag:     r.CorrectlyVotedHead,
+	} {
+		if set {
+			flags |= flag
+		}
+	}
+	enc[0] = flags
+	for i, val := range []uint64{
+		r.InclusionSlot,
+		r.InclusionDistance,
+		r.ProposalsMade,
+		r.ProposalsMissed,
+		r.BalanceBefore,
+		r.BalanceAfter,
+	} {
+		copy(enc[1+i*8:9+i*8], bytesutil.Uint64ToBytesBigEndian(val))
+	}
+	return enc
+}
+
+func decodePerformanceRecord(epoch uint64, enc []byte) (*PerformanceRecord, error) {
+	if len(enc) != performanceRecordSize {
+		return nil, fmt.Errorf("wrong performance record size, expected %d got %d", performanceRecordSize, len(enc))
+	}
+	val := func(i int) uint64 {
+		return bytesutil.BytesToUint64BigEndian(enc[1+i*8 : 9+i*8])
+	}
+	flags := enc[0]
+	return &PerformanceRecord{
+		Epoch:                epoch,
+		AttestationReported:  flags&performanceReportedFlag != 0,
+		AttestationIncluded:  flags&performanceIncludedFlag != 0,
+		AttestationLate:      flags&performanceLateFlag != 0,
+		CorrectlyVotedSource: flags&performanceSourceFlag != 0,
+		CorrectlyVotedTarget: flags&performanceTargetFlag != 0,
+		CorrectlyVotedHead:   flags&performanceHeadFlag != 0,
+		InclusionSlot:        val(0),
+		InclusionDistance:    val(1),
+		ProposalsMade:        val(2),
+		ProposalsMissed:      val(3),
+		BalanceBefore:        val(4),
+		BalanceAfter:         val(5),
+	}, nil
+}
+
+// SavePerformanceRecords saves the performance of validators in an epoch, keyed by public key.
+// Records older than the weak subjectivity period are pruned.
+func (store *Store) SavePerformanceRecords(ctx context.Context, records map[[48]byte]*PerformanceRecord) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SavePerformanceRecords")
+	defer span.End()
+
+	return store.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(performanceBucket)
+		for pubKey, record := range records {
+			valBucket, err := bucket.CreateBucketIfNotExists(pubKey[:])
+			if err != nil {
+				return fmt.Errorf("could not create bucket for public key %#x", pubKey)
+			}
+			if err := valBucket.Put(bytesutil.Uint64ToBytesBigEndian(record.Epoch), record.encode()); err != nil {
+				return err
+			}
+			if err := prunePerformanceRecords(valBucket, record.Epoch); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// PerformanceRecords returns the performance of a validator from the start epoch up to and
+// including the end epoch, in order of epoch.
+func (store *Store) PerformanceRecords(
+	ctx context.Context,
+	pubKey [48]byte,
+	startEpoch, endEpoch uint64,
+) ([]*PerformanceRecord, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.PerformanceRecords")
+	defer span.End()
+
+	records := make([]*PerformanceRecord, 0)
+	err := store.view(func(tx *bolt.Tx) error {
+		valBucket := tx.Bucket(performanceBucket).Bucket(pubKey[:])
+		if valBucket == nil {
+			return nil
+		}
+		c := valBucket.Cursor()
+		for k, v := c.Seek(bytesutil.Uint64ToBytesBigEndian(startEpoch)); k != nil; k, v = c.Next() {
+			epoch := bytesutil.BytesToUint64BigEndian(k)
+			if epoch > endEpoch {
+				break
+			}
+			record, err := decodePerformanceRecord(epoch, v)
+			if err != nil {
+				return errors.Wrapf(err, "could not decode performance record at epoch %d", epoch)
+			}
+			records = append(records, record)
+		}
+		return nil
+	})
+	return records, err
+}
+
+// PerformancePublicKeys retrieves all public keys with a recorded performance.
+func (store *Store) PerformancePublicKeys(ctx context.Context) ([][48]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.PerformancePublicKeys")
+	defer span.End()
+
+	pubKeys := make([][48]byte, 0)
+	err := store.view(func(tx *bolt.Tx) error {
+		return tx.Bucket(performanceBucket).ForEach(func(key []byte, _ []byte) error {
+			pubKeys = append(pubKeys, bytesutil.ToBytes48(key))
+			return nil
+		})
+	})
+	return pubKeys, err
+}
+
+func prunePerformanceRecords(valBucket *bolt.Bucket, newestEpoch uint64) error {
+	c := valBucket.Cursor()
+	for k, _ := c.First(); k != nil; k, _ = c.First() {
+		epoch := bytesutil.BytesToUint64BigEndian(k)
+		// Only delete epochs that are older than the weak subjectivity period.
+		if epoch+params.BeaconConfig().WeakSubjectivityPeriod > newestEpoch {
+			break
+		}
+		if err := c.Delete(); err != nil {
+			return errors.Wrapf(err, "could not prune epoch %d in performance records", epoch)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestPerformanceRecords_SaveAndRetrieve(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	otherPubKey := [48]byte{2}
+	db := setupDB(t, [][48]byte{})
+
+	for epoch := uint64(1); epoch <= 5; epoch++ {
+		require.NoError(t, db.SavePerformanceRecords(ctx, map[[48]byte]*PerformanceRecord{
+			pubKey: {
+				Epoch:                epoch,
+				AttestationReported:  true,
+				AttestationIncluded:  true,
+				AttestationLate:      epoch%2 == 0,
+				InclusionSlot:        epoch*params.BeaconConfig().SlotsPerEpoch + 1,
+				InclusionDistance:    1 + epoch%2,
+				CorrectlyVotedSource: true,
+				CorrectlyVotedTarget: true,
+				CorrectlyVotedHead:   epoch != 3,
+				ProposalsMade:        1,
+				BalanceBefore:        32000000000,
+				BalanceAfter:         32000000000 + epoch,
+			},
+			otherPubKey: {Epoch: epoch, ProposalsMissed: 1},
+		}))
+	}
+
+	records, err := db.PerformanceRecords(ctx, pubKey, 2, 4)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(records))
+	want := &PerformanceRecord{
+		Epoch:                3,
+		AttestationReported:  true,
+		AttestationIncluded:  true,
+		InclusionSlot:        3*params.BeaconConfig().SlotsPerEpoch + 1,
+		InclusionDistance:    2,
+		CorrectlyVotedSource: true,
+		CorrectlyVotedTarget: true,
+		ProposalsMade:        1,
+		BalanceBefore:        32000000000,
+		BalanceAfter:         32000000003,
+	}
+	assert.DeepEqual(t, want, records[1])
+	assert.Equal(t, uint64(2), records[0].Epoch)
+	assert.Equal(t, true, records[0].AttestationLate)
+	assert.Equal(t, uint64(4), records[2].Epoch)
+
+	records, err = db.PerformanceRecords(ctx, otherPubKey, 0, 100)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(records))
+	assert.Equal(t, uint64(1), records[0].ProposalsMissed)
+
+	records, err = db.PerformanceRecords(ctx, [48]byte{3}, 0, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(records))
+
+	pubKeys, err := db.PerformancePublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][48]byte{pubKey, otherPubKey}, pubKeys)
+}
+
+func TestPerformanceRecords_PrunesOldEpochs(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	db := setupDB(t, [][48]byte{})
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+
+	for _, epoch := range []uint64{1, 2, wsPeriod + 1} {
+		require.NoError(t, db.SavePerformanceRecords(ctx, map[[48]byte]*PerformanceRecord{
+			pubKey: {Epoch: epoch},
+		}))
+	}
+	records, err := db.PerformanceRecords(ctx, pubKey, 0, wsPeriod+1)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(records))
+	assert.Equal(t, uint64(2), records[0].Epoch)
+	assert.Equal(t, wsPeriod+1, records[1].Epoch)
+}
@@ -20,6 +20,9 @@ var (
 	// Genesis validators root bucket key.
 	genesisValidatorsRootKey = []byte("genesis-val-root")
 
+	// Performance of validators in every epoch.
+	performanceBucket = []byte("performance-bucket")
+
 	// Migrations
 	migrationsBucket = []byte("migrations")
 )
@@ -28,10 +28,17 @@ var (
 	}
 	// BeaconRPCProviderFlag defines a beacon node RPC endpoint.
 	BeaconRPCProviderFlag = &cli.StringFlag{
-		Name:  "beacon-rpc-provider",
-		Usage: "Beacon node RPC provider endpoint",
+		Name: "beacon-rpc-provider",
+		Usage: "Beacon node RPC provider endpoint. A comma separated list of endpoints fails over " +
+			"to the healthiest beacon node",
 		Value: "127.0.0.1:4000",
 	}
+	// BroadcastToAllBeaconNodesFlag sends blocks and attestations to every beacon node given.
+	BroadcastToAllBeaconNodesFlag = &cli.BoolFlag{
+		Name: "broadcast-to-all-beacon-nodes",
+		Usage: "Publishes signed blocks and attestations through all beacon nodes given in " +
+			"--beacon-rpc-provider, instead of only the one in use",
+	}
 	// BeaconRPCGatewayProviderFlag defines a beacon node JSON-RPC endpoint.
 	BeaconRPCGatewayProviderFlag = &cli.StringFlag{
 		Name:  "beacon-rpc-gateway-provider",
@@ -264,10 +271,23 @@ var (
 		Usage: "/path/to/ca.crt for establishing a secure, TLS gRPC connection to a remote signer server",
 		Value: "",
 	}
+	// Web3SignerURLFlag defines the base URL of a signer exposing the Web3Signer HTTP API.
+	Web3SignerURLFlag = &cli.StringFlag{
+		Name:  "web3signer-url",
+		Usage: "Base URL of a remote signer exposing the Web3Signer HTTP API, such as https://signer.example.com:9000",
+		Value: "",
+	}
+	// Web3SignerGenesisValidatorsRootFlag defines the genesis validators root sent to a Web3Signer
+	// signer as part of the fork information of sign requests.
+	Web3SignerGenesisValidatorsRootFlag = &cli.StringFlag{
+		Name:  "web3signer-genesis-validators-root",
+		Usage: "Hex encoded genesis validators root of the chain, sent to a Web3Signer signer along with sign requests",
+		Value: "",
+	}
 	// KeymanagerKindFlag defines the kind of keymanager desired by a user during wallet creation.
 	KeymanagerKindFlag = &cli.StringFlag{
 		Name:  "keymanager-kind",
-		Usage: "Kind of keymanager, either imported, derived, or remote, specified during wallet creation",
+		Usage: "Kind of keymanager, either imported, derived, remote or web3signer, specified during wallet creation",
 		Value: "",
 	}
 	// SkipDepositConfirmationFlag skips the y/n confirmation prompt for sending a deposit to the deposit contract.
@@ -293,6 +313,56 @@ var (
 		Usage: "Enables more verbose logging for counting down to duty",
 		Value: false,
 	}
+	// SignerHostFlag defines the host on which the signer daemon serves the remote signer gRPC API.
+	SignerHostFlag = &cli.StringFlag{
+		Name:  "signer-host",
+		Usage: "Host on which the signer daemon listens for remote signer gRPC requests",
+		Value: "127.0.0.1",
+	}
+	// SignerPortFlag defines the port on which the signer daemon serves the remote signer gRPC API.
+	SignerPortFlag = &cli.IntFlag{
+		Name:  "signer-port",
+		Usage: "Port on which the signer daemon listens for remote signer gRPC requests",
+		Value: 7500,
+	}
+	// SignerTLSCertFlag defines the path to the server certificate of the signer daemon.
+	SignerTLSCertFlag = &cli.StringFlag{
+		Name:  "signer-tls-cert",
+		Usage: "/path/to/server.crt presented by the signer daemon to connecting validator clients",
+		Value: "",
+	}
+	// SignerTLSKeyFlag defines the path to the server private key of the signer daemon.
+	SignerTLSKeyFlag = &cli.StringFlag{
+		Name:  "signer-tls-key",
+		Usage: "/path/to/server.key for the certificate presented by the signer daemon",
+		Value: "",
+	}
+	// SignerTLSCACertFlag defines the path to the certificate authority used by the signer daemon
+	// to verify the client certificates of connecting validator clients.
+	SignerTLSCACertFlag = &cli.StringFlag{
+		Name:  "signer-tls-ca-cert",
+		Usage: "/path/to/ca.crt used by the signer daemon to verify client certificates of validator clients",
+		Value: "",
+	}
+	// SlashingProtectionJSONFileFlag is used to enter the file path of an EIP-3076 slashing protection JSON file.
+	SlashingProtectionJSONFileFlag = &cli.StringFlag{
+		Name:  "slashing-protection-json-file",
+		Usage: "Path to an EIP-3076 compliant slashing protection JSON file",
+		Value: "",
+	}
+	// SlashingProtectionJSONFilesFlag is used to enter the file paths of EIP-3076 slashing protection
+	// JSON files which are merged together.
+	SlashingProtectionJSONFilesFlag = &cli.StringSliceFlag{
+		Name:  "slashing-protection-json-files",
+		Usage: "Paths to EIP-3076 compliant slashing protection JSON files to merge, such as file1.json,file2.json",
+	}
+	// SlashingProtectionExportDirFlag is used to specify the directory in which to write
+	// an EIP-3076 slashing protection JSON file.
+	SlashingProtectionExportDirFlag = &cli.StringFlag{
+		Name:  "slashing-protection-export-dir",
+		Usage: "Directory in which the EIP-3076 slashing protection JSON file is written",
+		Value: "",
+	}
 )
 
 // DefaultValidatorDir returns OS-specific default validator directory.
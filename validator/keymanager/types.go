@@ -27,8 +27,8 @@ type Keystore struct {
 	Name    string                 `json:"name"`
 }
 
-// Kind defines an enum for either imported, derived, or remote-signing
-// keystores for Prysm wallets.
+// Kind defines an enum for either imported, derived, remote-signing or
+// web3signer keystores for Prysm wallets.
 type Kind int
 
 const (
@@ -38,6 +38,8 @@ const (
 	Derived
 	// Remote keymanager capable of remote-signing data.
 	Remote
+	// Web3Signer keymanager capable of remote-signing typed data over HTTP.
+	Web3Signer
 )
 
 // String marshals a keymanager kind to a string value.
@@ -49,6 +51,8 @@ func (k Kind) String() string {
 		return "direct"
 	case Remote:
 		return "remote"
+	case Web3Signer:
+		return "web3signer"
 	default:
 		return fmt.Sprintf("%d", int(k))
 	}
@@ -63,6 +67,8 @@ func ParseKind(k string) (Kind, error) {
 		return Imported, nil
 	case "remote":
 		return Remote, nil
+	case "web3signer":
+		return Web3Signer, nil
 	default:
 		return 0, fmt.Errorf("%s is not an allowed keymanager", k)
 	}
@@ -0,0 +1,40 @@
+/*
+Package web3signer defines a keymanager implementation which signs over the
+HTTP/JSON API exposed by Web3Signer and compatible remote signing services.
+
+Contrary to the gRPC remote keymanager, which only sends the signing root of
+the data being signed, sign requests carry the typed beacon chain object
+(block, attestation, aggregate and proof, randao reveal, voluntary exit or
+aggregation slot) along with the fork information, allowing the signer to
+recompute the signing root and apply its own slashing protection:
+
+ POST /api/v1/eth2/sign/0x<public key>
+ {
+   "type": "ATTESTATION",
+   "fork_info": {
+     "fork": {"previous_version": "0x00000000", "current_version": "0x00000000", "epoch": "0"},
+     "genesis_validators_root": "0x..."
+   },
+   "signingRoot": "0x...",
+   "attestation": {"slot": "1", "index": "0", ...}
+ }
+
+The list of public keys available for signing is retrieved from the signer via
+GET /api/v1/eth2/publicKeys, and is periodically refreshed.
+
+The keymanager can be customized via a keymanageropts.json file which requires
+the following schema:
+
+ {
+   "base_url": "https://signer.example.com:9000", // Base URL of the signer.
+   "genesis_validators_root": "0x...",           // Genesis validators root of the chain.
+   "request_timeout_seconds": 5,                 // Timeout of every request to the signer.
+   "key_refresh_interval_seconds": 60,           // Interval at which public keys are reloaded.
+   "tls": {
+     "crt_path": "/home/eth2/certs/client.crt",  // Optional client certificate path.
+     "key_path": "/home/eth2/certs/client.key",  // Optional client key path.
+     "ca_crt_path": "/home/eth2/certs/ca.crt"    // Optional certificate authority cert path.
+   }
+ }
+*/
+package web3signer
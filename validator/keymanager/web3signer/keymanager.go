@@ -0,0 +1,375 @@
+package web3signer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/p2putils"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	publicKeysPath = "/api/v1/eth2/publicKeys"
+	signPath       = "/api/v1/eth2/sign/"
+
+	defaultRequestTimeout     = 5 * time.Second
+	defaultKeyRefreshInterval = time.Minute
+)
+
+var (
+	log = logrus.WithField("prefix", "web3signer-keymanager")
+	// ErrSigningFailed defines a failure from the remote signer
+	// when performing a signing operation.
+	ErrSigningFailed = errors.New("signing failed in the remote signer")
+	// ErrSigningDenied defines a failure from the remote signer when performing
+	// a signing operation was denied, e.g. by its slashing protection.
+	ErrSigningDenied = errors.New("signing request was denied by remote signer")
+	// ErrUnknownPublicKey defines a failure from the remote signer when it does not
+	// hold the key requested to sign data.
+	ErrUnknownPublicKey = errors.New("public key not found in remote signer")
+)
+
+// KeymanagerOpts for a Web3Signer keymanager.
+type KeymanagerOpts struct {
+	BaseURL                   string     `json:"base_url"`
+	GenesisValidatorsRoot     string     `json:"genesis_validators_root"`
+	RequestTimeoutSeconds     uint64     `json:"request_timeout_seconds"`
+	KeyRefreshIntervalSeconds uint64     `json:"key_refresh_interval_seconds"`
+	TLS                       *TLSConfig `json:"tls,omitempty"`
+}
+
+// TLSConfig defines optional client certificates and certificate authority for
+// connecting to a signer over HTTPS.
+type TLSConfig struct {
+	ClientCertPath string `json:"crt_path"`
+	ClientKeyPath  string `json:"key_path"`
+	CACertPath     string `json:"ca_crt_path"`
+}
+
+// SetupConfig includes configuration values for initializing
+// a keymanager, such as the options read from the wallet.
+type SetupConfig struct {
+	Opts *KeymanagerOpts
+}
+
+// Keymanager implementation signing over the Web3Signer HTTP API.
+type Keymanager struct {
+	opts                  *KeymanagerOpts
+	client                *http.Client
+	requestTimeout        time.Duration
+	genesisValidatorsRoot []byte
+	lock                  sync.RWMutex
+	keys                  [][48]byte
+	accountsChangedFeed   *event.Feed
+}
+
+// NewKeymanager instantiates a new Web3Signer keymanager from configuration options,
+// loading the public keys held by the signer and refreshing them in the background.
+func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
+	if cfg.Opts == nil || cfg.Opts.BaseURL == "" {
+		return nil, errors.New("signer base URL is required")
+	}
+	gvr, err := hex.DecodeString(strings.TrimPrefix(cfg.Opts.GenesisValidatorsRoot, "0x"))
+	if err != nil || len(gvr) != 32 {
+		return nil, errors.New("genesis validators root must be a 32 byte hex string")
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if cfg.Opts.TLS != nil {
+		tlsCfg, err := loadTLSConfig(cfg.Opts.TLS)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = tlsCfg
+	}
+	requestTimeout := defaultRequestTimeout
+	if cfg.Opts.RequestTimeoutSeconds > 0 {
+		requestTimeout = time.Duration(cfg.Opts.RequestTimeoutSeconds) * time.Second
+	}
+	k := &Keymanager{
+		opts:                  cfg.Opts,
+		client:                &http.Client{Transport: transport},
+		requestTimeout:        requestTimeout,
+		genesisValidatorsRoot: gvr,
+		accountsChangedFeed:   new(event.Feed),
+	}
+	if err := k.ReloadPublicKeys(ctx); err != nil {
+		return nil, errors.Wrap(err, "could not load public keys from remote signer")
+	}
+	refreshInterval := defaultKeyRefreshInterval
+	if cfg.Opts.KeyRefreshIntervalSeconds > 0 {
+		refreshInterval = time.Duration(cfg.Opts.KeyRefreshIntervalSeconds) * time.Second
+	}
+	go k.refreshPublicKeys(ctx, refreshInterval)
+	return k, nil
+}
+
+func loadTLSConfig(cfg *TLSConfig) (*tls.Config, error) {
+	tlsCfg := &tls.Config{}
+	if cfg.ClientCertPath != "" || cfg.ClientKeyPath != "" {
+		clientPair, err := tls.LoadX509KeyPair(cfg.ClientCertPath, cfg.ClientKeyPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain client's certificate and/or key")
+		}
+		tlsCfg.Certificates = []tls.Certificate{clientPair}
+	}
+	if cfg.CACertPath != "" {
+		serverCA, err := ioutil.ReadFile(cfg.CACertPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain server's CA certificate")
+		}
+		cp := x509.NewCertPool()
+		if !cp.AppendCertsFromPEM(serverCA) {
+			return nil, errors.New("failed to add server's CA certificate to pool")
+		}
+		tlsCfg.RootCAs = cp
+	}
+	return tlsCfg, nil
+}
+
+// UnmarshalOptionsFile attempts to JSON unmarshal a keymanager
+// options file into a struct.
+func UnmarshalOptionsFile(r io.ReadCloser) (*KeymanagerOpts, error) {
+	enc, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read config")
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.Errorf("Could not close keymanager config file: %v", err)
+		}
+	}()
+	opts := &KeymanagerOpts{}
+	if err := json.Unmarshal(enc, opts); err != nil {
+		return nil, errors.Wrap(err, "could not JSON unmarshal")
+	}
+	return opts, nil
+}
+
+// MarshalOptionsFile for the keymanager.
+func MarshalOptionsFile(_ context.Context, cfg *KeymanagerOpts) ([]byte, error) {
+	return json.MarshalIndent(cfg, "", "\t")
+}
+
+// String pretty-print of a Web3Signer keymanager options.
+func (opts *KeymanagerOpts) String() string {
+	au := aurora.NewAurora(true)
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s: %s\n", au.BrightMagenta("Signer URL"), opts.BaseURL)
+	fmt.Fprintf(&b, "%s: %s\n", au.BrightMagenta("Genesis validators root"), opts.GenesisValidatorsRoot)
+	fmt.Fprintf(&b, "%s: %d\n", au.BrightMagenta("Request timeout (seconds)"), opts.RequestTimeoutSeconds)
+	fmt.Fprintf(&b, "%s: %d\n", au.BrightMagenta("Key refresh interval (seconds)"), opts.KeyRefreshIntervalSeconds)
+	if opts.TLS != nil {
+		fmt.Fprintf(&b, "%s: %s\n", au.BrightMagenta("Client cert path"), opts.TLS.ClientCertPath)
+		fmt.Fprintf(&b, "%s: %s\n", au.BrightMagenta("Client key path"), opts.TLS.ClientKeyPath)
+		fmt.Fprintf(&b, "%s: %s\n", au.BrightMagenta("CA cert path"), opts.TLS.CACertPath)
+	}
+	return b.String()
+}
+
+// KeymanagerOpts for the Web3Signer keymanager.
+func (k *Keymanager) KeymanagerOpts() *KeymanagerOpts {
+	return k.opts
+}
+
+// SubscribeAccountChanges creates an event subscription for a channel
+// to listen for public key changes in the remote signer.
+func (k *Keymanager) SubscribeAccountChanges(pubKeysChan chan [][48]byte) event.Subscription {
+	return k.accountsChangedFeed.Subscribe(pubKeysChan)
+}
+
+// FetchValidatingPublicKeys fetches the list of public keys that should be used to validate with.
+func (k *Keymanager) FetchValidatingPublicKeys(_ context.Context) ([][48]byte, error) {
+	k.lock.RLock()
+	defer k.lock.RUnlock()
+	keys := make([][48]byte, len(k.keys))
+	copy(keys, k.keys)
+	return keys, nil
+}
+
+// FetchAllValidatingPublicKeys fetches the list of all public keys, including disabled ones.
+func (k *Keymanager) FetchAllValidatingPublicKeys(ctx context.Context) ([][48]byte, error) {
+	return k.FetchValidatingPublicKeys(ctx)
+}
+
+// ReloadPublicKeys retrieves the list of public keys held by the remote signer, notifying
+// subscribers to account changes if the list changed.
+func (k *Keymanager) ReloadPublicKeys(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, k.requestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, k.url(publicKeysPath), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "could not list public keys")
+	}
+	defer closeBody(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not list public keys: unexpected status %s", resp.Status)
+	}
+	var encodedKeys []string
+	if err := json.NewDecoder(resp.Body).Decode(&encodedKeys); err != nil {
+		return errors.Wrap(err, "could not decode public keys")
+	}
+	keys := make([][48]byte, len(encodedKeys))
+	for i, encoded := range encodedKeys {
+		key, err := hex.DecodeString(strings.TrimPrefix(encoded, "0x"))
+		if err != nil || len(key) != 48 {
+			return fmt.Errorf("invalid public key %q", encoded)
+		}
+		keys[i] = bytesutil.ToBytes48(key)
+	}
+
+	k.lock.Lock()
+	changed := !equalKeys(k.keys, keys)
+	k.keys = keys
+	k.lock.Unlock()
+	if changed {
+		k.accountsChangedFeed.Send(keys)
+	}
+	return nil
+}
+
+func (k *Keymanager) refreshPublicKeys(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := k.ReloadPublicKeys(ctx); err != nil {
+				log.WithError(err).Error("Could not refresh public keys from remote signer")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Sign signs a message for a validator key by sending the typed sign request to the remote signer.
+func (k *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	forkInfo, err := k.forkInfo(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := newSignRequestJSON(req, forkInfo)
+	if err != nil {
+		return nil, err
+	}
+	enc, err := json.Marshal(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal sign request")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, k.requestTimeout)
+	defer cancel()
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, k.url(signPath+hexString(req.PublicKey)), bytes.NewReader(enc))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+	resp, err := k.client.Do(httpReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not send sign request")
+	}
+	defer closeBody(resp.Body)
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, ErrUnknownPublicKey
+	case http.StatusPreconditionFailed:
+		return nil, ErrSigningDenied
+	default:
+		return nil, errors.Wrapf(ErrSigningFailed, "unexpected status %s", resp.Status)
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read sign response")
+	}
+	encodedSig := strings.TrimSpace(string(respBody))
+	if strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+		sigResp := &signResponseJSON{}
+		if err := json.Unmarshal(respBody, sigResp); err != nil {
+			return nil, errors.Wrap(err, "could not decode sign response")
+		}
+		encodedSig = sigResp.Signature
+	}
+	sig, err := hex.DecodeString(strings.TrimPrefix(encodedSig, "0x"))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode signature")
+	}
+	return bls.SignatureFromBytes(sig)
+}
+
+// forkInfo returns the information of the fork active at the signing epoch of the request,
+// after checking that it matches the signature domain the validator client computed.
+func (k *Keymanager) forkInfo(req *validatorpb.SignRequest) (*forkInfoJSON, error) {
+	if len(req.SignatureDomain) != 32 {
+		return nil, errors.New("invalid signature domain")
+	}
+	fork, err := p2putils.Fork(req.SigningEpoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not determine fork")
+	}
+	var domainType [helpers.DomainByteLength]byte
+	copy(domainType[:], req.SignatureDomain[:helpers.DomainByteLength])
+	domain, err := helpers.Domain(fork, req.SigningEpoch, domainType, k.genesisValidatorsRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute signature domain")
+	}
+	if !bytes.Equal(domain, req.SignatureDomain) {
+		return nil, errors.New("signature domain does not match the fork at the signing epoch and genesis validators root")
+	}
+	return &forkInfoJSON{
+		Fork: &forkJSON{
+			PreviousVersion: hexString(fork.PreviousVersion),
+			CurrentVersion:  hexString(fork.CurrentVersion),
+			Epoch:           strconv.FormatUint(fork.Epoch, 10),
+		},
+		GenesisValidatorsRoot: hexString(k.genesisValidatorsRoot),
+	}, nil
+}
+
+func (k *Keymanager) url(path string) string {
+	return strings.TrimSuffix(k.opts.BaseURL, "/") + path
+}
+
+func closeBody(body io.ReadCloser) {
+	if err := body.Close(); err != nil {
+		log.WithError(err).Debug("Could not close response body")
+	}
+}
+
+func equalKeys(a, b [][48]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
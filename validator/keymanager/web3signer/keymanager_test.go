@@ -0,0 +1,359 @@
+package web3signer
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+var genesisValidatorsRoot = bytesutil.PadTo([]byte("genesis"), 32)
+
+type mockSigner struct {
+	lock       sync.Mutex
+	keys       []bls.SecretKey
+	status     int
+	delay      time.Duration
+	lastSignRq map[string]interface{}
+	lastPath   string
+}
+
+func (m *mockSigner) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	time.Sleep(m.delay)
+	if r.URL.Path == publicKeysPath {
+		keys := make([]string, len(m.keys))
+		for i, key := range m.keys {
+			keys[i] = hexString(key.PublicKey().Marshal())
+		}
+		if err := json.NewEncoder(w).Encode(keys); err != nil {
+			panic(err)
+		}
+		return
+	}
+	m.lastPath = r.URL.Path
+	m.lastSignRq = make(map[string]interface{})
+	if err := json.NewDecoder(r.Body).Decode(&m.lastSignRq); err != nil {
+		panic(err)
+	}
+	if m.status != 0 {
+		w.WriteHeader(m.status)
+		return
+	}
+	root, err := hex.DecodeString(strings.TrimPrefix(m.lastSignRq["signingRoot"].(string), "0x"))
+	if err != nil {
+		panic(err)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	sig := m.keys[0].Sign(root).Marshal()
+	if err := json.NewEncoder(w).Encode(&signResponseJSON{Signature: hexString(sig)}); err != nil {
+		panic(err)
+	}
+}
+
+func (m *mockSigner) setResponse(status int, delay time.Duration) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.status = status
+	m.delay = delay
+}
+
+func setupKeymanager(t *testing.T, signer *mockSigner) *Keymanager {
+	srv := httptest.NewServer(signer)
+	t.Cleanup(srv.Close)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	km, err := NewKeymanager(ctx, &SetupConfig{Opts: &KeymanagerOpts{
+		BaseURL:               srv.URL,
+		GenesisValidatorsRoot: hexString(genesisValidatorsRoot),
+	}})
+	require.NoError(t, err)
+	return km
+}
+
+func signatureDomain(t *testing.T, domainType [4]byte) []byte {
+	domain, err := helpers.ComputeDomain(domainType, params.BeaconConfig().GenesisForkVersion, genesisValidatorsRoot)
+	require.NoError(t, err)
+	return domain
+}
+
+func TestNewKeymanager_InvalidOptions(t *testing.T) {
+	_, err := NewKeymanager(context.Background(), &SetupConfig{Opts: &KeymanagerOpts{}})
+	assert.ErrorContains(t, "base URL is required", err)
+	_, err = NewKeymanager(context.Background(), &SetupConfig{Opts: &KeymanagerOpts{
+		BaseURL:               "http://localhost:9000",
+		GenesisValidatorsRoot: "0x1234",
+	}})
+	assert.ErrorContains(t, "genesis validators root", err)
+}
+
+func TestKeymanager_ReloadPublicKeys(t *testing.T) {
+	key1, err := bls.RandKey()
+	require.NoError(t, err)
+	key2, err := bls.RandKey()
+	require.NoError(t, err)
+	signer := &mockSigner{keys: []bls.SecretKey{key1}}
+	km := setupKeymanager(t, signer)
+
+	keys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(keys))
+	assert.Equal(t, bytesutil.ToBytes48(key1.PublicKey().Marshal()), keys[0])
+
+	keysChan := make(chan [][48]byte, 1)
+	sub := km.SubscribeAccountChanges(keysChan)
+	defer sub.Unsubscribe()
+	signer.lock.Lock()
+	signer.keys = append(signer.keys, key2)
+	signer.lock.Unlock()
+	require.NoError(t, km.ReloadPublicKeys(context.Background()))
+	select {
+	case changed := <-keysChan:
+		assert.Equal(t, 2, len(changed))
+	case <-time.After(time.Second):
+		t.Fatal("Did not receive account changes")
+	}
+	keys, err = km.FetchAllValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(keys))
+}
+
+func TestKeymanager_Sign(t *testing.T) {
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	signer := &mockSigner{keys: []bls.SecretKey{key}}
+	km := setupKeymanager(t, signer)
+	root := bytesutil.PadTo([]byte("root"), 32)
+
+	tests := []struct {
+		req        *validatorpb.SignRequest
+		domainType [4]byte
+		wantType   string
+		wantField  string
+	}{
+		{
+			req:        &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Block{Block: &ethpb.BeaconBlock{Slot: 3, Body: &ethpb.BeaconBlockBody{}}}},
+			domainType: params.BeaconConfig().DomainBeaconProposer,
+			wantType:   typeBlock,
+			wantField:  "block",
+		},
+		{
+			req:        &validatorpb.SignRequest{Object: &validatorpb.SignRequest_AttestationData{AttestationData: &ethpb.AttestationData{Slot: 3}}},
+			domainType: params.BeaconConfig().DomainBeaconAttester,
+			wantType:   typeAttestation,
+			wantField:  "attestation",
+		},
+		{
+			req:        &validatorpb.SignRequest{Object: &validatorpb.SignRequest_AggregateAttestationAndProof{AggregateAttestationAndProof: &ethpb.AggregateAttestationAndProof{AggregatorIndex: 1}}},
+			domainType: params.BeaconConfig().DomainAggregateAndProof,
+			wantType:   typeAggregateAndProof,
+			wantField:  "aggregate_and_proof",
+		},
+		{
+			req:        &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Slot{Slot: 3}},
+			domainType: params.BeaconConfig().DomainSelectionProof,
+			wantType:   typeAggregationSlot,
+			wantField:  "aggregation_slot",
+		},
+		{
+			req:        &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Epoch{Epoch: 1}},
+			domainType: params.BeaconConfig().DomainRandao,
+			wantType:   typeRandaoReveal,
+			wantField:  "randao_reveal",
+		},
+		{
+			req:        &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Exit{Exit: &ethpb.VoluntaryExit{Epoch: 1, ValidatorIndex: 2}}},
+			domainType: params.BeaconConfig().DomainVoluntaryExit,
+			wantType:   typeVoluntaryExit,
+			wantField:  "voluntary_exit",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.wantType, func(t *testing.T) {
+			tt.req.PublicKey = key.PublicKey().Marshal()
+			tt.req.SigningRoot = root
+			tt.req.SignatureDomain = signatureDomain(t, tt.domainType)
+			sig, err := km.Sign(context.Background(), tt.req)
+			require.NoError(t, err)
+			assert.Equal(t, true, sig.Verify(key.PublicKey(), root), "Invalid signature")
+			signer.lock.Lock()
+			defer signer.lock.Unlock()
+			assert.Equal(t, signPath+hexString(key.PublicKey().Marshal()), signer.lastPath)
+			assert.Equal(t, tt.wantType, signer.lastSignRq["type"])
+			assert.NotNil(t, signer.lastSignRq[tt.wantField], "Missing %s in sign request", tt.wantField)
+			forkInfo, ok := signer.lastSignRq["fork_info"].(map[string]interface{})
+			require.Equal(t, true, ok, "Missing fork info in sign request")
+			assert.Equal(t, hexString(genesisValidatorsRoot), forkInfo["genesis_validators_root"])
+		})
+	}
+}
+
+func TestKeymanager_Sign_BlockWithOperations(t *testing.T) {
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	signer := &mockSigner{keys: []bls.SecretKey{key}}
+	km := setupKeymanager(t, signer)
+	root := bytesutil.PadTo([]byte("root"), 32)
+
+	block := &ethpb.BeaconBlock{
+		Slot: 3,
+		Body: &ethpb.BeaconBlockBody{
+			ProposerSlashings: []*ethpb.ProposerSlashing{{
+				Header_1: &ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{Slot: 1, ProposerIndex: 4}},
+				Header_2: &ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{Slot: 1, ProposerIndex: 4}},
+			}},
+			VoluntaryExits: []*ethpb.SignedVoluntaryExit{{
+				Exit: &ethpb.VoluntaryExit{Epoch: 1, ValidatorIndex: 5},
+			}},
+		},
+	}
+	_, err = km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:       key.PublicKey().Marshal(),
+		SigningRoot:     root,
+		SignatureDomain: signatureDomain(t, params.BeaconConfig().DomainBeaconProposer),
+		Object:          &validatorpb.SignRequest_Block{Block: block},
+	})
+	require.NoError(t, err)
+
+	signer.lock.Lock()
+	defer signer.lock.Unlock()
+	enc, err := json.Marshal(signer.lastSignRq["block"])
+	require.NoError(t, err)
+	var sent struct {
+		Body struct {
+			ProposerSlashings []struct {
+				SignedHeader1 struct {
+					Message struct {
+						ProposerIndex string `json:"proposer_index"`
+					} `json:"message"`
+				} `json:"signed_header_1"`
+				SignedHeader2 struct {
+					Message struct {
+						ProposerIndex string `json:"proposer_index"`
+					} `json:"message"`
+				} `json:"signed_header_2"`
+			} `json:"proposer_slashings"`
+			VoluntaryExits []struct {
+				Message struct {
+					ValidatorIndex string `json:"validator_index"`
+				} `json:"message"`
+			} `json:"voluntary_exits"`
+		} `json:"body"`
+	}
+	require.NoError(t, json.Unmarshal(enc, &sent))
+	require.Equal(t, 1, len(sent.Body.ProposerSlashings))
+	assert.Equal(t, "4", sent.Body.ProposerSlashings[0].SignedHeader1.Message.ProposerIndex)
+	assert.Equal(t, "4", sent.Body.ProposerSlashings[0].SignedHeader2.Message.ProposerIndex)
+	require.Equal(t, 1, len(sent.Body.VoluntaryExits))
+	assert.Equal(t, "5", sent.Body.VoluntaryExits[0].Message.ValidatorIndex)
+}
+
+func TestKeymanager_Sign_ForkInfo(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	c := params.BeaconConfig()
+	c.ForkVersionSchedule = map[uint64][]byte{
+		5: {0, 0, 0, 1},
+	}
+	params.OverrideBeaconConfig(c)
+
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	signer := &mockSigner{keys: []bls.SecretKey{key}}
+	km := setupKeymanager(t, signer)
+	domain, err := helpers.ComputeDomain(params.BeaconConfig().DomainRandao, []byte{0, 0, 0, 1}, genesisValidatorsRoot)
+	require.NoError(t, err)
+	req := &validatorpb.SignRequest{
+		PublicKey:       key.PublicKey().Marshal(),
+		SigningRoot:     make([]byte, 32),
+		SignatureDomain: domain,
+		SigningEpoch:    6,
+		Object:          &validatorpb.SignRequest_Epoch{Epoch: 6},
+	}
+	_, err = km.Sign(context.Background(), req)
+	require.NoError(t, err)
+
+	signer.lock.Lock()
+	forkInfo, ok := signer.lastSignRq["fork_info"].(map[string]interface{})
+	require.Equal(t, true, ok, "Missing fork info in sign request")
+	fork, ok := forkInfo["fork"].(map[string]interface{})
+	require.Equal(t, true, ok, "Missing fork in sign request")
+	assert.Equal(t, "0x00000000", fork["previous_version"])
+	assert.Equal(t, "0x00000001", fork["current_version"])
+	assert.Equal(t, "5", fork["epoch"])
+	signer.lock.Unlock()
+
+	// The domain of the genesis fork is rejected after the scheduled fork.
+	req.SignatureDomain = signatureDomain(t, params.BeaconConfig().DomainRandao)
+	_, err = km.Sign(context.Background(), req)
+	assert.ErrorContains(t, "signature domain does not match", err)
+}
+
+func TestKeymanager_Sign_Errors(t *testing.T) {
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	signer := &mockSigner{keys: []bls.SecretKey{key}}
+	km := setupKeymanager(t, signer)
+	req := &validatorpb.SignRequest{
+		PublicKey:       key.PublicKey().Marshal(),
+		SigningRoot:     make([]byte, 32),
+		SignatureDomain: signatureDomain(t, params.BeaconConfig().DomainRandao),
+		Object:          &validatorpb.SignRequest_Epoch{Epoch: 1},
+	}
+
+	signer.setResponse(http.StatusPreconditionFailed, 0)
+	_, err = km.Sign(context.Background(), req)
+	assert.ErrorContains(t, ErrSigningDenied.Error(), err)
+	signer.setResponse(http.StatusNotFound, 0)
+	_, err = km.Sign(context.Background(), req)
+	assert.ErrorContains(t, ErrUnknownPublicKey.Error(), err)
+	signer.setResponse(http.StatusInternalServerError, 0)
+	_, err = km.Sign(context.Background(), req)
+	assert.ErrorContains(t, ErrSigningFailed.Error(), err)
+
+	km.requestTimeout = 10 * time.Millisecond
+	signer.setResponse(0, 100*time.Millisecond)
+	_, err = km.Sign(context.Background(), req)
+	assert.ErrorContains(t, "deadline exceeded", err)
+	signer.setResponse(0, 0)
+
+	wrongDomain, err := helpers.ComputeDomain(params.BeaconConfig().DomainRandao, params.BeaconConfig().GenesisForkVersion, make([]byte, 32))
+	require.NoError(t, err)
+	_, err = km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:       key.PublicKey().Marshal(),
+		SigningRoot:     make([]byte, 32),
+		SignatureDomain: wrongDomain,
+		Object:          &validatorpb.SignRequest_Epoch{Epoch: 1},
+	})
+	assert.ErrorContains(t, "signature domain does not match", err)
+
+	req.Object = nil
+	_, err = km.Sign(context.Background(), req)
+	assert.ErrorContains(t, "unsupported sign request object", err)
+}
+
+func TestUnmarshalOptionsFile(t *testing.T) {
+	enc := `{"base_url": "https://signer:9000", "request_timeout_seconds": 2, "tls": {"ca_crt_path": "/ca.crt"}}`
+	opts, err := UnmarshalOptionsFile(ioutil.NopCloser(strings.NewReader(enc)))
+	require.NoError(t, err)
+	assert.Equal(t, "https://signer:9000", opts.BaseURL)
+	assert.Equal(t, uint64(2), opts.RequestTimeoutSeconds)
+	assert.Equal(t, "/ca.crt", opts.TLS.CACertPath)
+	assert.Equal(t, true, strings.Contains(opts.String(), "https://signer:9000"), fmt.Sprintf("Unexpected options string %s", opts))
+}
@@ -0,0 +1,171 @@
+package web3signer
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+)
+
+// Sign request types of the Web3Signer API.
+const (
+	typeBlock             = "BLOCK"
+	typeAttestation       = "ATTESTATION"
+	typeAggregateAndProof = "AGGREGATE_AND_PROOF"
+	typeAggregationSlot   = "AGGREGATION_SLOT"
+	typeRandaoReveal      = "RANDAO_REVEAL"
+	typeVoluntaryExit     = "VOLUNTARY_EXIT"
+)
+
+// fieldRenames maps protobuf message names to the fields of the message whose name differs
+// in the eth2 API, and their name in the API.
+var fieldRenames = map[string]map[string]string{
+	"AttestationData": {
+		"committee_index": "index",
+	},
+	"ProposerSlashing": {
+		"header_1": "signed_header_1",
+		"header_2": "signed_header_2",
+	},
+	"SignedBeaconBlockHeader": {
+		"header": "message",
+	},
+	"SignedVoluntaryExit": {
+		"exit": "message",
+	},
+}
+
+type forkJSON struct {
+	PreviousVersion string `json:"previous_version"`
+	CurrentVersion  string `json:"current_version"`
+	Epoch           string `json:"epoch"`
+}
+
+type forkInfoJSON struct {
+	Fork                  *forkJSON `json:"fork"`
+	GenesisValidatorsRoot string    `json:"genesis_validators_root"`
+}
+
+type randaoRevealJSON struct {
+	Epoch string `json:"epoch"`
+}
+
+type aggregationSlotJSON struct {
+	Slot string `json:"slot"`
+}
+
+// signRequestJSON is the body of a request to the sign endpoint of the signer.
+type signRequestJSON struct {
+	Type              string                 `json:"type"`
+	ForkInfo          *forkInfoJSON          `json:"fork_info"`
+	SigningRoot       string                 `json:"signingRoot"`
+	Block             map[string]interface{} `json:"block,omitempty"`
+	Attestation       map[string]interface{} `json:"attestation,omitempty"`
+	AggregateAndProof map[string]interface{} `json:"aggregate_and_proof,omitempty"`
+	AggregationSlot   *aggregationSlotJSON   `json:"aggregation_slot,omitempty"`
+	RandaoReveal      *randaoRevealJSON      `json:"randao_reveal,omitempty"`
+	VoluntaryExit     map[string]interface{} `json:"voluntary_exit,omitempty"`
+}
+
+type signResponseJSON struct {
+	Signature string `json:"signature"`
+}
+
+// newSignRequestJSON converts a sign request into the typed request of the Web3Signer API.
+func newSignRequestJSON(req *validatorpb.SignRequest, forkInfo *forkInfoJSON) (*signRequestJSON, error) {
+	r := &signRequestJSON{
+		ForkInfo:    forkInfo,
+		SigningRoot: hexString(req.SigningRoot),
+	}
+	switch obj := req.Object.(type) {
+	case *validatorpb.SignRequest_Block:
+		r.Type = typeBlock
+		r.Block = apiObject(obj.Block)
+	case *validatorpb.SignRequest_AttestationData:
+		r.Type = typeAttestation
+		r.Attestation = apiObject(obj.AttestationData)
+	case *validatorpb.SignRequest_AggregateAttestationAndProof:
+		r.Type = typeAggregateAndProof
+		r.AggregateAndProof = apiObject(obj.AggregateAttestationAndProof)
+	case *validatorpb.SignRequest_Slot:
+		r.Type = typeAggregationSlot
+		r.AggregationSlot = &aggregationSlotJSON{Slot: strconv.FormatUint(obj.Slot, 10)}
+	case *validatorpb.SignRequest_Epoch:
+		r.Type = typeRandaoReveal
+		r.RandaoReveal = &randaoRevealJSON{Epoch: strconv.FormatUint(obj.Epoch, 10)}
+	case *validatorpb.SignRequest_Exit:
+		r.Type = typeVoluntaryExit
+		r.VoluntaryExit = apiObject(obj.Exit)
+	default:
+		return nil, errors.Errorf("unsupported sign request object %T", req.Object)
+	}
+	return r, nil
+}
+
+// apiObject converts a beacon chain protobuf message into its eth2 API JSON representation,
+// where integers are encoded as decimal strings and byte arrays as 0x-prefixed hex strings.
+func apiObject(msg interface{}) map[string]interface{} {
+	obj, ok := apiValue(reflect.ValueOf(msg)).(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return obj
+}
+
+func apiValue(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil
+		}
+		return apiValue(v.Elem())
+	case reflect.Struct:
+		obj := make(map[string]interface{})
+		renames := fieldRenames[v.Type().Name()]
+		for i := 0; i < v.NumField(); i++ {
+			name := protoFieldName(v.Type().Field(i))
+			if name == "" {
+				continue
+			}
+			if renamed, ok := renames[name]; ok {
+				name = renamed
+			}
+			obj[name] = apiValue(v.Field(i))
+		}
+		return obj
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return hexString(v.Bytes())
+		}
+		list := make([]interface{}, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			list[i] = apiValue(v.Index(i))
+		}
+		return list
+	case reflect.Uint64, reflect.Uint32:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Bool:
+		return v.Bool()
+	default:
+		return fmt.Sprintf("%v", v.Interface())
+	}
+}
+
+// protoFieldName returns the protobuf name of a generated struct field, or an empty
+// string for fields which are not part of the message.
+func protoFieldName(f reflect.StructField) string {
+	for _, part := range strings.Split(f.Tag.Get("protobuf"), ",") {
+		if strings.HasPrefix(part, "name=") {
+			return strings.TrimPrefix(part, "name=")
+		}
+	}
+	return ""
+}
+
+func hexString(b []byte) string {
+	return "0x" + hex.EncodeToString(b)
+}
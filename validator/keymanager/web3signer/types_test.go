@@ -0,0 +1,57 @@
+package web3signer
+
+import (
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestApiObject(t *testing.T) {
+	obj := apiObject(&ethpb.AggregateAttestationAndProof{
+		AggregatorIndex: 7,
+		Aggregate: &ethpb.Attestation{
+			AggregationBits: bitfield.Bitlist{0x01, 0x02},
+			Data: &ethpb.AttestationData{
+				Slot:            5,
+				CommitteeIndex:  2,
+				BeaconBlockRoot: []byte{0xaa},
+				Source:          &ethpb.Checkpoint{Epoch: 1},
+			},
+		},
+	})
+	assert.Equal(t, "7", obj["aggregator_index"])
+	assert.Equal(t, "0x", obj["selection_proof"])
+	aggregate, ok := obj["aggregate"].(map[string]interface{})
+	require.Equal(t, true, ok)
+	assert.Equal(t, "0x0102", aggregate["aggregation_bits"])
+	data, ok := aggregate["data"].(map[string]interface{})
+	require.Equal(t, true, ok)
+	assert.Equal(t, "5", data["slot"])
+	assert.Equal(t, "2", data["index"], "Committee index should be renamed to index")
+	assert.Equal(t, "0xaa", data["beacon_block_root"])
+	assert.Equal(t, nil, data["target"])
+	_, ok = data["XXX_unrecognized"]
+	assert.Equal(t, false, ok, "Unexpected protobuf internal field")
+
+	obj = apiObject(&ethpb.BeaconBlockBody{
+		ProposerSlashings: []*ethpb.ProposerSlashing{{}},
+	})
+	slashings, ok := obj["proposer_slashings"].([]interface{})
+	require.Equal(t, true, ok)
+	slashing, ok := slashings[0].(map[string]interface{})
+	require.Equal(t, true, ok)
+	_, ok = slashing["signed_header_1"]
+	assert.Equal(t, true, ok, "Proposer slashing header should be renamed")
+
+	obj = apiObject(&ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{Epoch: 1}})
+	_, ok = obj["message"]
+	assert.Equal(t, true, ok, "Signed voluntary exit should be renamed to message")
+	obj = apiObject(&ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{Slot: 1}})
+	_, ok = obj["message"]
+	assert.Equal(t, true, ok, "Signed block header should be renamed to message")
+	obj = apiObject(&ethpb.AttestationDataRequest{CommitteeIndex: 2})
+	assert.Equal(t, "2", obj["committee_index"], "Renames should be scoped to their message")
+}
@@ -24,6 +24,8 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/flags"
 	"github.com/prysmaticlabs/prysm/validator/node"
+	"github.com/prysmaticlabs/prysm/validator/signer"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/history"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 	prefixed "github.com/x-cray/logrus-prefixed-formatter"
@@ -47,6 +49,7 @@ func startNode(ctx *cli.Context) error {
 
 var appFlags = []cli.Flag{
 	flags.BeaconRPCProviderFlag,
+	flags.BroadcastToAllBeaconNodesFlag,
 	flags.BeaconRPCGatewayProviderFlag,
 	flags.CertFlag,
 	flags.GraffitiFlag,
@@ -114,6 +117,8 @@ func main() {
 		accounts.WalletCommands,
 		accounts.AccountCommands,
 		db.DatabaseCommands,
+		signer.Commands,
+		history.Commands,
 	}
 
 	app.Flags = appFlags
@@ -407,6 +407,7 @@ func (s *ValidatorClient) registerClientService(
 		WalletInitializedFeed:      s.walletInitialized,
 		GraffitiStruct:             gStruct,
 		LogDutyCountDown:           s.cliCtx.Bool(flags.EnableDutyCountDown.Name),
+		BroadcastToAllNodes:        s.cliCtx.Bool(flags.BroadcastToAllBeaconNodesFlag.Name),
 	})
 
 	if err != nil {
@@ -0,0 +1,111 @@
+package rpc
+
+import (
+	"context"
+	"math"
+
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetPerformanceHistory returns the performance of validating keys in every epoch recorded
+// in the validator database, along with totals over the requested epochs.
+func (s *Server) GetPerformanceHistory(
+	ctx context.Context, req *pb.PerformanceHistoryRequest,
+) (*pb.PerformanceHistoryResponse, error) {
+	if s.valDB == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator database not yet initialized")
+	}
+	endEpoch := req.EndEpoch
+	if endEpoch == 0 {
+		endEpoch = math.MaxUint64
+	}
+	if req.StartEpoch > endEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Start epoch %d can not be greater than end epoch %d",
+			req.StartEpoch,
+			endEpoch,
+		)
+	}
+	var pubKeys [][48]byte
+	if len(req.PublicKeys) == 0 {
+		var err error
+		pubKeys, err = s.valDB.PerformancePublicKeys(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get public keys: %v", err)
+		}
+	} else {
+		pubKeys = make([][48]byte, len(req.PublicKeys))
+		for i, key := range req.PublicKeys {
+			if len(key) != 48 {
+				return nil, status.Errorf(codes.InvalidArgument, "Public key %#x is not 48 bytes long", key)
+			}
+			pubKeys[i] = bytesutil.ToBytes48(key)
+		}
+	}
+
+	histories := make([]*pb.ValidatorPerformanceHistory, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		records, err := s.valDB.PerformanceRecords(ctx, pubKey, req.StartEpoch, endEpoch)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get performance of key %#x: %v", pubKey, err)
+		}
+		epochs := make([]*pb.EpochPerformance, len(records))
+		summary := &pb.PerformanceSummary{}
+		var totalDistance uint64
+		for j, r := range records {
+			balanceChange := int64(r.BalanceAfter) - int64(r.BalanceBefore)
+			epochs[j] = &pb.EpochPerformance{
+				Epoch:                r.Epoch,
+				AttestationIncluded:  r.AttestationIncluded,
+				AttestationLate:      r.AttestationLate,
+				InclusionSlot:        r.InclusionSlot,
+				InclusionDistance:    r.InclusionDistance,
+				CorrectlyVotedSource: r.CorrectlyVotedSource,
+				CorrectlyVotedTarget: r.CorrectlyVotedTarget,
+				CorrectlyVotedHead:   r.CorrectlyVotedHead,
+				ProposalsMade:        r.ProposalsMade,
+				ProposalsMissed:      r.ProposalsMissed,
+				BalanceBefore:        r.BalanceBefore,
+				BalanceAfter:         r.BalanceAfter,
+				BalanceChange:        balanceChange,
+			}
+			if r.AttestationIncluded {
+				summary.IncludedAttestations++
+				totalDistance += r.InclusionDistance
+			} else if r.AttestationReported {
+				summary.MissedAttestations++
+			}
+			if r.AttestationLate {
+				summary.LateAttestations++
+			}
+			if r.CorrectlyVotedSource {
+				summary.CorrectlyVotedSource++
+			}
+			if r.CorrectlyVotedTarget {
+				summary.CorrectlyVotedTarget++
+			}
+			if r.CorrectlyVotedHead {
+				summary.CorrectlyVotedHead++
+			}
+			summary.ProposalsMade += r.ProposalsMade
+			summary.ProposalsMissed += r.ProposalsMissed
+			summary.BalanceChange += balanceChange
+		}
+		if summary.IncludedAttestations > 0 {
+			summary.AverageInclusionDistance = float64(totalDistance) / float64(summary.IncludedAttestations)
+		}
+		copyKey := pubKey
+		histories[i] = &pb.ValidatorPerformanceHistory{
+			PublicKey: copyKey[:],
+			Epochs:    epochs,
+			Summary:   summary,
+		}
+	}
+	return &pb.PerformanceHistoryResponse{
+		Histories: histories,
+	}, nil
+}
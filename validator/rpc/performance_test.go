@@ -0,0 +1,90 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+)
+
+func TestServer_GetPerformanceHistory(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	otherPubKey := [48]byte{2}
+	valDB := dbtest.SetupDB(t, [][48]byte{})
+	s := &Server{valDB: valDB}
+
+	for epoch := uint64(1); epoch <= 4; epoch++ {
+		require.NoError(t, valDB.SavePerformanceRecords(ctx, map[[48]byte]*kv.PerformanceRecord{
+			pubKey: {
+				Epoch:                epoch,
+				AttestationReported:  true,
+				AttestationIncluded:  epoch != 2,
+				AttestationLate:      epoch == 3,
+				InclusionDistance:    epoch,
+				CorrectlyVotedSource: true,
+				CorrectlyVotedTarget: epoch != 2,
+				BalanceBefore:        100,
+				BalanceAfter:         100 + epoch,
+			},
+			otherPubKey: {
+				Epoch:           epoch,
+				ProposalsMade:   1,
+				ProposalsMissed: 1,
+				BalanceBefore:   100,
+				BalanceAfter:    90,
+			},
+		}))
+	}
+
+	resp, err := s.GetPerformanceHistory(ctx, &pb.PerformanceHistoryRequest{
+		PublicKeys: [][]byte{pubKey[:]},
+		StartEpoch: 2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Histories))
+	history := resp.Histories[0]
+	assert.DeepEqual(t, pubKey[:], history.PublicKey)
+	require.Equal(t, 3, len(history.Epochs))
+	assert.Equal(t, uint64(2), history.Epochs[0].Epoch)
+	assert.Equal(t, false, history.Epochs[0].AttestationIncluded)
+	assert.Equal(t, int64(3), history.Epochs[1].BalanceChange)
+	want := &pb.PerformanceSummary{
+		IncludedAttestations:     2,
+		MissedAttestations:       1,
+		LateAttestations:         1,
+		AverageInclusionDistance: 3.5,
+		CorrectlyVotedSource:     3,
+		CorrectlyVotedTarget:     2,
+		BalanceChange:            9,
+	}
+	assert.DeepEqual(t, want, history.Summary)
+
+	// All recorded keys are returned when none is requested.
+	resp, err = s.GetPerformanceHistory(ctx, &pb.PerformanceHistoryRequest{EndEpoch: 3})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Histories))
+	assert.DeepEqual(t, otherPubKey[:], resp.Histories[1].PublicKey)
+	assert.Equal(t, 3, len(resp.Histories[1].Epochs))
+	assert.Equal(t, uint64(3), resp.Histories[1].Summary.ProposalsMade)
+	assert.Equal(t, uint64(3), resp.Histories[1].Summary.ProposalsMissed)
+	assert.Equal(t, uint64(0), resp.Histories[1].Summary.MissedAttestations, "Proposal-only records hold no attestation")
+	assert.Equal(t, int64(-30), resp.Histories[1].Summary.BalanceChange)
+}
+
+func TestServer_GetPerformanceHistory_InvalidRequest(t *testing.T) {
+	s := &Server{valDB: dbtest.SetupDB(t, [][48]byte{})}
+	_, err := s.GetPerformanceHistory(context.Background(), &pb.PerformanceHistoryRequest{
+		StartEpoch: 5,
+		EndEpoch:   4,
+	})
+	assert.ErrorContains(t, "Start epoch 5 can not be greater than end epoch 4", err)
+	_, err = s.GetPerformanceHistory(context.Background(), &pb.PerformanceHistoryRequest{
+		PublicKeys: [][]byte{{1, 2, 3}},
+	})
+	assert.ErrorContains(t, "is not 48 bytes long", err)
+}
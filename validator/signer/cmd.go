@@ -0,0 +1,112 @@
+package signer
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/tos"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// Commands for running a Prysm signer daemon.
+var Commands = &cli.Command{
+	Name:     "signer",
+	Category: "signer",
+	Usage: "runs a slashing protected signer serving the keys of an imported or derived wallet " +
+		"over the remote signer gRPC API, secured by mutual TLS",
+	Flags: cmd.WrapFlags([]cli.Flag{
+		flags.WalletDirFlag,
+		flags.WalletPasswordFileFlag,
+		cmd.DataDirFlag,
+		flags.SignerHostFlag,
+		flags.SignerPortFlag,
+		flags.SignerTLSCertFlag,
+		flags.SignerTLSKeyFlag,
+		flags.SignerTLSCACertFlag,
+		featureconfig.Mainnet,
+		featureconfig.PyrmontTestnet,
+		featureconfig.ToledoTestnet,
+		cmd.AcceptTosFlag,
+	}),
+	Before: func(cliCtx *cli.Context) error {
+		if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+			return err
+		}
+		return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+	},
+	Action: func(cliCtx *cli.Context) error {
+		featureconfig.ConfigureValidator(cliCtx)
+		if err := run(cliCtx); err != nil {
+			log.Fatalf("Could not run signer: %v", err)
+		}
+		return nil
+	},
+}
+
+func run(cliCtx *cli.Context) error {
+	w, err := wallet.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
+		return nil, wallet.ErrNoWalletFound
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not open wallet")
+	}
+	if kind := w.KeymanagerKind(); kind != keymanager.Imported && kind != keymanager.Derived {
+		return errors.Errorf("signer only supports imported or derived wallets, not %s", kind)
+	}
+	km, err := w.InitializeKeymanager(cliCtx.Context)
+	if err != nil {
+		return errors.Wrap(err, "could not initialize keymanager")
+	}
+	log.WithFields(logrus.Fields{
+		"wallet":          w.AccountsDir(),
+		"keymanager-kind": w.KeymanagerKind().String(),
+	}).Info("Opened validator wallet")
+
+	dataDir := w.AccountsDir()
+	if cliCtx.String(cmd.DataDirFlag.Name) != cmd.DefaultDataDir() {
+		dataDir = cliCtx.String(cmd.DataDirFlag.Name)
+	}
+	log.WithField("databasePath", dataDir).Info("Checking DB")
+	valDB, err := kv.NewKVStore(cliCtx.Context, dataDir, nil)
+	if err != nil {
+		return errors.Wrap(err, "could not initialize db")
+	}
+	defer func() {
+		if err := valDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+	if err := valDB.RunMigrations(cliCtx.Context); err != nil {
+		return errors.Wrap(err, "could not run database migration")
+	}
+
+	server := NewServer(cliCtx.Context, &Config{
+		Host:       cliCtx.String(flags.SignerHostFlag.Name),
+		Port:       cliCtx.Int(flags.SignerPortFlag.Name),
+		CertPath:   cliCtx.String(flags.SignerTLSCertFlag.Name),
+		KeyPath:    cliCtx.String(flags.SignerTLSKeyFlag.Name),
+		CACertPath: cliCtx.String(flags.SignerTLSCACertFlag.Name),
+		Keymanager: km,
+		ValDB:      valDB,
+	})
+	server.Start()
+	if err := server.Status(); err != nil {
+		return errors.Wrap(err, "could not start signer server")
+	}
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigc)
+	<-sigc
+	log.Info("Got interrupt, shutting down...")
+	return server.Stop()
+}
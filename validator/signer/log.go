@@ -0,0 +1,5 @@
+package signer
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "signer")
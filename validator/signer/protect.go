@@ -0,0 +1,129 @@
+package signer
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+)
+
+var (
+	errDoubleProposal       = errors.New("attempted to sign a double proposal, rejected by slashing protection")
+	errSlashableAttestation = errors.New("attempted to sign a slashable attestation, rejected by slashing protection")
+	errNoObject             = errors.New("sign request does not contain an object to sign")
+	errSigningRootMismatch  = errors.New("signing root does not match the object and domain of the request")
+)
+
+// verifySigningRoot checks the signing root of a request is the one of the object it carries
+// under the requested domain, so slashing protection applies to what is actually signed.
+func verifySigningRoot(req *validatorpb.SignRequest) ([32]byte, error) {
+	var obj interface{}
+	switch o := req.Object.(type) {
+	case *validatorpb.SignRequest_Block:
+		if o.Block != nil && o.Block.Body != nil {
+			obj = o.Block
+		}
+	case *validatorpb.SignRequest_AttestationData:
+		if o.AttestationData != nil && o.AttestationData.Source != nil && o.AttestationData.Target != nil {
+			obj = o.AttestationData
+		}
+	case *validatorpb.SignRequest_AggregateAttestationAndProof:
+		if o.AggregateAttestationAndProof != nil {
+			obj = o.AggregateAttestationAndProof
+		}
+	case *validatorpb.SignRequest_Exit:
+		if o.Exit != nil {
+			obj = o.Exit
+		}
+	case *validatorpb.SignRequest_Slot:
+		obj = o.Slot
+	case *validatorpb.SignRequest_Epoch:
+		obj = o.Epoch
+	}
+	if obj == nil {
+		return [32]byte{}, errNoObject
+	}
+	root, err := helpers.ComputeSigningRoot(obj, req.SignatureDomain)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not compute signing root")
+	}
+	if root != bytesutil.ToBytes32(req.SigningRoot) || len(req.SigningRoot) != len(root) {
+		return [32]byte{}, errSigningRootMismatch
+	}
+	return root, nil
+}
+
+// checkAndRecordHistory rejects slashable block proposals and attestations and otherwise
+// records them in the slashing protection history of the public key.
+func (s *Server) checkAndRecordHistory(
+	ctx context.Context,
+	pubKey [48]byte,
+	req *validatorpb.SignRequest,
+	signingRoot [32]byte,
+) error {
+	switch o := req.Object.(type) {
+	case *validatorpb.SignRequest_Block:
+		return s.checkAndRecordProposal(ctx, pubKey, o.Block.Slot, signingRoot)
+	case *validatorpb.SignRequest_AttestationData:
+		return s.checkAndRecordAttestation(ctx, pubKey, o.AttestationData, signingRoot)
+	}
+	return nil
+}
+
+func (s *Server) checkAndRecordProposal(ctx context.Context, pubKey [48]byte, slot uint64, signingRoot [32]byte) error {
+	prevSigningRoot, exists, err := s.valDB.ProposalHistoryForSlot(ctx, pubKey, slot)
+	if err != nil {
+		return errors.Wrap(err, "could not get proposal history")
+	}
+	if exists {
+		// Signing the very same block again is not slashable.
+		if prevSigningRoot == signingRoot {
+			return nil
+		}
+		return errDoubleProposal
+	}
+	if err := s.valDB.SaveProposalHistoryForSlot(ctx, pubKey, slot, signingRoot[:]); err != nil {
+		return errors.Wrap(err, "could not save proposal history")
+	}
+	return nil
+}
+
+func (s *Server) checkAndRecordAttestation(
+	ctx context.Context,
+	pubKey [48]byte,
+	data *ethpb.AttestationData,
+	signingRoot [32]byte,
+) error {
+	history, err := s.valDB.AttestationHistoryForPubKeyV2(ctx, pubKey)
+	if err != nil {
+		return errors.Wrap(err, "could not get attester history")
+	}
+	slashable, err := client.IsNewAttSlashable(ctx, history, data.Source.Epoch, data.Target.Epoch, signingRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not check if attestation is slashable")
+	}
+	if slashable {
+		return errSlashableAttestation
+	}
+	newHistory, err := kv.MarkAllAsAttestedSinceLatestWrittenEpoch(
+		ctx,
+		history,
+		data.Target.Epoch,
+		&kv.HistoryData{
+			Source:      data.Source.Epoch,
+			SigningRoot: signingRoot[:],
+		},
+	)
+	if err != nil {
+		return errors.Wrapf(err, "could not mark epoch %d as attested", data.Target.Epoch)
+	}
+	if err := s.valDB.SaveAttestationHistoryForPubKeyV2(ctx, pubKey, newHistory); err != nil {
+		return errors.Wrap(err, "could not save attestation history")
+	}
+	return nil
+}
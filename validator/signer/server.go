@@ -0,0 +1,231 @@
+// Package signer defines a slashing protected signer daemon which serves the keys of an
+// imported or derived wallet over the RemoteSigner gRPC API, secured by mutual TLS.
+package signer
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"sync"
+
+	ptypes "github.com/gogo/protobuf/types"
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/pkg/errors"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+// Config options for the signer gRPC server.
+type Config struct {
+	Host       string
+	Port       int
+	CertPath   string
+	KeyPath    string
+	CACertPath string
+	Keymanager keymanager.IKeymanager
+	ValDB      db.Database
+}
+
+// Server implements the RemoteSigner gRPC API, checking every request against
+// the slashing protection history of the validator database before signing it.
+type Server struct {
+	ctx        context.Context
+	cancel     context.CancelFunc
+	host       string
+	port       int
+	certPath   string
+	keyPath    string
+	caCertPath string
+	keymanager keymanager.IKeymanager
+	valDB      db.Database
+	// Slashing protection checks and history updates of concurrent requests must not interleave.
+	protectionLock sync.Mutex
+	listener       net.Listener
+	grpcServer     *grpc.Server
+	startFailure   error
+}
+
+// NewServer instantiates a new signer gRPC server.
+func NewServer(ctx context.Context, cfg *Config) *Server {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Server{
+		ctx:        ctx,
+		cancel:     cancel,
+		host:       cfg.Host,
+		port:       cfg.Port,
+		certPath:   cfg.CertPath,
+		keyPath:    cfg.KeyPath,
+		caCertPath: cfg.CACertPath,
+		keymanager: cfg.Keymanager,
+		valDB:      cfg.ValDB,
+	}
+}
+
+// Start the gRPC server.
+func (s *Server) Start() {
+	creds, err := mutualTLSCredentials(s.certPath, s.keyPath, s.caCertPath)
+	if err != nil {
+		log.WithError(err).Error("Could not load TLS credentials")
+		s.startFailure = err
+		return
+	}
+	address := fmt.Sprintf("%s:%d", s.host, s.port)
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		log.WithError(err).Errorf("Could not listen on address %s", address)
+		s.startFailure = err
+		return
+	}
+	s.listener = lis
+
+	opts := []grpc.ServerOption{
+		grpc.Creds(creds),
+		grpc.UnaryInterceptor(middleware.ChainUnaryServer(
+			recovery.UnaryServerInterceptor(
+				recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
+			),
+			grpc_prometheus.UnaryServerInterceptor,
+		)),
+	}
+	s.grpcServer = grpc.NewServer(opts...)
+	validatorpb.RegisterRemoteSignerServer(s.grpcServer, s)
+
+	go func() {
+		if err := s.grpcServer.Serve(s.listener); err != nil {
+			log.Errorf("Could not serve: %v", err)
+		}
+	}()
+	log.WithFields(logrus.Fields{
+		"address":  address,
+		"crt-path": s.certPath,
+		"ca-path":  s.caCertPath,
+	}).Info("Remote signer gRPC server listening with mutual TLS")
+}
+
+// Stop the gRPC server.
+func (s *Server) Stop() error {
+	s.cancel()
+	if s.grpcServer != nil {
+		s.grpcServer.GracefulStop()
+		log.Debug("Initiated graceful stop of server")
+	}
+	return nil
+}
+
+// Status returns an error if the server could not be started.
+func (s *Server) Status() error {
+	return s.startFailure
+}
+
+// ListValidatingPublicKeys returns the public keys the signer is able to sign with.
+func (s *Server) ListValidatingPublicKeys(ctx context.Context, _ *ptypes.Empty) (*validatorpb.ListPublicKeysResponse, error) {
+	pubKeys, err := s.keymanager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not fetch validating public keys: %v", err)
+	}
+	keys := make([][]byte, len(pubKeys))
+	for i := range pubKeys {
+		keys[i] = bytesutil.SafeCopyBytes(pubKeys[i][:])
+	}
+	return &validatorpb.ListPublicKeysResponse{
+		ValidatingPublicKeys: keys,
+	}, nil
+}
+
+// Sign a request after verifying its signing root matches the object it carries and
+// that signing it would not be slashable given the history of the public key.
+func (s *Server) Sign(ctx context.Context, req *validatorpb.SignRequest) (*validatorpb.SignResponse, error) {
+	if len(req.PublicKey) != params.BeaconConfig().BLSPubkeyLength {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid public key length %d", len(req.PublicKey))
+	}
+	pubKey := bytesutil.ToBytes48(req.PublicKey)
+	known, err := s.hasPublicKey(ctx, pubKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not fetch validating public keys: %v", err)
+	}
+	if !known {
+		return nil, status.Errorf(codes.NotFound, "No signing key found for public key %#x", pubKey)
+	}
+	logFields := logrus.Fields{
+		"publicKey": fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+	}
+	signingRoot, err := verifySigningRoot(req)
+	if err != nil {
+		log.WithFields(logFields).WithError(err).Warn("Denied invalid sign request")
+		return &validatorpb.SignResponse{Status: validatorpb.SignResponse_DENIED}, nil
+	}
+
+	s.protectionLock.Lock()
+	defer s.protectionLock.Unlock()
+	// The history is updated before signing, so a signature is never handed out for
+	// an object which is not recorded in the slashing protection database.
+	if err := s.checkAndRecordHistory(ctx, pubKey, req, signingRoot); err != nil {
+		if errors.Is(err, errDoubleProposal) || errors.Is(err, errSlashableAttestation) {
+			log.WithFields(logFields).WithError(err).Warn("Denied slashable sign request")
+			return &validatorpb.SignResponse{Status: validatorpb.SignResponse_DENIED}, nil
+		}
+		return nil, status.Errorf(codes.Internal, "Could not apply slashing protection: %v", err)
+	}
+	sig, err := s.keymanager.Sign(ctx, req)
+	if err != nil {
+		log.WithFields(logFields).WithError(err).Error("Could not sign request")
+		return &validatorpb.SignResponse{Status: validatorpb.SignResponse_FAILED}, nil
+	}
+	return &validatorpb.SignResponse{
+		Signature: sig.Marshal(),
+		Status:    validatorpb.SignResponse_SUCCEEDED,
+	}, nil
+}
+
+func (s *Server) hasPublicKey(ctx context.Context, pubKey [48]byte) (bool, error) {
+	pubKeys, err := s.keymanager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, k := range pubKeys {
+		if k == pubKey {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// mutualTLSCredentials loads server credentials which require connecting clients to
+// present a certificate signed by the given certificate authority.
+func mutualTLSCredentials(certPath, keyPath, caCertPath string) (credentials.TransportCredentials, error) {
+	if certPath == "" || keyPath == "" || caCertPath == "" {
+		return nil, errors.New("a server certificate, key and certificate authority are required for mutual TLS")
+	}
+	serverPair, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load server key pair")
+	}
+	caCert, err := ioutil.ReadFile(caCertPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read certificate authority")
+	}
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(caCert) {
+		return nil, errors.New("could not parse certificate authority")
+	}
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{serverPair},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}), nil
+}
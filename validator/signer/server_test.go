@@ -0,0 +1,176 @@
+package signer
+
+import (
+	"context"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockKeymanager struct {
+	keys map[[48]byte]bls.SecretKey
+}
+
+func (m *mockKeymanager) FetchValidatingPublicKeys(_ context.Context) ([][48]byte, error) {
+	pubKeys := make([][48]byte, 0, len(m.keys))
+	for pubKey := range m.keys {
+		pubKeys = append(pubKeys, pubKey)
+	}
+	return pubKeys, nil
+}
+
+func (m *mockKeymanager) FetchAllValidatingPublicKeys(ctx context.Context) ([][48]byte, error) {
+	return m.FetchValidatingPublicKeys(ctx)
+}
+
+func (m *mockKeymanager) Sign(_ context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	return m.keys[bytesutil.ToBytes48(req.PublicKey)].Sign(req.SigningRoot), nil
+}
+
+func setupServer(t *testing.T) (*Server, bls.SecretKey) {
+	secretKey, err := bls.RandKey()
+	require.NoError(t, err)
+	pubKey := bytesutil.ToBytes48(secretKey.PublicKey().Marshal())
+	km := &mockKeymanager{keys: map[[48]byte]bls.SecretKey{pubKey: secretKey}}
+	s := NewServer(context.Background(), &Config{
+		Keymanager: km,
+		ValDB:      dbtest.SetupDB(t, [][48]byte{pubKey}),
+	})
+	return s, secretKey
+}
+
+func signRequest(t *testing.T, secretKey bls.SecretKey, obj interface{}) *validatorpb.SignRequest {
+	domain := make([]byte, 32)
+	req := &validatorpb.SignRequest{
+		PublicKey:       secretKey.PublicKey().Marshal(),
+		SignatureDomain: domain,
+	}
+	switch o := obj.(type) {
+	case *ethpb.BeaconBlock:
+		req.Object = &validatorpb.SignRequest_Block{Block: o}
+	case *ethpb.AttestationData:
+		req.Object = &validatorpb.SignRequest_AttestationData{AttestationData: o}
+	case uint64:
+		req.Object = &validatorpb.SignRequest_Epoch{Epoch: o}
+	}
+	root, err := helpers.ComputeSigningRoot(obj, domain)
+	require.NoError(t, err)
+	req.SigningRoot = root[:]
+	return req
+}
+
+func attestationData(source, target uint64, blockRoot byte) *ethpb.AttestationData {
+	return &ethpb.AttestationData{
+		BeaconBlockRoot: bytesutil.PadTo([]byte{blockRoot}, 32),
+		Source:          &ethpb.Checkpoint{Epoch: source, Root: make([]byte, 32)},
+		Target:          &ethpb.Checkpoint{Epoch: target, Root: make([]byte, 32)},
+	}
+}
+
+func TestServer_ListValidatingPublicKeys(t *testing.T) {
+	s, secretKey := setupServer(t)
+	resp, err := s.ListValidatingPublicKeys(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.ValidatingPublicKeys))
+	assert.DeepEqual(t, secretKey.PublicKey().Marshal(), resp.ValidatingPublicKeys[0])
+}
+
+func TestServer_Sign_UnknownPublicKey(t *testing.T) {
+	s, _ := setupServer(t)
+	otherKey, err := bls.RandKey()
+	require.NoError(t, err)
+	_, err = s.Sign(context.Background(), signRequest(t, otherKey, uint64(1)))
+	require.ErrorContains(t, "No signing key found", err)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestServer_Sign_RandaoReveal(t *testing.T) {
+	s, secretKey := setupServer(t)
+	req := signRequest(t, secretKey, uint64(3))
+	resp, err := s.Sign(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, validatorpb.SignResponse_SUCCEEDED, resp.Status)
+	sig, err := bls.SignatureFromBytes(resp.Signature)
+	require.NoError(t, err)
+	assert.Equal(t, true, sig.Verify(secretKey.PublicKey(), req.SigningRoot))
+}
+
+func TestServer_Sign_DeniesInvalidRequests(t *testing.T) {
+	s, secretKey := setupServer(t)
+
+	req := signRequest(t, secretKey, uint64(3))
+	req.SigningRoot = bytesutil.PadTo([]byte("arbitrary"), 32)
+	resp, err := s.Sign(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, validatorpb.SignResponse_DENIED, resp.Status)
+
+	req = signRequest(t, secretKey, uint64(3))
+	req.Object = nil
+	resp, err = s.Sign(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, validatorpb.SignResponse_DENIED, resp.Status)
+}
+
+func TestServer_Sign_DoubleProposal(t *testing.T) {
+	ctx := context.Background()
+	s, secretKey := setupServer(t)
+
+	block := testutil.NewBeaconBlock().Block
+	block.Slot = 5
+	resp, err := s.Sign(ctx, signRequest(t, secretKey, block))
+	require.NoError(t, err)
+	require.Equal(t, validatorpb.SignResponse_SUCCEEDED, resp.Status)
+
+	// Signing the same block again is allowed.
+	resp, err = s.Sign(ctx, signRequest(t, secretKey, block))
+	require.NoError(t, err)
+	require.Equal(t, validatorpb.SignResponse_SUCCEEDED, resp.Status)
+
+	conflicting := testutil.NewBeaconBlock().Block
+	conflicting.Slot = 5
+	conflicting.Body.Graffiti = bytesutil.PadTo([]byte("conflicting"), 32)
+	resp, err = s.Sign(ctx, signRequest(t, secretKey, conflicting))
+	require.NoError(t, err)
+	assert.Equal(t, validatorpb.SignResponse_DENIED, resp.Status)
+	assert.Equal(t, 0, len(resp.Signature))
+}
+
+func TestServer_Sign_SlashableAttestations(t *testing.T) {
+	ctx := context.Background()
+	s, secretKey := setupServer(t)
+
+	resp, err := s.Sign(ctx, signRequest(t, secretKey, attestationData(2, 3, 1)))
+	require.NoError(t, err)
+	require.Equal(t, validatorpb.SignResponse_SUCCEEDED, resp.Status)
+
+	// Double vote for the same target epoch.
+	resp, err = s.Sign(ctx, signRequest(t, secretKey, attestationData(2, 3, 2)))
+	require.NoError(t, err)
+	assert.Equal(t, validatorpb.SignResponse_DENIED, resp.Status)
+
+	// Surrounding vote.
+	resp, err = s.Sign(ctx, signRequest(t, secretKey, attestationData(1, 4, 1)))
+	require.NoError(t, err)
+	assert.Equal(t, validatorpb.SignResponse_DENIED, resp.Status)
+
+	// Attestations which do not conflict with the history are signed.
+	resp, err = s.Sign(ctx, signRequest(t, secretKey, attestationData(3, 4, 1)))
+	require.NoError(t, err)
+	assert.Equal(t, validatorpb.SignResponse_SUCCEEDED, resp.Status)
+}
+
+func TestMutualTLSCredentials_RequiresAllPaths(t *testing.T) {
+	_, err := mutualTLSCredentials("server.crt", "server.key", "")
+	assert.ErrorContains(t, "required for mutual TLS", err)
+}
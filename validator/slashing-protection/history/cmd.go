@@ -0,0 +1,83 @@
+// Package history defines commands to export, import, merge, and validate the slashing
+// protection history of a validator client as EIP-3076 compliant JSON files.
+package history
+
+import (
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/tos"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// Commands for slashing protection history of the Prysm validator client.
+var Commands = &cli.Command{
+	Name:     "slashing-protection",
+	Category: "slashing-protection",
+	Usage:    "defines commands for interacting with the EIP-3076 slashing protection history of the validator client",
+	Subcommands: []*cli.Command{
+		{
+			Name: "export",
+			Description: `exports the complete slashing protection history of a validator database ` +
+				`into an EIP-3076 compliant JSON file`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				flags.SlashingProtectionExportDirFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := exportSlashingProtectionJSON(cliCtx); err != nil {
+					logrus.Fatalf("Could not export slashing protection file: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "import",
+			Description: `validates an EIP-3076 compliant JSON file against the history of a validator ` +
+				`database and imports it if no slashable messages are found`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				flags.SlashingProtectionJSONFileFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := importSlashingProtectionJSON(cliCtx); err != nil {
+					logrus.Fatalf("Could not import slashing protection file: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "merge",
+			Description: `merges several EIP-3076 compliant JSON files into a single one`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.SlashingProtectionJSONFilesFlag,
+				flags.SlashingProtectionExportDirFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := mergeSlashingProtectionJSONs(cliCtx); err != nil {
+					logrus.Fatalf("Could not merge slashing protection files: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "validate",
+			Description: `checks an EIP-3076 compliant JSON file for double proposals, double votes, and surround votes, ` +
+				`within the file and against the history of a validator database if --datadir is set`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				flags.SlashingProtectionJSONFileFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := validateSlashingProtectionJSON(cliCtx); err != nil {
+					logrus.Fatalf("Slashing protection file did not pass validation: %v", err)
+				}
+				return nil
+			},
+		},
+	},
+}
@@ -0,0 +1,202 @@
+package history
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	interchangeformat "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	exportFileName = "slashing_protection.json"
+	mergedFileName = "merged_slashing_protection.json"
+)
+
+func exportSlashingProtectionJSON(cliCtx *cli.Context) error {
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	if !fileutil.FileExists(filepath.Join(dataDir, kv.ProtectionDbFileName)) {
+		return errors.Errorf("no validator database found at %s", dataDir)
+	}
+	outputDir := cliCtx.String(flags.SlashingProtectionExportDirFlag.Name)
+	if outputDir == "" {
+		return errors.Errorf("--%s must be set", flags.SlashingProtectionExportDirFlag.Name)
+	}
+	valDB, err := kv.NewKVStore(cliCtx.Context, dataDir, nil)
+	if err != nil {
+		return errors.Wrap(err, "could not initialize db")
+	}
+	defer func() {
+		if err := valDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+	interchangeJSON, err := interchangeformat.ExportStandardProtectionJSON(cliCtx.Context, valDB)
+	if err != nil {
+		return errors.Wrap(err, "could not export slashing protection history")
+	}
+	outputPath, err := writeInterchangeJSON(outputDir, exportFileName, interchangeJSON)
+	if err != nil {
+		return err
+	}
+	log.WithField("path", outputPath).Info("Exported slashing protection history")
+	return nil
+}
+
+func importSlashingProtectionJSON(cliCtx *cli.Context) error {
+	encoded, interchangeJSON, err := readInterchangeJSON(cliCtx.String(flags.SlashingProtectionJSONFileFlag.Name))
+	if err != nil {
+		return err
+	}
+	valDB, err := kv.NewKVStore(cliCtx.Context, cliCtx.String(cmd.DataDirFlag.Name), nil)
+	if err != nil {
+		return errors.Wrap(err, "could not initialize db")
+	}
+	defer func() {
+		if err := valDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+	if err := valDB.RunMigrations(cliCtx.Context); err != nil {
+		return errors.Wrap(err, "could not run database migration")
+	}
+	conflicts, err := interchangeformat.ValidateStandardProtectionJSON(cliCtx.Context, valDB, interchangeJSON)
+	if err != nil {
+		return errors.Wrap(err, "could not validate slashing protection file")
+	}
+	if err := reportConflicts(conflicts); err != nil {
+		return errors.Wrap(err, "refusing to import")
+	}
+	if err := interchangeformat.ImportStandardProtectionJSON(cliCtx.Context, valDB, bytes.NewReader(encoded)); err != nil {
+		return err
+	}
+	log.Info("Imported slashing protection history")
+	return nil
+}
+
+func mergeSlashingProtectionJSONs(cliCtx *cli.Context) error {
+	paths := cliCtx.StringSlice(flags.SlashingProtectionJSONFilesFlag.Name)
+	if len(paths) < 2 {
+		return errors.Errorf("--%s must list at least two files", flags.SlashingProtectionJSONFilesFlag.Name)
+	}
+	outputDir := cliCtx.String(flags.SlashingProtectionExportDirFlag.Name)
+	if outputDir == "" {
+		return errors.Errorf("--%s must be set", flags.SlashingProtectionExportDirFlag.Name)
+	}
+	interchangeJSONs := make([]*interchangeformat.EIPSlashingProtectionFormat, len(paths))
+	for i, path := range paths {
+		_, interchangeJSON, err := readInterchangeJSON(path)
+		if err != nil {
+			return err
+		}
+		interchangeJSONs[i] = interchangeJSON
+	}
+	merged, err := interchangeformat.MergeStandardProtectionJSONs(interchangeJSONs)
+	if err != nil {
+		return err
+	}
+	outputPath, err := writeInterchangeJSON(outputDir, mergedFileName, merged)
+	if err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"files": len(paths),
+		"path":  outputPath,
+	}).Info("Merged slashing protection files")
+	return nil
+}
+
+func validateSlashingProtectionJSON(cliCtx *cli.Context) error {
+	_, interchangeJSON, err := readInterchangeJSON(cliCtx.String(flags.SlashingProtectionJSONFileFlag.Name))
+	if err != nil {
+		return err
+	}
+	var validatorDB db.Database
+	if cliCtx.IsSet(cmd.DataDirFlag.Name) {
+		dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+		if !fileutil.FileExists(filepath.Join(dataDir, kv.ProtectionDbFileName)) {
+			return errors.Errorf("no validator database found at %s", dataDir)
+		}
+		valDB, err := kv.NewKVStore(cliCtx.Context, dataDir, nil)
+		if err != nil {
+			return errors.Wrap(err, "could not initialize db")
+		}
+		defer func() {
+			if err := valDB.Close(); err != nil {
+				log.WithError(err).Error("Could not close database")
+			}
+		}()
+		validatorDB = valDB
+	} else {
+		log.Info("No validator database given, only checking the file for conflicts")
+	}
+	conflicts, err := interchangeformat.ValidateStandardProtectionJSON(cliCtx.Context, validatorDB, interchangeJSON)
+	if err != nil {
+		return err
+	}
+	if err := reportConflicts(conflicts); err != nil {
+		return err
+	}
+	log.Info("No slashable messages found in slashing protection file")
+	return nil
+}
+
+// reportConflicts logs every conflict found and returns an error if there is any.
+func reportConflicts(conflicts []*interchangeformat.Conflict) error {
+	for _, conflict := range conflicts {
+		log.WithFields(logrus.Fields{
+			"withDatabase": conflict.WithDatabase,
+		}).Error(conflict.String())
+	}
+	if len(conflicts) > 0 {
+		return errors.Errorf("found %d slashable conflicts", len(conflicts))
+	}
+	return nil
+}
+
+func readInterchangeJSON(path string) ([]byte, *interchangeformat.EIPSlashingProtectionFormat, error) {
+	if path == "" {
+		return nil, nil, errors.New("no slashing protection JSON file specified")
+	}
+	expanded, err := fileutil.ExpandPath(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	encoded, err := ioutil.ReadFile(expanded)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "could not read slashing protection file %s", path)
+	}
+	interchangeJSON := &interchangeformat.EIPSlashingProtectionFormat{}
+	if err := json.Unmarshal(encoded, interchangeJSON); err != nil {
+		return nil, nil, errors.Wrapf(err, "could not unmarshal slashing protection file %s", path)
+	}
+	return encoded, interchangeJSON, nil
+}
+
+func writeInterchangeJSON(
+	outputDir string,
+	fileName string,
+	interchangeJSON *interchangeformat.EIPSlashingProtectionFormat,
+) (string, error) {
+	encoded, err := json.MarshalIndent(interchangeJSON, "", "\t")
+	if err != nil {
+		return "", errors.Wrap(err, "could not marshal slashing protection history")
+	}
+	if err := fileutil.MkdirAll(outputDir); err != nil {
+		return "", errors.Wrapf(err, "could not create directory %s", outputDir)
+	}
+	outputPath := filepath.Join(outputDir, fileName)
+	if err := fileutil.WriteFile(outputPath, encoded); err != nil {
+		return "", errors.Wrapf(err, "could not write file to path %s", outputPath)
+	}
+	return outputPath, nil
+}
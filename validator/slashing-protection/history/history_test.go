@@ -0,0 +1,91 @@
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	interchangeformat "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
+	"github.com/urfave/cli/v2"
+)
+
+func cliContext(t *testing.T, dataDir, exportDir, jsonFile string, jsonFiles ...string) *cli.Context {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, "", "")
+	set.String(flags.SlashingProtectionExportDirFlag.Name, "", "")
+	set.String(flags.SlashingProtectionJSONFileFlag.Name, "", "")
+	filesFlag := cli.StringSlice{}
+	set.Var(&filesFlag, flags.SlashingProtectionJSONFilesFlag.Name, "")
+	if dataDir != "" {
+		require.NoError(t, set.Set(cmd.DataDirFlag.Name, dataDir))
+	}
+	require.NoError(t, set.Set(flags.SlashingProtectionExportDirFlag.Name, exportDir))
+	require.NoError(t, set.Set(flags.SlashingProtectionJSONFileFlag.Name, jsonFile))
+	for _, f := range jsonFiles {
+		require.NoError(t, set.Set(flags.SlashingProtectionJSONFilesFlag.Name, f))
+	}
+	cliCtx := cli.NewContext(&app, set, nil)
+	cliCtx.Context = context.Background()
+	return cliCtx
+}
+
+func TestExportMergeValidateImport(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	dataDir := t.TempDir()
+	validatorDB, err := kv.NewKVStore(ctx, dataDir, [][48]byte{pubKey})
+	require.NoError(t, err)
+	require.NoError(t, validatorDB.SaveGenesisValidatorsRoot(ctx, make([]byte, 32)))
+	signingRoot := [32]byte{1}
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, 5, signingRoot[:]))
+	require.NoError(t, validatorDB.Close())
+
+	exportDir := filepath.Join(t.TempDir(), "export")
+	require.NoError(t, exportSlashingProtectionJSON(cliContext(t, dataDir, exportDir, "")))
+	exported := filepath.Join(exportDir, exportFileName)
+
+	// A second file proposing another block at the same slot.
+	conflicting := &interchangeformat.EIPSlashingProtectionFormat{
+		Data: []*interchangeformat.ProtectionData{{
+			Pubkey: fmt.Sprintf("%#x", pubKey),
+			SignedBlocks: []*interchangeformat.SignedBlock{
+				{Slot: "5", SigningRoot: fmt.Sprintf("%#x", [32]byte{2})},
+			},
+		}},
+	}
+	conflicting.Metadata.InterchangeFormatVersion = interchangeformat.INTERCHANGE_FORMAT_VERSION
+	conflicting.Metadata.GenesisValidatorsRoot = fmt.Sprintf("%#x", [32]byte{})
+	encoded, err := json.Marshal(conflicting)
+	require.NoError(t, err)
+	conflictingFile := filepath.Join(t.TempDir(), "conflicting.json")
+	require.NoError(t, ioutil.WriteFile(conflictingFile, encoded, 0600))
+
+	// The exported file is consistent with itself and with the database it came from.
+	require.NoError(t, validateSlashingProtectionJSON(cliContext(t, "", "", exported)))
+	require.NoError(t, validateSlashingProtectionJSON(cliContext(t, dataDir, "", exported)))
+	// The conflicting file is only slashable together with the database.
+	require.NoError(t, validateSlashingProtectionJSON(cliContext(t, "", "", conflictingFile)))
+	err = validateSlashingProtectionJSON(cliContext(t, dataDir, "", conflictingFile))
+	assert.ErrorContains(t, "found 1 slashable conflicts", err)
+	err = importSlashingProtectionJSON(cliContext(t, dataDir, "", conflictingFile))
+	assert.ErrorContains(t, "refusing to import", err)
+
+	mergeDir := filepath.Join(t.TempDir(), "merged")
+	require.NoError(t, mergeSlashingProtectionJSONs(cliContext(t, "", mergeDir, "", exported, conflictingFile)))
+	merged := filepath.Join(mergeDir, mergedFileName)
+	err = validateSlashingProtectionJSON(cliContext(t, "", "", merged))
+	assert.ErrorContains(t, "found 1 slashable conflicts", err)
+
+	// Importing the exported file into a new database succeeds.
+	require.NoError(t, importSlashingProtectionJSON(cliContext(t, t.TempDir(), "", exported)))
+}
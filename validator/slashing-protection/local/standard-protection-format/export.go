@@ -1,14 +1,18 @@
 package interchangeformat
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"sort"
 
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/validator/db"
 )
 
 // ExportStandardProtectionJSON extracts all slashing protection data from a validator database
-// and packages it into an EIP-3076 compliant, standard
+// and packages it into an EIP-3076 compliant, standard JSON struct holding the complete
+// proposal and attestation history of every validator in the database.
 func ExportStandardProtectionJSON(ctx context.Context, validatorDB db.Database) (*EIPSlashingProtectionFormat, error) {
 	interchangeJSON := &EIPSlashingProtectionFormat{}
 	genesisValidatorsRoot, err := validatorDB.GenesisValidatorsRoot(ctx)
@@ -27,30 +31,63 @@ func ExportStandardProtectionJSON(ctx context.Context, validatorDB db.Database)
 	if err != nil {
 		return nil, err
 	}
+	attestedPublicKeys, err := validatorDB.AttestedPublicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
 	dataByPubKey := make(map[[48]byte]*ProtectionData)
+	dataForPubKey := func(pubKey [48]byte) (*ProtectionData, error) {
+		if data, ok := dataByPubKey[pubKey]; ok {
+			return data, nil
+		}
+		pubKeyHex, err := pubKeyToHexString(pubKey[:])
+		if err != nil {
+			return nil, err
+		}
+		dataByPubKey[pubKey] = &ProtectionData{
+			Pubkey:             pubKeyHex,
+			SignedBlocks:       make([]*SignedBlock, 0),
+			SignedAttestations: make([]*SignedAttestation, 0),
+		}
+		return dataByPubKey[pubKey], nil
+	}
 
 	// Extract the signed proposals by public keys.
 	for _, pubKey := range proposedPublicKeys {
-		pubKeyHex, err := pubKeyToHexString(pubKey[:])
+		signedBlocks, err := getSignedBlocksByPubKey(ctx, validatorDB, pubKey)
 		if err != nil {
 			return nil, err
 		}
-		signedBlocks, err := getSignedBlocksByPubKey(ctx, validatorDB, pubKey)
+		data, err := dataForPubKey(pubKey)
 		if err != nil {
 			return nil, err
 		}
-		dataByPubKey[pubKey] = &ProtectionData{
-			Pubkey:             pubKeyHex,
-			SignedBlocks:       signedBlocks,
-			SignedAttestations: nil,
+		data.SignedBlocks = signedBlocks
+	}
+
+	// Extract the signed attestations by public keys, so the exported file
+	// holds the complete signing history of our validators.
+	for _, pubKey := range attestedPublicKeys {
+		signedAttestations, err := getSignedAttestationsByPubKey(ctx, validatorDB, pubKey)
+		if err != nil {
+			return nil, err
+		}
+		data, err := dataForPubKey(pubKey)
+		if err != nil {
+			return nil, err
 		}
+		data.SignedAttestations = signedAttestations
 	}
 
-	// Next we turn our map into a slice as expected by the EIP-3076 JSON standard.
-	dataList := make([]*ProtectionData, 0)
+	// Next we turn our map into a slice as expected by the EIP-3076 JSON standard,
+	// sorted by public key so exports of the same history are identical.
+	dataList := make([]*ProtectionData, 0, len(dataByPubKey))
 	for _, item := range dataByPubKey {
 		dataList = append(dataList, item)
 	}
+	sort.Slice(dataList, func(i, j int) bool {
+		return dataList[i].Pubkey < dataList[j].Pubkey
+	})
 	interchangeJSON.Data = dataList
 	return interchangeJSON, nil
 }
@@ -86,3 +123,50 @@ func getSignedBlocksByPubKey(ctx context.Context, validatorDB db.Database, pubKe
 	}
 	return signedBlocks, nil
 }
+
+// The attesting history of a validator is kept in a ring buffer spanning a weak subjectivity
+// period, so only the targets within a period of the latest epoch written can be exported.
+func getSignedAttestationsByPubKey(ctx context.Context, validatorDB db.Database, pubKey [48]byte) ([]*SignedAttestation, error) {
+	history, err := validatorDB.AttestationHistoryForPubKeyV2(ctx, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	latestEpochWritten, err := history.GetLatestEpochWritten(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lowestSignedTarget, err := validatorDB.LowestSignedTargetEpoch(ctx, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	startTarget := lowestSignedTarget
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	if latestEpochWritten >= wsPeriod && latestEpochWritten-wsPeriod+1 > startTarget {
+		startTarget = latestEpochWritten - wsPeriod + 1
+	}
+	signedAttestations := make([]*SignedAttestation, 0)
+	for target := startTarget; target <= latestEpochWritten; target++ {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		historyData, err := history.GetTargetData(ctx, target)
+		if err != nil {
+			return nil, err
+		}
+		// Targets skipped while the history array grew are zero filled rather than marked as
+		// empty, and are told apart from attestations by their missing signing root.
+		if historyData.IsEmpty() || (historyData.Source == 0 && bytes.Equal(historyData.SigningRoot, params.BeaconConfig().ZeroHash[:])) {
+			continue
+		}
+		signingRootHex, err := rootToHexString(historyData.SigningRoot)
+		if err != nil {
+			return nil, err
+		}
+		signedAttestations = append(signedAttestations, &SignedAttestation{
+			SourceEpoch: fmt.Sprintf("%d", historyData.Source),
+			TargetEpoch: fmt.Sprintf("%d", target),
+			SigningRoot: signingRootHex,
+		})
+	}
+	return signedAttestations, nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
 )
 
@@ -58,3 +59,47 @@ func Test_getSignedBlocksByPubKey(t *testing.T) {
 		assert.DeepEqual(t, blk, signedBlocks[i])
 	}
 }
+
+func Test_getSignedAttestationsByPubKey(t *testing.T) {
+	pubKeys := [][48]byte{
+		{1},
+	}
+	ctx := context.Background()
+	validatorDB := dbtest.SetupDB(t, pubKeys)
+
+	// No attesting history will return empty.
+	signedAtts, err := getSignedAttestationsByPubKey(ctx, validatorDB, pubKeys[0])
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(signedAtts))
+
+	// We mark target 3 and target 5 as attested, leaving target 4 empty.
+	dummyRoot := [32]byte{1}
+	history := kv.NewAttestationHistoryArray(0)
+	history, err = kv.MarkAllAsAttestedSinceLatestWrittenEpoch(ctx, history, 3, &kv.HistoryData{
+		Source:      1,
+		SigningRoot: dummyRoot[:],
+	})
+	require.NoError(t, err)
+	history, err = kv.MarkAllAsAttestedSinceLatestWrittenEpoch(ctx, history, 5, &kv.HistoryData{
+		Source:      3,
+		SigningRoot: make([]byte, 32),
+	})
+	require.NoError(t, err)
+	require.NoError(t, validatorDB.SaveAttestationHistoryForPubKeyV2(ctx, pubKeys[0], history))
+
+	signedAtts, err = getSignedAttestationsByPubKey(ctx, validatorDB, pubKeys[0])
+	require.NoError(t, err)
+	wanted := []*SignedAttestation{
+		{
+			SourceEpoch: "1",
+			TargetEpoch: "3",
+			SigningRoot: fmt.Sprintf("%#x", dummyRoot),
+		},
+		{
+			SourceEpoch: "3",
+			TargetEpoch: "5",
+			SigningRoot: "0x0000000000000000000000000000000000000000000000000000000000000000",
+		},
+	}
+	assert.DeepEqual(t, wanted, signedAtts)
+}
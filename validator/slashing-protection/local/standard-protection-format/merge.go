@@ -0,0 +1,143 @@
+package interchangeformat
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// MergeStandardProtectionJSONs combines several EIP-3076 slashing protection JSON structs, such as
+// the exports of validator clients which ran the same keys, into a single one holding the signing
+// history of all of them. Every input must use the supported interchange format version and the same
+// genesis validators root. Duplicate entries are removed while conflicting ones are all kept, as
+// dropping any of them would lose slashing protection. Use ValidateStandardProtectionJSON to find them.
+func MergeStandardProtectionJSONs(interchangeJSONs []*EIPSlashingProtectionFormat) (*EIPSlashingProtectionFormat, error) {
+	if len(interchangeJSONs) == 0 {
+		return nil, errors.New("no slashing protection JSON to merge")
+	}
+	genesisValidatorsRoot, err := rootFromHex(interchangeJSONs[0].Metadata.GenesisValidatorsRoot)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not a valid root: %v", interchangeJSONs[0].Metadata.GenesisValidatorsRoot, err)
+	}
+	allData := make([]*ProtectionData, 0)
+	for i, interchangeJSON := range interchangeJSONs {
+		if version := interchangeJSON.Metadata.InterchangeFormatVersion; version != INTERCHANGE_FORMAT_VERSION {
+			return nil, fmt.Errorf(
+				"slashing protection JSON %d has version '%s' which is not supported, wanted '%s'",
+				i,
+				version,
+				INTERCHANGE_FORMAT_VERSION,
+			)
+		}
+		gvr, err := rootFromHex(interchangeJSON.Metadata.GenesisValidatorsRoot)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid root: %v", interchangeJSON.Metadata.GenesisValidatorsRoot, err)
+		}
+		if gvr != genesisValidatorsRoot {
+			return nil, fmt.Errorf(
+				"slashing protection JSON %d has genesis validators root %#x, wanted %#x",
+				i,
+				gvr,
+				genesisValidatorsRoot,
+			)
+		}
+		allData = append(allData, interchangeJSON.Data...)
+	}
+
+	signedBlocksByPubKey, err := parseUniqueSignedBlocksByPubKey(allData)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse unique entries for blocks by public key")
+	}
+	signedAttsByPubKey, err := parseUniqueSignedAttestationsByPubKey(allData)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse unique entries for attestations by public key")
+	}
+
+	// Public keys without any signed message are kept in the merged data as well.
+	pubKeys := make([][48]byte, 0)
+	seenPubKeys := make(map[[48]byte]bool)
+	for _, validatorData := range allData {
+		pubKey, err := pubKeyFromHex(validatorData.Pubkey)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid public key: %v", validatorData.Pubkey, err)
+		}
+		if seenPubKeys[pubKey] {
+			continue
+		}
+		seenPubKeys[pubKey] = true
+		pubKeys = append(pubKeys, pubKey)
+	}
+
+	merged := &EIPSlashingProtectionFormat{}
+	merged.Metadata.InterchangeFormatVersion = INTERCHANGE_FORMAT_VERSION
+	merged.Metadata.GenesisValidatorsRoot = fmt.Sprintf("%#x", genesisValidatorsRoot)
+	merged.Data = make([]*ProtectionData, 0, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		signedBlocks := signedBlocksByPubKey[pubKey]
+		if signedBlocks == nil {
+			signedBlocks = make([]*SignedBlock, 0)
+		}
+		if err := sortSignedBlocks(signedBlocks); err != nil {
+			return nil, errors.Wrapf(err, "could not sort signed blocks for key %#x", pubKey)
+		}
+		signedAtts := signedAttsByPubKey[pubKey]
+		if signedAtts == nil {
+			signedAtts = make([]*SignedAttestation, 0)
+		}
+		if err := sortSignedAttestations(signedAtts); err != nil {
+			return nil, errors.Wrapf(err, "could not sort signed attestations for key %#x", pubKey)
+		}
+		merged.Data = append(merged.Data, &ProtectionData{
+			Pubkey:             fmt.Sprintf("%#x", pubKey),
+			SignedBlocks:       signedBlocks,
+			SignedAttestations: signedAtts,
+		})
+	}
+	sort.Slice(merged.Data, func(i, j int) bool {
+		return merged.Data[i].Pubkey < merged.Data[j].Pubkey
+	})
+	return merged, nil
+}
+
+// Sorts signed blocks by slot.
+func sortSignedBlocks(signedBlocks []*SignedBlock) error {
+	slots := make(map[*SignedBlock]uint64, len(signedBlocks))
+	for _, sBlock := range signedBlocks {
+		slot, err := uint64FromString(sBlock.Slot)
+		if err != nil {
+			return fmt.Errorf("%s is not a valid slot: %v", sBlock.Slot, err)
+		}
+		slots[sBlock] = slot
+	}
+	sort.SliceStable(signedBlocks, func(i, j int) bool {
+		return slots[signedBlocks[i]] < slots[signedBlocks[j]]
+	})
+	return nil
+}
+
+// Sorts signed attestations by target epoch, then by source epoch.
+func sortSignedAttestations(signedAtts []*SignedAttestation) error {
+	sources := make(map[*SignedAttestation]uint64, len(signedAtts))
+	targets := make(map[*SignedAttestation]uint64, len(signedAtts))
+	for _, sAtt := range signedAtts {
+		source, err := uint64FromString(sAtt.SourceEpoch)
+		if err != nil {
+			return fmt.Errorf("%s is not a valid epoch: %v", sAtt.SourceEpoch, err)
+		}
+		target, err := uint64FromString(sAtt.TargetEpoch)
+		if err != nil {
+			return fmt.Errorf("%s is not a valid epoch: %v", sAtt.TargetEpoch, err)
+		}
+		sources[sAtt] = source
+		targets[sAtt] = target
+	}
+	sort.SliceStable(signedAtts, func(i, j int) bool {
+		ti, tj := targets[signedAtts[i]], targets[signedAtts[j]]
+		if ti != tj {
+			return ti < tj
+		}
+		return sources[signedAtts[i]] < sources[signedAtts[j]]
+	})
+	return nil
+}
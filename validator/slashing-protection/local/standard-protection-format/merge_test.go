@@ -0,0 +1,91 @@
+package interchangeformat
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func interchangeJSONWithData(genesisValidatorsRoot byte, data ...*ProtectionData) *EIPSlashingProtectionFormat {
+	interchangeJSON := &EIPSlashingProtectionFormat{Data: data}
+	interchangeJSON.Metadata.InterchangeFormatVersion = INTERCHANGE_FORMAT_VERSION
+	interchangeJSON.Metadata.GenesisValidatorsRoot = fmt.Sprintf("%#x", [32]byte{genesisValidatorsRoot})
+	return interchangeJSON
+}
+
+func TestMergeStandardProtectionJSONs(t *testing.T) {
+	pubKey1 := fmt.Sprintf("%#x", [48]byte{1})
+	pubKey2 := fmt.Sprintf("%#x", [48]byte{2})
+	root1 := fmt.Sprintf("%#x", [32]byte{1})
+	root2 := fmt.Sprintf("%#x", [32]byte{2})
+	first := interchangeJSONWithData(1, &ProtectionData{
+		Pubkey: pubKey2,
+		SignedBlocks: []*SignedBlock{
+			{Slot: "10", SigningRoot: root1},
+			{Slot: "2", SigningRoot: root1},
+		},
+		SignedAttestations: []*SignedAttestation{
+			{SourceEpoch: "2", TargetEpoch: "3", SigningRoot: root1},
+		},
+	})
+	second := interchangeJSONWithData(1,
+		&ProtectionData{
+			Pubkey: pubKey2,
+			SignedBlocks: []*SignedBlock{
+				{Slot: "2", SigningRoot: root1},
+				{Slot: "2", SigningRoot: root2},
+			},
+			SignedAttestations: []*SignedAttestation{
+				{SourceEpoch: "2", TargetEpoch: "3", SigningRoot: root1},
+				{SourceEpoch: "0", TargetEpoch: "1"},
+			},
+		},
+		&ProtectionData{Pubkey: pubKey1},
+	)
+
+	merged, err := MergeStandardProtectionJSONs([]*EIPSlashingProtectionFormat{first, second})
+	require.NoError(t, err)
+	assert.Equal(t, first.Metadata, merged.Metadata)
+	require.Equal(t, 2, len(merged.Data))
+	assert.DeepEqual(t, &ProtectionData{
+		Pubkey:             pubKey1,
+		SignedBlocks:       []*SignedBlock{},
+		SignedAttestations: []*SignedAttestation{},
+	}, merged.Data[0])
+	// Duplicates are removed, conflicting entries are all kept.
+	assert.DeepEqual(t, &ProtectionData{
+		Pubkey: pubKey2,
+		SignedBlocks: []*SignedBlock{
+			{Slot: "2", SigningRoot: root1},
+			{Slot: "2", SigningRoot: root2},
+			{Slot: "10", SigningRoot: root1},
+		},
+		SignedAttestations: []*SignedAttestation{
+			{SourceEpoch: "0", TargetEpoch: "1"},
+			{SourceEpoch: "2", TargetEpoch: "3", SigningRoot: root1},
+		},
+	}, merged.Data[1])
+}
+
+func TestMergeStandardProtectionJSONs_Errors(t *testing.T) {
+	_, err := MergeStandardProtectionJSONs(nil)
+	assert.ErrorContains(t, "no slashing protection JSON to merge", err)
+
+	_, err = MergeStandardProtectionJSONs([]*EIPSlashingProtectionFormat{
+		interchangeJSONWithData(1), interchangeJSONWithData(2),
+	})
+	assert.ErrorContains(t, "has genesis validators root", err)
+
+	badVersion := interchangeJSONWithData(1)
+	badVersion.Metadata.InterchangeFormatVersion = "4"
+	_, err = MergeStandardProtectionJSONs([]*EIPSlashingProtectionFormat{interchangeJSONWithData(1), badVersion})
+	assert.ErrorContains(t, "is not supported", err)
+
+	_, err = MergeStandardProtectionJSONs([]*EIPSlashingProtectionFormat{interchangeJSONWithData(1, &ProtectionData{
+		Pubkey:       fmt.Sprintf("%#x", [48]byte{1}),
+		SignedBlocks: []*SignedBlock{{Slot: "BadSlot"}},
+	})})
+	assert.ErrorContains(t, "could not sort signed blocks", err)
+}
@@ -0,0 +1,333 @@
+package interchangeformat
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/validator/db"
+)
+
+// ConflictKind describes why two signed messages of a validator are slashable together.
+type ConflictKind int
+
+const (
+	// DoubleProposal is two different blocks proposed at the same slot.
+	DoubleProposal ConflictKind = iota
+	// DoubleVote is two different attestations with the same target epoch.
+	DoubleVote
+	// SurroundVote is an attestation whose source and target epochs surround those of another.
+	SurroundVote
+)
+
+// String returns a human readable conflict kind.
+func (k ConflictKind) String() string {
+	switch k {
+	case DoubleProposal:
+		return "double proposal"
+	case DoubleVote:
+		return "double vote"
+	case SurroundVote:
+		return "surround vote"
+	default:
+		return fmt.Sprintf("unknown conflict %d", k)
+	}
+}
+
+// Conflict is a slashable pair of signed messages found in slashing protection history.
+type Conflict struct {
+	PubKey [48]byte
+	Kind   ConflictKind
+	// WithDatabase is set when one of the messages comes from the history stored in the
+	// validator database rather than from the slashing protection JSON.
+	WithDatabase bool
+	First        string
+	Second       string
+}
+
+// String returns a human readable description of the conflict.
+func (c *Conflict) String() string {
+	return fmt.Sprintf("%s for key %#x: %s and %s", c.Kind, c.PubKey, c.First, c.Second)
+}
+
+type proposalRecord struct {
+	slot        uint64
+	signingRoot [32]byte
+	fromDB      bool
+}
+
+func (r *proposalRecord) String() string {
+	return fmt.Sprintf("block at slot %d with signing root %#x%s", r.slot, r.signingRoot, originSuffix(r.fromDB))
+}
+
+type attestationRecord struct {
+	source      uint64
+	target      uint64
+	signingRoot [32]byte
+	fromDB      bool
+}
+
+func (r *attestationRecord) String() string {
+	return fmt.Sprintf(
+		"attestation with source %d and target %d with signing root %#x%s",
+		r.source,
+		r.target,
+		r.signingRoot,
+		originSuffix(r.fromDB),
+	)
+}
+
+func originSuffix(fromDB bool) string {
+	if fromDB {
+		return " (validator database)"
+	}
+	return ""
+}
+
+// ValidateStandardProtectionJSON checks the signing history of an EIP-3076 slashing protection JSON
+// for slashable pairs of messages before it is imported. If a validator database is given, the
+// history of every validator in the JSON is also checked against the history already stored in
+// the database. Messages without a signing root cannot be told apart from a repeat of the same
+// message, so they are only reported when their slot or epochs prove a conflict.
+func ValidateStandardProtectionJSON(
+	ctx context.Context,
+	validatorDB db.Database,
+	interchangeJSON *EIPSlashingProtectionFormat,
+) ([]*Conflict, error) {
+	if version := interchangeJSON.Metadata.InterchangeFormatVersion; version != INTERCHANGE_FORMAT_VERSION {
+		return nil, fmt.Errorf(
+			"slashing protection JSON version '%s' is not supported, wanted '%s'",
+			version,
+			INTERCHANGE_FORMAT_VERSION,
+		)
+	}
+	if _, err := rootFromHex(interchangeJSON.Metadata.GenesisValidatorsRoot); err != nil {
+		return nil, fmt.Errorf("%s is not a valid root: %v", interchangeJSON.Metadata.GenesisValidatorsRoot, err)
+	}
+	signedBlocksByPubKey, err := parseUniqueSignedBlocksByPubKey(interchangeJSON.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse unique entries for blocks by public key")
+	}
+	signedAttsByPubKey, err := parseUniqueSignedAttestationsByPubKey(interchangeJSON.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse unique entries for attestations by public key")
+	}
+
+	conflicts := make([]*Conflict, 0)
+	for pubKey, signedBlocks := range signedBlocksByPubKey {
+		proposals, err := proposalRecords(signedBlocks, false)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse signed blocks for key %#x", pubKey)
+		}
+		if validatorDB != nil {
+			dbBlocks, err := getSignedBlocksByPubKey(ctx, validatorDB, pubKey)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not get proposal history for key %#x", pubKey)
+			}
+			dbProposals, err := proposalRecords(dbBlocks, true)
+			if err != nil {
+				return nil, err
+			}
+			proposals = append(proposals, dbProposals...)
+		}
+		conflicts = append(conflicts, doubleProposals(pubKey, proposals)...)
+	}
+	for pubKey, signedAtts := range signedAttsByPubKey {
+		atts, err := attestationRecords(signedAtts, false)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse signed attestations for key %#x", pubKey)
+		}
+		if validatorDB != nil {
+			dbSignedAtts, err := getSignedAttestationsByPubKey(ctx, validatorDB, pubKey)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not get attesting history for key %#x", pubKey)
+			}
+			dbAtts, err := attestationRecords(dbSignedAtts, true)
+			if err != nil {
+				return nil, err
+			}
+			atts = append(atts, dbAtts...)
+		}
+		conflicts = append(conflicts, doubleVotes(pubKey, atts)...)
+		conflicts = append(conflicts, surroundVotes(pubKey, atts)...)
+	}
+	sort.SliceStable(conflicts, func(i, j int) bool {
+		return string(conflicts[i].PubKey[:]) < string(conflicts[j].PubKey[:])
+	})
+	return conflicts, nil
+}
+
+func proposalRecords(signedBlocks []*SignedBlock, fromDB bool) ([]*proposalRecord, error) {
+	records := make([]*proposalRecord, len(signedBlocks))
+	for i, sBlock := range signedBlocks {
+		slot, err := uint64FromString(sBlock.Slot)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid slot: %v", sBlock.Slot, err)
+		}
+		var signingRoot [32]byte
+		// Signing roots are optional in the standard JSON file.
+		if sBlock.SigningRoot != "" {
+			signingRoot, err = rootFromHex(sBlock.SigningRoot)
+			if err != nil {
+				return nil, fmt.Errorf("%s is not a valid root: %v", sBlock.SigningRoot, err)
+			}
+		}
+		records[i] = &proposalRecord{slot: slot, signingRoot: signingRoot, fromDB: fromDB}
+	}
+	return records, nil
+}
+
+func attestationRecords(signedAtts []*SignedAttestation, fromDB bool) ([]*attestationRecord, error) {
+	records := make([]*attestationRecord, len(signedAtts))
+	for i, sAtt := range signedAtts {
+		source, err := uint64FromString(sAtt.SourceEpoch)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid epoch: %v", sAtt.SourceEpoch, err)
+		}
+		target, err := uint64FromString(sAtt.TargetEpoch)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid epoch: %v", sAtt.TargetEpoch, err)
+		}
+		if source > target {
+			return nil, fmt.Errorf("attestation source epoch %d is greater than its target epoch %d", source, target)
+		}
+		var signingRoot [32]byte
+		// Signing roots are optional in the standard JSON file.
+		if sAtt.SigningRoot != "" {
+			signingRoot, err = rootFromHex(sAtt.SigningRoot)
+			if err != nil {
+				return nil, fmt.Errorf("%s is not a valid root: %v", sAtt.SigningRoot, err)
+			}
+		}
+		records[i] = &attestationRecord{source: source, target: target, signingRoot: signingRoot, fromDB: fromDB}
+	}
+	return records, nil
+}
+
+// Signing roots prove two messages differ only if both of them are known.
+func differentSigningRoots(a, b [32]byte) bool {
+	return a != [32]byte{} && b != [32]byte{} && a != b
+}
+
+func doubleProposals(pubKey [48]byte, proposals []*proposalRecord) []*Conflict {
+	bySlot := make(map[uint64][]*proposalRecord)
+	slots := make([]uint64, 0)
+	for _, p := range proposals {
+		if _, ok := bySlot[p.slot]; !ok {
+			slots = append(slots, p.slot)
+		}
+		bySlot[p.slot] = append(bySlot[p.slot], p)
+	}
+	sort.Slice(slots, func(i, j int) bool {
+		return slots[i] < slots[j]
+	})
+	conflicts := make([]*Conflict, 0)
+	for _, slot := range slots {
+		records := bySlot[slot]
+		for i := 0; i < len(records); i++ {
+			for j := i + 1; j < len(records); j++ {
+				// Conflicts within the database are not introduced by the JSON.
+				if records[i].fromDB && records[j].fromDB {
+					continue
+				}
+				if !differentSigningRoots(records[i].signingRoot, records[j].signingRoot) {
+					continue
+				}
+				conflicts = append(conflicts, &Conflict{
+					PubKey:       pubKey,
+					Kind:         DoubleProposal,
+					WithDatabase: records[i].fromDB || records[j].fromDB,
+					First:        records[i].String(),
+					Second:       records[j].String(),
+				})
+			}
+		}
+	}
+	return conflicts
+}
+
+func doubleVotes(pubKey [48]byte, atts []*attestationRecord) []*Conflict {
+	byTarget := make(map[uint64][]*attestationRecord)
+	targets := make([]uint64, 0)
+	for _, att := range atts {
+		if _, ok := byTarget[att.target]; !ok {
+			targets = append(targets, att.target)
+		}
+		byTarget[att.target] = append(byTarget[att.target], att)
+	}
+	sort.Slice(targets, func(i, j int) bool {
+		return targets[i] < targets[j]
+	})
+	conflicts := make([]*Conflict, 0)
+	for _, target := range targets {
+		records := byTarget[target]
+		for i := 0; i < len(records); i++ {
+			for j := i + 1; j < len(records); j++ {
+				// Conflicts within the database are not introduced by the JSON.
+				if records[i].fromDB && records[j].fromDB {
+					continue
+				}
+				if records[i].source == records[j].source &&
+					!differentSigningRoots(records[i].signingRoot, records[j].signingRoot) {
+					continue
+				}
+				conflicts = append(conflicts, &Conflict{
+					PubKey:       pubKey,
+					Kind:         DoubleVote,
+					WithDatabase: records[i].fromDB || records[j].fromDB,
+					First:        records[i].String(),
+					Second:       records[j].String(),
+				})
+			}
+		}
+	}
+	return conflicts
+}
+
+// surroundVotes sweeps attestations by increasing source epoch, keeping the attestation with
+// the highest target among those with a lower source. Any attestation with a lower target is
+// surrounded by it. The widest attestations of the JSON and of the database are tracked apart,
+// so each surrounded attestation is reported against both origins.
+func surroundVotes(pubKey [48]byte, atts []*attestationRecord) []*Conflict {
+	sorted := make([]*attestationRecord, len(atts))
+	copy(sorted, atts)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].source < sorted[j].source
+	})
+	conflicts := make([]*Conflict, 0)
+	var widestFromJSON, widestFromDB *attestationRecord
+	for i := 0; i < len(sorted); {
+		// Attestations sharing a source epoch cannot surround each other.
+		j := i
+		for j < len(sorted) && sorted[j].source == sorted[i].source {
+			j++
+		}
+		group := sorted[i:j]
+		for _, att := range group {
+			for _, widest := range []*attestationRecord{widestFromJSON, widestFromDB} {
+				if widest == nil || att.target >= widest.target || (att.fromDB && widest.fromDB) {
+					continue
+				}
+				conflicts = append(conflicts, &Conflict{
+					PubKey:       pubKey,
+					Kind:         SurroundVote,
+					WithDatabase: att.fromDB || widest.fromDB,
+					First:        widest.String(),
+					Second:       att.String(),
+				})
+			}
+		}
+		for _, att := range group {
+			if att.fromDB {
+				if widestFromDB == nil || att.target > widestFromDB.target {
+					widestFromDB = att
+				}
+			} else if widestFromJSON == nil || att.target > widestFromJSON.target {
+				widestFromJSON = att
+			}
+		}
+		i = j
+	}
+	return conflicts
+}
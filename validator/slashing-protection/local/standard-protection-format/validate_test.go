@@ -0,0 +1,147 @@
+package interchangeformat
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+)
+
+func TestValidateStandardProtectionJSON(t *testing.T) {
+	pubKey := [48]byte{1}
+	root1 := fmt.Sprintf("%#x", [32]byte{1})
+	root2 := fmt.Sprintf("%#x", [32]byte{2})
+	tests := []struct {
+		name      string
+		blocks    []*SignedBlock
+		atts      []*SignedAttestation
+		wantKinds []ConflictKind
+	}{
+		{
+			name: "no conflicts",
+			blocks: []*SignedBlock{
+				{Slot: "1", SigningRoot: root1},
+				{Slot: "2", SigningRoot: root1},
+			},
+			atts: []*SignedAttestation{
+				{SourceEpoch: "1", TargetEpoch: "2", SigningRoot: root1},
+				{SourceEpoch: "2", TargetEpoch: "3", SigningRoot: root1},
+				{SourceEpoch: "2", TargetEpoch: "4", SigningRoot: root1},
+			},
+		},
+		{
+			name: "double proposal",
+			blocks: []*SignedBlock{
+				{Slot: "1", SigningRoot: root1},
+				{Slot: "1", SigningRoot: root2},
+			},
+			wantKinds: []ConflictKind{DoubleProposal},
+		},
+		{
+			name: "missing signing roots are not conflicts",
+			blocks: []*SignedBlock{
+				{Slot: "1", SigningRoot: root1},
+				{Slot: "1"},
+			},
+			atts: []*SignedAttestation{
+				{SourceEpoch: "1", TargetEpoch: "2", SigningRoot: root1},
+				{SourceEpoch: "1", TargetEpoch: "2"},
+			},
+		},
+		{
+			name: "double vote with different roots",
+			atts: []*SignedAttestation{
+				{SourceEpoch: "1", TargetEpoch: "2", SigningRoot: root1},
+				{SourceEpoch: "1", TargetEpoch: "2", SigningRoot: root2},
+			},
+			wantKinds: []ConflictKind{DoubleVote},
+		},
+		{
+			name: "double vote with different sources",
+			atts: []*SignedAttestation{
+				{SourceEpoch: "0", TargetEpoch: "2"},
+				{SourceEpoch: "1", TargetEpoch: "2"},
+			},
+			wantKinds: []ConflictKind{DoubleVote},
+		},
+		{
+			name: "surround vote",
+			atts: []*SignedAttestation{
+				{SourceEpoch: "3", TargetEpoch: "4", SigningRoot: root1},
+				{SourceEpoch: "1", TargetEpoch: "6", SigningRoot: root2},
+			},
+			wantKinds: []ConflictKind{SurroundVote},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interchangeJSON := interchangeJSONWithData(1, &ProtectionData{
+				Pubkey:             fmt.Sprintf("%#x", pubKey),
+				SignedBlocks:       tt.blocks,
+				SignedAttestations: tt.atts,
+			})
+			conflicts, err := ValidateStandardProtectionJSON(context.Background(), nil, interchangeJSON)
+			require.NoError(t, err)
+			require.Equal(t, len(tt.wantKinds), len(conflicts))
+			for i, kind := range tt.wantKinds {
+				assert.Equal(t, kind, conflicts[i].Kind)
+				assert.Equal(t, pubKey, conflicts[i].PubKey)
+				assert.Equal(t, false, conflicts[i].WithDatabase)
+			}
+		})
+	}
+}
+
+func TestValidateStandardProtectionJSON_InvalidAttestation(t *testing.T) {
+	interchangeJSON := interchangeJSONWithData(1, &ProtectionData{
+		Pubkey: fmt.Sprintf("%#x", [48]byte{1}),
+		SignedAttestations: []*SignedAttestation{
+			{SourceEpoch: "3", TargetEpoch: "2"},
+		},
+	})
+	_, err := ValidateStandardProtectionJSON(context.Background(), nil, interchangeJSON)
+	assert.ErrorContains(t, "is greater than its target epoch", err)
+}
+
+func TestValidateStandardProtectionJSON_AgainstDatabase(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	validatorDB := dbtest.SetupDB(t, [][48]byte{pubKey})
+	dbRoot := [32]byte{1}
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, 5, dbRoot[:]))
+	history, err := kv.MarkAllAsAttestedSinceLatestWrittenEpoch(ctx, kv.NewAttestationHistoryArray(0), 10, &kv.HistoryData{
+		Source:      9,
+		SigningRoot: dbRoot[:],
+	})
+	require.NoError(t, err)
+	require.NoError(t, validatorDB.SaveAttestationHistoryForPubKeyV2(ctx, pubKey, history))
+	require.NoError(t, validatorDB.SaveLowestSignedTargetEpoch(ctx, pubKey, 10))
+
+	interchangeJSON := interchangeJSONWithData(1, &ProtectionData{
+		Pubkey: fmt.Sprintf("%#x", pubKey),
+		SignedBlocks: []*SignedBlock{
+			// A repeat of the block in the database is not a conflict.
+			{Slot: "5", SigningRoot: fmt.Sprintf("%#x", dbRoot)},
+			{Slot: "5", SigningRoot: fmt.Sprintf("%#x", [32]byte{2})},
+		},
+		SignedAttestations: []*SignedAttestation{
+			{SourceEpoch: "9", TargetEpoch: "10", SigningRoot: fmt.Sprintf("%#x", dbRoot)},
+			{SourceEpoch: "8", TargetEpoch: "11", SigningRoot: fmt.Sprintf("%#x", [32]byte{2})},
+		},
+	})
+	conflicts, err := ValidateStandardProtectionJSON(ctx, validatorDB, interchangeJSON)
+	require.NoError(t, err)
+	// The conflicting block and attestation are reported both against the repeats in the
+	// file and against the history in the database.
+	require.Equal(t, 4, len(conflicts))
+	kinds := []ConflictKind{DoubleProposal, DoubleProposal, SurroundVote, SurroundVote}
+	withDatabase := []bool{false, true, false, true}
+	for i, conflict := range conflicts {
+		assert.Equal(t, kinds[i], conflict.Kind)
+		assert.Equal(t, withDatabase[i], conflict.WithDatabase, "Wrong origin for conflict %s", conflict)
+	}
+}
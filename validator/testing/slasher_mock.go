@@ -27,6 +27,16 @@ func (ms MockSlasher) HighestAttestations(ctx context.Context, req *slashpb.High
 	}, nil
 }
 
+// AttesterSlashings will return an empty array of attester slashings.
+func (ms MockSlasher) AttesterSlashings(_ context.Context, _ *slashpb.SlashingsRequest, _ ...grpc.CallOption) (*slashpb.AttesterSlashingsResponse, error) {
+	return &slashpb.AttesterSlashingsResponse{}, nil
+}
+
+// ProposerSlashings will return an empty array of proposer slashings.
+func (ms MockSlasher) ProposerSlashings(_ context.Context, _ *slashpb.SlashingsRequest, _ ...grpc.CallOption) (*slashpb.ProposerSlashingsResponse, error) {
+	return &slashpb.ProposerSlashingsResponse{}, nil
+}
+
 // IsSlashableAttestation returns slashbale attestation if slash attestation is set to true.
 func (ms MockSlasher) IsSlashableAttestation(_ context.Context, in *eth.IndexedAttestation, _ ...grpc.CallOption) (*slashpb.AttesterSlashingResponse, error) {
 	ms.IsSlashableAttestationCalled = true